@@ -0,0 +1,104 @@
+package cel
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+
+	"github.com/filtex/filtex-go/builders/cel/types"
+	"github.com/filtex/filtex-go/constants"
+	"github.com/filtex/filtex-go/errors"
+	"github.com/filtex/filtex-go/models"
+	"github.com/filtex/filtex-go/utils"
+)
+
+type CelEnvironmentBuilder struct {
+	typesMap map[constants.FieldType]string
+}
+
+var (
+	identifierPattern = regexp.MustCompile(`^[_a-zA-Z][_a-zA-Z0-9]*$`)
+	reservedWords     = []string{
+		"as", "break", "const", "continue", "else", "false", "for", "function", "if", "import",
+		"in", "let", "loop", "package", "namespace", "null", "return", "true", "var", "void", "while",
+	}
+)
+
+func NewCelEnvironmentBuilder() *CelEnvironmentBuilder {
+	return &CelEnvironmentBuilder{
+		typesMap: map[constants.FieldType]string{
+			constants.FieldTypeString:   "string",
+			constants.FieldTypeNumber:   "double",
+			constants.FieldTypeInteger:  "int",
+			constants.FieldTypeDecimal:  "double",
+			constants.FieldTypeBoolean:  "bool",
+			constants.FieldTypeDate:     "google.protobuf.Timestamp",
+			constants.FieldTypeTime:     "int",
+			constants.FieldTypeDuration: "google.protobuf.Duration",
+			constants.FieldTypeDateTime: "google.protobuf.Timestamp",
+			constants.FieldTypeUUID:     "string",
+			constants.FieldTypeEnum:     "dyn",
+			constants.FieldTypeIP:       "string",
+			constants.FieldTypeGeoPoint: "dyn",
+		},
+	}
+}
+
+func (b *CelEnvironmentBuilder) Build(metadata *models.Metadata) (*types.CelEnvironment, error) {
+	if metadata == nil {
+		return nil, errors.NewCouldNotBeBuiltError()
+	}
+
+	declarations := make([]types.CelDeclaration, 0)
+	names := make(map[string]bool)
+
+	for _, v := range metadata.Fields {
+		if !isIdentifier(v.Name) || names[v.Name] {
+			return nil, errors.NewInvalidFieldNameError()
+		}
+
+		celType, ok := b.celType(constants.FieldType(v.Type))
+		if !ok {
+			return nil, errors.NewInvalidFieldTypeError()
+		}
+
+		names[v.Name] = true
+		declarations = append(declarations, types.CelDeclaration{
+			Name: v.Name,
+			Type: celType,
+		})
+	}
+
+	return &types.CelEnvironment{
+		Declarations: declarations,
+	}, nil
+}
+
+func (b *CelEnvironmentBuilder) celType(fieldType constants.FieldType) (string, bool) {
+	if fieldType.IsArray() {
+		for k, v := range b.typesMap {
+			if k.ToArray() == fieldType {
+				return fmt.Sprintf("list(%s)", v), true
+			}
+		}
+
+		return "", false
+	}
+
+	celType, ok := b.typesMap[fieldType]
+	return celType, ok
+}
+
+func isIdentifier(field string) bool {
+	for _, v := range strings.Split(field, ".") {
+		if !identifierPattern.MatchString(v) {
+			return false
+		}
+
+		if utils.IsInAny(v, reservedWords) {
+			return false
+		}
+	}
+
+	return true
+}
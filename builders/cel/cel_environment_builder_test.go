@@ -0,0 +1,130 @@
+package cel
+
+import (
+	"testing"
+
+	"github.com/filtex/filtex-go/builders/cel/types"
+	"github.com/filtex/filtex-go/constants"
+	"github.com/filtex/filtex-go/errors"
+	"github.com/filtex/filtex-go/models"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestEnvironmentBuild_ShouldReturnError_WhenMetadataIsNil(t *testing.T) {
+	// Arrange
+	builder := NewCelEnvironmentBuilder()
+
+	// Act
+	environment, err := builder.Build(nil)
+
+	// Assert
+	assert.Nil(t, environment)
+	assert.Error(t, err)
+}
+
+func TestEnvironmentBuild_ShouldReturnDeclarations_WhenFieldsAreValid(t *testing.T) {
+	// Arrange
+	builder := NewCelEnvironmentBuilder()
+	metadata := &models.Metadata{
+		Fields: []models.Field{
+			{Name: "name", Type: constants.FieldTypeString.String()},
+			{Name: "score", Type: constants.FieldTypeNumber.String()},
+			{Name: "age", Type: constants.FieldTypeInteger.String()},
+			{Name: "price", Type: constants.FieldTypeDecimal.String()},
+			{Name: "enabled", Type: constants.FieldTypeBoolean.String()},
+			{Name: "birthDate", Type: constants.FieldTypeDate.String()},
+			{Name: "opensAt", Type: constants.FieldTypeTime.String()},
+			{Name: "timeout", Type: constants.FieldTypeDuration.String()},
+			{Name: "createdAt", Type: constants.FieldTypeDateTime.String()},
+			{Name: "id", Type: constants.FieldTypeUUID.String()},
+			{Name: "status", Type: constants.FieldTypeEnum.String()},
+			{Name: "address", Type: constants.FieldTypeIP.String()},
+			{Name: "location", Type: constants.FieldTypeGeoPoint.String()},
+			{Name: "tags", Type: constants.FieldTypeStringArray.String()},
+			{Name: "dates", Type: constants.FieldTypeDateTimeArray.String()},
+			{Name: "user.name", Type: constants.FieldTypeString.String()},
+		},
+	}
+
+	// Act
+	environment, err := builder.Build(metadata)
+
+	// Assert
+	assert.NoError(t, err)
+	assert.Equal(t, &types.CelEnvironment{
+		Declarations: []types.CelDeclaration{
+			{Name: "name", Type: "string"},
+			{Name: "score", Type: "double"},
+			{Name: "age", Type: "int"},
+			{Name: "price", Type: "double"},
+			{Name: "enabled", Type: "bool"},
+			{Name: "birthDate", Type: "google.protobuf.Timestamp"},
+			{Name: "opensAt", Type: "int"},
+			{Name: "timeout", Type: "google.protobuf.Duration"},
+			{Name: "createdAt", Type: "google.protobuf.Timestamp"},
+			{Name: "id", Type: "string"},
+			{Name: "status", Type: "dyn"},
+			{Name: "address", Type: "string"},
+			{Name: "location", Type: "dyn"},
+			{Name: "tags", Type: "list(string)"},
+			{Name: "dates", Type: "list(google.protobuf.Timestamp)"},
+			{Name: "user.name", Type: "string"},
+		},
+	}, environment)
+}
+
+func TestEnvironmentBuild_ShouldReturnError_WhenFieldNameIsNotIdentifier(t *testing.T) {
+	// Arrange
+	builder := NewCelEnvironmentBuilder()
+	samples := []string{"", "first name", "1st", "user..name", "in", "null"}
+
+	for _, v := range samples {
+		metadata := &models.Metadata{
+			Fields: []models.Field{
+				{Name: v, Type: constants.FieldTypeString.String()},
+			},
+		}
+
+		// Act
+		environment, err := builder.Build(metadata)
+
+		// Assert
+		assert.Nil(t, environment)
+		assert.Equal(t, errors.NewInvalidFieldNameError(), err)
+	}
+}
+
+func TestEnvironmentBuild_ShouldReturnError_WhenFieldNameIsDuplicated(t *testing.T) {
+	// Arrange
+	builder := NewCelEnvironmentBuilder()
+	metadata := &models.Metadata{
+		Fields: []models.Field{
+			{Name: "name", Type: constants.FieldTypeString.String()},
+			{Name: "name", Type: constants.FieldTypeInteger.String()},
+		},
+	}
+
+	// Act
+	environment, err := builder.Build(metadata)
+
+	// Assert
+	assert.Nil(t, environment)
+	assert.Equal(t, errors.NewInvalidFieldNameError(), err)
+}
+
+func TestEnvironmentBuild_ShouldReturnError_WhenFieldTypeIsUnknown(t *testing.T) {
+	// Arrange
+	builder := NewCelEnvironmentBuilder()
+	metadata := &models.Metadata{
+		Fields: []models.Field{
+			{Name: "name", Type: "text"},
+		},
+	}
+
+	// Act
+	environment, err := builder.Build(metadata)
+
+	// Assert
+	assert.Nil(t, environment)
+	assert.Equal(t, errors.NewInvalidFieldTypeError(), err)
+}
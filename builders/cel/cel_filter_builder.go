@@ -0,0 +1,147 @@
+package cel
+
+import (
+	"github.com/filtex/filtex-go/builders/cel/logics"
+	"github.com/filtex/filtex-go/builders/cel/operators"
+	"github.com/filtex/filtex-go/builders/cel/types"
+	"github.com/filtex/filtex-go/constants"
+	"github.com/filtex/filtex-go/errors"
+	"github.com/filtex/filtex-go/expressions"
+	"github.com/filtex/filtex-go/utils"
+)
+
+type CelFilterBuilder struct {
+	fields        map[string]string
+	fieldMapper   func(field string) string
+	logicsMap     map[constants.Logic]func(expressions []types.CelExpression) *types.CelExpression
+	operatorsMap  map[constants.Operator]func(fieldType constants.FieldType, field string, value interface{}) *types.CelExpression
+	collationsMap map[constants.Collation]map[constants.Operator]func(fieldType constants.FieldType, field string, value interface{}) *types.CelExpression
+}
+
+func NewCelFilterBuilder() *CelFilterBuilder {
+	return &CelFilterBuilder{
+		fields: map[string]string{},
+		logicsMap: map[constants.Logic]func(expressions []types.CelExpression) *types.CelExpression{
+			constants.LogicAnd: logics.AndLogic{}.Build,
+			constants.LogicOr:  logics.OrLogic{}.Build,
+		},
+		operatorsMap: map[constants.Operator]func(fieldType constants.FieldType, field string, value interface{}) *types.CelExpression{
+			constants.OperatorEqual:              operators.EqualOperator{}.Build,
+			constants.OperatorNotEqual:           operators.NotEqualOperator{}.Build,
+			constants.OperatorContain:            operators.ContainOperator{}.Build,
+			constants.OperatorNotContain:         operators.NotContainOperator{}.Build,
+			constants.OperatorStartWith:          operators.StartWithOperator{}.Build,
+			constants.OperatorNotStartWith:       operators.NotStartWithOperator{}.Build,
+			constants.OperatorEndWith:            operators.EndWithOperator{}.Build,
+			constants.OperatorNotEndWith:         operators.NotEndWithOperator{}.Build,
+			constants.OperatorBlank:              operators.BlankOperator{}.Build,
+			constants.OperatorNotBlank:           operators.NotBlankOperator{}.Build,
+			constants.OperatorIsNull:             operators.IsNullOperator{}.Build,
+			constants.OperatorIsNotNull:          operators.IsNotNullOperator{}.Build,
+			constants.OperatorGreaterThan:        operators.GreaterThanOperator{}.Build,
+			constants.OperatorGreaterThanOrEqual: operators.GreaterThanOrEqualOperator{}.Build,
+			constants.OperatorLessThan:           operators.LessThanOperator{}.Build,
+			constants.OperatorLessThanOrEqual:    operators.LessThanOrEqualOperator{}.Build,
+			constants.OperatorIn:                 operators.InOperator{}.Build,
+			constants.OperatorNotIn:              operators.NotInOperator{}.Build,
+		},
+		collationsMap: map[constants.Collation]map[constants.Operator]func(fieldType constants.FieldType, field string, value interface{}) *types.CelExpression{
+			constants.CollationCaseSensitive:     newCollationOperatorsMap(constants.CollationCaseSensitive),
+			constants.CollationAccentInsensitive: newCollationOperatorsMap(constants.CollationAccentInsensitive),
+		},
+	}
+}
+
+func newCollationOperatorsMap(collation constants.Collation) map[constants.Operator]func(fieldType constants.FieldType, field string, value interface{}) *types.CelExpression {
+	return map[constants.Operator]func(fieldType constants.FieldType, field string, value interface{}) *types.CelExpression{
+		constants.OperatorEqual:              operators.EqualOperator{Collation: collation}.Build,
+		constants.OperatorNotEqual:           operators.NotEqualOperator{Collation: collation}.Build,
+		constants.OperatorContain:            operators.ContainOperator{Collation: collation}.Build,
+		constants.OperatorNotContain:         operators.NotContainOperator{Collation: collation}.Build,
+		constants.OperatorStartWith:          operators.StartWithOperator{Collation: collation}.Build,
+		constants.OperatorNotStartWith:       operators.NotStartWithOperator{Collation: collation}.Build,
+		constants.OperatorEndWith:            operators.EndWithOperator{Collation: collation}.Build,
+		constants.OperatorNotEndWith:         operators.NotEndWithOperator{Collation: collation}.Build,
+		constants.OperatorIn:                 operators.InOperator{Collation: collation}.Build,
+		constants.OperatorNotIn:              operators.NotInOperator{Collation: collation}.Build,
+		constants.OperatorGreaterThan:        operators.GreaterThanOperator{Collation: collation}.Build,
+		constants.OperatorGreaterThanOrEqual: operators.GreaterThanOrEqualOperator{Collation: collation}.Build,
+		constants.OperatorLessThan:           operators.LessThanOperator{Collation: collation}.Build,
+		constants.OperatorLessThanOrEqual:    operators.LessThanOrEqualOperator{Collation: collation}.Build,
+	}
+}
+
+func (b *CelFilterBuilder) Fields(fields map[string]string) *CelFilterBuilder {
+	for k, v := range fields {
+		b.fields[k] = v
+	}
+	return b
+}
+
+func (b *CelFilterBuilder) FieldMapper(mapper func(field string) string) *CelFilterBuilder {
+	b.fieldMapper = mapper
+	return b
+}
+
+func (b *CelFilterBuilder) Build(ex expressions.Expression) (*types.CelExpression, error) {
+	switch exp := ex.(type) {
+	case *expressions.LogicExpression:
+		expressions := make([]types.CelExpression, 0)
+
+		for _, v := range exp.Expressions {
+			e, err := b.Build(v)
+			if err != nil {
+				return nil, err
+			}
+			expressions = append(expressions, *e)
+		}
+
+		if fn, ok := b.logicsMap[exp.Logic]; ok && len(expressions) > 0 {
+			return fn(expressions), nil
+		}
+
+		return nil, errors.NewCouldNotBeBuiltError()
+	case *expressions.OperatorExpression:
+		field := utils.MapField(b.fields, b.fieldMapper, exp.Field)
+		if !isIdentifier(field) {
+			return nil, errors.NewCouldNotBeBuiltError()
+		}
+
+		if fn, ok := b.findOperator(exp.Operator, exp.Collation); ok {
+			result := fn(exp.Type, field, exp.Value)
+			if result == nil {
+				return nil, errors.NewCouldNotBeBuiltError()
+			}
+
+			return result, nil
+		}
+
+		return nil, errors.NewCouldNotBeBuiltError()
+	case *expressions.SearchExpression:
+		fields := utils.MapFields(b.fields, b.fieldMapper, exp.Fields)
+
+		for _, v := range fields {
+			if !isIdentifier(v) {
+				return nil, errors.NewCouldNotBeBuiltError()
+			}
+		}
+
+		result := operators.SearchOperator{}.Build(fields, exp.Value)
+		if result == nil {
+			return nil, errors.NewCouldNotBeBuiltError()
+		}
+
+		return result, nil
+	}
+
+	return nil, errors.NewCouldNotBeBuiltError()
+}
+
+func (b *CelFilterBuilder) findOperator(operator constants.Operator, collation constants.Collation) (func(fieldType constants.FieldType, field string, value interface{}) *types.CelExpression, bool) {
+	if fn, ok := b.collationsMap[collation][operator]; ok {
+		return fn, true
+	}
+
+	fn, ok := b.operatorsMap[operator]
+	return fn, ok
+}
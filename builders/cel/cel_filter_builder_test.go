@@ -0,0 +1,212 @@
+package cel
+
+import (
+	"testing"
+
+	"github.com/filtex/filtex-go/builders/cel/types"
+	"github.com/filtex/filtex-go/constants"
+	"github.com/filtex/filtex-go/expressions"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestBuild_ShouldReturnError_WhenExpressionIsNil(t *testing.T) {
+	// Arrange
+	builder := NewCelFilterBuilder()
+
+	// Act
+	expression, err := builder.Build(nil)
+
+	// Assert
+	assert.Nil(t, expression)
+	assert.Error(t, err)
+}
+
+func TestBuild_ShouldReturnError_WhenExpressionIsNotValid(t *testing.T) {
+	// Arrange
+	builder := NewCelFilterBuilder()
+
+	// Act
+	expression, err := builder.Build(struct{}{})
+
+	// Assert
+	assert.Nil(t, expression)
+	assert.Error(t, err)
+}
+
+func TestBuild_ShouldReturnError_WhenExpressionIsLogicExpressionAndNotValidLogic(t *testing.T) {
+	// Arrange
+	builder := NewCelFilterBuilder()
+	logicExpression := expressions.NewLogicExpression("", []expressions.Expression{})
+
+	// Act
+	expression, err := builder.Build(logicExpression)
+
+	// Assert
+	assert.Nil(t, expression)
+	assert.Error(t, err)
+}
+
+func TestBuild_ShouldReturnExpression_WhenExpressionIsLogicExpressionAndValid(t *testing.T) {
+	// Arrange
+	builder := NewCelFilterBuilder()
+	logicExpression := expressions.NewLogicExpression(constants.LogicOr, []expressions.Expression{
+		expressions.NewOperatorExpression(constants.FieldTypeInteger, "Age", constants.OperatorGreaterThan, int64(18)),
+		expressions.NewOperatorExpression(constants.FieldTypeBoolean, "Enabled", constants.OperatorEqual, true),
+	})
+
+	// Act
+	expression, err := builder.Build(logicExpression)
+
+	// Assert
+	assert.NoError(t, err)
+	assert.Equal(t, &types.CelExpression{
+		Condition: "(type(Age) != null_type && Age > 18) || (Enabled == true)",
+	}, expression)
+}
+
+func TestBuild_ShouldReturnError_WhenExpressionIsOperatorExpressionAndNotValidOperator(t *testing.T) {
+	// Arrange
+	builder := NewCelFilterBuilder()
+	operatorExpression := expressions.NewOperatorExpression(constants.FieldTypeString, "Value", constants.Operator{}, "Filtex")
+
+	// Act
+	expression, err := builder.Build(operatorExpression)
+
+	// Assert
+	assert.Nil(t, expression)
+	assert.Error(t, err)
+}
+
+func TestBuild_ShouldReturnError_WhenOperatorDoesNotSupportFieldType(t *testing.T) {
+	// Arrange
+	builder := NewCelFilterBuilder()
+	operatorExpression := expressions.NewOperatorExpression(constants.FieldTypeString, "Value", constants.OperatorGreaterThan, "Filtex")
+
+	// Act
+	expression, err := builder.Build(operatorExpression)
+
+	// Assert
+	assert.Nil(t, expression)
+	assert.Error(t, err)
+}
+
+func TestBuild_ShouldReturnError_WhenOperatorIsNotSupported(t *testing.T) {
+	// Arrange
+	builder := NewCelFilterBuilder()
+	operatorExpression := expressions.NewOperatorExpression(constants.FieldTypeIP, "Value", constants.OperatorInSubnet, "10.0.0.0/8")
+
+	// Act
+	expression, err := builder.Build(operatorExpression)
+
+	// Assert
+	assert.Nil(t, expression)
+	assert.Error(t, err)
+}
+
+func TestBuild_ShouldReturnError_WhenFieldIsNotIdentifier(t *testing.T) {
+	// Arrange
+	builder := NewCelFilterBuilder()
+	operatorExpression := expressions.NewOperatorExpression(constants.FieldTypeBoolean, "true || Value", constants.OperatorEqual, true)
+
+	// Act
+	expression, err := builder.Build(operatorExpression)
+
+	// Assert
+	assert.Nil(t, expression)
+	assert.Error(t, err)
+}
+
+func TestBuild_ShouldReturnExpression_WhenExpressionIsOperatorExpressionAndValid(t *testing.T) {
+	// Arrange
+	builder := NewCelFilterBuilder()
+	operatorExpression := expressions.NewOperatorExpression(constants.FieldTypeString, "user.name", constants.OperatorStartWith, "Fil")
+
+	// Act
+	expression, err := builder.Build(operatorExpression)
+
+	// Assert
+	assert.NoError(t, err)
+	assert.Equal(t, &types.CelExpression{
+		Condition: `type(user.name) != null_type && user.name.matches("(?i)^Fil")`,
+	}, expression)
+}
+
+func TestBuild_ShouldUseCollation_WhenExpressionHasCollation(t *testing.T) {
+	// Arrange
+	builder := NewCelFilterBuilder()
+	samples := map[constants.Collation]string{
+		constants.CollationDefault:           `type(Value) != null_type && Value.matches("(?i)^Filtex$")`,
+		constants.CollationCaseInsensitive:   `type(Value) != null_type && Value.matches("(?i)^Filtex$")`,
+		constants.CollationCaseSensitive:     `Value == "Filtex"`,
+		constants.CollationAccentInsensitive: `type(Value) != null_type && Value.matches("(?i)^f[iìíîïĩīĭįı][lĺļľŀł][tţťŧț][eèéêëēĕėęě]x$")`,
+	}
+
+	for k, v := range samples {
+		// Act
+		expression, err := builder.Build(expressions.NewCollatedOperatorExpression(constants.FieldTypeString, "Value", constants.OperatorEqual, "Filtex", k))
+
+		// Assert
+		assert.NoError(t, err)
+		assert.Equal(t, v, expression.Condition)
+	}
+}
+
+func TestBuild_ShouldReturnExpression_WhenExpressionIsSearchExpression(t *testing.T) {
+	// Arrange
+	builder := NewCelFilterBuilder()
+	searchExpression := expressions.NewSearchExpression([]string{"Name", "Tags"}, "Filtex")
+
+	// Act
+	expression, err := builder.Build(searchExpression)
+
+	// Assert
+	assert.NoError(t, err)
+	assert.Contains(t, expression.Condition, "dyn(Name).matches(")
+	assert.Contains(t, expression.Condition, "dyn(Tags).exists(")
+}
+
+func TestBuild_ShouldReturnError_WhenSearchFieldIsNotIdentifier(t *testing.T) {
+	// Arrange
+	builder := NewCelFilterBuilder()
+	searchExpression := expressions.NewSearchExpression([]string{"Name", "in"}, "Filtex")
+
+	// Act
+	expression, err := builder.Build(searchExpression)
+
+	// Assert
+	assert.Nil(t, expression)
+	assert.Error(t, err)
+}
+
+func TestBuild_ShouldMapFields_WhenFieldsAreSet(t *testing.T) {
+	// Arrange
+	builder := NewCelFilterBuilder().
+		Fields(map[string]string{"createdAt": "meta.created"}).
+		FieldMapper(func(field string) string { return "request." + field })
+	logicExpression := expressions.NewLogicExpression(constants.LogicAnd, []expressions.Expression{
+		expressions.NewOperatorExpression(constants.FieldTypeNumber, "createdAt", constants.OperatorIsNull, nil),
+		expressions.NewOperatorExpression(constants.FieldTypeNumber, "version", constants.OperatorIsNull, nil),
+	})
+
+	// Act
+	expression, err := builder.Build(logicExpression)
+
+	// Assert
+	assert.NoError(t, err)
+	assert.Contains(t, expression.Condition, "meta.created")
+	assert.Contains(t, expression.Condition, "request.version")
+	assert.NotContains(t, expression.Condition, "createdAt")
+}
+
+func TestBuild_ShouldReturnError_WhenMappedFieldIsNotIdentifier(t *testing.T) {
+	// Arrange
+	builder := NewCelFilterBuilder().Fields(map[string]string{"createdAt": "created at"})
+	operatorExpression := expressions.NewOperatorExpression(constants.FieldTypeNumber, "createdAt", constants.OperatorIsNull, nil)
+
+	// Act
+	expression, err := builder.Build(operatorExpression)
+
+	// Assert
+	assert.Nil(t, expression)
+	assert.Error(t, err)
+}
@@ -0,0 +1,22 @@
+package logics
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/filtex/filtex-go/builders/cel/types"
+)
+
+type OrLogic struct{}
+
+func (OrLogic) Build(expressions []types.CelExpression) *types.CelExpression {
+	conditions := make([]string, 0)
+
+	for _, v := range expressions {
+		conditions = append(conditions, fmt.Sprintf("(%s)", v.Condition))
+	}
+
+	return &types.CelExpression{
+		Condition: strings.Join(conditions, " || "),
+	}
+}
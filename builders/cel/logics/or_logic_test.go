@@ -0,0 +1,25 @@
+package logics
+
+import (
+	"testing"
+
+	"github.com/filtex/filtex-go/builders/cel/operators"
+	"github.com/filtex/filtex-go/builders/cel/types"
+	"github.com/filtex/filtex-go/constants"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestOrExpression_ShouldReturnExpression_WhenThereAreExpressions(t *testing.T) {
+	// Arrange
+	expressions := []types.CelExpression{
+		*operators.EqualOperator{Collation: constants.CollationCaseSensitive}.Build(constants.FieldTypeString, "Name", "Filtex"),
+		*operators.GreaterThanOperator{}.Build(constants.FieldTypeInteger, "Age", int64(18)),
+	}
+
+	// Act
+	expression := OrLogic{}.Build(expressions)
+
+	// Assert
+	assert.NotNil(t, expression)
+	assert.Equal(t, `(Name == "Filtex") || (type(Age) != null_type && Age > 18)`, expression.Condition)
+}
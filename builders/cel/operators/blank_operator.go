@@ -0,0 +1,29 @@
+package operators
+
+import (
+	"fmt"
+
+	"github.com/filtex/filtex-go/builders/cel/types"
+	"github.com/filtex/filtex-go/constants"
+)
+
+type BlankOperator struct{}
+
+func (BlankOperator) Build(fieldType constants.FieldType, field string, value interface{}) *types.CelExpression {
+	if fieldType.IsArray() {
+		return &types.CelExpression{
+			Condition: fmt.Sprintf("type(%s) == null_type || size(%s) == 0", field, field),
+		}
+	}
+
+	switch fieldType {
+	case constants.FieldTypeString:
+		return &types.CelExpression{
+			Condition: fmt.Sprintf(`type(%s) == null_type || %s == ""`, field, field),
+		}
+	}
+
+	return &types.CelExpression{
+		Condition: fmt.Sprintf("type(%s) == null_type", field),
+	}
+}
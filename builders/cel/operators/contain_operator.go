@@ -0,0 +1,59 @@
+package operators
+
+import (
+	"fmt"
+
+	"github.com/filtex/filtex-go/builders/cel/types"
+	"github.com/filtex/filtex-go/constants"
+	"github.com/filtex/filtex-go/utils"
+)
+
+type ContainOperator struct {
+	Collation constants.Collation
+}
+
+func (o ContainOperator) Build(fieldType constants.FieldType, field string, value interface{}) *types.CelExpression {
+	if fieldType.IsArray() {
+		if fieldType == constants.FieldTypeStringArray && !o.Collation.IsCaseSensitive() {
+			str, err := utils.String(value)
+			if err != nil {
+				return nil
+			}
+
+			return &types.CelExpression{
+				Condition: present(field, fmt.Sprintf("%s.exists(x, x.matches(%s))", field, pattern(o.Collation, "^", str, "$"))),
+			}
+		}
+
+		l, ok := literal(fieldType, value)
+		if !ok {
+			return nil
+		}
+
+		return &types.CelExpression{
+			Condition: present(field, fmt.Sprintf("%s in %s", l, field)),
+		}
+	}
+
+	switch fieldType {
+	case constants.FieldTypeString:
+		str, err := utils.String(value)
+		if err != nil {
+			return nil
+		}
+
+		if o.Collation.IsCaseSensitive() {
+			l, _ := literal(fieldType, str)
+
+			return &types.CelExpression{
+				Condition: present(field, fmt.Sprintf("%s.contains(%s)", field, l)),
+			}
+		}
+
+		return &types.CelExpression{
+			Condition: present(field, fmt.Sprintf("%s.matches(%s)", field, pattern(o.Collation, "", str, ""))),
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,79 @@
+package operators
+
+import (
+	"testing"
+
+	"github.com/filtex/filtex-go/constants"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestContainExpression_ShouldReturnExpression_WhenFieldTypeIsString(t *testing.T) {
+	// Arrange
+	value := "a.b"
+
+	// Act
+	expression := ContainOperator{}.Build(constants.FieldTypeString, "Value", value)
+
+	// Assert
+	assert.NotNil(t, expression)
+	assert.Equal(t, `type(Value) != null_type && Value.matches("(?i)a\\.b")`, expression.Condition)
+}
+
+func TestContainExpression_ShouldReturnExpression_WhenFieldTypeIsStringAndCaseSensitive(t *testing.T) {
+	// Arrange
+	value := "Filtex"
+
+	// Act
+	expression := ContainOperator{Collation: constants.CollationCaseSensitive}.Build(constants.FieldTypeString, "Value", value)
+
+	// Assert
+	assert.NotNil(t, expression)
+	assert.Equal(t, `type(Value) != null_type && Value.contains("Filtex")`, expression.Condition)
+}
+
+func TestContainExpression_ShouldReturnExpression_WhenFieldTypeIsStringArray(t *testing.T) {
+	// Arrange
+	value := "Filtex"
+
+	// Act
+	expression := ContainOperator{}.Build(constants.FieldTypeStringArray, "Value", value)
+
+	// Assert
+	assert.NotNil(t, expression)
+	assert.Equal(t, `type(Value) != null_type && Value.exists(x, x.matches("(?i)^Filtex$"))`, expression.Condition)
+}
+
+func TestContainExpression_ShouldReturnExpression_WhenFieldTypeIsStringArrayAndCaseSensitive(t *testing.T) {
+	// Arrange
+	value := "Filtex"
+
+	// Act
+	expression := ContainOperator{Collation: constants.CollationCaseSensitive}.Build(constants.FieldTypeStringArray, "Value", value)
+
+	// Assert
+	assert.NotNil(t, expression)
+	assert.Equal(t, `type(Value) != null_type && "Filtex" in Value`, expression.Condition)
+}
+
+func TestContainExpression_ShouldReturnExpression_WhenFieldTypeIsIntegerArray(t *testing.T) {
+	// Arrange
+	value := int64(10)
+
+	// Act
+	expression := ContainOperator{}.Build(constants.FieldTypeIntegerArray, "Value", value)
+
+	// Assert
+	assert.NotNil(t, expression)
+	assert.Equal(t, `type(Value) != null_type && 10 in Value`, expression.Condition)
+}
+
+func TestContainExpression_ShouldReturnNil_WhenFieldTypeIsNumber(t *testing.T) {
+	// Arrange
+	value := float64(10)
+
+	// Act
+	expression := ContainOperator{}.Build(constants.FieldTypeNumber, "Value", value)
+
+	// Assert
+	assert.Nil(t, expression)
+}
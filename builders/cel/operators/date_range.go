@@ -0,0 +1,90 @@
+package operators
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/filtex/filtex-go/builders/cel/types"
+	"github.com/filtex/filtex-go/constants"
+	"github.com/filtex/filtex-go/utils"
+)
+
+func hasRange(fieldType constants.FieldType, value interface{}) bool {
+	if fieldType == constants.FieldTypeDate {
+		return true
+	}
+
+	if fieldType != constants.FieldTypeDateTime {
+		return false
+	}
+
+	values, ok := value.([]interface{})
+	if !ok {
+		values = []interface{}{value}
+	}
+
+	for _, v := range values {
+		if _, ok := utils.AsTimeRange(v); ok {
+			return true
+		}
+	}
+
+	return false
+}
+
+func valueRange(fieldType constants.FieldType, value interface{}) (*utils.TimeRange, bool) {
+	if r, ok := utils.AsTimeRange(value); ok {
+		return r, true
+	}
+
+	if fieldType == constants.FieldTypeDate {
+		r, err := utils.DayRange(value)
+		return r, err == nil
+	}
+
+	return nil, false
+}
+
+func equalRange(fieldType constants.FieldType, field string, value interface{}) *types.CelExpression {
+	r, ok := valueRange(fieldType, value)
+	if !ok {
+		if fieldType != constants.FieldTypeDateTime {
+			return nil
+		}
+
+		l, ok := literal(fieldType, value)
+		if !ok {
+			return nil
+		}
+
+		return &types.CelExpression{
+			Condition: fmt.Sprintf("%s == %s", field, l),
+		}
+	}
+
+	return &types.CelExpression{
+		Condition: present(field, fmt.Sprintf("%s >= %s && %s < %s", field, timestampLiteral(r.Start), field, timestampLiteral(r.End))),
+	}
+}
+
+func ranges(fieldType constants.FieldType, field string, value interface{}) *types.CelExpression {
+	values, ok := value.([]interface{})
+	if !ok {
+		values = []interface{}{value}
+	}
+
+	conditions := make([]string, 0)
+
+	for _, v := range values {
+		expression := equalRange(fieldType, field, v)
+		if expression == nil {
+			return nil
+		}
+
+		conditions = append(conditions, fmt.Sprintf("(%s)", expression.Condition))
+	}
+
+	return &types.CelExpression{
+		Condition: strings.Join(conditions, " || "),
+	}
+}
@@ -0,0 +1,43 @@
+package operators
+
+import (
+	"testing"
+
+	"github.com/filtex/filtex-go/constants"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestEndWithExpression_ShouldReturnExpression_WhenFieldTypeIsString(t *testing.T) {
+	// Arrange
+	value := "Filtex"
+
+	// Act
+	expression := EndWithOperator{}.Build(constants.FieldTypeString, "Value", value)
+
+	// Assert
+	assert.NotNil(t, expression)
+	assert.Equal(t, `type(Value) != null_type && Value.matches("(?i)Filtex$")`, expression.Condition)
+}
+
+func TestEndWithExpression_ShouldReturnExpression_WhenFieldTypeIsStringAndCaseSensitive(t *testing.T) {
+	// Arrange
+	value := "Filtex"
+
+	// Act
+	expression := EndWithOperator{Collation: constants.CollationCaseSensitive}.Build(constants.FieldTypeString, "Value", value)
+
+	// Assert
+	assert.NotNil(t, expression)
+	assert.Equal(t, `type(Value) != null_type && Value.endsWith("Filtex")`, expression.Condition)
+}
+
+func TestEndWithExpression_ShouldReturnNil_WhenFieldTypeIsNotString(t *testing.T) {
+	// Arrange
+	value := "Filtex"
+
+	// Act
+	expression := EndWithOperator{}.Build(constants.FieldTypeStringArray, "Value", value)
+
+	// Assert
+	assert.Nil(t, expression)
+}
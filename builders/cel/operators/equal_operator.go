@@ -0,0 +1,43 @@
+package operators
+
+import (
+	"fmt"
+
+	"github.com/filtex/filtex-go/builders/cel/types"
+	"github.com/filtex/filtex-go/constants"
+	"github.com/filtex/filtex-go/utils"
+)
+
+type EqualOperator struct {
+	Collation constants.Collation
+}
+
+func (o EqualOperator) Build(fieldType constants.FieldType, field string, value interface{}) *types.CelExpression {
+	if fieldType.IsArray() || value == nil {
+		return nil
+	}
+
+	if hasRange(fieldType, value) {
+		return equalRange(fieldType, field, value)
+	}
+
+	if fieldType == constants.FieldTypeString && !o.Collation.IsCaseSensitive() {
+		str, err := utils.String(value)
+		if err != nil {
+			return nil
+		}
+
+		return &types.CelExpression{
+			Condition: present(field, fmt.Sprintf("%s.matches(%s)", field, pattern(o.Collation, "^", str, "$"))),
+		}
+	}
+
+	l, ok := literal(fieldType, value)
+	if !ok {
+		return nil
+	}
+
+	return &types.CelExpression{
+		Condition: fmt.Sprintf("%s == %s", field, l),
+	}
+}
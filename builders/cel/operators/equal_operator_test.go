@@ -0,0 +1,164 @@
+package operators
+
+import (
+	"math"
+	"testing"
+	"time"
+
+	"github.com/filtex/filtex-go/constants"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestEqualExpression_ShouldReturnExpression_WhenFieldTypeIsString(t *testing.T) {
+	// Arrange
+	value := "Filtex"
+
+	// Act
+	expression := EqualOperator{}.Build(constants.FieldTypeString, "Value", value)
+
+	// Assert
+	assert.NotNil(t, expression)
+	assert.Equal(t, `type(Value) != null_type && Value.matches("(?i)^Filtex$")`, expression.Condition)
+}
+
+func TestEqualExpression_ShouldReturnExpression_WhenFieldTypeIsStringAndCaseSensitive(t *testing.T) {
+	// Arrange
+	value := "Filtex"
+
+	// Act
+	expression := EqualOperator{Collation: constants.CollationCaseSensitive}.Build(constants.FieldTypeString, "Value", value)
+
+	// Assert
+	assert.NotNil(t, expression)
+	assert.Equal(t, `Value == "Filtex"`, expression.Condition)
+}
+
+func TestEqualExpression_ShouldReturnExpression_WhenFieldTypeIsStringAndAccentInsensitive(t *testing.T) {
+	// Arrange
+	value := "Ça"
+
+	// Act
+	expression := EqualOperator{Collation: constants.CollationAccentInsensitive}.Build(constants.FieldTypeString, "Value", value)
+
+	// Assert
+	assert.NotNil(t, expression)
+	assert.Equal(t, `type(Value) != null_type && Value.matches("(?i)^[cçćĉċč][aàáâãäåāăą]$")`, expression.Condition)
+}
+
+func TestEqualExpression_ShouldReturnExpression_WhenFieldTypeIsNumber(t *testing.T) {
+	// Arrange
+	value := float64(100)
+
+	// Act
+	expression := EqualOperator{}.Build(constants.FieldTypeNumber, "Value", value)
+
+	// Assert
+	assert.NotNil(t, expression)
+	assert.Equal(t, `Value == 100.0`, expression.Condition)
+}
+
+func TestEqualExpression_ShouldReturnExpression_WhenFieldTypeIsInteger(t *testing.T) {
+	// Arrange
+	value := int64(100)
+
+	// Act
+	expression := EqualOperator{}.Build(constants.FieldTypeInteger, "Value", value)
+
+	// Assert
+	assert.NotNil(t, expression)
+	assert.Equal(t, `Value == 100`, expression.Condition)
+}
+
+func TestEqualExpression_ShouldReturnExpression_WhenFieldTypeIsBoolean(t *testing.T) {
+	// Arrange
+	value := true
+
+	// Act
+	expression := EqualOperator{}.Build(constants.FieldTypeBoolean, "Value", value)
+
+	// Assert
+	assert.NotNil(t, expression)
+	assert.Equal(t, `Value == true`, expression.Condition)
+}
+
+func TestEqualExpression_ShouldReturnExpression_WhenFieldTypeIsDate(t *testing.T) {
+	// Arrange
+	value := time.Date(2024, 03, 10, 15, 30, 0, 0, time.UTC)
+
+	// Act
+	expression := EqualOperator{}.Build(constants.FieldTypeDate, "Value", value)
+
+	// Assert
+	assert.NotNil(t, expression)
+	assert.Equal(t, `type(Value) != null_type && Value >= timestamp("2024-03-10T00:00:00Z") && Value < timestamp("2024-03-11T00:00:00Z")`, expression.Condition)
+}
+
+func TestEqualExpression_ShouldReturnExpression_WhenFieldTypeIsDateTime(t *testing.T) {
+	// Arrange
+	value := time.Date(2024, 03, 10, 15, 30, 0, 0, time.UTC)
+
+	// Act
+	expression := EqualOperator{}.Build(constants.FieldTypeDateTime, "Value", value)
+
+	// Assert
+	assert.NotNil(t, expression)
+	assert.Equal(t, `Value == timestamp("2024-03-10T15:30:00Z")`, expression.Condition)
+}
+
+func TestEqualExpression_ShouldReturnExpression_WhenFieldTypeIsTime(t *testing.T) {
+	// Arrange
+	value := 60
+
+	// Act
+	expression := EqualOperator{}.Build(constants.FieldTypeTime, "Value", value)
+
+	// Assert
+	assert.NotNil(t, expression)
+	assert.Equal(t, `Value == 60`, expression.Condition)
+}
+
+func TestEqualExpression_ShouldReturnExpression_WhenFieldTypeIsDuration(t *testing.T) {
+	// Arrange
+	value := 90 * time.Second
+
+	// Act
+	expression := EqualOperator{}.Build(constants.FieldTypeDuration, "Value", value)
+
+	// Assert
+	assert.NotNil(t, expression)
+	assert.Equal(t, `Value == duration("1m30s")`, expression.Condition)
+}
+
+func TestEqualExpression_ShouldReturnExpression_WhenFieldTypeIsEnum(t *testing.T) {
+	// Arrange
+	value := "active"
+
+	// Act
+	expression := EqualOperator{}.Build(constants.FieldTypeEnum, "Value", value)
+
+	// Assert
+	assert.NotNil(t, expression)
+	assert.Equal(t, `Value == "active"`, expression.Condition)
+}
+
+func TestEqualExpression_ShouldReturnNil_WhenFieldTypeIsArray(t *testing.T) {
+	// Arrange
+	value := "Filtex"
+
+	// Act
+	expression := EqualOperator{}.Build(constants.FieldTypeStringArray, "Value", value)
+
+	// Assert
+	assert.Nil(t, expression)
+}
+
+func TestEqualExpression_ShouldReturnNil_WhenValueIsNotValid(t *testing.T) {
+	// Arrange
+	value := math.NaN()
+
+	// Act
+	expression := EqualOperator{}.Build(constants.FieldTypeNumber, "Value", value)
+
+	// Assert
+	assert.Nil(t, expression)
+}
@@ -0,0 +1,46 @@
+package operators
+
+import (
+	"fmt"
+
+	"github.com/filtex/filtex-go/builders/cel/types"
+	"github.com/filtex/filtex-go/constants"
+)
+
+type GreaterThanOperator struct {
+	Collation constants.Collation
+}
+
+func (o GreaterThanOperator) Build(fieldType constants.FieldType, field string, value interface{}) *types.CelExpression {
+	if fieldType != constants.FieldTypeNumber &&
+		fieldType != constants.FieldTypeInteger &&
+		fieldType != constants.FieldTypeDecimal &&
+		fieldType != constants.FieldTypeDate &&
+		fieldType != constants.FieldTypeTime &&
+		fieldType != constants.FieldTypeDuration &&
+		fieldType != constants.FieldTypeDateTime &&
+		fieldType != constants.FieldTypeString &&
+		fieldType != constants.FieldTypeUUID &&
+		fieldType != constants.FieldTypeBoolean {
+		return nil
+	}
+
+	if fieldType == constants.FieldTypeString && !o.Collation.IsCaseSensitive() {
+		return nil
+	}
+
+	if r, ok := valueRange(fieldType, value); ok {
+		return &types.CelExpression{
+			Condition: present(field, fmt.Sprintf("%s >= %s", field, timestampLiteral(r.End))),
+		}
+	}
+
+	l, ok := literal(fieldType, value)
+	if !ok {
+		return nil
+	}
+
+	return &types.CelExpression{
+		Condition: present(field, fmt.Sprintf("%s > %s", field, l)),
+	}
+}
@@ -0,0 +1,60 @@
+package operators
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/filtex/filtex-go/builders/cel/types"
+	"github.com/filtex/filtex-go/constants"
+	"github.com/filtex/filtex-go/utils"
+)
+
+type InOperator struct {
+	Collation constants.Collation
+}
+
+func (o InOperator) Build(fieldType constants.FieldType, field string, value interface{}) *types.CelExpression {
+	if fieldType.IsArray() || value == nil {
+		return nil
+	}
+
+	if hasRange(fieldType, value) {
+		return ranges(fieldType, field, value)
+	}
+
+	if fieldType == constants.FieldTypeString && !o.Collation.IsCaseSensitive() {
+		values, ok := value.([]interface{})
+		if !ok {
+			values = []interface{}{value}
+		}
+
+		patterns := make([]string, 0)
+
+		for _, v := range values {
+			str, err := utils.String(v)
+			if err != nil {
+				return nil
+			}
+
+			patterns = append(patterns, regex(o.Collation, str))
+		}
+
+		if len(patterns) == 0 {
+			return nil
+		}
+
+		return &types.CelExpression{
+			Condition: present(field, fmt.Sprintf("%s.matches(%s)", field, strconv.Quote("(?i)^("+strings.Join(patterns, "|")+")$"))),
+		}
+	}
+
+	l, ok := literals(fieldType, value)
+	if !ok {
+		return nil
+	}
+
+	return &types.CelExpression{
+		Condition: fmt.Sprintf("%s in [%s]", field, strings.Join(l, ", ")),
+	}
+}
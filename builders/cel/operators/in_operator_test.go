@@ -0,0 +1,79 @@
+package operators
+
+import (
+	"testing"
+	"time"
+
+	"github.com/filtex/filtex-go/constants"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestInExpression_ShouldReturnExpression_WhenFieldTypeIsString(t *testing.T) {
+	// Arrange
+	value := []interface{}{"a", "b.c"}
+
+	// Act
+	expression := InOperator{}.Build(constants.FieldTypeString, "Value", value)
+
+	// Assert
+	assert.NotNil(t, expression)
+	assert.Equal(t, `type(Value) != null_type && Value.matches("(?i)^(a|b\\.c)$")`, expression.Condition)
+}
+
+func TestInExpression_ShouldReturnExpression_WhenFieldTypeIsStringAndCaseSensitive(t *testing.T) {
+	// Arrange
+	value := []interface{}{"a", "b"}
+
+	// Act
+	expression := InOperator{Collation: constants.CollationCaseSensitive}.Build(constants.FieldTypeString, "Value", value)
+
+	// Assert
+	assert.NotNil(t, expression)
+	assert.Equal(t, `Value in ["a", "b"]`, expression.Condition)
+}
+
+func TestInExpression_ShouldReturnExpression_WhenFieldTypeIsInteger(t *testing.T) {
+	// Arrange
+	value := []interface{}{int64(1), int64(2)}
+
+	// Act
+	expression := InOperator{}.Build(constants.FieldTypeInteger, "Value", value)
+
+	// Assert
+	assert.NotNil(t, expression)
+	assert.Equal(t, `Value in [1, 2]`, expression.Condition)
+}
+
+func TestInExpression_ShouldReturnExpression_WhenFieldTypeIsDate(t *testing.T) {
+	// Arrange
+	value := []interface{}{time.Date(2024, 03, 10, 0, 0, 0, 0, time.UTC)}
+
+	// Act
+	expression := InOperator{}.Build(constants.FieldTypeDate, "Value", value)
+
+	// Assert
+	assert.NotNil(t, expression)
+	assert.Equal(t, `(type(Value) != null_type && Value >= timestamp("2024-03-10T00:00:00Z") && Value < timestamp("2024-03-11T00:00:00Z"))`, expression.Condition)
+}
+
+func TestInExpression_ShouldReturnNil_WhenFieldTypeIsArray(t *testing.T) {
+	// Arrange
+	value := []interface{}{"a"}
+
+	// Act
+	expression := InOperator{}.Build(constants.FieldTypeStringArray, "Value", value)
+
+	// Assert
+	assert.Nil(t, expression)
+}
+
+func TestInExpression_ShouldReturnNil_WhenValueIsNil(t *testing.T) {
+	// Arrange
+	var value interface{}
+
+	// Act
+	expression := InOperator{}.Build(constants.FieldTypeString, "Value", value)
+
+	// Assert
+	assert.Nil(t, expression)
+}
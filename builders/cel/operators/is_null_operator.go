@@ -0,0 +1,16 @@
+package operators
+
+import (
+	"fmt"
+
+	"github.com/filtex/filtex-go/builders/cel/types"
+	"github.com/filtex/filtex-go/constants"
+)
+
+type IsNullOperator struct{}
+
+func (IsNullOperator) Build(fieldType constants.FieldType, field string, value interface{}) *types.CelExpression {
+	return &types.CelExpression{
+		Condition: fmt.Sprintf("type(%s) == null_type", field),
+	}
+}
@@ -0,0 +1,20 @@
+package operators
+
+import (
+	"testing"
+
+	"github.com/filtex/filtex-go/constants"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestIsNullExpression_ShouldReturnExpression_WhenFieldTypeIsString(t *testing.T) {
+	// Arrange
+	var value interface{}
+
+	// Act
+	expression := IsNullOperator{}.Build(constants.FieldTypeString, "Value", value)
+
+	// Assert
+	assert.NotNil(t, expression)
+	assert.Equal(t, `type(Value) == null_type`, expression.Condition)
+}
@@ -0,0 +1,80 @@
+package operators
+
+import (
+	"testing"
+	"time"
+
+	"github.com/filtex/filtex-go/constants"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestLessThanExpression_ShouldReturnExpression_WhenFieldTypeIsNumber(t *testing.T) {
+	// Arrange
+	value := float64(100)
+
+	// Act
+	expression := LessThanOperator{}.Build(constants.FieldTypeNumber, "Value", value)
+
+	// Assert
+	assert.NotNil(t, expression)
+	assert.Equal(t, `type(Value) != null_type && Value < 100.0`, expression.Condition)
+}
+
+func TestLessThanExpression_ShouldReturnExpression_WhenFieldTypeIsDate(t *testing.T) {
+	// Arrange
+	value := time.Date(2024, 03, 10, 15, 30, 0, 0, time.UTC)
+
+	// Act
+	expression := LessThanOperator{}.Build(constants.FieldTypeDate, "Value", value)
+
+	// Assert
+	assert.NotNil(t, expression)
+	assert.Equal(t, `type(Value) != null_type && Value < timestamp("2024-03-10T00:00:00Z")`, expression.Condition)
+}
+
+func TestLessThanExpression_ShouldReturnExpression_WhenFieldTypeIsDuration(t *testing.T) {
+	// Arrange
+	value := time.Minute
+
+	// Act
+	expression := LessThanOperator{}.Build(constants.FieldTypeDuration, "Value", value)
+
+	// Assert
+	assert.NotNil(t, expression)
+	assert.Equal(t, `type(Value) != null_type && Value < duration("1m0s")`, expression.Condition)
+}
+
+func TestLessThanExpression_ShouldReturnNil_WhenFieldTypeIsString(t *testing.T) {
+	// Arrange
+	value := "Filtex"
+
+	// Act
+	expression := LessThanOperator{}.Build(constants.FieldTypeString, "Value", value)
+
+	// Assert
+	assert.Nil(t, expression)
+}
+
+func TestLessThanExpression_ShouldReturnExpression_WhenFieldTypeIsCaseSensitiveString(t *testing.T) {
+	// Arrange
+	value := "Filtex"
+
+	// Act
+	expression := LessThanOperator{Collation: constants.CollationCaseSensitive}.Build(constants.FieldTypeString, "Value", value)
+
+	// Assert
+	assert.NotNil(t, expression)
+	assert.Equal(t, `type(Value) != null_type && Value < "Filtex"`, expression.Condition)
+}
+
+func TestLessThanExpression_ShouldReturnExpression_WhenFieldTypeIsBoolean(t *testing.T) {
+	// Arrange
+	value := true
+
+	// Act
+	expression := LessThanOperator{}.Build(constants.FieldTypeBoolean, "Value", value)
+
+	// Assert
+	assert.NotNil(t, expression)
+	assert.Equal(t, `type(Value) != null_type && Value < true`, expression.Condition)
+}
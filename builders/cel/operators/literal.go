@@ -0,0 +1,153 @@
+package operators
+
+import (
+	"fmt"
+	"math"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/filtex/filtex-go/builders/cel/types"
+	"github.com/filtex/filtex-go/constants"
+	"github.com/filtex/filtex-go/utils"
+)
+
+func literal(fieldType constants.FieldType, value interface{}) (string, bool) {
+	switch fieldType {
+	case constants.FieldTypeString, constants.FieldTypeStringArray,
+		constants.FieldTypeUUID, constants.FieldTypeUUIDArray,
+		constants.FieldTypeIP, constants.FieldTypeIPArray:
+		s, err := utils.String(value)
+		return strconv.Quote(s), err == nil
+	case constants.FieldTypeNumber, constants.FieldTypeNumberArray,
+		constants.FieldTypeDecimal, constants.FieldTypeDecimalArray:
+		n, err := utils.Number(value)
+		if err != nil {
+			return "", false
+		}
+		return doubleLiteral(n)
+	case constants.FieldTypeInteger, constants.FieldTypeIntegerArray:
+		i, err := utils.Integer(value)
+		return strconv.FormatInt(i, 10), err == nil
+	case constants.FieldTypeBoolean, constants.FieldTypeBooleanArray:
+		b, err := utils.Boolean(value)
+		return strconv.FormatBool(b), err == nil
+	case constants.FieldTypeDate, constants.FieldTypeDateArray,
+		constants.FieldTypeDateTime, constants.FieldTypeDateTimeArray:
+		t, err := utils.DateTime(value)
+		if err != nil || t == nil {
+			return "", false
+		}
+		return timestampLiteral(*t), true
+	case constants.FieldTypeTime, constants.FieldTypeTimeArray:
+		seconds, err := utils.Time(value)
+		if err != nil || seconds == nil {
+			return "", false
+		}
+		return strconv.Itoa(*seconds), true
+	case constants.FieldTypeDuration, constants.FieldTypeDurationArray:
+		d, err := utils.Duration(value)
+		return fmt.Sprintf("duration(%s)", strconv.Quote(d.String())), err == nil
+	case constants.FieldTypeEnum, constants.FieldTypeEnumArray:
+		return enumLiteral(value)
+	}
+
+	return "", false
+}
+
+func enumLiteral(value interface{}) (string, bool) {
+	switch v := value.(type) {
+	case nil:
+		return "", false
+	case string:
+		return strconv.Quote(v), true
+	case bool:
+		return strconv.FormatBool(v), true
+	case float32, float64:
+		n, err := utils.Number(v)
+		if err != nil {
+			return "", false
+		}
+		return doubleLiteral(n)
+	}
+
+	i, err := utils.Integer(value)
+	return strconv.FormatInt(i, 10), err == nil
+}
+
+func doubleLiteral(n float64) (string, bool) {
+	if math.IsNaN(n) || math.IsInf(n, 0) {
+		return "", false
+	}
+
+	s := strconv.FormatFloat(n, 'g', -1, 64)
+	if !strings.ContainsAny(s, ".e") {
+		s += ".0"
+	}
+
+	return s, true
+}
+
+func timestampLiteral(t time.Time) string {
+	return fmt.Sprintf("timestamp(%s)", strconv.Quote(t.Format(time.RFC3339Nano)))
+}
+
+func literals(fieldType constants.FieldType, value interface{}) ([]string, bool) {
+	values, ok := value.([]interface{})
+	if !ok {
+		values = []interface{}{value}
+	}
+
+	result := make([]string, 0)
+
+	for _, v := range values {
+		l, ok := literal(fieldType, v)
+		if !ok {
+			return nil, false
+		}
+		result = append(result, l)
+	}
+
+	return result, len(result) > 0
+}
+
+func pattern(collation constants.Collation, prefix string, value string, suffix string) string {
+	if collation.IsCaseSensitive() {
+		return strconv.Quote(prefix + regex(collation, value) + suffix)
+	}
+
+	return strconv.Quote("(?i)" + prefix + regex(collation, value) + suffix)
+}
+
+func regex(collation constants.Collation, value string) string {
+	if !collation.IsAccentInsensitive() {
+		return regexp.QuoteMeta(value)
+	}
+
+	var builder strings.Builder
+
+	for _, r := range utils.RemoveAccents(value) {
+		if class := utils.AccentInsensitivePattern(string(r)); class != string(r) {
+			builder.WriteString(class)
+		} else {
+			builder.WriteString(regexp.QuoteMeta(string(r)))
+		}
+	}
+
+	return builder.String()
+}
+
+func present(field string, condition string) string {
+	return fmt.Sprintf("type(%s) != null_type && %s", field, condition)
+}
+
+func negate(expression *types.CelExpression) *types.CelExpression {
+	if expression == nil {
+		return nil
+	}
+
+	return &types.CelExpression{
+		Condition: fmt.Sprintf("!(%s)", expression.Condition),
+	}
+}
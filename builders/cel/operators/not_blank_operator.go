@@ -0,0 +1,29 @@
+package operators
+
+import (
+	"fmt"
+
+	"github.com/filtex/filtex-go/builders/cel/types"
+	"github.com/filtex/filtex-go/constants"
+)
+
+type NotBlankOperator struct{}
+
+func (NotBlankOperator) Build(fieldType constants.FieldType, field string, value interface{}) *types.CelExpression {
+	if fieldType.IsArray() {
+		return &types.CelExpression{
+			Condition: present(field, fmt.Sprintf("size(%s) > 0", field)),
+		}
+	}
+
+	switch fieldType {
+	case constants.FieldTypeString:
+		return &types.CelExpression{
+			Condition: present(field, fmt.Sprintf(`%s != ""`, field)),
+		}
+	}
+
+	return &types.CelExpression{
+		Condition: fmt.Sprintf("type(%s) != null_type", field),
+	}
+}
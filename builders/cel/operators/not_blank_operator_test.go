@@ -0,0 +1,44 @@
+package operators
+
+import (
+	"testing"
+
+	"github.com/filtex/filtex-go/constants"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNotBlankExpression_ShouldReturnExpression_WhenFieldTypeIsString(t *testing.T) {
+	// Arrange
+	var value interface{}
+
+	// Act
+	expression := NotBlankOperator{}.Build(constants.FieldTypeString, "Value", value)
+
+	// Assert
+	assert.NotNil(t, expression)
+	assert.Equal(t, `type(Value) != null_type && Value != ""`, expression.Condition)
+}
+
+func TestNotBlankExpression_ShouldReturnExpression_WhenFieldTypeIsArray(t *testing.T) {
+	// Arrange
+	var value interface{}
+
+	// Act
+	expression := NotBlankOperator{}.Build(constants.FieldTypeStringArray, "Value", value)
+
+	// Assert
+	assert.NotNil(t, expression)
+	assert.Equal(t, `type(Value) != null_type && size(Value) > 0`, expression.Condition)
+}
+
+func TestNotBlankExpression_ShouldReturnExpression_WhenFieldTypeIsNumber(t *testing.T) {
+	// Arrange
+	var value interface{}
+
+	// Act
+	expression := NotBlankOperator{}.Build(constants.FieldTypeNumber, "Value", value)
+
+	// Assert
+	assert.NotNil(t, expression)
+	assert.Equal(t, `type(Value) != null_type`, expression.Condition)
+}
@@ -0,0 +1,14 @@
+package operators
+
+import (
+	"github.com/filtex/filtex-go/builders/cel/types"
+	"github.com/filtex/filtex-go/constants"
+)
+
+type NotContainOperator struct {
+	Collation constants.Collation
+}
+
+func (o NotContainOperator) Build(fieldType constants.FieldType, field string, value interface{}) *types.CelExpression {
+	return negate(ContainOperator{Collation: o.Collation}.Build(fieldType, field, value))
+}
@@ -0,0 +1,43 @@
+package operators
+
+import (
+	"testing"
+
+	"github.com/filtex/filtex-go/constants"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNotContainExpression_ShouldReturnExpression_WhenFieldTypeIsString(t *testing.T) {
+	// Arrange
+	value := "Filtex"
+
+	// Act
+	expression := NotContainOperator{}.Build(constants.FieldTypeString, "Value", value)
+
+	// Assert
+	assert.NotNil(t, expression)
+	assert.Equal(t, `!(type(Value) != null_type && Value.matches("(?i)Filtex"))`, expression.Condition)
+}
+
+func TestNotContainExpression_ShouldReturnExpression_WhenFieldTypeIsStringArrayAndCaseSensitive(t *testing.T) {
+	// Arrange
+	value := "Filtex"
+
+	// Act
+	expression := NotContainOperator{Collation: constants.CollationCaseSensitive}.Build(constants.FieldTypeStringArray, "Value", value)
+
+	// Assert
+	assert.NotNil(t, expression)
+	assert.Equal(t, `!(type(Value) != null_type && "Filtex" in Value)`, expression.Condition)
+}
+
+func TestNotContainExpression_ShouldReturnNil_WhenFieldTypeIsNumber(t *testing.T) {
+	// Arrange
+	value := float64(10)
+
+	// Act
+	expression := NotContainOperator{}.Build(constants.FieldTypeNumber, "Value", value)
+
+	// Assert
+	assert.Nil(t, expression)
+}
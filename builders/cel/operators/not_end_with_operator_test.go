@@ -0,0 +1,31 @@
+package operators
+
+import (
+	"testing"
+
+	"github.com/filtex/filtex-go/constants"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNotEndWithExpression_ShouldReturnExpression_WhenFieldTypeIsString(t *testing.T) {
+	// Arrange
+	value := "Filtex"
+
+	// Act
+	expression := NotEndWithOperator{}.Build(constants.FieldTypeString, "Value", value)
+
+	// Assert
+	assert.NotNil(t, expression)
+	assert.Equal(t, `!(type(Value) != null_type && Value.matches("(?i)Filtex$"))`, expression.Condition)
+}
+
+func TestNotEndWithExpression_ShouldReturnNil_WhenFieldTypeIsNotString(t *testing.T) {
+	// Arrange
+	value := "Filtex"
+
+	// Act
+	expression := NotEndWithOperator{}.Build(constants.FieldTypeNumber, "Value", value)
+
+	// Assert
+	assert.Nil(t, expression)
+}
@@ -0,0 +1,14 @@
+package operators
+
+import (
+	"github.com/filtex/filtex-go/builders/cel/types"
+	"github.com/filtex/filtex-go/constants"
+)
+
+type NotEqualOperator struct {
+	Collation constants.Collation
+}
+
+func (o NotEqualOperator) Build(fieldType constants.FieldType, field string, value interface{}) *types.CelExpression {
+	return negate(EqualOperator{Collation: o.Collation}.Build(fieldType, field, value))
+}
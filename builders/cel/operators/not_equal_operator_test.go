@@ -0,0 +1,43 @@
+package operators
+
+import (
+	"testing"
+
+	"github.com/filtex/filtex-go/constants"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNotEqualExpression_ShouldReturnExpression_WhenFieldTypeIsString(t *testing.T) {
+	// Arrange
+	value := "Filtex"
+
+	// Act
+	expression := NotEqualOperator{}.Build(constants.FieldTypeString, "Value", value)
+
+	// Assert
+	assert.NotNil(t, expression)
+	assert.Equal(t, `!(type(Value) != null_type && Value.matches("(?i)^Filtex$"))`, expression.Condition)
+}
+
+func TestNotEqualExpression_ShouldReturnExpression_WhenFieldTypeIsNumber(t *testing.T) {
+	// Arrange
+	value := 1.5
+
+	// Act
+	expression := NotEqualOperator{}.Build(constants.FieldTypeNumber, "Value", value)
+
+	// Assert
+	assert.NotNil(t, expression)
+	assert.Equal(t, `!(Value == 1.5)`, expression.Condition)
+}
+
+func TestNotEqualExpression_ShouldReturnNil_WhenFieldTypeIsArray(t *testing.T) {
+	// Arrange
+	value := "Filtex"
+
+	// Act
+	expression := NotEqualOperator{}.Build(constants.FieldTypeStringArray, "Value", value)
+
+	// Assert
+	assert.Nil(t, expression)
+}
@@ -0,0 +1,31 @@
+package operators
+
+import (
+	"testing"
+
+	"github.com/filtex/filtex-go/constants"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNotInExpression_ShouldReturnExpression_WhenFieldTypeIsInteger(t *testing.T) {
+	// Arrange
+	value := []interface{}{int64(1), int64(2)}
+
+	// Act
+	expression := NotInOperator{}.Build(constants.FieldTypeInteger, "Value", value)
+
+	// Assert
+	assert.NotNil(t, expression)
+	assert.Equal(t, `!(Value in [1, 2])`, expression.Condition)
+}
+
+func TestNotInExpression_ShouldReturnNil_WhenValueIsNil(t *testing.T) {
+	// Arrange
+	var value interface{}
+
+	// Act
+	expression := NotInOperator{}.Build(constants.FieldTypeString, "Value", value)
+
+	// Assert
+	assert.Nil(t, expression)
+}
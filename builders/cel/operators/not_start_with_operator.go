@@ -0,0 +1,14 @@
+package operators
+
+import (
+	"github.com/filtex/filtex-go/builders/cel/types"
+	"github.com/filtex/filtex-go/constants"
+)
+
+type NotStartWithOperator struct {
+	Collation constants.Collation
+}
+
+func (o NotStartWithOperator) Build(fieldType constants.FieldType, field string, value interface{}) *types.CelExpression {
+	return negate(StartWithOperator{Collation: o.Collation}.Build(fieldType, field, value))
+}
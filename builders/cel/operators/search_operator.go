@@ -0,0 +1,41 @@
+package operators
+
+import (
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+	"unicode"
+
+	"github.com/filtex/filtex-go/builders/cel/types"
+)
+
+type SearchOperator struct{}
+
+func (SearchOperator) Build(fields []string, value string) *types.CelExpression {
+	terms := strings.FieldsFunc(strings.ToLower(value), func(r rune) bool {
+		return !unicode.IsLetter(r) && !unicode.IsDigit(r)
+	})
+
+	if len(fields) == 0 || len(terms) == 0 {
+		return nil
+	}
+
+	conditions := make([]string, 0)
+
+	for _, term := range terms {
+		p := strconv.Quote(`(?i)(^|[^\pL\pN])` + regexp.QuoteMeta(term) + `([^\pL\pN]|$)`)
+		matches := make([]string, 0)
+
+		for _, field := range fields {
+			matches = append(matches, fmt.Sprintf("(type(%s) == string && dyn(%s).matches(%s))", field, field, p))
+			matches = append(matches, fmt.Sprintf("(type(%s) == list && dyn(%s).exists(x, x.matches(%s)))", field, field, p))
+		}
+
+		conditions = append(conditions, fmt.Sprintf("(%s)", strings.Join(matches, " || ")))
+	}
+
+	return &types.CelExpression{
+		Condition: strings.Join(conditions, " && "),
+	}
+}
@@ -0,0 +1,55 @@
+package operators
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSearchExpression_ShouldReturnExpression_WhenThereIsOneField(t *testing.T) {
+	// Arrange
+	value := "Filtex"
+
+	// Act
+	expression := SearchOperator{}.Build([]string{"Value"}, value)
+
+	// Assert
+	assert.NotNil(t, expression)
+	assert.Equal(t, `((type(Value) == string && dyn(Value).matches("(?i)(^|[^\\pL\\pN])filtex([^\\pL\\pN]|$)")) || (type(Value) == list && dyn(Value).exists(x, x.matches("(?i)(^|[^\\pL\\pN])filtex([^\\pL\\pN]|$)"))))`, expression.Condition)
+}
+
+func TestSearchExpression_ShouldReturnExpression_WhenThereAreMultipleTerms(t *testing.T) {
+	// Arrange
+	value := "Filtex, Go"
+
+	// Act
+	expression := SearchOperator{}.Build([]string{"Name", "Tags"}, value)
+
+	// Assert
+	assert.NotNil(t, expression)
+	assert.Contains(t, expression.Condition, `dyn(Name).matches("(?i)(^|[^\\pL\\pN])filtex([^\\pL\\pN]|$)")`)
+	assert.Contains(t, expression.Condition, `dyn(Tags).exists(x, x.matches("(?i)(^|[^\\pL\\pN])go([^\\pL\\pN]|$)"))`)
+	assert.Contains(t, expression.Condition, `)) && ((`)
+}
+
+func TestSearchExpression_ShouldReturnNil_WhenThereAreNoFields(t *testing.T) {
+	// Arrange
+	value := "Filtex"
+
+	// Act
+	expression := SearchOperator{}.Build([]string{}, value)
+
+	// Assert
+	assert.Nil(t, expression)
+}
+
+func TestSearchExpression_ShouldReturnNil_WhenThereAreNoTerms(t *testing.T) {
+	// Arrange
+	value := " ,. "
+
+	// Act
+	expression := SearchOperator{}.Build([]string{"Value"}, value)
+
+	// Assert
+	assert.Nil(t, expression)
+}
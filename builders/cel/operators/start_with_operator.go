@@ -0,0 +1,36 @@
+package operators
+
+import (
+	"fmt"
+
+	"github.com/filtex/filtex-go/builders/cel/types"
+	"github.com/filtex/filtex-go/constants"
+	"github.com/filtex/filtex-go/utils"
+)
+
+type StartWithOperator struct {
+	Collation constants.Collation
+}
+
+func (o StartWithOperator) Build(fieldType constants.FieldType, field string, value interface{}) *types.CelExpression {
+	if fieldType != constants.FieldTypeString {
+		return nil
+	}
+
+	str, err := utils.String(value)
+	if err != nil {
+		return nil
+	}
+
+	if o.Collation.IsCaseSensitive() {
+		l, _ := literal(fieldType, str)
+
+		return &types.CelExpression{
+			Condition: present(field, fmt.Sprintf("%s.startsWith(%s)", field, l)),
+		}
+	}
+
+	return &types.CelExpression{
+		Condition: present(field, fmt.Sprintf("%s.matches(%s)", field, pattern(o.Collation, "^", str, ""))),
+	}
+}
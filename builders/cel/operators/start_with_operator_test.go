@@ -0,0 +1,43 @@
+package operators
+
+import (
+	"testing"
+
+	"github.com/filtex/filtex-go/constants"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestStartWithExpression_ShouldReturnExpression_WhenFieldTypeIsString(t *testing.T) {
+	// Arrange
+	value := "Filtex"
+
+	// Act
+	expression := StartWithOperator{}.Build(constants.FieldTypeString, "Value", value)
+
+	// Assert
+	assert.NotNil(t, expression)
+	assert.Equal(t, `type(Value) != null_type && Value.matches("(?i)^Filtex")`, expression.Condition)
+}
+
+func TestStartWithExpression_ShouldReturnExpression_WhenFieldTypeIsStringAndCaseSensitive(t *testing.T) {
+	// Arrange
+	value := "Filtex"
+
+	// Act
+	expression := StartWithOperator{Collation: constants.CollationCaseSensitive}.Build(constants.FieldTypeString, "Value", value)
+
+	// Assert
+	assert.NotNil(t, expression)
+	assert.Equal(t, `type(Value) != null_type && Value.startsWith("Filtex")`, expression.Condition)
+}
+
+func TestStartWithExpression_ShouldReturnNil_WhenFieldTypeIsNotString(t *testing.T) {
+	// Arrange
+	value := "Filtex"
+
+	// Act
+	expression := StartWithOperator{}.Build(constants.FieldTypeStringArray, "Value", value)
+
+	// Assert
+	assert.Nil(t, expression)
+}
@@ -0,0 +1,10 @@
+package types
+
+type CelDeclaration struct {
+	Name string
+	Type string
+}
+
+type CelEnvironment struct {
+	Declarations []CelDeclaration
+}
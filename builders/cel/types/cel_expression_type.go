@@ -0,0 +1,5 @@
+package types
+
+type CelExpression struct {
+	Condition string
+}
@@ -0,0 +1,63 @@
+package gorm
+
+import (
+	"strings"
+
+	"github.com/filtex/filtex-go/errors"
+	goGorm "gorm.io/gorm"
+	"gorm.io/gorm/clause"
+	"gorm.io/gorm/schema"
+)
+
+type gormColumn struct {
+	field   string
+	columns map[string]string
+	mapper  func(field string) string
+}
+
+func (c gormColumn) Build(builder clause.Builder) {
+	if name, ok := c.columns[c.field]; ok {
+		builder.WriteQuoted(name)
+		return
+	}
+
+	if c.mapper != nil {
+		builder.WriteQuoted(c.mapper(c.field))
+		return
+	}
+
+	stmt, ok := builder.(*goGorm.Statement)
+	if !ok || stmt.Schema == nil {
+		builder.WriteQuoted(c.field)
+		return
+	}
+
+	field := lookUpField(stmt.Schema, c.field)
+	if field == nil {
+		_ = stmt.AddError(errors.NewUnknownQueryFieldError(c.field))
+		builder.WriteQuoted(c.field)
+		return
+	}
+
+	builder.WriteQuoted(clause.Column{Table: clause.CurrentTable, Name: field.DBName})
+}
+
+func lookUpField(s *schema.Schema, name string) *schema.Field {
+	if field := s.LookUpField(name); field != nil && field.DBName != "" {
+		return field
+	}
+
+	for _, field := range s.Fields {
+		if field.DBName == "" {
+			continue
+		}
+
+		tag := strings.Split(field.Tag.Get("json"), ",")[0]
+
+		if strings.EqualFold(field.Name, name) || strings.EqualFold(field.DBName, name) || (tag != "" && tag == name) {
+			return field
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,87 @@
+package gorm
+
+import (
+	"testing"
+	"time"
+
+	"github.com/filtex/filtex-go/errors"
+	"github.com/stretchr/testify/assert"
+	goGorm "gorm.io/gorm"
+	"gorm.io/gorm/clause"
+	"gorm.io/gorm/logger"
+	"gorm.io/gorm/utils/tests"
+)
+
+type testProduct struct {
+	ID        uint
+	Name      string    `json:"name"`
+	Price     float64   `json:"price"`
+	CreatedAt time.Time `json:"createdAt"`
+	Internal  string    `gorm:"-"`
+}
+
+func newTestDB() *goGorm.DB {
+	db, _ := goGorm.Open(tests.DummyDialector{}, &goGorm.Config{DryRun: true, Logger: logger.Discard})
+	return db
+}
+
+func buildTestQuery(db *goGorm.DB, expression clause.Expression) *goGorm.DB {
+	return db.Model(&testProduct{}).Where(expression).Find(&[]testProduct{})
+}
+
+func TestGormColumn_ShouldUseColumnName_WhenFieldIsSchemaField(t *testing.T) {
+	// Arrange
+	samples := []string{"Name", "name", "NAME"}
+
+	for _, v := range samples {
+		// Act
+		result := buildTestQuery(newTestDB(), clause.Expr{SQL: "? IS NULL", Vars: []interface{}{gormColumn{field: v}}})
+
+		// Assert
+		assert.NoError(t, result.Error)
+		assert.Equal(t, "SELECT * FROM `test_products` WHERE `test_products`.`name` IS NULL", result.Statement.SQL.String())
+	}
+}
+
+func TestGormColumn_ShouldUseColumnName_WhenFieldIsJsonName(t *testing.T) {
+	// Act
+	result := buildTestQuery(newTestDB(), clause.Expr{SQL: "? IS NULL", Vars: []interface{}{gormColumn{field: "createdAt"}}})
+
+	// Assert
+	assert.NoError(t, result.Error)
+	assert.Equal(t, "SELECT * FROM `test_products` WHERE `test_products`.`created_at` IS NULL", result.Statement.SQL.String())
+}
+
+func TestGormColumn_ShouldUseMappedColumn_WhenFieldIsInColumns(t *testing.T) {
+	// Arrange
+	column := gormColumn{field: "created", columns: map[string]string{"created": "p.created_at"}}
+
+	// Act
+	result := buildTestQuery(newTestDB(), clause.Expr{SQL: "? IS NULL", Vars: []interface{}{column}})
+
+	// Assert
+	assert.NoError(t, result.Error)
+	assert.Equal(t, "SELECT * FROM `test_products` WHERE `p`.`created_at` IS NULL", result.Statement.SQL.String())
+}
+
+func TestGormColumn_ShouldAddError_WhenFieldIsNotInSchema(t *testing.T) {
+	// Arrange
+	samples := []string{"Unknown", "Internal"}
+
+	for _, v := range samples {
+		// Act
+		result := buildTestQuery(newTestDB(), clause.Expr{SQL: "? IS NULL", Vars: []interface{}{gormColumn{field: v}}})
+
+		// Assert
+		assert.Equal(t, errors.NewUnknownQueryFieldError(v), result.Error)
+	}
+}
+
+func TestGormColumn_ShouldQuoteField_WhenThereIsNoSchema(t *testing.T) {
+	// Act
+	result := newTestDB().Table("products").Where(clause.Expr{SQL: "? IS NULL", Vars: []interface{}{gormColumn{field: "name"}}}).Find(&[]map[string]interface{}{})
+
+	// Assert
+	assert.NoError(t, result.Error)
+	assert.Equal(t, "SELECT * FROM `products` WHERE `name` IS NULL", result.Statement.SQL.String())
+}
@@ -0,0 +1,202 @@
+package gorm
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/filtex/filtex-go/builders/gorm/logics"
+	"github.com/filtex/filtex-go/builders/gorm/operators"
+	"github.com/filtex/filtex-go/constants"
+	"github.com/filtex/filtex-go/errors"
+	"github.com/filtex/filtex-go/expressions"
+	"github.com/filtex/filtex-go/utils"
+	goGorm "gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+type GormScopeBuilder struct {
+	columns       map[string]string
+	columnMapper  func(field string) string
+	logicsMap     map[constants.Logic]func(expressions []clause.Expression) clause.Expression
+	operatorsMap  map[constants.Operator]func(fieldType constants.FieldType, column clause.Expression, value interface{}) clause.Expression
+	collationsMap map[constants.Collation]map[constants.Operator]func(fieldType constants.FieldType, column clause.Expression, value interface{}) clause.Expression
+}
+
+func NewGormScopeBuilder() *GormScopeBuilder {
+	return &GormScopeBuilder{
+		columns: map[string]string{},
+		logicsMap: map[constants.Logic]func(expressions []clause.Expression) clause.Expression{
+			constants.LogicAnd: logics.AndLogic{}.Build,
+			constants.LogicOr:  logics.OrLogic{}.Build,
+		},
+		operatorsMap: map[constants.Operator]func(fieldType constants.FieldType, column clause.Expression, value interface{}) clause.Expression{
+			constants.OperatorEqual:              operators.EqualOperator{}.Build,
+			constants.OperatorNotEqual:           operators.NotEqualOperator{}.Build,
+			constants.OperatorContain:            operators.ContainOperator{}.Build,
+			constants.OperatorNotContain:         operators.NotContainOperator{}.Build,
+			constants.OperatorStartWith:          operators.StartWithOperator{}.Build,
+			constants.OperatorNotStartWith:       operators.NotStartWithOperator{}.Build,
+			constants.OperatorEndWith:            operators.EndWithOperator{}.Build,
+			constants.OperatorNotEndWith:         operators.NotEndWithOperator{}.Build,
+			constants.OperatorBlank:              operators.BlankOperator{}.Build,
+			constants.OperatorNotBlank:           operators.NotBlankOperator{}.Build,
+			constants.OperatorIsNull:             operators.IsNullOperator{}.Build,
+			constants.OperatorIsNotNull:          operators.IsNotNullOperator{}.Build,
+			constants.OperatorGreaterThan:        operators.GreaterThanOperator{}.Build,
+			constants.OperatorGreaterThanOrEqual: operators.GreaterThanOrEqualOperator{}.Build,
+			constants.OperatorLessThan:           operators.LessThanOperator{}.Build,
+			constants.OperatorLessThanOrEqual:    operators.LessThanOrEqualOperator{}.Build,
+			constants.OperatorIn:                 operators.InOperator{}.Build,
+			constants.OperatorNotIn:              operators.NotInOperator{}.Build,
+		},
+		collationsMap: map[constants.Collation]map[constants.Operator]func(fieldType constants.FieldType, column clause.Expression, value interface{}) clause.Expression{
+			constants.CollationCaseSensitive:     newCollationOperatorsMap(constants.CollationCaseSensitive),
+			constants.CollationAccentInsensitive: newCollationOperatorsMap(constants.CollationAccentInsensitive),
+		},
+	}
+}
+
+func newCollationOperatorsMap(collation constants.Collation) map[constants.Operator]func(fieldType constants.FieldType, column clause.Expression, value interface{}) clause.Expression {
+	return map[constants.Operator]func(fieldType constants.FieldType, column clause.Expression, value interface{}) clause.Expression{
+		constants.OperatorEqual:              operators.EqualOperator{Collation: collation}.Build,
+		constants.OperatorNotEqual:           operators.NotEqualOperator{Collation: collation}.Build,
+		constants.OperatorContain:            operators.ContainOperator{Collation: collation}.Build,
+		constants.OperatorNotContain:         operators.NotContainOperator{Collation: collation}.Build,
+		constants.OperatorStartWith:          operators.StartWithOperator{Collation: collation}.Build,
+		constants.OperatorNotStartWith:       operators.NotStartWithOperator{Collation: collation}.Build,
+		constants.OperatorEndWith:            operators.EndWithOperator{Collation: collation}.Build,
+		constants.OperatorNotEndWith:         operators.NotEndWithOperator{Collation: collation}.Build,
+		constants.OperatorIn:                 operators.InOperator{Collation: collation}.Build,
+		constants.OperatorNotIn:              operators.NotInOperator{Collation: collation}.Build,
+		constants.OperatorGreaterThan:        operators.GreaterThanOperator{Collation: collation}.Build,
+		constants.OperatorGreaterThanOrEqual: operators.GreaterThanOrEqualOperator{Collation: collation}.Build,
+		constants.OperatorLessThan:           operators.LessThanOperator{Collation: collation}.Build,
+		constants.OperatorLessThanOrEqual:    operators.LessThanOrEqualOperator{Collation: collation}.Build,
+	}
+}
+
+func (b *GormScopeBuilder) Columns(columns map[string]string) *GormScopeBuilder {
+	for k, v := range columns {
+		b.columns[k] = v
+	}
+	return b
+}
+
+func (b *GormScopeBuilder) ColumnMapper(mapper func(field string) string) *GormScopeBuilder {
+	b.columnMapper = mapper
+	return b
+}
+
+func (b *GormScopeBuilder) Build(ex expressions.Expression) (func(db *goGorm.DB) *goGorm.DB, error) {
+	expression, err := b.buildInternal(ex)
+	if err != nil {
+		return nil, err
+	}
+
+	return func(db *goGorm.DB) *goGorm.DB {
+		return db.Where(expression)
+	}, nil
+}
+
+func (b *GormScopeBuilder) buildInternal(ex expressions.Expression) (clause.Expression, error) {
+	switch exp := ex.(type) {
+	case *expressions.LogicExpression:
+		expressions := make([]clause.Expression, 0)
+
+		for _, v := range exp.Expressions {
+			e, err := b.buildInternal(v)
+			if err != nil {
+				return nil, err
+			}
+			expressions = append(expressions, e)
+		}
+
+		if len(expressions) == 0 {
+			return nil, errors.NewCouldNotBeBuiltError()
+		}
+
+		if fn, ok := b.logicsMap[exp.Logic]; ok {
+			return fn(expressions), nil
+		}
+
+		return nil, errors.NewCouldNotBeBuiltError()
+	case *expressions.OperatorExpression:
+		if fn, ok := b.findOperator(exp.Operator, exp.Collation); ok {
+			result := fn(exp.Type, b.column(exp.Field), castValue(exp.Type, exp.Value))
+			if result == nil {
+				return nil, errors.NewCouldNotBeBuiltError()
+			}
+
+			return result, nil
+		}
+
+		return nil, errors.NewCouldNotBeBuiltError()
+	case *expressions.SearchExpression:
+		columns := make([]clause.Expression, 0)
+
+		for _, v := range exp.Fields {
+			columns = append(columns, b.column(v))
+		}
+
+		result := operators.SearchOperator{}.Build(columns, exp.Value)
+		if result == nil {
+			return nil, errors.NewCouldNotBeBuiltError()
+		}
+
+		return result, nil
+	}
+
+	return nil, errors.NewCouldNotBeBuiltError()
+}
+
+func (b *GormScopeBuilder) findOperator(operator constants.Operator, collation constants.Collation) (func(fieldType constants.FieldType, column clause.Expression, value interface{}) clause.Expression, bool) {
+	if fn, ok := b.collationsMap[collation][operator]; ok {
+		return fn, true
+	}
+
+	fn, ok := b.operatorsMap[operator]
+	return fn, ok
+}
+
+func (b *GormScopeBuilder) column(field string) clause.Expression {
+	return gormColumn{field: field, columns: b.columns, mapper: b.columnMapper}
+}
+
+func castValue(fieldType constants.FieldType, value interface{}) interface{} {
+	if values, ok := value.([]interface{}); ok {
+		result := make([]interface{}, 0)
+
+		for _, v := range values {
+			result = append(result, castValue(fieldType, v))
+		}
+
+		return result
+	}
+
+	if value == nil {
+		return value
+	}
+
+	if t, ok := value.(*time.Time); ok {
+		return *t
+	}
+
+	switch fieldType {
+	case constants.FieldTypeTime, constants.FieldTypeTimeArray:
+		seconds, err := utils.Time(value)
+		if err != nil {
+			return value
+		}
+
+		return fmt.Sprintf("%02d:%02d:%02d", *seconds/3600, *seconds%3600/60, *seconds%60)
+	case constants.FieldTypeDuration, constants.FieldTypeDurationArray:
+		duration, err := utils.Duration(value)
+		if err != nil {
+			return value
+		}
+
+		return int64(duration)
+	}
+
+	return value
+}
@@ -0,0 +1,208 @@
+package gorm
+
+import (
+	"testing"
+	"time"
+
+	"github.com/filtex/filtex-go/constants"
+	"github.com/filtex/filtex-go/errors"
+	"github.com/filtex/filtex-go/expressions"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestBuild_ShouldReturnError_WhenExpressionIsNil(t *testing.T) {
+	// Arrange
+	builder := NewGormScopeBuilder()
+
+	// Act
+	scope, err := builder.Build(nil)
+
+	// Assert
+	assert.Nil(t, scope)
+	assert.Error(t, err)
+}
+
+func TestBuild_ShouldReturnError_WhenExpressionIsLogicExpressionAndNotValidLogic(t *testing.T) {
+	// Arrange
+	builder := NewGormScopeBuilder()
+	logicExpression := expressions.NewLogicExpression("", []expressions.Expression{
+		expressions.NewOperatorExpression(constants.FieldTypeString, "name", constants.OperatorEqual, "Filtex"),
+	})
+
+	// Act
+	scope, err := builder.Build(logicExpression)
+
+	// Assert
+	assert.Nil(t, scope)
+	assert.Error(t, err)
+}
+
+func TestBuild_ShouldReturnError_WhenOperatorIsNotSupported(t *testing.T) {
+	// Arrange
+	builder := NewGormScopeBuilder()
+	operatorExpression := expressions.NewOperatorExpression(constants.FieldTypeIP, "name", constants.OperatorInSubnet, "10.0.0.0/8")
+
+	// Act
+	scope, err := builder.Build(operatorExpression)
+
+	// Assert
+	assert.Nil(t, scope)
+	assert.Error(t, err)
+}
+
+func TestBuild_ShouldReturnError_WhenOperatorDoesNotSupportFieldType(t *testing.T) {
+	// Arrange
+	builder := NewGormScopeBuilder()
+	operatorExpression := expressions.NewOperatorExpression(constants.FieldTypeNumber, "price", constants.OperatorContain, float64(1))
+
+	// Act
+	scope, err := builder.Build(operatorExpression)
+
+	// Assert
+	assert.Nil(t, scope)
+	assert.Error(t, err)
+}
+
+func TestBuild_ShouldReturnScope_WhenExpressionIsLogicExpressionAndValid(t *testing.T) {
+	// Arrange
+	builder := NewGormScopeBuilder()
+	logicExpression := expressions.NewLogicExpression(constants.LogicOr, []expressions.Expression{
+		expressions.NewOperatorExpression(constants.FieldTypeString, "name", constants.OperatorStartWith, "Fil"),
+		expressions.NewLogicExpression(constants.LogicAnd, []expressions.Expression{
+			expressions.NewOperatorExpression(constants.FieldTypeNumber, "price", constants.OperatorGreaterThan, float64(10)),
+			expressions.NewOperatorExpression(constants.FieldTypeNumber, "price", constants.OperatorLessThan, float64(20)),
+		}),
+	})
+
+	// Act
+	scope, err := builder.Build(logicExpression)
+
+	// Assert
+	assert.NoError(t, err)
+	result := newTestDB().Model(&testProduct{}).Scopes(scope).Find(&[]testProduct{})
+	assert.NoError(t, result.Error)
+	assert.Equal(t, "SELECT * FROM `test_products` WHERE (LOWER(`test_products`.`name`) LIKE ? ESCAPE '!' OR (`test_products`.`price` > ? AND `test_products`.`price` < ?))", result.Statement.SQL.String())
+	assert.Equal(t, []interface{}{"fil%", float64(10), float64(20)}, result.Statement.Vars)
+}
+
+func TestBuild_ShouldUseCollation_WhenExpressionHasCollation(t *testing.T) {
+	// Arrange
+	builder := NewGormScopeBuilder()
+	operatorExpression := expressions.NewCollatedOperatorExpression(constants.FieldTypeString, "name", constants.OperatorEqual, "Filtex", constants.CollationCaseSensitive)
+
+	// Act
+	scope, err := builder.Build(operatorExpression)
+
+	// Assert
+	assert.NoError(t, err)
+	result := newTestDB().Model(&testProduct{}).Scopes(scope).Find(&[]testProduct{})
+	assert.Equal(t, "SELECT * FROM `test_products` WHERE `test_products`.`name` = ?", result.Statement.SQL.String())
+	assert.Equal(t, []interface{}{"Filtex"}, result.Statement.Vars)
+}
+
+func TestBuild_ShouldReturnError_WhenCollationIsNotSupported(t *testing.T) {
+	// Arrange
+	builder := NewGormScopeBuilder()
+	operatorExpression := expressions.NewCollatedOperatorExpression(constants.FieldTypeString, "name", constants.OperatorEqual, "Filtex", constants.CollationAccentInsensitive)
+
+	// Act
+	scope, err := builder.Build(operatorExpression)
+
+	// Assert
+	assert.Nil(t, scope)
+	assert.Error(t, err)
+}
+
+func TestBuild_ShouldDereferenceTimeValues_WhenFieldTypeIsDateTime(t *testing.T) {
+	// Arrange
+	builder := NewGormScopeBuilder()
+	value := time.Date(2024, 03, 10, 15, 30, 0, 0, time.UTC)
+	operatorExpression := expressions.NewOperatorExpression(constants.FieldTypeDateTime, "createdAt", constants.OperatorGreaterThanOrEqual, &value)
+
+	// Act
+	scope, err := builder.Build(operatorExpression)
+
+	// Assert
+	assert.NoError(t, err)
+	result := newTestDB().Model(&testProduct{}).Scopes(scope).Find(&[]testProduct{})
+	assert.Equal(t, "SELECT * FROM `test_products` WHERE `test_products`.`created_at` >= ?", result.Statement.SQL.String())
+	assert.Equal(t, []interface{}{value}, result.Statement.Vars)
+}
+
+func TestBuild_ShouldUseTimeValues_WhenFieldTypeIsTime(t *testing.T) {
+	// Arrange
+	builder := NewGormScopeBuilder()
+	seconds := 3723
+	operatorExpression := expressions.NewOperatorExpression(constants.FieldTypeTime, "opensAt", constants.OperatorEqual, &seconds)
+
+	// Act
+	scope, err := builder.Build(operatorExpression)
+
+	// Assert
+	assert.NoError(t, err)
+	result := newTestDB().Table("stores").Scopes(scope).Find(&[]map[string]interface{}{})
+	assert.Equal(t, "SELECT * FROM `stores` WHERE `opensAt` = ?", result.Statement.SQL.String())
+	assert.Equal(t, []interface{}{"01:02:03"}, result.Statement.Vars)
+}
+
+func TestBuild_ShouldReturnScope_WhenExpressionIsSearchExpression(t *testing.T) {
+	// Arrange
+	builder := NewGormScopeBuilder()
+	searchExpression := expressions.NewSearchExpression([]string{"name"}, "filtex")
+
+	// Act
+	scope, err := builder.Build(searchExpression)
+
+	// Assert
+	assert.NoError(t, err)
+	result := newTestDB().Model(&testProduct{}).Scopes(scope).Find(&[]testProduct{})
+	assert.Equal(t, "SELECT * FROM `test_products` WHERE LOWER(`test_products`.`name`) LIKE ? ESCAPE '!'", result.Statement.SQL.String())
+	assert.Equal(t, []interface{}{"%filtex%"}, result.Statement.Vars)
+}
+
+func TestBuild_ShouldUseColumns_WhenColumnsAreSet(t *testing.T) {
+	// Arrange
+	builder := NewGormScopeBuilder().Columns(map[string]string{"title": "name"})
+	operatorExpression := expressions.NewOperatorExpression(constants.FieldTypeString, "title", constants.OperatorIsNull, nil)
+
+	// Act
+	scope, err := builder.Build(operatorExpression)
+
+	// Assert
+	assert.NoError(t, err)
+	result := newTestDB().Model(&testProduct{}).Scopes(scope).Find(&[]testProduct{})
+	assert.Equal(t, "SELECT * FROM `test_products` WHERE `name` IS NULL", result.Statement.SQL.String())
+}
+
+func TestBuild_ShouldReturnQueryError_WhenFieldIsNotInModel(t *testing.T) {
+	// Arrange
+	builder := NewGormScopeBuilder()
+	operatorExpression := expressions.NewOperatorExpression(constants.FieldTypeString, "unknown", constants.OperatorIsNull, nil)
+
+	// Act
+	scope, err := builder.Build(operatorExpression)
+
+	// Assert
+	assert.NoError(t, err)
+	result := newTestDB().Model(&testProduct{}).Scopes(scope).Find(&[]testProduct{})
+	assert.Equal(t, errors.NewUnknownQueryFieldError("unknown"), result.Error)
+}
+
+func TestBuild_ShouldUseColumnMapper_WhenFieldIsNotInColumns(t *testing.T) {
+	// Arrange
+	builder := NewGormScopeBuilder().
+		Columns(map[string]string{"title": "name"}).
+		ColumnMapper(func(field string) string { return "p." + field })
+	logicExpression := expressions.NewLogicExpression(constants.LogicAnd, []expressions.Expression{
+		expressions.NewOperatorExpression(constants.FieldTypeString, "title", constants.OperatorIsNull, nil),
+		expressions.NewOperatorExpression(constants.FieldTypeNumber, "price", constants.OperatorIsNull, nil),
+	})
+
+	// Act
+	scope, err := builder.Build(logicExpression)
+
+	// Assert
+	assert.NoError(t, err)
+	result := newTestDB().Model(&testProduct{}).Scopes(scope).Find(&[]testProduct{})
+	assert.Equal(t, "SELECT * FROM `test_products` WHERE (`name` IS NULL AND `p`.`price` IS NULL)", result.Statement.SQL.String())
+}
@@ -0,0 +1,15 @@
+package logics
+
+import (
+	"gorm.io/gorm/clause"
+)
+
+type AndLogic struct{}
+
+func (AndLogic) Build(expressions []clause.Expression) clause.Expression {
+	if len(expressions) == 1 {
+		return expressions[0]
+	}
+
+	return clause.AndConditions{Exprs: expressions}
+}
@@ -0,0 +1,33 @@
+package logics
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"gorm.io/gorm/clause"
+)
+
+func TestAndLogic_ShouldReturnExpression_WhenThereIsOneExpression(t *testing.T) {
+	// Arrange
+	expression := clause.Expr{SQL: "`value` IS NULL"}
+
+	// Act
+	result := AndLogic{}.Build([]clause.Expression{expression})
+
+	// Assert
+	assert.Equal(t, expression, result)
+}
+
+func TestAndLogic_ShouldReturnAndConditions_WhenThereAreMultipleExpressions(t *testing.T) {
+	// Arrange
+	expressions := []clause.Expression{
+		clause.Expr{SQL: "`name` IS NULL"},
+		clause.Expr{SQL: "`price` IS NULL"},
+	}
+
+	// Act
+	result := AndLogic{}.Build(expressions)
+
+	// Assert
+	assert.Equal(t, clause.AndConditions{Exprs: expressions}, result)
+}
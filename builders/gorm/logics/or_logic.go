@@ -0,0 +1,15 @@
+package logics
+
+import (
+	"gorm.io/gorm/clause"
+)
+
+type OrLogic struct{}
+
+func (OrLogic) Build(expressions []clause.Expression) clause.Expression {
+	if len(expressions) == 1 {
+		return expressions[0]
+	}
+
+	return clause.OrConditions{Exprs: expressions}
+}
@@ -0,0 +1,33 @@
+package logics
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"gorm.io/gorm/clause"
+)
+
+func TestOrLogic_ShouldReturnExpression_WhenThereIsOneExpression(t *testing.T) {
+	// Arrange
+	expression := clause.Expr{SQL: "`value` IS NULL"}
+
+	// Act
+	result := OrLogic{}.Build([]clause.Expression{expression})
+
+	// Assert
+	assert.Equal(t, expression, result)
+}
+
+func TestOrLogic_ShouldReturnOrConditions_WhenThereAreMultipleExpressions(t *testing.T) {
+	// Arrange
+	expressions := []clause.Expression{
+		clause.Expr{SQL: "`name` IS NULL"},
+		clause.Expr{SQL: "`price` IS NULL"},
+	}
+
+	// Act
+	result := OrLogic{}.Build(expressions)
+
+	// Assert
+	assert.Equal(t, clause.OrConditions{Exprs: expressions}, result)
+}
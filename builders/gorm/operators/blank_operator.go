@@ -0,0 +1,21 @@
+package operators
+
+import (
+	"github.com/filtex/filtex-go/constants"
+	"gorm.io/gorm/clause"
+)
+
+type BlankOperator struct{}
+
+func (BlankOperator) Build(fieldType constants.FieldType, column clause.Expression, value interface{}) clause.Expression {
+	if fieldType.IsArray() {
+		return nil
+	}
+
+	switch fieldType {
+	case constants.FieldTypeString:
+		return clause.Expr{SQL: "? IS NULL OR ? = ''", Vars: []interface{}{column, column}}
+	}
+
+	return clause.Expr{SQL: "? IS NULL", Vars: []interface{}{column}}
+}
@@ -0,0 +1,36 @@
+package operators
+
+import (
+	"testing"
+
+	"github.com/filtex/filtex-go/constants"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestBlankOperator_ShouldReturnNil_WhenFieldTypeIsArray(t *testing.T) {
+	// Act
+	expression := BlankOperator{}.Build(constants.FieldTypeStringArray, testColumn, nil)
+
+	// Assert
+	assert.Nil(t, expression)
+}
+
+func TestBlankOperator_ShouldReturnExpression_WhenFieldTypeIsString(t *testing.T) {
+	// Act
+	expression := BlankOperator{}.Build(constants.FieldTypeString, testColumn, nil)
+
+	// Assert
+	sql, vars := buildTestSql(expression)
+	assert.Equal(t, "`value` IS NULL OR `value` = ''", sql)
+	assert.Empty(t, vars)
+}
+
+func TestBlankOperator_ShouldReturnExpression_WhenFieldTypeIsNumber(t *testing.T) {
+	// Act
+	expression := BlankOperator{}.Build(constants.FieldTypeNumber, testColumn, nil)
+
+	// Assert
+	sql, vars := buildTestSql(expression)
+	assert.Equal(t, "`value` IS NULL", sql)
+	assert.Empty(t, vars)
+}
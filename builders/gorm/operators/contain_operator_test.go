@@ -0,0 +1,44 @@
+package operators
+
+import (
+	"testing"
+
+	"github.com/filtex/filtex-go/constants"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestContainOperator_ShouldReturnNil_WhenFieldTypeIsNotString(t *testing.T) {
+	// Act
+	expression := ContainOperator{}.Build(constants.FieldTypeNumber, testColumn, float64(1))
+
+	// Assert
+	assert.Nil(t, expression)
+}
+
+func TestContainOperator_ShouldReturnExpression_WhenFieldTypeIsString(t *testing.T) {
+	// Act
+	expression := ContainOperator{}.Build(constants.FieldTypeString, testColumn, "Fil_tex%")
+
+	// Assert
+	sql, vars := buildTestSql(expression)
+	assert.Equal(t, "LOWER(`value`) LIKE ? ESCAPE '!'", sql)
+	assert.Equal(t, []interface{}{"%fil!_tex!%%"}, vars)
+}
+
+func TestContainOperator_ShouldReturnCaseSensitiveExpression_WhenCollationIsCaseSensitive(t *testing.T) {
+	// Act
+	expression := ContainOperator{Collation: constants.CollationCaseSensitive}.Build(constants.FieldTypeString, testColumn, "Fil_tex%")
+
+	// Assert
+	sql, vars := buildTestSql(expression)
+	assert.Equal(t, "`value` LIKE ? ESCAPE '!'", sql)
+	assert.Equal(t, []interface{}{"%Fil!_tex!%%"}, vars)
+}
+
+func TestContainOperator_ShouldReturnNil_WhenCollationIsAccentInsensitive(t *testing.T) {
+	// Act
+	expression := ContainOperator{Collation: constants.CollationAccentInsensitive}.Build(constants.FieldTypeString, testColumn, "Filtex")
+
+	// Assert
+	assert.Nil(t, expression)
+}
@@ -0,0 +1,101 @@
+package operators
+
+import (
+	"github.com/filtex/filtex-go/constants"
+	"github.com/filtex/filtex-go/utils"
+	"gorm.io/gorm/clause"
+)
+
+func hasRange(fieldType constants.FieldType, value interface{}) bool {
+	if fieldType == constants.FieldTypeDate {
+		return true
+	}
+
+	if fieldType != constants.FieldTypeDateTime {
+		return false
+	}
+
+	values, ok := value.([]interface{})
+	if !ok {
+		values = []interface{}{value}
+	}
+
+	for _, v := range values {
+		if _, ok := utils.AsTimeRange(v); ok {
+			return true
+		}
+	}
+
+	return false
+}
+
+func valueRange(fieldType constants.FieldType, value interface{}) (*utils.TimeRange, bool) {
+	if r, ok := utils.AsTimeRange(value); ok {
+		return r, true
+	}
+
+	if fieldType == constants.FieldTypeDate {
+		r, err := utils.DayRange(value)
+		return r, err == nil
+	}
+
+	return nil, false
+}
+
+func equalRange(fieldType constants.FieldType, column clause.Expression, value interface{}) clause.Expression {
+	r, ok := valueRange(fieldType, value)
+	if !ok {
+		if fieldType != constants.FieldTypeDateTime {
+			return nil
+		}
+
+		return clause.Expr{SQL: "? = ?", Vars: []interface{}{column, value}}
+	}
+
+	return clause.Expr{SQL: "? >= ? AND ? < ?", Vars: []interface{}{column, r.Start, column, r.End}}
+}
+
+func notEqualRange(fieldType constants.FieldType, column clause.Expression, value interface{}) clause.Expression {
+	r, ok := valueRange(fieldType, value)
+	if !ok {
+		if fieldType != constants.FieldTypeDateTime {
+			return nil
+		}
+
+		return orNull(column, clause.Expr{SQL: "? <> ?", Vars: []interface{}{column, value}})
+	}
+
+	return clause.Expr{SQL: "(? < ? OR ? >= ? OR ? IS NULL)", Vars: []interface{}{column, r.Start, column, r.End, column}}
+}
+
+func ranges(fieldType constants.FieldType, column clause.Expression, value interface{}, fn func(fieldType constants.FieldType, column clause.Expression, value interface{}) clause.Expression, isOr bool) clause.Expression {
+	values, ok := value.([]interface{})
+	if !ok {
+		values = []interface{}{value}
+	}
+
+	expressions := make([]clause.Expression, 0)
+
+	for _, v := range values {
+		expression := fn(fieldType, column, v)
+		if expression == nil {
+			return nil
+		}
+
+		expressions = append(expressions, expression)
+	}
+
+	if len(expressions) == 0 {
+		return nil
+	}
+
+	if len(expressions) == 1 {
+		return expressions[0]
+	}
+
+	if isOr {
+		return clause.OrConditions{Exprs: expressions}
+	}
+
+	return clause.AndConditions{Exprs: expressions}
+}
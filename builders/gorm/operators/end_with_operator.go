@@ -0,0 +1,18 @@
+package operators
+
+import (
+	"github.com/filtex/filtex-go/constants"
+	"gorm.io/gorm/clause"
+)
+
+type EndWithOperator struct {
+	Collation constants.Collation
+}
+
+func (o EndWithOperator) Build(fieldType constants.FieldType, column clause.Expression, value interface{}) clause.Expression {
+	if fieldType != constants.FieldTypeString {
+		return nil
+	}
+
+	return like(o.Collation, column, "%", value, "", "LIKE")
+}
@@ -0,0 +1,44 @@
+package operators
+
+import (
+	"testing"
+
+	"github.com/filtex/filtex-go/constants"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestEndWithOperator_ShouldReturnNil_WhenFieldTypeIsNotString(t *testing.T) {
+	// Act
+	expression := EndWithOperator{}.Build(constants.FieldTypeNumber, testColumn, float64(1))
+
+	// Assert
+	assert.Nil(t, expression)
+}
+
+func TestEndWithOperator_ShouldReturnExpression_WhenFieldTypeIsString(t *testing.T) {
+	// Act
+	expression := EndWithOperator{}.Build(constants.FieldTypeString, testColumn, "Fil_tex%")
+
+	// Assert
+	sql, vars := buildTestSql(expression)
+	assert.Equal(t, "LOWER(`value`) LIKE ? ESCAPE '!'", sql)
+	assert.Equal(t, []interface{}{"%fil!_tex!%"}, vars)
+}
+
+func TestEndWithOperator_ShouldReturnCaseSensitiveExpression_WhenCollationIsCaseSensitive(t *testing.T) {
+	// Act
+	expression := EndWithOperator{Collation: constants.CollationCaseSensitive}.Build(constants.FieldTypeString, testColumn, "Fil_tex%")
+
+	// Assert
+	sql, vars := buildTestSql(expression)
+	assert.Equal(t, "`value` LIKE ? ESCAPE '!'", sql)
+	assert.Equal(t, []interface{}{"%Fil!_tex!%"}, vars)
+}
+
+func TestEndWithOperator_ShouldReturnNil_WhenCollationIsAccentInsensitive(t *testing.T) {
+	// Act
+	expression := EndWithOperator{Collation: constants.CollationAccentInsensitive}.Build(constants.FieldTypeString, testColumn, "Filtex")
+
+	// Assert
+	assert.Nil(t, expression)
+}
@@ -0,0 +1,22 @@
+package operators
+
+import (
+	"github.com/filtex/filtex-go/constants"
+	"gorm.io/gorm/clause"
+)
+
+type EqualOperator struct {
+	Collation constants.Collation
+}
+
+func (o EqualOperator) Build(fieldType constants.FieldType, column clause.Expression, value interface{}) clause.Expression {
+	if fieldType.IsArray() || value == nil {
+		return nil
+	}
+
+	if hasRange(fieldType, value) {
+		return equalRange(fieldType, column, value)
+	}
+
+	return compare(fieldType, o.Collation, column, value, "=")
+}
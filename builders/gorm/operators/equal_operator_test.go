@@ -0,0 +1,100 @@
+package operators
+
+import (
+	"testing"
+	"time"
+
+	"github.com/filtex/filtex-go/constants"
+	"github.com/filtex/filtex-go/utils"
+	"github.com/stretchr/testify/assert"
+	goGorm "gorm.io/gorm"
+	"gorm.io/gorm/clause"
+	"gorm.io/gorm/utils/tests"
+)
+
+var testColumn = clause.Expr{SQL: "`value`"}
+
+func buildTestSql(expression clause.Expression) (string, []interface{}) {
+	db, _ := goGorm.Open(tests.DummyDialector{}, &goGorm.Config{DryRun: true})
+	stmt := &goGorm.Statement{DB: db, Clauses: map[string]clause.Clause{}}
+	expression.Build(stmt)
+	return stmt.SQL.String(), stmt.Vars
+}
+
+func TestEqualOperator_ShouldReturnNil_WhenFieldTypeIsArray(t *testing.T) {
+	// Act
+	expression := EqualOperator{}.Build(constants.FieldTypeStringArray, testColumn, "Filtex")
+
+	// Assert
+	assert.Nil(t, expression)
+}
+
+func TestEqualOperator_ShouldReturnExpression_WhenFieldTypeIsString(t *testing.T) {
+	// Act
+	expression := EqualOperator{}.Build(constants.FieldTypeString, testColumn, "Filtex")
+
+	// Assert
+	sql, vars := buildTestSql(expression)
+	assert.Equal(t, "LOWER(`value`) = ?", sql)
+	assert.Equal(t, []interface{}{"filtex"}, vars)
+}
+
+func TestEqualOperator_ShouldReturnCaseSensitiveExpression_WhenCollationIsCaseSensitive(t *testing.T) {
+	// Act
+	expression := EqualOperator{Collation: constants.CollationCaseSensitive}.Build(constants.FieldTypeString, testColumn, "Filtex")
+
+	// Assert
+	sql, vars := buildTestSql(expression)
+	assert.Equal(t, "`value` = ?", sql)
+	assert.Equal(t, []interface{}{"Filtex"}, vars)
+}
+
+func TestEqualOperator_ShouldReturnNil_WhenCollationIsAccentInsensitive(t *testing.T) {
+	// Act
+	expression := EqualOperator{Collation: constants.CollationAccentInsensitive}.Build(constants.FieldTypeString, testColumn, "Filtex")
+
+	// Assert
+	assert.Nil(t, expression)
+}
+
+func TestEqualOperator_ShouldReturnExpression_WhenFieldTypeIsNumber(t *testing.T) {
+	// Act
+	expression := EqualOperator{}.Build(constants.FieldTypeNumber, testColumn, float64(100))
+
+	// Assert
+	sql, vars := buildTestSql(expression)
+	assert.Equal(t, "`value` = ?", sql)
+	assert.Equal(t, []interface{}{float64(100)}, vars)
+}
+
+func TestEqualOperator_ShouldReturnRangeExpression_WhenFieldTypeIsDate(t *testing.T) {
+	// Arrange
+	value := time.Date(2024, 03, 10, 15, 30, 0, 0, time.UTC)
+
+	// Act
+	expression := EqualOperator{}.Build(constants.FieldTypeDate, testColumn, value)
+
+	// Assert
+	sql, vars := buildTestSql(expression)
+	assert.Equal(t, "`value` >= ? AND `value` < ?", sql)
+	assert.Equal(t, []interface{}{
+		time.Date(2024, 03, 10, 0, 0, 0, 0, time.UTC),
+		time.Date(2024, 03, 11, 0, 0, 0, 0, time.UTC),
+	}, vars)
+}
+
+func TestEqualOperator_ShouldReturnRangeExpression_WhenValueIsTimeRange(t *testing.T) {
+	// Arrange
+	value := &utils.TimeRange{
+		Start: time.Date(2024, 03, 10, 15, 0, 0, 0, time.UTC),
+		End:   time.Date(2024, 03, 10, 16, 0, 0, 0, time.UTC),
+	}
+
+	// Act
+	expression := EqualOperator{}.Build(constants.FieldTypeDateTime, testColumn, value)
+
+	// Assert
+	sql, vars := buildTestSql(expression)
+	assert.Equal(t, "`value` >= ? AND `value` < ?", sql)
+	assert.Equal(t, []interface{}{value.Start, value.End}, vars)
+}
@@ -0,0 +1,91 @@
+package operators
+
+import (
+	"testing"
+	"time"
+
+	"github.com/filtex/filtex-go/constants"
+	"github.com/filtex/filtex-go/utils"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGreaterThanOperator_ShouldReturnNil_WhenFieldTypeIsNotComparable(t *testing.T) {
+	// Arrange
+	samples := []constants.FieldType{
+		constants.FieldTypeIP,
+		constants.FieldTypeGeoPoint,
+		constants.FieldTypeNumberArray,
+	}
+
+	for _, v := range samples {
+		// Act
+		expression := GreaterThanOperator{}.Build(v, testColumn, float64(1))
+
+		// Assert
+		assert.Nil(t, expression)
+	}
+}
+
+func TestGreaterThanOperator_ShouldReturnExpression_WhenFieldTypeIsNumber(t *testing.T) {
+	// Act
+	expression := GreaterThanOperator{}.Build(constants.FieldTypeNumber, testColumn, float64(100))
+
+	// Assert
+	sql, vars := buildTestSql(expression)
+	assert.Equal(t, "`value` > ?", sql)
+	assert.Equal(t, []interface{}{float64(100)}, vars)
+}
+
+func TestGreaterThanOperator_ShouldReturnLowerExpression_WhenFieldTypeIsString(t *testing.T) {
+	// Act
+	expression := GreaterThanOperator{}.Build(constants.FieldTypeString, testColumn, "Filtex")
+
+	// Assert
+	sql, vars := buildTestSql(expression)
+	assert.Equal(t, "LOWER(`value`) > ?", sql)
+	assert.Equal(t, []interface{}{"filtex"}, vars)
+}
+
+func TestGreaterThanOperator_ShouldReturnExpression_WhenFieldTypeIsCaseSensitiveString(t *testing.T) {
+	// Act
+	expression := GreaterThanOperator{Collation: constants.CollationCaseSensitive}.Build(constants.FieldTypeString, testColumn, "Filtex")
+
+	// Assert
+	sql, vars := buildTestSql(expression)
+	assert.Equal(t, "`value` > ?", sql)
+	assert.Equal(t, []interface{}{"Filtex"}, vars)
+}
+
+func TestGreaterThanOperator_ShouldReturnNil_WhenFieldTypeIsAccentInsensitiveString(t *testing.T) {
+	// Act
+	expression := GreaterThanOperator{Collation: constants.CollationAccentInsensitive}.Build(constants.FieldTypeString, testColumn, "Filtex")
+
+	// Assert
+	assert.Nil(t, expression)
+}
+
+func TestGreaterThanOperator_ShouldReturnExpression_WhenFieldTypeIsBoolean(t *testing.T) {
+	// Act
+	expression := GreaterThanOperator{}.Build(constants.FieldTypeBoolean, testColumn, true)
+
+	// Assert
+	sql, vars := buildTestSql(expression)
+	assert.Equal(t, "`value` > ?", sql)
+	assert.Equal(t, []interface{}{true}, vars)
+}
+
+func TestGreaterThanOperator_ShouldReturnRangeExpression_WhenValueIsTimeRange(t *testing.T) {
+	// Arrange
+	value := &utils.TimeRange{
+		Start: time.Date(2024, 03, 10, 15, 0, 0, 0, time.UTC),
+		End:   time.Date(2024, 03, 10, 16, 0, 0, 0, time.UTC),
+	}
+
+	// Act
+	expression := GreaterThanOperator{}.Build(constants.FieldTypeDateTime, testColumn, value)
+
+	// Assert
+	sql, vars := buildTestSql(expression)
+	assert.Equal(t, "`value` >= ?", sql)
+	assert.Equal(t, []interface{}{value.End}, vars)
+}
@@ -0,0 +1,52 @@
+package operators
+
+import (
+	"strings"
+
+	"github.com/filtex/filtex-go/constants"
+	"github.com/filtex/filtex-go/utils"
+	"gorm.io/gorm/clause"
+)
+
+type InOperator struct {
+	Collation constants.Collation
+}
+
+func (o InOperator) Build(fieldType constants.FieldType, column clause.Expression, value interface{}) clause.Expression {
+	if fieldType.IsArray() || value == nil {
+		return nil
+	}
+
+	if hasRange(fieldType, value) {
+		return ranges(fieldType, column, value, equalRange, true)
+	}
+
+	values, ok := value.([]interface{})
+	if !ok {
+		values = []interface{}{value}
+	}
+
+	if len(values) == 0 {
+		return nil
+	}
+
+	if fieldType != constants.FieldTypeString || o.Collation.IsCaseSensitive() {
+		return clause.Expr{SQL: "? IN ?", Vars: []interface{}{column, values}}
+	}
+
+	if o.Collation.IsAccentInsensitive() {
+		return nil
+	}
+
+	lowered := make([]interface{}, 0)
+
+	for _, v := range values {
+		str, err := utils.String(v)
+		if err != nil {
+			return nil
+		}
+		lowered = append(lowered, strings.ToLower(str))
+	}
+
+	return clause.Expr{SQL: "LOWER(?) IN ?", Vars: []interface{}{column, lowered}}
+}
@@ -0,0 +1,71 @@
+package operators
+
+import (
+	"testing"
+	"time"
+
+	"github.com/filtex/filtex-go/constants"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestInOperator_ShouldReturnNil_WhenFieldTypeIsArray(t *testing.T) {
+	// Act
+	expression := InOperator{}.Build(constants.FieldTypeStringArray, testColumn, []interface{}{"Filtex"})
+
+	// Assert
+	assert.Nil(t, expression)
+}
+
+func TestInOperator_ShouldReturnNil_WhenValuesAreEmpty(t *testing.T) {
+	// Act
+	expression := InOperator{}.Build(constants.FieldTypeNumber, testColumn, []interface{}{})
+
+	// Assert
+	assert.Nil(t, expression)
+}
+
+func TestInOperator_ShouldReturnExpression_WhenFieldTypeIsString(t *testing.T) {
+	// Act
+	expression := InOperator{}.Build(constants.FieldTypeString, testColumn, []interface{}{"Filtex", "Go"})
+
+	// Assert
+	sql, vars := buildTestSql(expression)
+	assert.Equal(t, "LOWER(`value`) IN (?,?)", sql)
+	assert.Equal(t, []interface{}{"filtex", "go"}, vars)
+}
+
+func TestInOperator_ShouldReturnCaseSensitiveExpression_WhenCollationIsCaseSensitive(t *testing.T) {
+	// Act
+	expression := InOperator{Collation: constants.CollationCaseSensitive}.Build(constants.FieldTypeString, testColumn, []interface{}{"Filtex", "Go"})
+
+	// Assert
+	sql, vars := buildTestSql(expression)
+	assert.Equal(t, "`value` IN (?,?)", sql)
+	assert.Equal(t, []interface{}{"Filtex", "Go"}, vars)
+}
+
+func TestInOperator_ShouldReturnExpression_WhenFieldTypeIsNumber(t *testing.T) {
+	// Act
+	expression := InOperator{}.Build(constants.FieldTypeNumber, testColumn, float64(100))
+
+	// Assert
+	sql, vars := buildTestSql(expression)
+	assert.Equal(t, "`value` IN (?)", sql)
+	assert.Equal(t, []interface{}{float64(100)}, vars)
+}
+
+func TestInOperator_ShouldReturnRangeExpressions_WhenFieldTypeIsDate(t *testing.T) {
+	// Arrange
+	values := []interface{}{
+		time.Date(2024, 03, 10, 0, 0, 0, 0, time.UTC),
+		time.Date(2024, 03, 12, 0, 0, 0, 0, time.UTC),
+	}
+
+	// Act
+	expression := InOperator{}.Build(constants.FieldTypeDate, testColumn, values)
+
+	// Assert
+	sql, vars := buildTestSql(expression)
+	assert.Equal(t, "((`value` >= ? AND `value` < ?) OR (`value` >= ? AND `value` < ?))", sql)
+	assert.Len(t, vars, 4)
+}
@@ -0,0 +1,28 @@
+package operators
+
+import (
+	"testing"
+
+	"github.com/filtex/filtex-go/constants"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestIsNotNullOperator_ShouldReturnExpression_WhenFieldTypeIsString(t *testing.T) {
+	// Act
+	expression := IsNotNullOperator{}.Build(constants.FieldTypeString, testColumn, nil)
+
+	// Assert
+	sql, vars := buildTestSql(expression)
+	assert.Equal(t, "`value` IS NOT NULL", sql)
+	assert.Empty(t, vars)
+}
+
+func TestIsNotNullOperator_ShouldReturnExpression_WhenFieldTypeIsArray(t *testing.T) {
+	// Act
+	expression := IsNotNullOperator{}.Build(constants.FieldTypeNumberArray, testColumn, nil)
+
+	// Assert
+	sql, vars := buildTestSql(expression)
+	assert.Equal(t, "`value` IS NOT NULL", sql)
+	assert.Empty(t, vars)
+}
@@ -0,0 +1,12 @@
+package operators
+
+import (
+	"github.com/filtex/filtex-go/constants"
+	"gorm.io/gorm/clause"
+)
+
+type IsNullOperator struct{}
+
+func (IsNullOperator) Build(fieldType constants.FieldType, column clause.Expression, value interface{}) clause.Expression {
+	return clause.Expr{SQL: "? IS NULL", Vars: []interface{}{column}}
+}
@@ -0,0 +1,28 @@
+package operators
+
+import (
+	"testing"
+
+	"github.com/filtex/filtex-go/constants"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestIsNullOperator_ShouldReturnExpression_WhenFieldTypeIsString(t *testing.T) {
+	// Act
+	expression := IsNullOperator{}.Build(constants.FieldTypeString, testColumn, nil)
+
+	// Assert
+	sql, vars := buildTestSql(expression)
+	assert.Equal(t, "`value` IS NULL", sql)
+	assert.Empty(t, vars)
+}
+
+func TestIsNullOperator_ShouldReturnExpression_WhenFieldTypeIsArray(t *testing.T) {
+	// Act
+	expression := IsNullOperator{}.Build(constants.FieldTypeNumberArray, testColumn, nil)
+
+	// Assert
+	sql, vars := buildTestSql(expression)
+	assert.Equal(t, "`value` IS NULL", sql)
+	assert.Empty(t, vars)
+}
@@ -0,0 +1,35 @@
+package operators
+
+import (
+	"github.com/filtex/filtex-go/constants"
+	"gorm.io/gorm/clause"
+)
+
+type LessThanOperator struct {
+	Collation constants.Collation
+}
+
+func (o LessThanOperator) Build(fieldType constants.FieldType, column clause.Expression, value interface{}) clause.Expression {
+	if fieldType != constants.FieldTypeNumber &&
+		fieldType != constants.FieldTypeInteger &&
+		fieldType != constants.FieldTypeDecimal &&
+		fieldType != constants.FieldTypeDate &&
+		fieldType != constants.FieldTypeTime &&
+		fieldType != constants.FieldTypeDuration &&
+		fieldType != constants.FieldTypeDateTime &&
+		fieldType != constants.FieldTypeString &&
+		fieldType != constants.FieldTypeUUID &&
+		fieldType != constants.FieldTypeBoolean {
+		return nil
+	}
+
+	if fieldType == constants.FieldTypeString {
+		return compare(fieldType, o.Collation, column, value, "<")
+	}
+
+	if r, ok := valueRange(fieldType, value); ok {
+		return clause.Expr{SQL: "? < ?", Vars: []interface{}{column, r.Start}}
+	}
+
+	return clause.Expr{SQL: "? < ?", Vars: []interface{}{column, value}}
+}
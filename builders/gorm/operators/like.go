@@ -0,0 +1,68 @@
+package operators
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/filtex/filtex-go/constants"
+	"github.com/filtex/filtex-go/utils"
+	"gorm.io/gorm/clause"
+)
+
+var likeEscaper = strings.NewReplacer("!", "!!", "%", "!%", "_", "!_")
+
+func like(collation constants.Collation, column clause.Expression, prefix string, value interface{}, suffix string, operator string) clause.Expression {
+	if collation.IsAccentInsensitive() {
+		return nil
+	}
+
+	str, err := utils.String(value)
+	if err != nil {
+		return nil
+	}
+
+	pattern := prefix + likeEscaper.Replace(str) + suffix
+
+	if collation.IsCaseSensitive() {
+		return clause.Expr{
+			SQL:  fmt.Sprintf("? %s ? ESCAPE '!'", operator),
+			Vars: []interface{}{column, pattern},
+		}
+	}
+
+	return clause.Expr{
+		SQL:  fmt.Sprintf("LOWER(?) %s ? ESCAPE '!'", operator),
+		Vars: []interface{}{column, strings.ToLower(pattern)},
+	}
+}
+
+func compare(fieldType constants.FieldType, collation constants.Collation, column clause.Expression, value interface{}, operator string) clause.Expression {
+	if fieldType != constants.FieldTypeString || collation.IsCaseSensitive() {
+		return clause.Expr{
+			SQL:  fmt.Sprintf("? %s ?", operator),
+			Vars: []interface{}{column, value},
+		}
+	}
+
+	if collation.IsAccentInsensitive() {
+		return nil
+	}
+
+	str, err := utils.String(value)
+	if err != nil {
+		return nil
+	}
+
+	return clause.Expr{
+		SQL:  fmt.Sprintf("LOWER(?) %s ?", operator),
+		Vars: []interface{}{column, strings.ToLower(str)},
+	}
+}
+
+func orNull(column clause.Expression, expression clause.Expression) clause.Expression {
+	if expression == nil {
+		return nil
+	}
+
+	return clause.Expr{SQL: "(? OR ? IS NULL)", Vars: []interface{}{expression, column}}
+}
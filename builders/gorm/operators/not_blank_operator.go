@@ -0,0 +1,21 @@
+package operators
+
+import (
+	"github.com/filtex/filtex-go/constants"
+	"gorm.io/gorm/clause"
+)
+
+type NotBlankOperator struct{}
+
+func (NotBlankOperator) Build(fieldType constants.FieldType, column clause.Expression, value interface{}) clause.Expression {
+	if fieldType.IsArray() {
+		return nil
+	}
+
+	switch fieldType {
+	case constants.FieldTypeString:
+		return clause.Expr{SQL: "? IS NOT NULL AND ? <> ''", Vars: []interface{}{column, column}}
+	}
+
+	return clause.Expr{SQL: "? IS NOT NULL", Vars: []interface{}{column}}
+}
@@ -0,0 +1,36 @@
+package operators
+
+import (
+	"testing"
+
+	"github.com/filtex/filtex-go/constants"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNotBlankOperator_ShouldReturnNil_WhenFieldTypeIsArray(t *testing.T) {
+	// Act
+	expression := NotBlankOperator{}.Build(constants.FieldTypeStringArray, testColumn, nil)
+
+	// Assert
+	assert.Nil(t, expression)
+}
+
+func TestNotBlankOperator_ShouldReturnExpression_WhenFieldTypeIsString(t *testing.T) {
+	// Act
+	expression := NotBlankOperator{}.Build(constants.FieldTypeString, testColumn, nil)
+
+	// Assert
+	sql, vars := buildTestSql(expression)
+	assert.Equal(t, "`value` IS NOT NULL AND `value` <> ''", sql)
+	assert.Empty(t, vars)
+}
+
+func TestNotBlankOperator_ShouldReturnExpression_WhenFieldTypeIsNumber(t *testing.T) {
+	// Act
+	expression := NotBlankOperator{}.Build(constants.FieldTypeNumber, testColumn, nil)
+
+	// Assert
+	sql, vars := buildTestSql(expression)
+	assert.Equal(t, "`value` IS NOT NULL", sql)
+	assert.Empty(t, vars)
+}
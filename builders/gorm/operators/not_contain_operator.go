@@ -0,0 +1,18 @@
+package operators
+
+import (
+	"github.com/filtex/filtex-go/constants"
+	"gorm.io/gorm/clause"
+)
+
+type NotContainOperator struct {
+	Collation constants.Collation
+}
+
+func (o NotContainOperator) Build(fieldType constants.FieldType, column clause.Expression, value interface{}) clause.Expression {
+	if fieldType != constants.FieldTypeString {
+		return nil
+	}
+
+	return orNull(column, like(o.Collation, column, "%", value, "%", "NOT LIKE"))
+}
@@ -0,0 +1,44 @@
+package operators
+
+import (
+	"testing"
+
+	"github.com/filtex/filtex-go/constants"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNotContainOperator_ShouldReturnNil_WhenFieldTypeIsNotString(t *testing.T) {
+	// Act
+	expression := NotContainOperator{}.Build(constants.FieldTypeNumber, testColumn, float64(1))
+
+	// Assert
+	assert.Nil(t, expression)
+}
+
+func TestNotContainOperator_ShouldReturnExpression_WhenFieldTypeIsString(t *testing.T) {
+	// Act
+	expression := NotContainOperator{}.Build(constants.FieldTypeString, testColumn, "Fil_tex%")
+
+	// Assert
+	sql, vars := buildTestSql(expression)
+	assert.Equal(t, "(LOWER(`value`) NOT LIKE ? ESCAPE '!' OR `value` IS NULL)", sql)
+	assert.Equal(t, []interface{}{"%fil!_tex!%%"}, vars)
+}
+
+func TestNotContainOperator_ShouldReturnCaseSensitiveExpression_WhenCollationIsCaseSensitive(t *testing.T) {
+	// Act
+	expression := NotContainOperator{Collation: constants.CollationCaseSensitive}.Build(constants.FieldTypeString, testColumn, "Fil_tex%")
+
+	// Assert
+	sql, vars := buildTestSql(expression)
+	assert.Equal(t, "(`value` NOT LIKE ? ESCAPE '!' OR `value` IS NULL)", sql)
+	assert.Equal(t, []interface{}{"%Fil!_tex!%%"}, vars)
+}
+
+func TestNotContainOperator_ShouldReturnNil_WhenCollationIsAccentInsensitive(t *testing.T) {
+	// Act
+	expression := NotContainOperator{Collation: constants.CollationAccentInsensitive}.Build(constants.FieldTypeString, testColumn, "Filtex")
+
+	// Assert
+	assert.Nil(t, expression)
+}
@@ -0,0 +1,22 @@
+package operators
+
+import (
+	"github.com/filtex/filtex-go/constants"
+	"gorm.io/gorm/clause"
+)
+
+type NotEqualOperator struct {
+	Collation constants.Collation
+}
+
+func (o NotEqualOperator) Build(fieldType constants.FieldType, column clause.Expression, value interface{}) clause.Expression {
+	if fieldType.IsArray() || value == nil {
+		return nil
+	}
+
+	if hasRange(fieldType, value) {
+		return notEqualRange(fieldType, column, value)
+	}
+
+	return orNull(column, compare(fieldType, o.Collation, column, value, "<>"))
+}
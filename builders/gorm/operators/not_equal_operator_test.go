@@ -0,0 +1,67 @@
+package operators
+
+import (
+	"testing"
+	"time"
+
+	"github.com/filtex/filtex-go/constants"
+	"github.com/filtex/filtex-go/utils"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNotEqualOperator_ShouldReturnNil_WhenFieldTypeIsArray(t *testing.T) {
+	// Act
+	expression := NotEqualOperator{}.Build(constants.FieldTypeNumberArray, testColumn, float64(1))
+
+	// Assert
+	assert.Nil(t, expression)
+}
+
+func TestNotEqualOperator_ShouldReturnExpression_WhenFieldTypeIsString(t *testing.T) {
+	// Act
+	expression := NotEqualOperator{}.Build(constants.FieldTypeString, testColumn, "Filtex")
+
+	// Assert
+	sql, vars := buildTestSql(expression)
+	assert.Equal(t, "(LOWER(`value`) <> ? OR `value` IS NULL)", sql)
+	assert.Equal(t, []interface{}{"filtex"}, vars)
+}
+
+func TestNotEqualOperator_ShouldReturnExpression_WhenFieldTypeIsBoolean(t *testing.T) {
+	// Act
+	expression := NotEqualOperator{}.Build(constants.FieldTypeBoolean, testColumn, true)
+
+	// Assert
+	sql, vars := buildTestSql(expression)
+	assert.Equal(t, "(`value` <> ? OR `value` IS NULL)", sql)
+	assert.Equal(t, []interface{}{true}, vars)
+}
+
+func TestNotEqualOperator_ShouldReturnRangeExpression_WhenValueIsTimeRange(t *testing.T) {
+	// Arrange
+	value := &utils.TimeRange{
+		Start: time.Date(2024, 03, 10, 15, 0, 0, 0, time.UTC),
+		End:   time.Date(2024, 03, 10, 16, 0, 0, 0, time.UTC),
+	}
+
+	// Act
+	expression := NotEqualOperator{}.Build(constants.FieldTypeDateTime, testColumn, value)
+
+	// Assert
+	sql, vars := buildTestSql(expression)
+	assert.Equal(t, "(`value` < ? OR `value` >= ? OR `value` IS NULL)", sql)
+	assert.Equal(t, []interface{}{value.Start, value.End}, vars)
+}
+
+func TestNotEqualOperator_ShouldReturnExpression_WhenDateTimeHasNoRange(t *testing.T) {
+	// Arrange
+	value := time.Date(2024, 03, 10, 15, 0, 0, 0, time.UTC)
+
+	// Act
+	expression := NotEqualOperator{}.Build(constants.FieldTypeDateTime, testColumn, value)
+
+	// Assert
+	sql, vars := buildTestSql(expression)
+	assert.Equal(t, "(`value` <> ? OR `value` IS NULL)", sql)
+	assert.Equal(t, []interface{}{value}, vars)
+}
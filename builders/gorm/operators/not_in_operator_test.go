@@ -0,0 +1,44 @@
+package operators
+
+import (
+	"testing"
+
+	"github.com/filtex/filtex-go/constants"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNotInOperator_ShouldReturnNil_WhenValueIsNil(t *testing.T) {
+	// Act
+	expression := NotInOperator{}.Build(constants.FieldTypeString, testColumn, nil)
+
+	// Assert
+	assert.Nil(t, expression)
+}
+
+func TestNotInOperator_ShouldReturnExpression_WhenFieldTypeIsString(t *testing.T) {
+	// Act
+	expression := NotInOperator{}.Build(constants.FieldTypeString, testColumn, []interface{}{"Filtex", "Go"})
+
+	// Assert
+	sql, vars := buildTestSql(expression)
+	assert.Equal(t, "(LOWER(`value`) NOT IN (?,?) OR `value` IS NULL)", sql)
+	assert.Equal(t, []interface{}{"filtex", "go"}, vars)
+}
+
+func TestNotInOperator_ShouldReturnExpression_WhenFieldTypeIsInteger(t *testing.T) {
+	// Act
+	expression := NotInOperator{}.Build(constants.FieldTypeInteger, testColumn, []interface{}{int64(1), int64(2)})
+
+	// Assert
+	sql, vars := buildTestSql(expression)
+	assert.Equal(t, "(`value` NOT IN (?,?) OR `value` IS NULL)", sql)
+	assert.Equal(t, []interface{}{int64(1), int64(2)}, vars)
+}
+
+func TestNotInOperator_ShouldReturnNil_WhenCollationIsAccentInsensitive(t *testing.T) {
+	// Act
+	expression := NotInOperator{Collation: constants.CollationAccentInsensitive}.Build(constants.FieldTypeString, testColumn, []interface{}{"Filtex"})
+
+	// Assert
+	assert.Nil(t, expression)
+}
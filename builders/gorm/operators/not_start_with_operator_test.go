@@ -0,0 +1,44 @@
+package operators
+
+import (
+	"testing"
+
+	"github.com/filtex/filtex-go/constants"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNotStartWithOperator_ShouldReturnNil_WhenFieldTypeIsNotString(t *testing.T) {
+	// Act
+	expression := NotStartWithOperator{}.Build(constants.FieldTypeNumber, testColumn, float64(1))
+
+	// Assert
+	assert.Nil(t, expression)
+}
+
+func TestNotStartWithOperator_ShouldReturnExpression_WhenFieldTypeIsString(t *testing.T) {
+	// Act
+	expression := NotStartWithOperator{}.Build(constants.FieldTypeString, testColumn, "Fil_tex%")
+
+	// Assert
+	sql, vars := buildTestSql(expression)
+	assert.Equal(t, "(LOWER(`value`) NOT LIKE ? ESCAPE '!' OR `value` IS NULL)", sql)
+	assert.Equal(t, []interface{}{"fil!_tex!%%"}, vars)
+}
+
+func TestNotStartWithOperator_ShouldReturnCaseSensitiveExpression_WhenCollationIsCaseSensitive(t *testing.T) {
+	// Act
+	expression := NotStartWithOperator{Collation: constants.CollationCaseSensitive}.Build(constants.FieldTypeString, testColumn, "Fil_tex%")
+
+	// Assert
+	sql, vars := buildTestSql(expression)
+	assert.Equal(t, "(`value` NOT LIKE ? ESCAPE '!' OR `value` IS NULL)", sql)
+	assert.Equal(t, []interface{}{"Fil!_tex!%%"}, vars)
+}
+
+func TestNotStartWithOperator_ShouldReturnNil_WhenCollationIsAccentInsensitive(t *testing.T) {
+	// Act
+	expression := NotStartWithOperator{Collation: constants.CollationAccentInsensitive}.Build(constants.FieldTypeString, testColumn, "Filtex")
+
+	// Assert
+	assert.Nil(t, expression)
+}
@@ -0,0 +1,40 @@
+package operators
+
+import (
+	"strings"
+
+	"github.com/filtex/filtex-go/constants"
+	"gorm.io/gorm/clause"
+)
+
+type SearchOperator struct{}
+
+func (SearchOperator) Build(columns []clause.Expression, value string) clause.Expression {
+	terms := strings.Fields(value)
+
+	if len(columns) == 0 || len(terms) == 0 {
+		return nil
+	}
+
+	conditions := make([]clause.Expression, 0)
+
+	for _, term := range terms {
+		matches := make([]clause.Expression, 0)
+
+		for _, column := range columns {
+			matches = append(matches, like(constants.CollationDefault, column, "%", term, "%", "LIKE"))
+		}
+
+		if len(matches) == 1 {
+			conditions = append(conditions, matches[0])
+		} else {
+			conditions = append(conditions, clause.OrConditions{Exprs: matches})
+		}
+	}
+
+	if len(conditions) == 1 {
+		return conditions[0]
+	}
+
+	return clause.AndConditions{Exprs: conditions}
+}
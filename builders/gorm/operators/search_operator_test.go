@@ -0,0 +1,50 @@
+package operators
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"gorm.io/gorm/clause"
+)
+
+func TestSearchOperator_ShouldReturnNil_WhenThereAreNoColumns(t *testing.T) {
+	// Act
+	expression := SearchOperator{}.Build([]clause.Expression{}, "filtex")
+
+	// Assert
+	assert.Nil(t, expression)
+}
+
+func TestSearchOperator_ShouldReturnNil_WhenValueIsBlank(t *testing.T) {
+	// Act
+	expression := SearchOperator{}.Build([]clause.Expression{testColumn}, "  ")
+
+	// Assert
+	assert.Nil(t, expression)
+}
+
+func TestSearchOperator_ShouldReturnExpression_WhenThereIsOneColumn(t *testing.T) {
+	// Act
+	expression := SearchOperator{}.Build([]clause.Expression{testColumn}, "Filtex")
+
+	// Assert
+	sql, vars := buildTestSql(expression)
+	assert.Equal(t, "LOWER(`value`) LIKE ? ESCAPE '!'", sql)
+	assert.Equal(t, []interface{}{"%filtex%"}, vars)
+}
+
+func TestSearchOperator_ShouldReturnExpression_WhenThereAreMultipleColumnsAndTerms(t *testing.T) {
+	// Arrange
+	columns := []clause.Expression{
+		clause.Expr{SQL: "`name`"},
+		clause.Expr{SQL: "`description`"},
+	}
+
+	// Act
+	expression := SearchOperator{}.Build(columns, "filtex go")
+
+	// Assert
+	sql, vars := buildTestSql(expression)
+	assert.Equal(t, "((LOWER(`name`) LIKE ? ESCAPE '!' OR LOWER(`description`) LIKE ? ESCAPE '!') AND (LOWER(`name`) LIKE ? ESCAPE '!' OR LOWER(`description`) LIKE ? ESCAPE '!'))", sql)
+	assert.Equal(t, []interface{}{"%filtex%", "%filtex%", "%go%", "%go%"}, vars)
+}
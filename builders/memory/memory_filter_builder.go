@@ -7,15 +7,20 @@ import (
 	"github.com/filtex/filtex-go/constants"
 	"github.com/filtex/filtex-go/errors"
 	"github.com/filtex/filtex-go/expressions"
+	"github.com/filtex/filtex-go/utils"
 )
 
 type MemoryFilterBuilder struct {
-	logicsMap    map[constants.Logic]func(expressions []*types.MemoryExpression) *types.MemoryExpression
-	operatorsMap map[constants.Operator]func(fieldType constants.FieldType, field string, value interface{}) *types.MemoryExpression
+	fields        map[string]string
+	fieldMapper   func(field string) string
+	logicsMap     map[constants.Logic]func(expressions []*types.MemoryExpression) *types.MemoryExpression
+	operatorsMap  map[constants.Operator]func(fieldType constants.FieldType, field string, value interface{}) *types.MemoryExpression
+	collationsMap map[constants.Collation]map[constants.Operator]func(fieldType constants.FieldType, field string, value interface{}) *types.MemoryExpression
 }
 
 func NewMemoryFilterBuilder() *MemoryFilterBuilder {
 	return &MemoryFilterBuilder{
+		fields: map[string]string{},
 		logicsMap: map[constants.Logic]func(expressions []*types.MemoryExpression) *types.MemoryExpression{
 			constants.LogicAnd: logics.AndLogic{}.Build,
 			constants.LogicOr:  logics.OrLogic{}.Build,
@@ -31,14 +36,54 @@ func NewMemoryFilterBuilder() *MemoryFilterBuilder {
 			constants.OperatorNotEndWith:         operators.NotEndWithOperator{}.Build,
 			constants.OperatorBlank:              operators.BlankOperator{}.Build,
 			constants.OperatorNotBlank:           operators.NotBlankOperator{}.Build,
+			constants.OperatorIsNull:             operators.IsNullOperator{}.Build,
+			constants.OperatorIsNotNull:          operators.IsNotNullOperator{}.Build,
 			constants.OperatorGreaterThan:        operators.GreaterThanOperator{}.Build,
 			constants.OperatorGreaterThanOrEqual: operators.GreaterThanOrEqualOperator{}.Build,
 			constants.OperatorLessThan:           operators.LessThanOperator{}.Build,
 			constants.OperatorLessThanOrEqual:    operators.LessThanOrEqualOperator{}.Build,
 			constants.OperatorIn:                 operators.InOperator{}.Build,
 			constants.OperatorNotIn:              operators.NotInOperator{}.Build,
+			constants.OperatorInSubnet:           operators.InSubnetOperator{}.Build,
+			constants.OperatorNotInSubnet:        operators.NotInSubnetOperator{}.Build,
+			constants.OperatorWithin:             operators.WithinOperator{}.Build,
 		},
+		collationsMap: map[constants.Collation]map[constants.Operator]func(fieldType constants.FieldType, field string, value interface{}) *types.MemoryExpression{
+			constants.CollationCaseSensitive:     newCollationOperatorsMap(constants.CollationCaseSensitive),
+			constants.CollationAccentInsensitive: newCollationOperatorsMap(constants.CollationAccentInsensitive),
+		},
+	}
+}
+
+func newCollationOperatorsMap(collation constants.Collation) map[constants.Operator]func(fieldType constants.FieldType, field string, value interface{}) *types.MemoryExpression {
+	return map[constants.Operator]func(fieldType constants.FieldType, field string, value interface{}) *types.MemoryExpression{
+		constants.OperatorEqual:              operators.EqualOperator{Collation: collation}.Build,
+		constants.OperatorNotEqual:           operators.NotEqualOperator{Collation: collation}.Build,
+		constants.OperatorContain:            operators.ContainOperator{Collation: collation}.Build,
+		constants.OperatorNotContain:         operators.NotContainOperator{Collation: collation}.Build,
+		constants.OperatorStartWith:          operators.StartWithOperator{Collation: collation}.Build,
+		constants.OperatorNotStartWith:       operators.NotStartWithOperator{Collation: collation}.Build,
+		constants.OperatorEndWith:            operators.EndWithOperator{Collation: collation}.Build,
+		constants.OperatorNotEndWith:         operators.NotEndWithOperator{Collation: collation}.Build,
+		constants.OperatorIn:                 operators.InOperator{Collation: collation}.Build,
+		constants.OperatorNotIn:              operators.NotInOperator{Collation: collation}.Build,
+		constants.OperatorGreaterThan:        operators.GreaterThanOperator{Collation: collation}.Build,
+		constants.OperatorGreaterThanOrEqual: operators.GreaterThanOrEqualOperator{Collation: collation}.Build,
+		constants.OperatorLessThan:           operators.LessThanOperator{Collation: collation}.Build,
+		constants.OperatorLessThanOrEqual:    operators.LessThanOrEqualOperator{Collation: collation}.Build,
+	}
+}
+
+func (b *MemoryFilterBuilder) Fields(fields map[string]string) *MemoryFilterBuilder {
+	for k, v := range fields {
+		b.fields[k] = v
 	}
+	return b
+}
+
+func (b *MemoryFilterBuilder) FieldMapper(mapper func(field string) string) *MemoryFilterBuilder {
+	b.fieldMapper = mapper
+	return b
 }
 
 func (b *MemoryFilterBuilder) Build(expression expressions.Expression) (*types.MemoryExpression, error) {
@@ -60,12 +105,23 @@ func (b *MemoryFilterBuilder) Build(expression expressions.Expression) (*types.M
 
 		return nil, errors.NewCouldNotBeBuiltError()
 	case *expressions.OperatorExpression:
-		if fn, ok := b.operatorsMap[exp.Operator]; ok {
-			return fn(exp.Type, exp.Field, exp.Value), nil
+		if fn, ok := b.findOperator(exp.Operator, exp.Collation); ok {
+			return fn(exp.Type, utils.MapField(b.fields, b.fieldMapper, exp.Field), exp.Value), nil
 		}
 
 		return nil, errors.NewCouldNotBeBuiltError()
+	case *expressions.SearchExpression:
+		return operators.SearchOperator{}.Build(utils.MapFields(b.fields, b.fieldMapper, exp.Fields), exp.Value), nil
 	}
 
 	return nil, errors.NewCouldNotBeBuiltError()
 }
+
+func (b *MemoryFilterBuilder) findOperator(operator constants.Operator, collation constants.Collation) (func(fieldType constants.FieldType, field string, value interface{}) *types.MemoryExpression, bool) {
+	if fn, ok := b.collationsMap[collation][operator]; ok {
+		return fn, true
+	}
+
+	fn, ok := b.operatorsMap[operator]
+	return fn, ok
+}
@@ -1,12 +1,17 @@
 package memory
 
 import (
+	"strings"
 	"testing"
+	"time"
 
 	"github.com/filtex/filtex-go/builders/memory/types"
+	"github.com/filtex/filtex-go/builders/mongo"
+	"github.com/filtex/filtex-go/builders/postgres"
 	"github.com/filtex/filtex-go/constants"
 	"github.com/filtex/filtex-go/expressions"
 	"github.com/stretchr/testify/assert"
+	"go.mongodb.org/mongo-driver/bson"
 )
 
 func TestBuild_ShouldReturnError_WhenExpressionIsNil(t *testing.T) {
@@ -90,3 +95,108 @@ func TestBuild_ShouldReturnExpression_WhenExpressionIsOperatorExpressionAndValid
 	assert.IsType(t, memoryExpression, expression)
 	assert.NoError(t, err)
 }
+
+func TestBuild_ShouldMapFields_WhenFieldsAreSet(t *testing.T) {
+	// Arrange
+	builder := NewMemoryFilterBuilder().Fields(map[string]string{"createdAt": "created_at"})
+	operatorExpression := expressions.NewOperatorExpression(constants.FieldTypeNumber, "createdAt", constants.OperatorEqual, float64(1))
+
+	// Act
+	expression, err := builder.Build(operatorExpression)
+
+	// Assert
+	assert.NoError(t, err)
+	assert.True(t, expression.Fn(map[string]interface{}{"created_at": float64(1)}))
+	assert.False(t, expression.Fn(map[string]interface{}{"createdAt": float64(1)}))
+}
+
+func TestBuild_ShouldUseFieldMapper_WhenFieldIsNotInFields(t *testing.T) {
+	// Arrange
+	builder := NewMemoryFilterBuilder().FieldMapper(strings.ToLower)
+	searchExpression := expressions.NewSearchExpression([]string{"Name"}, "filtex")
+
+	// Act
+	expression, err := builder.Build(searchExpression)
+
+	// Assert
+	assert.NoError(t, err)
+	assert.True(t, expression.Fn(map[string]interface{}{"name": "Filtex Go"}))
+}
+
+func TestBuild_ShouldCompareDatesByDay_LikePostgresAndMongo(t *testing.T) {
+	// Arrange
+	day := time.Date(2024, 3, 10, 0, 0, 0, 0, time.UTC)
+	next := day.AddDate(0, 0, 1)
+	rows := []time.Time{day.Add(-time.Hour), day, day.Add(15 * time.Hour), next}
+	tests := []struct {
+		operator  constants.Operator
+		matches   []bool
+		condition string
+		bound     time.Time
+		mongo     bson.M
+	}{
+		{constants.OperatorGreaterThan, []bool{false, false, false, true}, `Day >= $1`, next, bson.M{"Day": bson.M{"$gte": next}}},
+		{constants.OperatorGreaterThanOrEqual, []bool{false, true, true, true}, `Day >= $1`, day, bson.M{"Day": bson.M{"$gte": day}}},
+		{constants.OperatorLessThan, []bool{true, false, false, false}, `Day < $1`, day, bson.M{"Day": bson.M{"$lt": day}}},
+		{constants.OperatorLessThanOrEqual, []bool{true, true, true, false}, `Day < $1`, next, bson.M{"Day": bson.M{"$lt": next}}},
+	}
+
+	for _, test := range tests {
+		operatorExpression := expressions.NewOperatorExpression(constants.FieldTypeDate, "Day", test.operator, day)
+
+		// Act
+		memoryExpression, memoryErr := NewMemoryFilterBuilder().Build(operatorExpression)
+		postgresExpression, postgresErr := postgres.NewPostgresFilterBuilder().Build(operatorExpression)
+		mongoExpression, mongoErr := mongo.NewMongoFilterBuilder().Build(operatorExpression)
+
+		// Assert
+		assert.NoError(t, memoryErr)
+		assert.NoError(t, postgresErr)
+		assert.NoError(t, mongoErr)
+		for i, row := range rows {
+			assert.Equal(t, test.matches[i], memoryExpression.Fn(map[string]interface{}{"Day": row}), "%s %s", test.operator, row)
+			if strings.Contains(test.condition, "<") {
+				assert.Equal(t, test.matches[i], row.Before(test.bound), "%s %s", test.operator, row)
+			} else {
+				assert.Equal(t, test.matches[i], !row.Before(test.bound), "%s %s", test.operator, row)
+			}
+		}
+		assert.Equal(t, test.condition, postgresExpression.Condition)
+		assert.Equal(t, []interface{}{test.bound}, postgresExpression.Args)
+		assert.Equal(t, test.mongo, mongoExpression.Condition)
+	}
+}
+
+func TestBuild_ShouldMatchNullValues_WhenOperatorIsNegated(t *testing.T) {
+	// Arrange
+	tests := []struct {
+		expression expressions.Expression
+		condition  string
+		mongo      bson.M
+	}{
+		{expressions.NewOperatorExpression(constants.FieldTypeNumber, "Value", constants.OperatorNotEqual, float64(5)), `(Value <> $1 OR Value IS NULL)`, bson.M{"Value": bson.M{"$ne": float64(5)}}},
+		{expressions.NewOperatorExpression(constants.FieldTypeNumber, "Value", constants.OperatorNotIn, []interface{}{float64(5)}), `(Value NOT IN ($1) OR Value IS NULL)`, bson.M{"Value": bson.M{"$nin": []interface{}{float64(5)}}}},
+		{expressions.NewOperatorExpression(constants.FieldTypeString, "Value", constants.OperatorNotContain, "a"), `(Value NOT ILIKE '%' || $1 || '%' OR Value IS NULL)`, bson.M{"Value": bson.M{"$not": bson.M{"$regex": "a", "$options": "i"}}}},
+		{expressions.NewOperatorExpression(constants.FieldTypeString, "Value", constants.OperatorNotStartWith, "a"), `(Value NOT ILIKE $1 || '%' OR Value IS NULL)`, bson.M{"Value": bson.M{"$not": bson.M{"$regex": "^a", "$options": "i"}}}},
+		{expressions.NewOperatorExpression(constants.FieldTypeString, "Value", constants.OperatorNotEndWith, "a"), `(Value NOT ILIKE '%' || $1 OR Value IS NULL)`, bson.M{"Value": bson.M{"$not": bson.M{"$regex": "a$", "$options": "i"}}}},
+		{expressions.NewOperatorExpression(constants.FieldTypeIP, "Value", constants.OperatorNotInSubnet, "10.0.0.0/8"), `(NOT (Value <<= $1::inet) OR Value IS NULL)`, nil},
+	}
+
+	for _, test := range tests {
+		// Act
+		memoryExpression, memoryErr := NewMemoryFilterBuilder().Build(test.expression)
+		postgresExpression, postgresErr := postgres.NewPostgresFilterBuilder().Build(test.expression)
+		mongoExpression, mongoErr := mongo.NewMongoFilterBuilder().Build(test.expression)
+
+		// Assert
+		assert.NoError(t, memoryErr)
+		assert.NoError(t, postgresErr)
+		assert.NoError(t, mongoErr)
+		assert.True(t, memoryExpression.Fn(map[string]interface{}{"Value": nil}), test.condition)
+		assert.True(t, memoryExpression.Fn(map[string]interface{}{}), test.condition)
+		assert.Equal(t, test.condition, postgresExpression.Condition)
+		if test.mongo != nil {
+			assert.Equal(t, test.mongo, mongoExpression.Condition)
+		}
+	}
+}
@@ -0,0 +1,95 @@
+package memory
+
+import (
+	"github.com/filtex/filtex-go/builders/memory/types"
+	memoryUtils "github.com/filtex/filtex-go/builders/memory/utils"
+	"github.com/filtex/filtex-go/constants"
+	"github.com/filtex/filtex-go/errors"
+	"github.com/filtex/filtex-go/expressions"
+	"github.com/filtex/filtex-go/utils"
+)
+
+type MemorySortBuilder struct {
+	fields      map[string]string
+	fieldMapper func(field string) string
+}
+
+func NewMemorySortBuilder() *MemorySortBuilder {
+	return &MemorySortBuilder{
+		fields: map[string]string{},
+	}
+}
+
+func (b *MemorySortBuilder) Fields(fields map[string]string) *MemorySortBuilder {
+	for k, v := range fields {
+		b.fields[k] = v
+	}
+	return b
+}
+
+func (b *MemorySortBuilder) FieldMapper(mapper func(field string) string) *MemorySortBuilder {
+	b.fieldMapper = mapper
+	return b
+}
+
+func (b *MemorySortBuilder) Build(sorts []*expressions.SortExpression) (*types.MemorySort, error) {
+	fields := make([]string, 0)
+
+	for _, v := range sorts {
+		if v == nil || v.Field == "" {
+			return nil, errors.NewCouldNotBeBuiltError()
+		}
+
+		if v.Direction != constants.SortDirectionAsc &&
+			v.Direction != constants.SortDirectionDesc &&
+			v.Direction != constants.SortDirectionUnknown {
+			return nil, errors.NewCouldNotBeBuiltError()
+		}
+
+		fields = append(fields, utils.MapField(b.fields, b.fieldMapper, v.Field))
+	}
+
+	compare := func(a map[string]interface{}, b map[string]interface{}) int {
+		for i, v := range sorts {
+			result := compareSortValues(v, a[fields[i]], b[fields[i]])
+			if result != 0 {
+				return result
+			}
+		}
+
+		return 0
+	}
+
+	return &types.MemorySort{
+		Compare: compare,
+		Less: func(a map[string]interface{}, b map[string]interface{}) bool {
+			return compare(a, b) < 0
+		},
+	}, nil
+}
+
+func compareSortValues(sort *expressions.SortExpression, a interface{}, b interface{}) int {
+	isDesc := sort.Direction == constants.SortDirectionDesc
+
+	if a == nil || b == nil {
+		if a == nil && b == nil {
+			return 0
+		}
+
+		nullsFirst := sort.Nulls == constants.SortNullsFirst || (sort.Nulls == constants.SortNullsDefault && isDesc)
+
+		if (a == nil) == nullsFirst {
+			return -1
+		}
+
+		return 1
+	}
+
+	result, _ := memoryUtils.Compare(sort.Type, sort.Collation, a, b)
+
+	if isDesc {
+		return -result
+	}
+
+	return result
+}
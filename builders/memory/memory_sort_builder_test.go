@@ -0,0 +1,90 @@
+package memory
+
+import (
+	"sort"
+	"testing"
+
+	"github.com/filtex/filtex-go/constants"
+	"github.com/filtex/filtex-go/expressions"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSortBuild_ShouldReturnError_WhenSortIsNil(t *testing.T) {
+	// Arrange
+	builder := NewMemorySortBuilder()
+
+	// Act
+	result, err := builder.Build([]*expressions.SortExpression{nil})
+
+	// Assert
+	assert.Nil(t, result)
+	assert.Error(t, err)
+}
+
+func TestSortBuild_ShouldSortItems_WhenSortsAreValid(t *testing.T) {
+	// Arrange
+	builder := NewMemorySortBuilder()
+	items := []map[string]interface{}{
+		{"Name": "b", "Version": 1},
+		{"Name": "A", "Version": 2},
+		{"Name": "b", "Version": 3},
+		{"Name": nil, "Version": 4},
+	}
+
+	// Act
+	result, err := builder.Build([]*expressions.SortExpression{
+		expressions.NewSortExpression(constants.FieldTypeString, "Name", constants.SortDirectionAsc, constants.SortNullsDefault),
+		expressions.NewSortExpression(constants.FieldTypeNumber, "Version", constants.SortDirectionDesc, constants.SortNullsDefault),
+	})
+	sort.Slice(items, func(i, j int) bool {
+		return result.Less(items[i], items[j])
+	})
+
+	// Assert
+	assert.NoError(t, err)
+	assert.Equal(t, []interface{}{2, 3, 1, 4}, []interface{}{items[0]["Version"], items[1]["Version"], items[2]["Version"], items[3]["Version"]})
+}
+
+func TestSortBuild_ShouldPlaceNullsFirst_WhenNullsFirstIsDefined(t *testing.T) {
+	// Arrange
+	builder := NewMemorySortBuilder()
+	items := []map[string]interface{}{
+		{"Version": 2},
+		{"Version": nil},
+		{"Version": 1},
+	}
+
+	// Act
+	result, err := builder.Build([]*expressions.SortExpression{
+		expressions.NewSortExpression(constants.FieldTypeNumber, "Version", constants.SortDirectionAsc, constants.SortNullsFirst),
+	})
+	sort.Slice(items, func(i, j int) bool {
+		return result.Less(items[i], items[j])
+	})
+
+	// Assert
+	assert.NoError(t, err)
+	assert.Equal(t, []interface{}{nil, 1, 2}, []interface{}{items[0]["Version"], items[1]["Version"], items[2]["Version"]})
+}
+
+func TestSortBuild_ShouldMapFields_WhenFieldsAreSet(t *testing.T) {
+	// Arrange
+	builder := NewMemorySortBuilder().Fields(map[string]string{"version": "v"})
+	items := []map[string]interface{}{
+		{"v": 2},
+		{"v": 3},
+		{"v": 1},
+	}
+
+	// Act
+	result, err := builder.Build([]*expressions.SortExpression{
+		expressions.NewSortExpression(constants.FieldTypeNumber, "version", constants.SortDirectionDesc, constants.SortNullsDefault),
+	})
+	sort.Slice(items, func(i, j int) bool {
+		return result.Less(items[i], items[j])
+	})
+
+	// Assert
+	assert.NoError(t, err)
+	assert.Equal(t, []interface{}{3, 2, 1}, []interface{}{items[0]["v"], items[1]["v"], items[2]["v"]})
+}
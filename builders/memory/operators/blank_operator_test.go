@@ -264,7 +264,7 @@ func TestBlankExpression_ShouldReturnTrue_WhenFieldTypeIsDateTimeArrayAndNil(t *
 	assert.True(t, result)
 }
 
-func TestBlankExpression_ShouldReturnFalse_WhenFieldTypeIsNumber(t *testing.T) {
+func TestBlankExpression_ShouldReturnTrue_WhenFieldTypeIsNumberAndNil(t *testing.T) {
 	// Arrange
 	data := utils.ObjectToMap(struct {
 		Value *int
@@ -277,10 +277,10 @@ func TestBlankExpression_ShouldReturnFalse_WhenFieldTypeIsNumber(t *testing.T) {
 	result := expression.Fn(data)
 
 	// Assert
-	assert.False(t, result)
+	assert.True(t, result)
 }
 
-func TestBlankExpression_ShouldReturnFalse_WhenFieldTypeIsBoolean(t *testing.T) {
+func TestBlankExpression_ShouldReturnTrue_WhenFieldTypeIsBooleanAndNil(t *testing.T) {
 	// Arrange
 	data := utils.ObjectToMap(struct {
 		Value *bool
@@ -293,10 +293,10 @@ func TestBlankExpression_ShouldReturnFalse_WhenFieldTypeIsBoolean(t *testing.T)
 	result := expression.Fn(data)
 
 	// Assert
-	assert.False(t, result)
+	assert.True(t, result)
 }
 
-func TestBlankExpression_ShouldReturnFalse_WhenFieldTypeIsDate(t *testing.T) {
+func TestBlankExpression_ShouldReturnTrue_WhenFieldTypeIsDateAndNil(t *testing.T) {
 	// Arrange
 	data := utils.ObjectToMap(struct {
 		Value *time.Time
@@ -309,10 +309,10 @@ func TestBlankExpression_ShouldReturnFalse_WhenFieldTypeIsDate(t *testing.T) {
 	result := expression.Fn(data)
 
 	// Assert
-	assert.False(t, result)
+	assert.True(t, result)
 }
 
-func TestBlankExpression_ShouldReturnFalse_WhenFieldTypeIsTime(t *testing.T) {
+func TestBlankExpression_ShouldReturnTrue_WhenFieldTypeIsTimeAndNil(t *testing.T) {
 	// Arrange
 	data := utils.ObjectToMap(struct {
 		Value *int
@@ -325,10 +325,10 @@ func TestBlankExpression_ShouldReturnFalse_WhenFieldTypeIsTime(t *testing.T) {
 	result := expression.Fn(data)
 
 	// Assert
-	assert.False(t, result)
+	assert.True(t, result)
 }
 
-func TestBlankExpression_ShouldReturnFalse_WhenFieldTypeIsDateTime(t *testing.T) {
+func TestBlankExpression_ShouldReturnTrue_WhenFieldTypeIsDateTimeAndNil(t *testing.T) {
 	// Arrange
 	data := utils.ObjectToMap(struct {
 		Value *time.Time
@@ -341,5 +341,5 @@ func TestBlankExpression_ShouldReturnFalse_WhenFieldTypeIsDateTime(t *testing.T)
 	result := expression.Fn(data)
 
 	// Assert
-	assert.False(t, result)
+	assert.True(t, result)
 }
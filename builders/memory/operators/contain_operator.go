@@ -9,9 +9,11 @@ import (
 	"github.com/filtex/filtex-go/utils"
 )
 
-type ContainOperator struct{}
+type ContainOperator struct {
+	Collation constants.Collation
+}
 
-func (ContainOperator) Build(fieldType constants.FieldType, field string, value interface{}) *types.MemoryExpression {
+func (o ContainOperator) Build(fieldType constants.FieldType, field string, value interface{}) *types.MemoryExpression {
 	return &types.MemoryExpression{
 		Fn: func(data map[string]interface{}) bool {
 			if fieldType.IsArray() {
@@ -23,7 +25,7 @@ func (ContainOperator) Build(fieldType constants.FieldType, field string, value
 
 				if items, err := utils.Array(val); err == nil {
 					for _, item := range items {
-						if memoryUtils.CheckEquality(fieldType, item, value) {
+						if memoryUtils.CheckCollatedEquality(fieldType, o.Collation, item, value) {
 							return true
 						}
 					}
@@ -35,7 +37,7 @@ func (ContainOperator) Build(fieldType constants.FieldType, field string, value
 					return false
 				}
 
-				return strings.Contains(strings.ToLower(val.(string)), strings.ToLower(value.(string)))
+				return strings.Contains(memoryUtils.Collate(o.Collation, val.(string)), memoryUtils.Collate(o.Collation, value.(string)))
 			}
 
 			return false
@@ -624,3 +624,51 @@ func TestContainExpression_ShouldReturnFalse_WhenFieldTypeIsDateTime(t *testing.
 	// Assert
 	assert.False(t, result)
 }
+
+func TestContainExpression_ShouldReturnFalse_WhenCollationIsCaseSensitiveAndCaseDiffers(t *testing.T) {
+	// Arrange
+	data := utils.ObjectToMap(struct {
+		Value string
+	}{
+		Value: "Filtex",
+	})
+	expression := ContainOperator{Collation: constants.CollationCaseSensitive}.Build(constants.FieldTypeString, "Value", "ILT")
+
+	// Act
+	result := expression.Fn(data)
+
+	// Assert
+	assert.False(t, result)
+}
+
+func TestContainExpression_ShouldReturnTrue_WhenCollationIsAccentInsensitiveAndAccentsDiffer(t *testing.T) {
+	// Arrange
+	data := utils.ObjectToMap(struct {
+		Value string
+	}{
+		Value: "Crème brûlée",
+	})
+	expression := ContainOperator{Collation: constants.CollationAccentInsensitive}.Build(constants.FieldTypeString, "Value", "brulee")
+
+	// Act
+	result := expression.Fn(data)
+
+	// Assert
+	assert.True(t, result)
+}
+
+func TestContainExpression_ShouldReturnFalse_WhenCollationIsCaseSensitiveAndStringArrayCaseDiffers(t *testing.T) {
+	// Arrange
+	data := utils.ObjectToMap(struct {
+		Value []string
+	}{
+		Value: []string{"Filtex"},
+	})
+	expression := ContainOperator{Collation: constants.CollationCaseSensitive}.Build(constants.FieldTypeStringArray, "Value", "filtex")
+
+	// Act
+	result := expression.Fn(data)
+
+	// Assert
+	assert.False(t, result)
+}
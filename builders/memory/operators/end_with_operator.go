@@ -4,12 +4,15 @@ import (
 	"strings"
 
 	"github.com/filtex/filtex-go/builders/memory/types"
+	memoryUtils "github.com/filtex/filtex-go/builders/memory/utils"
 	"github.com/filtex/filtex-go/constants"
 )
 
-type EndWithOperator struct{}
+type EndWithOperator struct {
+	Collation constants.Collation
+}
 
-func (EndWithOperator) Build(fieldType constants.FieldType, field string, value interface{}) *types.MemoryExpression {
+func (o EndWithOperator) Build(fieldType constants.FieldType, field string, value interface{}) *types.MemoryExpression {
 	return &types.MemoryExpression{
 		Fn: func(data map[string]interface{}) bool {
 			val := data[field]
@@ -19,7 +22,7 @@ func (EndWithOperator) Build(fieldType constants.FieldType, field string, value
 			}
 
 			if fieldType == constants.FieldTypeString {
-				return strings.HasSuffix(strings.ToLower(val.(string)), strings.ToLower(value.(string)))
+				return strings.HasSuffix(memoryUtils.Collate(o.Collation, val.(string)), memoryUtils.Collate(o.Collation, value.(string)))
 			}
 
 			return false
@@ -6,9 +6,11 @@ import (
 	"github.com/filtex/filtex-go/constants"
 )
 
-type EqualOperator struct{}
+type EqualOperator struct {
+	Collation constants.Collation
+}
 
-func (EqualOperator) Build(fieldType constants.FieldType, field string, value interface{}) *types.MemoryExpression {
+func (o EqualOperator) Build(fieldType constants.FieldType, field string, value interface{}) *types.MemoryExpression {
 	return &types.MemoryExpression{
 		Fn: func(data map[string]interface{}) bool {
 			if fieldType.IsArray() {
@@ -21,7 +23,7 @@ func (EqualOperator) Build(fieldType constants.FieldType, field string, value in
 				return false
 			}
 
-			return utils.CheckEquality(fieldType, val, value)
+			return utils.CheckCollatedEquality(fieldType, o.Collation, val, value)
 		},
 	}
 }
@@ -6,6 +6,7 @@ import (
 	"time"
 
 	"github.com/filtex/filtex-go/constants"
+	filtexUtils "github.com/filtex/filtex-go/utils"
 	"github.com/stretchr/testify/assert"
 )
 
@@ -659,3 +660,174 @@ func TestEqualExpression_ShouldReturnFalse_WhenFieldTypeIsDateTimeArray(t *testi
 	// Assert
 	assert.False(t, result)
 }
+
+func TestEqualExpression_ShouldReturnFalse_WhenCollationIsCaseSensitiveAndCaseDiffers(t *testing.T) {
+	// Arrange
+	data := utils.ObjectToMap(struct {
+		Value string
+	}{
+		Value: "Filtex",
+	})
+	expression := EqualOperator{Collation: constants.CollationCaseSensitive}.Build(constants.FieldTypeString, "Value", "filtex")
+
+	// Act
+	result := expression.Fn(data)
+
+	// Assert
+	assert.False(t, result)
+}
+
+func TestEqualExpression_ShouldReturnTrue_WhenCollationIsCaseSensitiveAndCaseMatches(t *testing.T) {
+	// Arrange
+	data := utils.ObjectToMap(struct {
+		Value string
+	}{
+		Value: "Filtex",
+	})
+	expression := EqualOperator{Collation: constants.CollationCaseSensitive}.Build(constants.FieldTypeString, "Value", "Filtex")
+
+	// Act
+	result := expression.Fn(data)
+
+	// Assert
+	assert.True(t, result)
+}
+
+func TestEqualExpression_ShouldReturnTrue_WhenCollationIsAccentInsensitiveAndAccentsDiffer(t *testing.T) {
+	// Arrange
+	data := utils.ObjectToMap(struct {
+		Value string
+	}{
+		Value: "Café",
+	})
+	expression := EqualOperator{Collation: constants.CollationAccentInsensitive}.Build(constants.FieldTypeString, "Value", "cafe")
+
+	// Act
+	result := expression.Fn(data)
+
+	// Assert
+	assert.True(t, result)
+}
+
+func TestEqualExpression_ShouldReturnTrue_WhenFieldTypeIsDateAndFieldValueIsSameDayInValueLocation(t *testing.T) {
+	// Arrange
+	location := time.FixedZone("UTC+3", 3*60*60)
+	data := utils.ObjectToMap(struct {
+		Value time.Time
+	}{
+		Value: time.Date(2024, 03, 9, 22, 0, 0, 0, time.UTC),
+	})
+	expression := EqualOperator{}.Build(constants.FieldTypeDate, "Value", time.Date(2024, 03, 10, 0, 0, 0, 0, location))
+
+	// Act
+	result := expression.Fn(data)
+
+	// Assert
+	assert.True(t, result)
+}
+
+func TestEqualExpression_ShouldReturnTrue_WhenFieldTypeIsDateTimeAndValueIsInTimeRange(t *testing.T) {
+	// Arrange
+	data := utils.ObjectToMap(struct {
+		Value time.Time
+	}{
+		Value: time.Date(2024, 01, 05, 18, 30, 0, 0, time.UTC),
+	})
+	expression := EqualOperator{}.Build(constants.FieldTypeDateTime, "Value", &filtexUtils.TimeRange{
+		Start: time.Date(2024, 01, 05, 0, 0, 0, 0, time.UTC),
+		End:   time.Date(2024, 01, 06, 0, 0, 0, 0, time.UTC),
+	})
+
+	// Act
+	result := expression.Fn(data)
+
+	// Assert
+	assert.True(t, result)
+}
+
+func TestEqualExpression_ShouldReturnFalse_WhenFieldTypeIsDateTimeAndValueIsAtTimeRangeEnd(t *testing.T) {
+	// Arrange
+	data := utils.ObjectToMap(struct {
+		Value time.Time
+	}{
+		Value: time.Date(2024, 01, 06, 0, 0, 0, 0, time.UTC),
+	})
+	expression := EqualOperator{}.Build(constants.FieldTypeDateTime, "Value", &filtexUtils.TimeRange{
+		Start: time.Date(2024, 01, 05, 0, 0, 0, 0, time.UTC),
+		End:   time.Date(2024, 01, 06, 0, 0, 0, 0, time.UTC),
+	})
+
+	// Act
+	result := expression.Fn(data)
+
+	// Assert
+	assert.False(t, result)
+}
+
+func TestEqualExpression_ShouldReturnTrue_WhenFieldTypeIsDecimalAndValuesAreEqualWithDifferentScale(t *testing.T) {
+	// Arrange
+	data := utils.ObjectToMap(struct {
+		Value float64
+	}{
+		Value: 19.9,
+	})
+	expression := EqualOperator{}.Build(constants.FieldTypeDecimal, "Value", "19.90")
+
+	// Act
+	result := expression.Fn(data)
+
+	// Assert
+	assert.True(t, result)
+}
+
+func TestEqualExpression_ShouldReturnFalse_WhenFieldTypeIsIntegerAndValuesDifferBeyondFloatPrecision(t *testing.T) {
+	// Arrange
+	data := utils.ObjectToMap(struct {
+		Value int64
+	}{
+		Value: 9007199254740992,
+	})
+	expression := EqualOperator{}.Build(constants.FieldTypeInteger, "Value", int64(9007199254740993))
+
+	// Act
+	result := expression.Fn(data)
+
+	// Assert
+	assert.False(t, result)
+}
+
+func TestEqualExpression_ShouldReturnTrue_WhenFieldTypeIsUUIDAndCaseIsDifferent(t *testing.T) {
+	// Arrange
+	data := map[string]interface{}{"Value": [16]byte{0x3f, 0x25, 0x04, 0xe0, 0x4f, 0x89, 0x11, 0xd3, 0x9a, 0x0c, 0x03, 0x05, 0xe8, 0x2c, 0x33, 0x01}}
+	expression := EqualOperator{}.Build(constants.FieldTypeUUID, "Value", "3F2504E0-4F89-11D3-9A0C-0305E82C3301")
+
+	// Act
+	result := expression.Fn(data)
+
+	// Assert
+	assert.True(t, result)
+}
+
+func TestEqualExpression_ShouldReturnFalse_WhenFieldTypeIsEnumAndValueIsDifferent(t *testing.T) {
+	// Arrange
+	data := map[string]interface{}{"Value": "active"}
+	expression := EqualOperator{}.Build(constants.FieldTypeEnum, "Value", "inactive")
+
+	// Act
+	result := expression.Fn(data)
+
+	// Assert
+	assert.False(t, result)
+}
+
+func TestEqualExpression_ShouldReturnTrue_WhenFieldTypeIsIPAndValueIsNormalized(t *testing.T) {
+	// Arrange
+	data := map[string]interface{}{"Value": "::ffff:10.0.0.1"}
+	expression := EqualOperator{}.Build(constants.FieldTypeIP, "Value", "10.0.0.1")
+
+	// Act
+	result := expression.Fn(data)
+
+	// Assert
+	assert.True(t, result)
+}
@@ -6,6 +6,7 @@ import (
 	"time"
 
 	"github.com/filtex/filtex-go/constants"
+	filtexUtils "github.com/filtex/filtex-go/utils"
 	"github.com/stretchr/testify/assert"
 )
 
@@ -464,7 +465,7 @@ func TestGreaterThanExpression_ShouldReturnTrue_WhenFieldTypeIsDateTimeAndGreate
 	assert.True(t, result)
 }
 
-func TestGreaterThanExpression_ShouldReturnFalse_WhenFieldTypeIsString(t *testing.T) {
+func TestGreaterThanExpression_ShouldReturnFalse_WhenStringValueIsNil(t *testing.T) {
 	// Arrange
 	data := utils.ObjectToMap(struct {
 		Value string
@@ -496,7 +497,7 @@ func TestGreaterThanExpression_ShouldReturnFalse_WhenFieldTypeIsStringArray(t *t
 	assert.False(t, result)
 }
 
-func TestGreaterThanExpression_ShouldReturnFalse_WhenFieldTypeIsBoolean(t *testing.T) {
+func TestGreaterThanExpression_ShouldReturnFalse_WhenBooleanValueIsNil(t *testing.T) {
 	// Arrange
 	data := utils.ObjectToMap(struct {
 		Value bool
@@ -591,3 +592,158 @@ func TestGreaterThanExpression_ShouldReturnFalse_WhenFieldTypeIsDateTimeArray(t
 	// Assert
 	assert.False(t, result)
 }
+
+func TestGreaterThanExpression_ShouldReturnFalse_WhenFieldTypeIsDateTimeAndValueIsInTimeRange(t *testing.T) {
+	// Arrange
+	data := utils.ObjectToMap(struct {
+		Value time.Time
+	}{
+		Value: time.Date(2024, 01, 05, 18, 30, 0, 0, time.UTC),
+	})
+	expression := GreaterThanOperator{}.Build(constants.FieldTypeDateTime, "Value", &filtexUtils.TimeRange{
+		Start: time.Date(2024, 01, 05, 0, 0, 0, 0, time.UTC),
+		End:   time.Date(2024, 01, 06, 0, 0, 0, 0, time.UTC),
+	})
+
+	// Act
+	result := expression.Fn(data)
+
+	// Assert
+	assert.False(t, result)
+}
+
+func TestGreaterThanExpression_ShouldReturnTrue_WhenFieldTypeIsDateTimeAndValueIsAtTimeRangeEnd(t *testing.T) {
+	// Arrange
+	data := utils.ObjectToMap(struct {
+		Value time.Time
+	}{
+		Value: time.Date(2024, 01, 06, 0, 0, 0, 0, time.UTC),
+	})
+	expression := GreaterThanOperator{}.Build(constants.FieldTypeDateTime, "Value", &filtexUtils.TimeRange{
+		Start: time.Date(2024, 01, 05, 0, 0, 0, 0, time.UTC),
+		End:   time.Date(2024, 01, 06, 0, 0, 0, 0, time.UTC),
+	})
+
+	// Act
+	result := expression.Fn(data)
+
+	// Assert
+	assert.True(t, result)
+}
+
+func TestGreaterThanExpression_ShouldReturnFalse_WhenFieldTypeIsDecimalAndValueIsLess(t *testing.T) {
+	// Arrange
+	data := utils.ObjectToMap(struct {
+		Value string
+	}{
+		Value: "0.30",
+	})
+	expression := GreaterThanOperator{}.Build(constants.FieldTypeDecimal, "Value", "0.1e1")
+
+	// Act
+	result := expression.Fn(data)
+
+	// Assert
+	assert.False(t, result)
+}
+
+func TestGreaterThanExpression_ShouldReturnTrue_WhenFieldTypeIsIntegerAndValueIsGreater(t *testing.T) {
+	// Arrange
+	data := utils.ObjectToMap(struct {
+		Value int64
+	}{
+		Value: 9007199254740993,
+	})
+	expression := GreaterThanOperator{}.Build(constants.FieldTypeInteger, "Value", int64(9007199254740992))
+
+	// Act
+	result := expression.Fn(data)
+
+	// Assert
+	assert.True(t, result)
+}
+
+func TestGreaterThanExpression_ShouldCompareDurations_WhenFieldTypeIsDuration(t *testing.T) {
+	// Arrange
+	sampleMap := map[interface{}]bool{
+		2 * time.Hour:    true,
+		"1d":             true,
+		90 * time.Minute: false,
+		"1h":             false,
+		int64(7200):      true,
+		time.Duration(0): false,
+	}
+	expression := GreaterThanOperator{}.Build(constants.FieldTypeDuration, "Value", 90*time.Minute)
+
+	for input, expected := range sampleMap {
+		// Act
+		result := expression.Fn(map[string]interface{}{"Value": input})
+
+		// Assert
+		assert.Equal(t, expected, result)
+	}
+}
+
+func TestGreaterThanExpression_ShouldReturnFalse_WhenStringsDifferInCase(t *testing.T) {
+	// Arrange
+	data := utils.ObjectToMap(struct {
+		Value string
+	}{
+		Value: "filtex",
+	})
+	expression := GreaterThanOperator{}.Build(constants.FieldTypeString, "Value", "Filtex")
+
+	// Act
+	result := expression.Fn(data)
+
+	// Assert
+	assert.False(t, result)
+}
+
+func TestGreaterThanExpression_ShouldReturnTrue_WhenCaseSensitiveStringsDifferInCase(t *testing.T) {
+	// Arrange
+	data := utils.ObjectToMap(struct {
+		Value string
+	}{
+		Value: "filtex",
+	})
+	expression := GreaterThanOperator{Collation: constants.CollationCaseSensitive}.Build(constants.FieldTypeString, "Value", "Filtex")
+
+	// Act
+	result := expression.Fn(data)
+
+	// Assert
+	assert.True(t, result)
+}
+
+func TestGreaterThanExpression_ShouldReturnFalse_WhenUUIDsDifferInCase(t *testing.T) {
+	// Arrange
+	data := utils.ObjectToMap(struct {
+		Value string
+	}{
+		Value: "7a1e5b4c-0c5e-4a8e-9f5e-2d6b1c3a4e5f",
+	})
+	expression := GreaterThanOperator{}.Build(constants.FieldTypeUUID, "Value", "7A1E5B4C-0C5E-4A8E-9F5E-2D6B1C3A4E5F")
+
+	// Act
+	result := expression.Fn(data)
+
+	// Assert
+	assert.False(t, result)
+}
+
+func TestGreaterThanExpression_ShouldReturnTrue_WhenTrueIsComparedToFalse(t *testing.T) {
+	// Arrange
+	data := utils.ObjectToMap(struct {
+		Value bool
+	}{
+		Value: true,
+	})
+	expression := GreaterThanOperator{}.Build(constants.FieldTypeBoolean, "Value", false)
+
+	// Act
+	result := expression.Fn(data)
+
+	// Assert
+	assert.True(t, result)
+}
@@ -464,7 +464,7 @@ func TestGreaterThanOrEqualExpression_ShouldReturnTrue_WhenFieldTypeIsDateTimeAn
 	assert.True(t, result)
 }
 
-func TestGreaterThanOrEqualExpression_ShouldReturnFalse_WhenFieldTypeIsString(t *testing.T) {
+func TestGreaterThanOrEqualExpression_ShouldReturnFalse_WhenStringValueIsNil(t *testing.T) {
 	// Arrange
 	data := utils.ObjectToMap(struct {
 		Value string
@@ -496,7 +496,7 @@ func TestGreaterThanOrEqualExpression_ShouldReturnFalse_WhenFieldTypeIsStringArr
 	assert.False(t, result)
 }
 
-func TestGreaterThanOrEqualExpression_ShouldReturnFalse_WhenFieldTypeIsBoolean(t *testing.T) {
+func TestGreaterThanOrEqualExpression_ShouldReturnFalse_WhenBooleanValueIsNil(t *testing.T) {
 	// Arrange
 	data := utils.ObjectToMap(struct {
 		Value bool
@@ -591,3 +591,67 @@ func TestGreaterThanOrEqualExpression_ShouldReturnFalse_WhenFieldTypeIsDateTimeA
 	// Assert
 	assert.False(t, result)
 }
+
+func TestGreaterThanOrEqualExpression_ShouldReturnTrue_WhenStringsDifferInCase(t *testing.T) {
+	// Arrange
+	data := utils.ObjectToMap(struct {
+		Value string
+	}{
+		Value: "filtex",
+	})
+	expression := GreaterThanOrEqualOperator{}.Build(constants.FieldTypeString, "Value", "Filtex")
+
+	// Act
+	result := expression.Fn(data)
+
+	// Assert
+	assert.True(t, result)
+}
+
+func TestGreaterThanOrEqualExpression_ShouldReturnTrue_WhenCaseSensitiveStringsDifferInCase(t *testing.T) {
+	// Arrange
+	data := utils.ObjectToMap(struct {
+		Value string
+	}{
+		Value: "filtex",
+	})
+	expression := GreaterThanOrEqualOperator{Collation: constants.CollationCaseSensitive}.Build(constants.FieldTypeString, "Value", "Filtex")
+
+	// Act
+	result := expression.Fn(data)
+
+	// Assert
+	assert.True(t, result)
+}
+
+func TestGreaterThanOrEqualExpression_ShouldReturnTrue_WhenUUIDsDifferInCase(t *testing.T) {
+	// Arrange
+	data := utils.ObjectToMap(struct {
+		Value string
+	}{
+		Value: "7a1e5b4c-0c5e-4a8e-9f5e-2d6b1c3a4e5f",
+	})
+	expression := GreaterThanOrEqualOperator{}.Build(constants.FieldTypeUUID, "Value", "7A1E5B4C-0C5E-4A8E-9F5E-2D6B1C3A4E5F")
+
+	// Act
+	result := expression.Fn(data)
+
+	// Assert
+	assert.True(t, result)
+}
+
+func TestGreaterThanOrEqualExpression_ShouldReturnTrue_WhenTrueIsComparedToFalse(t *testing.T) {
+	// Arrange
+	data := utils.ObjectToMap(struct {
+		Value bool
+	}{
+		Value: true,
+	})
+	expression := GreaterThanOrEqualOperator{}.Build(constants.FieldTypeBoolean, "Value", false)
+
+	// Act
+	result := expression.Fn(data)
+
+	// Assert
+	assert.True(t, result)
+}
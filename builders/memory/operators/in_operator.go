@@ -6,9 +6,11 @@ import (
 	"github.com/filtex/filtex-go/constants"
 )
 
-type InOperator struct{}
+type InOperator struct {
+	Collation constants.Collation
+}
 
-func (InOperator) Build(fieldType constants.FieldType, field string, value interface{}) *types.MemoryExpression {
+func (o InOperator) Build(fieldType constants.FieldType, field string, value interface{}) *types.MemoryExpression {
 	return &types.MemoryExpression{
 		Fn: func(data map[string]interface{}) bool {
 			val := data[field]
@@ -19,11 +21,11 @@ func (InOperator) Build(fieldType constants.FieldType, field string, value inter
 
 			items, ok := value.([]interface{})
 			if !ok {
-				return utils.CheckEquality(fieldType, val, value)
+				return utils.CheckCollatedEquality(fieldType, o.Collation, val, value)
 			}
 
 			for _, v := range items {
-				if utils.CheckEquality(fieldType, val, v) {
+				if utils.CheckCollatedEquality(fieldType, o.Collation, val, v) {
 					return true
 				}
 			}
@@ -413,7 +413,7 @@ func TestInExpression_ShouldReturnTrue_WhenFieldTypeIsDateTimeAndValueIsNotArray
 	}{
 		Value: now,
 	})
-	expression := InOperator{}.Build(constants.FieldTypeDateTime, "Value", time.Now())
+	expression := InOperator{}.Build(constants.FieldTypeDateTime, "Value", now)
 
 	// Act
 	result := expression.Fn(data)
@@ -568,3 +568,35 @@ func TestInExpression_ShouldReturnFalse_WhenFieldTypeIsDateTimeArray(t *testing.
 	// Assert
 	assert.False(t, result)
 }
+
+func TestInExpression_ShouldReturnFalse_WhenCollationIsCaseSensitiveAndCaseDiffers(t *testing.T) {
+	// Arrange
+	data := utils.ObjectToMap(struct {
+		Value string
+	}{
+		Value: "Filtex",
+	})
+	expression := InOperator{Collation: constants.CollationCaseSensitive}.Build(constants.FieldTypeString, "Value", []interface{}{"filtex"})
+
+	// Act
+	result := expression.Fn(data)
+
+	// Assert
+	assert.False(t, result)
+}
+
+func TestInExpression_ShouldReturnTrue_WhenCollationIsAccentInsensitiveAndAccentsDiffer(t *testing.T) {
+	// Arrange
+	data := utils.ObjectToMap(struct {
+		Value string
+	}{
+		Value: "Café",
+	})
+	expression := InOperator{Collation: constants.CollationAccentInsensitive}.Build(constants.FieldTypeString, "Value", []interface{}{"cafe"})
+
+	// Act
+	result := expression.Fn(data)
+
+	// Assert
+	assert.True(t, result)
+}
@@ -0,0 +1,59 @@
+package operators
+
+import (
+	"net"
+	"testing"
+
+	"github.com/filtex/filtex-go/constants"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestInSubnetExpression_ShouldReturnTrue_WhenFieldValueIsInSubnet(t *testing.T) {
+	// Arrange
+	samples := []interface{}{
+		"192.168.16.1",
+		"192.168.31.255",
+		net.ParseIP("192.168.20.1"),
+		"192.168.16.0/24",
+	}
+	expression := InSubnetOperator{}.Build(constants.FieldTypeIP, "Value", "192.168.16.0/20")
+
+	for _, v := range samples {
+		// Act
+		result := expression.Fn(map[string]interface{}{"Value": v})
+
+		// Assert
+		assert.True(t, result)
+	}
+}
+
+func TestInSubnetExpression_ShouldReturnFalse_WhenFieldValueIsNotInSubnet(t *testing.T) {
+	// Arrange
+	samples := []interface{}{
+		nil,
+		"abc",
+		"192.168.32.0",
+		"192.168.0.0/16",
+		"2001:db8::1",
+	}
+	expression := InSubnetOperator{}.Build(constants.FieldTypeIP, "Value", "192.168.16.0/20")
+
+	for _, v := range samples {
+		// Act
+		result := expression.Fn(map[string]interface{}{"Value": v})
+
+		// Assert
+		assert.False(t, result)
+	}
+}
+
+func TestInSubnetExpression_ShouldReturnTrue_WhenSubnetIsIPv6(t *testing.T) {
+	// Arrange
+	expression := InSubnetOperator{}.Build(constants.FieldTypeIP, "Value", "2001:db8::/32")
+
+	// Act
+	result := expression.Fn(map[string]interface{}{"Value": "2001:db8:1::1"})
+
+	// Assert
+	assert.True(t, result)
+}
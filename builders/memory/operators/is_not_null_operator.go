@@ -0,0 +1,17 @@
+package operators
+
+import (
+	"github.com/filtex/filtex-go/builders/memory/types"
+	memoryUtils "github.com/filtex/filtex-go/builders/memory/utils"
+	"github.com/filtex/filtex-go/constants"
+)
+
+type IsNotNullOperator struct{}
+
+func (IsNotNullOperator) Build(fieldType constants.FieldType, field string, value interface{}) *types.MemoryExpression {
+	return &types.MemoryExpression{
+		Fn: func(data map[string]interface{}) bool {
+			return !memoryUtils.IsNull(data[field])
+		},
+	}
+}
@@ -0,0 +1,85 @@
+package operators
+
+import (
+	"testing"
+
+	"github.com/filtex/filtex-go/builders/memory/utils"
+	"github.com/filtex/filtex-go/constants"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestIsNotNullExpression_ShouldReturnFalse_WhenFieldTypeIsStringPointerAndNil(t *testing.T) {
+	// Arrange
+	data := utils.ObjectToMap(struct {
+		Value *string
+	}{
+		Value: nil,
+	})
+	expression := IsNotNullOperator{}.Build(constants.FieldTypeString, "Value", nil)
+
+	// Act
+	result := expression.Fn(data)
+
+	// Assert
+	assert.False(t, result)
+}
+
+func TestIsNotNullExpression_ShouldReturnTrue_WhenFieldTypeIsStringAndEmpty(t *testing.T) {
+	// Arrange
+	data := utils.ObjectToMap(struct {
+		Value string
+	}{
+		Value: "",
+	})
+	expression := IsNotNullOperator{}.Build(constants.FieldTypeString, "Value", nil)
+
+	// Act
+	result := expression.Fn(data)
+
+	// Assert
+	assert.True(t, result)
+}
+
+func TestIsNotNullExpression_ShouldReturnFalse_WhenFieldTypeIsStringArrayAndNil(t *testing.T) {
+	// Arrange
+	data := utils.ObjectToMap(struct {
+		Value []string
+	}{
+		Value: nil,
+	})
+	expression := IsNotNullOperator{}.Build(constants.FieldTypeStringArray, "Value", nil)
+
+	// Act
+	result := expression.Fn(data)
+
+	// Assert
+	assert.False(t, result)
+}
+
+func TestIsNotNullExpression_ShouldReturnTrue_WhenFieldTypeIsStringArrayAndEmpty(t *testing.T) {
+	// Arrange
+	data := utils.ObjectToMap(struct {
+		Value []string
+	}{
+		Value: []string{},
+	})
+	expression := IsNotNullOperator{}.Build(constants.FieldTypeStringArray, "Value", nil)
+
+	// Act
+	result := expression.Fn(data)
+
+	// Assert
+	assert.True(t, result)
+}
+
+func TestIsNotNullExpression_ShouldReturnFalse_WhenFieldIsMissing(t *testing.T) {
+	// Arrange
+	data := map[string]interface{}{}
+	expression := IsNotNullOperator{}.Build(constants.FieldTypeNumber, "Value", nil)
+
+	// Act
+	result := expression.Fn(data)
+
+	// Assert
+	assert.False(t, result)
+}
@@ -0,0 +1,85 @@
+package operators
+
+import (
+	"testing"
+
+	"github.com/filtex/filtex-go/builders/memory/utils"
+	"github.com/filtex/filtex-go/constants"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestIsNullExpression_ShouldReturnTrue_WhenFieldTypeIsStringPointerAndNil(t *testing.T) {
+	// Arrange
+	data := utils.ObjectToMap(struct {
+		Value *string
+	}{
+		Value: nil,
+	})
+	expression := IsNullOperator{}.Build(constants.FieldTypeString, "Value", nil)
+
+	// Act
+	result := expression.Fn(data)
+
+	// Assert
+	assert.True(t, result)
+}
+
+func TestIsNullExpression_ShouldReturnFalse_WhenFieldTypeIsStringAndEmpty(t *testing.T) {
+	// Arrange
+	data := utils.ObjectToMap(struct {
+		Value string
+	}{
+		Value: "",
+	})
+	expression := IsNullOperator{}.Build(constants.FieldTypeString, "Value", nil)
+
+	// Act
+	result := expression.Fn(data)
+
+	// Assert
+	assert.False(t, result)
+}
+
+func TestIsNullExpression_ShouldReturnTrue_WhenFieldTypeIsStringArrayAndNil(t *testing.T) {
+	// Arrange
+	data := utils.ObjectToMap(struct {
+		Value []string
+	}{
+		Value: nil,
+	})
+	expression := IsNullOperator{}.Build(constants.FieldTypeStringArray, "Value", nil)
+
+	// Act
+	result := expression.Fn(data)
+
+	// Assert
+	assert.True(t, result)
+}
+
+func TestIsNullExpression_ShouldReturnFalse_WhenFieldTypeIsStringArrayAndEmpty(t *testing.T) {
+	// Arrange
+	data := utils.ObjectToMap(struct {
+		Value []string
+	}{
+		Value: []string{},
+	})
+	expression := IsNullOperator{}.Build(constants.FieldTypeStringArray, "Value", nil)
+
+	// Act
+	result := expression.Fn(data)
+
+	// Assert
+	assert.False(t, result)
+}
+
+func TestIsNullExpression_ShouldReturnTrue_WhenFieldIsMissing(t *testing.T) {
+	// Arrange
+	data := map[string]interface{}{}
+	expression := IsNullOperator{}.Build(constants.FieldTypeNumber, "Value", nil)
+
+	// Act
+	result := expression.Fn(data)
+
+	// Assert
+	assert.True(t, result)
+}
@@ -2,13 +2,16 @@ package operators
 
 import (
 	"github.com/filtex/filtex-go/builders/memory/types"
+	memoryUtils "github.com/filtex/filtex-go/builders/memory/utils"
 	"github.com/filtex/filtex-go/constants"
 	"github.com/filtex/filtex-go/utils"
 )
 
-type LessThanOperator struct{}
+type LessThanOperator struct {
+	Collation constants.Collation
+}
 
-func (LessThanOperator) Build(fieldType constants.FieldType, field string, value interface{}) *types.MemoryExpression {
+func (o LessThanOperator) Build(fieldType constants.FieldType, field string, value interface{}) *types.MemoryExpression {
 	return &types.MemoryExpression{
 		Fn: func(data map[string]interface{}) bool {
 			val := data[field]
@@ -18,16 +21,29 @@ func (LessThanOperator) Build(fieldType constants.FieldType, field string, value
 			}
 
 			switch fieldType {
+			case constants.FieldTypeString, constants.FieldTypeUUID, constants.FieldTypeBoolean:
+				result, ok := memoryUtils.Compare(fieldType, o.Collation, val, value)
+				return ok && result < 0
 			case constants.FieldTypeNumber:
 				castedResultValue, castedResultValueErr := utils.Number(val)
 				castedValue, castedValueErr := utils.Number(value)
 				if castedResultValueErr == nil && castedValueErr == nil {
 					return castedResultValue < castedValue
 				}
-			case constants.FieldTypeDate:
-				castedResultValue, castedResultValueErr := utils.Date(val)
-				castedValue, castedValueErr := utils.Date(value)
+			case constants.FieldTypeInteger:
+				castedResultValue, castedResultValueErr := utils.Integer(val)
+				castedValue, castedValueErr := utils.Integer(value)
 				if castedResultValueErr == nil && castedValueErr == nil {
+					return castedResultValue < castedValue
+				}
+			case constants.FieldTypeDecimal:
+				result, err := utils.CompareDecimal(val, value)
+				if err == nil {
+					return result < 0
+				}
+			case constants.FieldTypeDate:
+				castedResultValue, castedValue, ok := memoryUtils.CastDates(val, value)
+				if ok {
 					return castedResultValue.UnixNano() < castedValue.UnixNano()
 				}
 			case constants.FieldTypeTime:
@@ -36,7 +52,18 @@ func (LessThanOperator) Build(fieldType constants.FieldType, field string, value
 				if castedResultValueErr == nil && castedValueErr == nil {
 					return *castedResultValue < *castedValue
 				}
+			case constants.FieldTypeDuration:
+				castedResultValue, castedResultValueErr := utils.Duration(val)
+				castedValue, castedValueErr := utils.Duration(value)
+				if castedResultValueErr == nil && castedValueErr == nil {
+					return castedResultValue < castedValue
+				}
 			case constants.FieldTypeDateTime:
+				if r, ok := utils.AsTimeRange(value); ok {
+					result, ok := memoryUtils.CompareTimeRange(val, r)
+					return ok && result < 0
+				}
+
 				castedResultValue, castedResultValueErr := utils.DateTime(val)
 				castedValue, castedValueErr := utils.DateTime(value)
 				if castedResultValueErr == nil && castedValueErr == nil {
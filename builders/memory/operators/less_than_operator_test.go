@@ -464,7 +464,7 @@ func TestLessThanExpression_ShouldReturnFalse_WhenFieldTypeIsDateTimeAndGreater(
 	assert.False(t, result)
 }
 
-func TestLessThanExpression_ShouldReturnFalse_WhenFieldTypeIsString(t *testing.T) {
+func TestLessThanExpression_ShouldReturnFalse_WhenStringValueIsNil(t *testing.T) {
 	// Arrange
 	data := utils.ObjectToMap(struct {
 		Value string
@@ -496,7 +496,7 @@ func TestLessThanExpression_ShouldReturnFalse_WhenFieldTypeIsStringArray(t *test
 	assert.False(t, result)
 }
 
-func TestLessThanExpression_ShouldReturnFalse_WhenFieldTypeIsBoolean(t *testing.T) {
+func TestLessThanExpression_ShouldReturnFalse_WhenBooleanValueIsNil(t *testing.T) {
 	// Arrange
 	data := utils.ObjectToMap(struct {
 		Value bool
@@ -591,3 +591,84 @@ func TestLessThanExpression_ShouldReturnFalse_WhenFieldTypeIsDateTimeArray(t *te
 	// Assert
 	assert.False(t, result)
 }
+
+func TestLessThanExpression_ShouldReturnFalse_WhenFieldTypeIsDateAndFieldValueIsSameDayInValueLocation(t *testing.T) {
+	// Arrange
+	location := time.FixedZone("UTC+3", 3*60*60)
+	data := utils.ObjectToMap(struct {
+		Value time.Time
+	}{
+		Value: time.Date(2024, 03, 9, 22, 0, 0, 0, time.UTC),
+	})
+	expression := LessThanOperator{}.Build(constants.FieldTypeDate, "Value", time.Date(2024, 03, 10, 0, 0, 0, 0, location))
+
+	// Act
+	result := expression.Fn(data)
+
+	// Assert
+	assert.False(t, result)
+}
+
+func TestLessThanExpression_ShouldReturnFalse_WhenStringsDifferInCase(t *testing.T) {
+	// Arrange
+	data := utils.ObjectToMap(struct {
+		Value string
+	}{
+		Value: "filtex",
+	})
+	expression := LessThanOperator{}.Build(constants.FieldTypeString, "Value", "Filtex")
+
+	// Act
+	result := expression.Fn(data)
+
+	// Assert
+	assert.False(t, result)
+}
+
+func TestLessThanExpression_ShouldReturnFalse_WhenCaseSensitiveStringsDifferInCase(t *testing.T) {
+	// Arrange
+	data := utils.ObjectToMap(struct {
+		Value string
+	}{
+		Value: "filtex",
+	})
+	expression := LessThanOperator{Collation: constants.CollationCaseSensitive}.Build(constants.FieldTypeString, "Value", "Filtex")
+
+	// Act
+	result := expression.Fn(data)
+
+	// Assert
+	assert.False(t, result)
+}
+
+func TestLessThanExpression_ShouldReturnFalse_WhenUUIDsDifferInCase(t *testing.T) {
+	// Arrange
+	data := utils.ObjectToMap(struct {
+		Value string
+	}{
+		Value: "7a1e5b4c-0c5e-4a8e-9f5e-2d6b1c3a4e5f",
+	})
+	expression := LessThanOperator{}.Build(constants.FieldTypeUUID, "Value", "7A1E5B4C-0C5E-4A8E-9F5E-2D6B1C3A4E5F")
+
+	// Act
+	result := expression.Fn(data)
+
+	// Assert
+	assert.False(t, result)
+}
+
+func TestLessThanExpression_ShouldReturnFalse_WhenTrueIsComparedToFalse(t *testing.T) {
+	// Arrange
+	data := utils.ObjectToMap(struct {
+		Value bool
+	}{
+		Value: true,
+	})
+	expression := LessThanOperator{}.Build(constants.FieldTypeBoolean, "Value", false)
+
+	// Act
+	result := expression.Fn(data)
+
+	// Assert
+	assert.False(t, result)
+}
@@ -6,6 +6,7 @@ import (
 	"time"
 
 	"github.com/filtex/filtex-go/constants"
+	filtexUtils "github.com/filtex/filtex-go/utils"
 	"github.com/stretchr/testify/assert"
 )
 
@@ -464,7 +465,7 @@ func TestLessThanOrEqualExpression_ShouldReturnFalse_WhenFieldTypeIsDateTimeAndG
 	assert.False(t, result)
 }
 
-func TestLessThanOrEqualExpression_ShouldReturnFalse_WhenFieldTypeIsString(t *testing.T) {
+func TestLessThanOrEqualExpression_ShouldReturnFalse_WhenStringValueIsNil(t *testing.T) {
 	// Arrange
 	data := utils.ObjectToMap(struct {
 		Value string
@@ -496,7 +497,7 @@ func TestLessThanOrEqualExpression_ShouldReturnFalse_WhenFieldTypeIsStringArray(
 	assert.False(t, result)
 }
 
-func TestLessThanOrEqualExpression_ShouldReturnFalse_WhenFieldTypeIsBoolean(t *testing.T) {
+func TestLessThanOrEqualExpression_ShouldReturnFalse_WhenBooleanValueIsNil(t *testing.T) {
 	// Arrange
 	data := utils.ObjectToMap(struct {
 		Value bool
@@ -591,3 +592,86 @@ func TestLessThanOrEqualExpression_ShouldReturnFalse_WhenFieldTypeIsDateTimeArra
 	// Assert
 	assert.False(t, result)
 }
+
+func TestLessThanOrEqualExpression_ShouldReturnTrue_WhenFieldTypeIsDateTimeAndValueIsInTimeRange(t *testing.T) {
+	// Arrange
+	data := utils.ObjectToMap(struct {
+		Value time.Time
+	}{
+		Value: time.Date(2024, 01, 05, 18, 30, 0, 0, time.UTC),
+	})
+	expression := LessThanOrEqualOperator{}.Build(constants.FieldTypeDateTime, "Value", &filtexUtils.TimeRange{
+		Start: time.Date(2024, 01, 05, 0, 0, 0, 0, time.UTC),
+		End:   time.Date(2024, 01, 06, 0, 0, 0, 0, time.UTC),
+	})
+
+	// Act
+	result := expression.Fn(data)
+
+	// Assert
+	assert.True(t, result)
+}
+
+func TestLessThanOrEqualExpression_ShouldReturnTrue_WhenStringsDifferInCase(t *testing.T) {
+	// Arrange
+	data := utils.ObjectToMap(struct {
+		Value string
+	}{
+		Value: "filtex",
+	})
+	expression := LessThanOrEqualOperator{}.Build(constants.FieldTypeString, "Value", "Filtex")
+
+	// Act
+	result := expression.Fn(data)
+
+	// Assert
+	assert.True(t, result)
+}
+
+func TestLessThanOrEqualExpression_ShouldReturnFalse_WhenCaseSensitiveStringsDifferInCase(t *testing.T) {
+	// Arrange
+	data := utils.ObjectToMap(struct {
+		Value string
+	}{
+		Value: "filtex",
+	})
+	expression := LessThanOrEqualOperator{Collation: constants.CollationCaseSensitive}.Build(constants.FieldTypeString, "Value", "Filtex")
+
+	// Act
+	result := expression.Fn(data)
+
+	// Assert
+	assert.False(t, result)
+}
+
+func TestLessThanOrEqualExpression_ShouldReturnTrue_WhenUUIDsDifferInCase(t *testing.T) {
+	// Arrange
+	data := utils.ObjectToMap(struct {
+		Value string
+	}{
+		Value: "7a1e5b4c-0c5e-4a8e-9f5e-2d6b1c3a4e5f",
+	})
+	expression := LessThanOrEqualOperator{}.Build(constants.FieldTypeUUID, "Value", "7A1E5B4C-0C5E-4A8E-9F5E-2D6B1C3A4E5F")
+
+	// Act
+	result := expression.Fn(data)
+
+	// Assert
+	assert.True(t, result)
+}
+
+func TestLessThanOrEqualExpression_ShouldReturnFalse_WhenTrueIsComparedToFalse(t *testing.T) {
+	// Arrange
+	data := utils.ObjectToMap(struct {
+		Value bool
+	}{
+		Value: true,
+	})
+	expression := LessThanOrEqualOperator{}.Build(constants.FieldTypeBoolean, "Value", false)
+
+	// Act
+	result := expression.Fn(data)
+
+	// Assert
+	assert.False(t, result)
+}
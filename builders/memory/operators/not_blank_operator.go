@@ -2,6 +2,7 @@ package operators
 
 import (
 	"github.com/filtex/filtex-go/builders/memory/types"
+	memoryUtils "github.com/filtex/filtex-go/builders/memory/utils"
 	"github.com/filtex/filtex-go/constants"
 	"github.com/filtex/filtex-go/utils"
 )
@@ -11,29 +12,21 @@ type NotBlankOperator struct{}
 func (NotBlankOperator) Build(fieldType constants.FieldType, field string, value interface{}) *types.MemoryExpression {
 	return &types.MemoryExpression{
 		Fn: func(data map[string]interface{}) bool {
-			if fieldType.IsArray() {
-				val := data[field]
+			val := data[field]
 
-				if val == nil {
-					return false
-				}
+			if memoryUtils.IsNull(val) {
+				return false
+			}
 
-				if items, err := utils.Array(val); err == nil {
-					return len(items) != 0
-				}
+			if fieldType.IsArray() {
+				items, err := utils.Array(val)
+				return err == nil && len(items) != 0
 			} else if fieldType == constants.FieldTypeString {
-				val := data[field]
-
-				if val == nil {
-					return false
-				}
-
-				if str, err := utils.String(val); err == nil {
-					return len(str) != 0
-				}
+				str, err := utils.String(val)
+				return err == nil && len(str) != 0
 			}
 
-			return false
+			return true
 		},
 	}
 }
@@ -342,3 +342,20 @@ func TestNotBlankExpression_ShouldReturnFalse_WhenFieldTypeIsDateTime(t *testing
 	// Assert
 	assert.False(t, result)
 }
+
+func TestNotBlankExpression_ShouldReturnTrue_WhenFieldTypeIsNumberAndNotNil(t *testing.T) {
+	// Arrange
+	value := 0
+	data := utils.ObjectToMap(struct {
+		Value *int
+	}{
+		Value: &value,
+	})
+	expression := NotBlankOperator{}.Build(constants.FieldTypeNumber, "Value", nil)
+
+	// Act
+	result := expression.Fn(data)
+
+	// Assert
+	assert.True(t, result)
+}
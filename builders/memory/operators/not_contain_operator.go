@@ -8,9 +8,11 @@ import (
 	"strings"
 )
 
-type NotContainOperator struct{}
+type NotContainOperator struct {
+	Collation constants.Collation
+}
 
-func (NotContainOperator) Build(fieldType constants.FieldType, field string, value interface{}) *types.MemoryExpression {
+func (o NotContainOperator) Build(fieldType constants.FieldType, field string, value interface{}) *types.MemoryExpression {
 	return &types.MemoryExpression{
 		Fn: func(data map[string]interface{}) bool {
 			if fieldType.IsArray() {
@@ -22,7 +24,7 @@ func (NotContainOperator) Build(fieldType constants.FieldType, field string, val
 
 				if items, err := utils.Array(val); err == nil {
 					for _, item := range items {
-						if memoryUtils.CheckEquality(fieldType, item, value) {
+						if memoryUtils.CheckCollatedEquality(fieldType, o.Collation, item, value) {
 							return false
 						}
 					}
@@ -36,7 +38,7 @@ func (NotContainOperator) Build(fieldType constants.FieldType, field string, val
 					return true
 				}
 
-				return !strings.Contains(strings.ToLower(val.(string)), strings.ToLower(value.(string)))
+				return !strings.Contains(memoryUtils.Collate(o.Collation, val.(string)), memoryUtils.Collate(o.Collation, value.(string)))
 			}
 
 			return false
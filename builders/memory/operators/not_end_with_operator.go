@@ -2,13 +2,16 @@ package operators
 
 import (
 	"github.com/filtex/filtex-go/builders/memory/types"
+	memoryUtils "github.com/filtex/filtex-go/builders/memory/utils"
 	"github.com/filtex/filtex-go/constants"
 	"strings"
 )
 
-type NotEndWithOperator struct{}
+type NotEndWithOperator struct {
+	Collation constants.Collation
+}
 
-func (NotEndWithOperator) Build(fieldType constants.FieldType, field string, value interface{}) *types.MemoryExpression {
+func (o NotEndWithOperator) Build(fieldType constants.FieldType, field string, value interface{}) *types.MemoryExpression {
 	return &types.MemoryExpression{
 		Fn: func(data map[string]interface{}) bool {
 			val := data[field]
@@ -18,7 +21,7 @@ func (NotEndWithOperator) Build(fieldType constants.FieldType, field string, val
 			}
 
 			if fieldType == constants.FieldTypeString {
-				return !strings.HasSuffix(strings.ToLower(val.(string)), strings.ToLower(value.(string)))
+				return !strings.HasSuffix(memoryUtils.Collate(o.Collation, val.(string)), memoryUtils.Collate(o.Collation, value.(string)))
 			}
 
 			return false
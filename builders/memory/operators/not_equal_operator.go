@@ -6,9 +6,11 @@ import (
 	"github.com/filtex/filtex-go/constants"
 )
 
-type NotEqualOperator struct{}
+type NotEqualOperator struct {
+	Collation constants.Collation
+}
 
-func (NotEqualOperator) Build(fieldType constants.FieldType, field string, value interface{}) *types.MemoryExpression {
+func (o NotEqualOperator) Build(fieldType constants.FieldType, field string, value interface{}) *types.MemoryExpression {
 	return &types.MemoryExpression{
 		Fn: func(data map[string]interface{}) bool {
 			if fieldType.IsArray() {
@@ -18,10 +20,10 @@ func (NotEqualOperator) Build(fieldType constants.FieldType, field string, value
 			val := data[field]
 
 			if val == nil {
-				return false
+				return true
 			}
 
-			return !utils.CheckEquality(fieldType, val, value)
+			return !utils.CheckCollatedEquality(fieldType, o.Collation, val, value)
 		},
 	}
 }
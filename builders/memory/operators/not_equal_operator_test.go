@@ -6,10 +6,11 @@ import (
 	"time"
 
 	"github.com/filtex/filtex-go/constants"
+	filtexUtils "github.com/filtex/filtex-go/utils"
 	"github.com/stretchr/testify/assert"
 )
 
-func TestNotEqualExpression_ShouldReturnFalse_WhenFieldTypeIsStringPointerAndNilAndValueIsEmpty(t *testing.T) {
+func TestNotEqualExpression_ShouldReturnTrue_WhenFieldTypeIsStringPointerAndNilAndValueIsEmpty(t *testing.T) {
 	// Arrange
 	data := utils.ObjectToMap(struct {
 		Value *string
@@ -22,10 +23,10 @@ func TestNotEqualExpression_ShouldReturnFalse_WhenFieldTypeIsStringPointerAndNil
 	result := expression.Fn(data)
 
 	// Assert
-	assert.False(t, result)
+	assert.True(t, result)
 }
 
-func TestNotEqualExpression_ShouldReturnFalse_WhenFieldTypeIsStringPointerAndNilAndValueIsNotEmpty(t *testing.T) {
+func TestNotEqualExpression_ShouldReturnTrue_WhenFieldTypeIsStringPointerAndNilAndValueIsNotEmpty(t *testing.T) {
 	// Arrange
 	data := utils.ObjectToMap(struct {
 		Value *string
@@ -38,7 +39,7 @@ func TestNotEqualExpression_ShouldReturnFalse_WhenFieldTypeIsStringPointerAndNil
 	result := expression.Fn(data)
 
 	// Assert
-	assert.False(t, result)
+	assert.True(t, result)
 }
 
 func TestNotEqualExpression_ShouldReturnTrue_WhenFieldTypeIsStringPointerAndEmptyAndValueIsNotEmpty(t *testing.T) {
@@ -160,7 +161,7 @@ func TestNotEqualExpression_ShouldReturnFalse_WhenFieldTypeIsStringAndValueIsSam
 	assert.False(t, result)
 }
 
-func TestNotEqualExpression_ShouldReturnFalse_WhenFieldTypeIsNumberPointerAndNilAndValueIsNotNil(t *testing.T) {
+func TestNotEqualExpression_ShouldReturnTrue_WhenFieldTypeIsNumberPointerAndNilAndValueIsNotNil(t *testing.T) {
 	// Arrange
 	data := utils.ObjectToMap(struct {
 		Value *float64
@@ -173,7 +174,7 @@ func TestNotEqualExpression_ShouldReturnFalse_WhenFieldTypeIsNumberPointerAndNil
 	result := expression.Fn(data)
 
 	// Assert
-	assert.False(t, result)
+	assert.True(t, result)
 }
 
 func TestNotEqualExpression_ShouldReturnTrue_WhenFieldTypeIsNumberPointerAndValueIsNotSame(t *testing.T) {
@@ -244,7 +245,7 @@ func TestNotEqualExpression_ShouldReturnFalse_WhenFieldTypeIsNumberAndValueIsSam
 	assert.False(t, result)
 }
 
-func TestNotEqualExpression_ShouldReturnFalse_WhenFieldTypeIsBooleanPointerAndNilAndValueIsNotNil(t *testing.T) {
+func TestNotEqualExpression_ShouldReturnTrue_WhenFieldTypeIsBooleanPointerAndNilAndValueIsNotNil(t *testing.T) {
 	// Arrange
 	data := utils.ObjectToMap(struct {
 		Value *bool
@@ -257,7 +258,7 @@ func TestNotEqualExpression_ShouldReturnFalse_WhenFieldTypeIsBooleanPointerAndNi
 	result := expression.Fn(data)
 
 	// Assert
-	assert.False(t, result)
+	assert.True(t, result)
 }
 
 func TestNotEqualExpression_ShouldReturnTrue_WhenFieldTypeIsBooleanPointerAndValueIsNotSame(t *testing.T) {
@@ -328,7 +329,7 @@ func TestNotEqualExpression_ShouldReturnFalse_WhenFieldTypeIsBooleanAndValueIsSa
 	assert.False(t, result)
 }
 
-func TestNotEqualExpression_ShouldReturnFalse_WhenFieldTypeIsDatePointerAndNilAndValueIsNotNil(t *testing.T) {
+func TestNotEqualExpression_ShouldReturnTrue_WhenFieldTypeIsDatePointerAndNilAndValueIsNotNil(t *testing.T) {
 	// Arrange
 	data := utils.ObjectToMap(struct {
 		Value *time.Time
@@ -341,7 +342,7 @@ func TestNotEqualExpression_ShouldReturnFalse_WhenFieldTypeIsDatePointerAndNilAn
 	result := expression.Fn(data)
 
 	// Assert
-	assert.False(t, result)
+	assert.True(t, result)
 }
 
 func TestNotEqualExpression_ShouldReturnTrue_WhenFieldTypeIsDatePointerAndValueIsNotSame(t *testing.T) {
@@ -412,7 +413,7 @@ func TestNotEqualExpression_ShouldReturnFalse_WhenFieldTypeIsDateAndValueIsSame(
 	assert.False(t, result)
 }
 
-func TestNotEqualExpression_ShouldReturnFalse_WhenFieldTypeIsTimePointerAndNilAndValueIsNotNil(t *testing.T) {
+func TestNotEqualExpression_ShouldReturnTrue_WhenFieldTypeIsTimePointerAndNilAndValueIsNotNil(t *testing.T) {
 	// Arrange
 	data := utils.ObjectToMap(struct {
 		Value *int
@@ -425,7 +426,7 @@ func TestNotEqualExpression_ShouldReturnFalse_WhenFieldTypeIsTimePointerAndNilAn
 	result := expression.Fn(data)
 
 	// Assert
-	assert.False(t, result)
+	assert.True(t, result)
 }
 
 func TestNotEqualExpression_ShouldReturnTrue_WhenFieldTypeIsTimePointerAndValueIsNotSame(t *testing.T) {
@@ -496,7 +497,7 @@ func TestNotEqualExpression_ShouldReturnFalse_WhenFieldTypeIsTimeAndValueIsSame(
 	assert.False(t, result)
 }
 
-func TestNotEqualExpression_ShouldReturnFalse_WhenFieldTypeIsDateTimePointerAndNilAndValueIsNotNil(t *testing.T) {
+func TestNotEqualExpression_ShouldReturnTrue_WhenFieldTypeIsDateTimePointerAndNilAndValueIsNotNil(t *testing.T) {
 	// Arrange
 	data := utils.ObjectToMap(struct {
 		Value *time.Time
@@ -509,7 +510,7 @@ func TestNotEqualExpression_ShouldReturnFalse_WhenFieldTypeIsDateTimePointerAndN
 	result := expression.Fn(data)
 
 	// Assert
-	assert.False(t, result)
+	assert.True(t, result)
 }
 
 func TestNotEqualExpression_ShouldReturnTrue_WhenFieldTypeIsDateTimePointerAndValueIsNotSame(t *testing.T) {
@@ -659,3 +660,22 @@ func TestNotEqualExpression_ShouldReturnFalse_WhenFieldTypeIsDateTimeArray(t *te
 	// Assert
 	assert.False(t, result)
 }
+
+func TestNotEqualExpression_ShouldReturnFalse_WhenFieldTypeIsDateTimeAndValueIsInTimeRange(t *testing.T) {
+	// Arrange
+	data := utils.ObjectToMap(struct {
+		Value time.Time
+	}{
+		Value: time.Date(2024, 01, 05, 18, 30, 0, 0, time.UTC),
+	})
+	expression := NotEqualOperator{}.Build(constants.FieldTypeDateTime, "Value", &filtexUtils.TimeRange{
+		Start: time.Date(2024, 01, 05, 0, 0, 0, 0, time.UTC),
+		End:   time.Date(2024, 01, 06, 0, 0, 0, 0, time.UTC),
+	})
+
+	// Act
+	result := expression.Fn(data)
+
+	// Assert
+	assert.False(t, result)
+}
@@ -0,0 +1,28 @@
+package operators
+
+import (
+	"github.com/filtex/filtex-go/builders/memory/types"
+	"github.com/filtex/filtex-go/builders/memory/utils"
+	"github.com/filtex/filtex-go/constants"
+)
+
+type NotInSubnetOperator struct{}
+
+func (o NotInSubnetOperator) Build(fieldType constants.FieldType, field string, value interface{}) *types.MemoryExpression {
+	return &types.MemoryExpression{
+		Fn: func(data map[string]interface{}) bool {
+			if fieldType != constants.FieldTypeIP {
+				return false
+			}
+
+			val := data[field]
+
+			if val == nil {
+				return true
+			}
+
+			result, ok := utils.CheckSubnet(val, value)
+			return ok && !result
+		},
+	}
+}
@@ -0,0 +1,47 @@
+package operators
+
+import (
+	"testing"
+
+	"github.com/filtex/filtex-go/constants"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNotInSubnetExpression_ShouldReturnTrue_WhenFieldValueIsNotInSubnet(t *testing.T) {
+	// Arrange
+	expression := NotInSubnetOperator{}.Build(constants.FieldTypeIP, "Value", "10.0.0.0/8")
+
+	// Act
+	result := expression.Fn(map[string]interface{}{"Value": "192.168.0.1"})
+
+	// Assert
+	assert.True(t, result)
+}
+
+func TestNotInSubnetExpression_ShouldReturnFalse_WhenFieldValueIsInSubnetOrNotValid(t *testing.T) {
+	// Arrange
+	samples := []interface{}{
+		"abc",
+		"10.1.2.3",
+	}
+	expression := NotInSubnetOperator{}.Build(constants.FieldTypeIP, "Value", "10.0.0.0/8")
+
+	for _, v := range samples {
+		// Act
+		result := expression.Fn(map[string]interface{}{"Value": v})
+
+		// Assert
+		assert.False(t, result)
+	}
+}
+
+func TestNotInSubnetExpression_ShouldReturnTrue_WhenFieldValueIsNil(t *testing.T) {
+	// Arrange
+	expression := NotInSubnetOperator{}.Build(constants.FieldTypeIP, "Value", "10.0.0.0/8")
+
+	// Act
+	result := expression.Fn(map[string]interface{}{"Value": nil})
+
+	// Assert
+	assert.True(t, result)
+}
@@ -0,0 +1,55 @@
+package operators
+
+import (
+	"github.com/filtex/filtex-go/builders/memory/types"
+	memoryUtils "github.com/filtex/filtex-go/builders/memory/utils"
+	"github.com/filtex/filtex-go/utils"
+)
+
+type SearchOperator struct{}
+
+func (SearchOperator) Build(fields []string, value string) *types.MemoryExpression {
+	terms := memoryUtils.Terms(value)
+
+	return &types.MemoryExpression{
+		Fn: func(data map[string]interface{}) bool {
+			if len(fields) == 0 || len(terms) == 0 {
+				return false
+			}
+
+			documentTerms := make(map[string]bool)
+
+			for _, field := range fields {
+				val := data[field]
+
+				if val == nil {
+					continue
+				}
+
+				items := []interface{}{val}
+
+				if utils.IsArray(val) {
+					if array, err := utils.Array(val); err == nil {
+						items = array
+					}
+				}
+
+				for _, item := range items {
+					if str, err := utils.String(item); err == nil {
+						for _, term := range memoryUtils.Terms(str) {
+							documentTerms[term] = true
+						}
+					}
+				}
+			}
+
+			for _, term := range terms {
+				if !documentTerms[term] {
+					return false
+				}
+			}
+
+			return true
+		},
+	}
+}
@@ -0,0 +1,61 @@
+package operators
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSearchExpression_ShouldReturnFalse_WhenFieldsAreEmpty(t *testing.T) {
+	// Arrange
+	data := map[string]interface{}{
+		"Name": "Red Shoes",
+	}
+
+	// Act
+	expression := SearchOperator{}.Build([]string{}, "red shoes")
+
+	// Assert
+	assert.False(t, expression.Fn(data))
+}
+
+func TestSearchExpression_ShouldReturnFalse_WhenAnyTermIsMissing(t *testing.T) {
+	// Arrange
+	data := map[string]interface{}{
+		"Name":        "Red boots",
+		"Description": nil,
+	}
+
+	// Act
+	expression := SearchOperator{}.Build([]string{"Name", "Description"}, "red shoes")
+
+	// Assert
+	assert.False(t, expression.Fn(data))
+}
+
+func TestSearchExpression_ShouldReturnTrue_WhenTermsAreSpreadAcrossFields(t *testing.T) {
+	// Arrange
+	data := map[string]interface{}{
+		"Name": "Running SHOES",
+		"Tags": []string{"summer", "red"},
+	}
+
+	// Act
+	expression := SearchOperator{}.Build([]string{"Name", "Tags"}, "red, shoes")
+
+	// Assert
+	assert.True(t, expression.Fn(data))
+}
+
+func TestSearchExpression_ShouldReturnFalse_WhenTermIsOnlyPartOfWord(t *testing.T) {
+	// Arrange
+	data := map[string]interface{}{
+		"Name": "Reddish shoes",
+	}
+
+	// Act
+	expression := SearchOperator{}.Build([]string{"Name"}, "red")
+
+	// Assert
+	assert.False(t, expression.Fn(data))
+}
@@ -2,13 +2,16 @@ package operators
 
 import (
 	"github.com/filtex/filtex-go/builders/memory/types"
+	memoryUtils "github.com/filtex/filtex-go/builders/memory/utils"
 	"github.com/filtex/filtex-go/constants"
 	"strings"
 )
 
-type StartWithOperator struct{}
+type StartWithOperator struct {
+	Collation constants.Collation
+}
 
-func (StartWithOperator) Build(fieldType constants.FieldType, field string, value interface{}) *types.MemoryExpression {
+func (o StartWithOperator) Build(fieldType constants.FieldType, field string, value interface{}) *types.MemoryExpression {
 	return &types.MemoryExpression{
 		Fn: func(data map[string]interface{}) bool {
 			val := data[field]
@@ -18,7 +21,7 @@ func (StartWithOperator) Build(fieldType constants.FieldType, field string, valu
 			}
 
 			if fieldType == constants.FieldTypeString {
-				return strings.HasPrefix(strings.ToLower(val.(string)), strings.ToLower(value.(string)))
+				return strings.HasPrefix(memoryUtils.Collate(o.Collation, val.(string)), memoryUtils.Collate(o.Collation, value.(string)))
 			}
 
 			return false
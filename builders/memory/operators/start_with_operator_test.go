@@ -312,3 +312,35 @@ func TestStartWithExpression_ShouldReturnFalse_WhenFieldTypeIsDateTimeArray(t *t
 	// Assert
 	assert.False(t, result)
 }
+
+func TestStartWithExpression_ShouldReturnFalse_WhenCollationIsCaseSensitiveAndCaseDiffers(t *testing.T) {
+	// Arrange
+	data := utils.ObjectToMap(struct {
+		Value string
+	}{
+		Value: "Filtex",
+	})
+	expression := StartWithOperator{Collation: constants.CollationCaseSensitive}.Build(constants.FieldTypeString, "Value", "fil")
+
+	// Act
+	result := expression.Fn(data)
+
+	// Assert
+	assert.False(t, result)
+}
+
+func TestStartWithExpression_ShouldReturnTrue_WhenCollationIsAccentInsensitiveAndAccentsDiffer(t *testing.T) {
+	// Arrange
+	data := utils.ObjectToMap(struct {
+		Value string
+	}{
+		Value: "École",
+	})
+	expression := StartWithOperator{Collation: constants.CollationAccentInsensitive}.Build(constants.FieldTypeString, "Value", "eco")
+
+	// Act
+	result := expression.Fn(data)
+
+	// Assert
+	assert.True(t, result)
+}
@@ -0,0 +1,28 @@
+package operators
+
+import (
+	"github.com/filtex/filtex-go/builders/memory/types"
+	"github.com/filtex/filtex-go/builders/memory/utils"
+	"github.com/filtex/filtex-go/constants"
+)
+
+type WithinOperator struct{}
+
+func (o WithinOperator) Build(fieldType constants.FieldType, field string, value interface{}) *types.MemoryExpression {
+	return &types.MemoryExpression{
+		Fn: func(data map[string]interface{}) bool {
+			if fieldType != constants.FieldTypeGeoPoint {
+				return false
+			}
+
+			val := data[field]
+
+			if val == nil {
+				return false
+			}
+
+			result, ok := utils.CheckGeoArea(val, value)
+			return ok && result
+		},
+	}
+}
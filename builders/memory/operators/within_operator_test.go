@@ -0,0 +1,102 @@
+package operators
+
+import (
+	"testing"
+
+	"github.com/filtex/filtex-go/constants"
+	filtexUtils "github.com/filtex/filtex-go/utils"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestWithinExpression_ShouldReturnTrue_WhenFieldValueIsInCircle(t *testing.T) {
+	// Arrange
+	samples := []interface{}{
+		"40.7128, -74.006",
+		filtexUtils.GeoPoint{Lat: 40.75, Lng: -73.99},
+		&filtexUtils.GeoPoint{Lat: 40.7, Lng: -74.05},
+		map[string]interface{}{"lat": 40.72, "lng": -74.0},
+		map[string]interface{}{"type": "Point", "coordinates": []interface{}{-74.01, 40.71}},
+		[]float64{-73.98, 40.73},
+	}
+	expression := WithinOperator{}.Build(constants.FieldTypeGeoPoint, "Value", "5km of (40.7128, -74.006)")
+
+	for _, v := range samples {
+		// Act
+		result := expression.Fn(map[string]interface{}{"Value": v})
+
+		// Assert
+		assert.True(t, result, v)
+	}
+}
+
+func TestWithinExpression_ShouldReturnFalse_WhenFieldValueIsNotInCircle(t *testing.T) {
+	// Arrange
+	samples := []interface{}{
+		nil,
+		"abc",
+		"40.6413, -73.7781",
+		map[string]interface{}{"lat": 95, "lng": 0},
+		[]interface{}{-74.006},
+	}
+	expression := WithinOperator{}.Build(constants.FieldTypeGeoPoint, "Value", "5km of (40.7128, -74.006)")
+
+	for _, v := range samples {
+		// Act
+		result := expression.Fn(map[string]interface{}{"Value": v})
+
+		// Assert
+		assert.False(t, result, v)
+	}
+}
+
+func TestWithinExpression_ShouldCheckContainment_WhenValueIsBox(t *testing.T) {
+	// Arrange
+	sampleMap := map[string]bool{
+		"40.5, -74":    true,
+		"41, -73":      true,
+		"42, -74":      false,
+		"40.5, -76":    false,
+		"-15, 175":     false,
+		"-15, -175.5":  false,
+		"40.5, -73.01": true,
+	}
+	expression := WithinOperator{}.Build(constants.FieldTypeGeoPoint, "Value", "box(40, -75, 41, -73)")
+
+	for input, expected := range sampleMap {
+		// Act
+		result := expression.Fn(map[string]interface{}{"Value": input})
+
+		// Assert
+		assert.Equal(t, expected, result, input)
+	}
+}
+
+func TestWithinExpression_ShouldCheckContainment_WhenBoxCrossesAntimeridian(t *testing.T) {
+	// Arrange
+	sampleMap := map[string]bool{
+		"-15, 175":  true,
+		"-15, -175": true,
+		"-15, 0":    false,
+		"-25, 175":  false,
+	}
+	expression := WithinOperator{}.Build(constants.FieldTypeGeoPoint, "Value", "box(-20, 170, -10, -170)")
+
+	for input, expected := range sampleMap {
+		// Act
+		result := expression.Fn(map[string]interface{}{"Value": input})
+
+		// Assert
+		assert.Equal(t, expected, result, input)
+	}
+}
+
+func TestWithinExpression_ShouldReturnFalse_WhenFieldTypeIsNotGeoPoint(t *testing.T) {
+	// Arrange
+	expression := WithinOperator{}.Build(constants.FieldTypeString, "Value", "5km of (40.7128, -74.006)")
+
+	// Act
+	result := expression.Fn(map[string]interface{}{"Value": "40.7128, -74.006"})
+
+	// Assert
+	assert.False(t, result)
+}
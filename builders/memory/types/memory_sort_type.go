@@ -0,0 +1,6 @@
+package types
+
+type MemorySort struct {
+	Compare func(a map[string]interface{}, b map[string]interface{}) int
+	Less    func(a map[string]interface{}, b map[string]interface{}) bool
+}
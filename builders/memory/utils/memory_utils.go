@@ -3,23 +3,29 @@ package utils
 import (
 	"reflect"
 	"strings"
+	"time"
+	"unicode"
 
 	"github.com/filtex/filtex-go/constants"
 	"github.com/filtex/filtex-go/utils"
 )
 
 func CheckEquality(fieldType constants.FieldType, fieldValue interface{}, value interface{}) bool {
+	return CheckCollatedEquality(fieldType, constants.CollationDefault, fieldValue, value)
+}
+
+func CheckCollatedEquality(fieldType constants.FieldType, collation constants.Collation, fieldValue interface{}, value interface{}) bool {
 	switch fieldType {
 	case constants.FieldTypeString:
 		castedValue, castedValueErr := utils.String(value)
 		castedFieldValue, castedFieldValueErr := utils.String(fieldValue)
-		if castedValueErr == nil && castedFieldValueErr == nil && strings.ToLower(castedValue) == strings.ToLower(castedFieldValue) {
+		if castedValueErr == nil && castedFieldValueErr == nil && Collate(collation, castedValue) == Collate(collation, castedFieldValue) {
 			return true
 		}
 	case constants.FieldTypeStringArray:
 		castedValue, castedValueErr := utils.String(value)
 		castedFieldValue, castedFieldValueErr := utils.String(fieldValue)
-		if castedValueErr == nil && castedFieldValueErr == nil && strings.ToLower(castedValue) == strings.ToLower(castedFieldValue) {
+		if castedValueErr == nil && castedFieldValueErr == nil && Collate(collation, castedValue) == Collate(collation, castedFieldValue) {
 			return true
 		}
 	case constants.FieldTypeNumber:
@@ -34,6 +40,17 @@ func CheckEquality(fieldType constants.FieldType, fieldValue interface{}, value
 		if castedValueErr == nil && castedFieldValueErr == nil && castedValue == castedFieldValue {
 			return true
 		}
+	case constants.FieldTypeInteger, constants.FieldTypeIntegerArray:
+		castedValue, castedValueErr := utils.Integer(value)
+		castedFieldValue, castedFieldValueErr := utils.Integer(fieldValue)
+		if castedValueErr == nil && castedFieldValueErr == nil && castedValue == castedFieldValue {
+			return true
+		}
+	case constants.FieldTypeDecimal, constants.FieldTypeDecimalArray:
+		result, err := utils.CompareDecimal(fieldValue, value)
+		if err == nil && result == 0 {
+			return true
+		}
 	case constants.FieldTypeBoolean:
 		castedValue, castedValueErr := utils.Boolean(value)
 		castedFieldValue, castedFieldValueErr := utils.Boolean(fieldValue)
@@ -47,15 +64,13 @@ func CheckEquality(fieldType constants.FieldType, fieldValue interface{}, value
 			return true
 		}
 	case constants.FieldTypeDate:
-		castedValue, castedValueErr := utils.Date(value)
-		castedFieldValue, castedFieldValueErr := utils.Date(fieldValue)
-		if castedValueErr == nil && castedFieldValueErr == nil && castedValue.UnixNano() == castedFieldValue.UnixNano() {
+		castedFieldValue, castedValue, ok := CastDates(fieldValue, value)
+		if ok && castedValue.UnixNano() == castedFieldValue.UnixNano() {
 			return true
 		}
 	case constants.FieldTypeDateArray:
-		castedValue, castedValueErr := utils.Date(value)
-		castedFieldValue, castedFieldValueErr := utils.Date(fieldValue)
-		if castedValueErr == nil && castedFieldValueErr == nil && castedValue.UnixNano() == castedFieldValue.UnixNano() {
+		castedFieldValue, castedValue, ok := CastDates(fieldValue, value)
+		if ok && castedValue.UnixNano() == castedFieldValue.UnixNano() {
 			return true
 		}
 	case constants.FieldTypeTime:
@@ -70,18 +85,238 @@ func CheckEquality(fieldType constants.FieldType, fieldValue interface{}, value
 		if castedValueErr == nil && castedFieldValueErr == nil && *castedValue == *castedFieldValue {
 			return true
 		}
+	case constants.FieldTypeDuration, constants.FieldTypeDurationArray:
+		castedValue, castedValueErr := utils.Duration(value)
+		castedFieldValue, castedFieldValueErr := utils.Duration(fieldValue)
+		if castedValueErr == nil && castedFieldValueErr == nil && castedValue == castedFieldValue {
+			return true
+		}
 	case constants.FieldTypeDateTime:
+		if r, ok := utils.AsTimeRange(value); ok {
+			result, ok := CompareTimeRange(fieldValue, r)
+			return ok && result == 0
+		}
+
 		castedValue, castedValueErr := utils.DateTime(value)
 		castedFieldValue, castedFieldValueErr := utils.DateTime(fieldValue)
 		if castedValueErr == nil && castedFieldValueErr == nil && castedValue.UnixNano() == castedFieldValue.UnixNano() {
 			return true
 		}
 	case constants.FieldTypeDateTimeArray:
+		if r, ok := utils.AsTimeRange(value); ok {
+			result, ok := CompareTimeRange(fieldValue, r)
+			return ok && result == 0
+		}
+
 		castedValue, castedValueErr := utils.DateTime(value)
 		castedFieldValue, castedFieldValueErr := utils.DateTime(fieldValue)
 		if castedValueErr == nil && castedFieldValueErr == nil && castedValue.UnixNano() == castedFieldValue.UnixNano() {
 			return true
 		}
+	case constants.FieldTypeUUID, constants.FieldTypeUUIDArray:
+		castedValue, castedValueErr := utils.UUID(value)
+		castedFieldValue, castedFieldValueErr := utils.UUID(fieldValue)
+		if castedValueErr == nil && castedFieldValueErr == nil && castedValue == castedFieldValue {
+			return true
+		}
+	case constants.FieldTypeEnum, constants.FieldTypeEnumArray:
+		castedValue, castedValueErr := utils.String(value)
+		castedFieldValue, castedFieldValueErr := utils.String(fieldValue)
+		if castedValueErr == nil && castedFieldValueErr == nil && castedValue == castedFieldValue {
+			return true
+		}
+	case constants.FieldTypeIP, constants.FieldTypeIPArray:
+		castedValue, castedValueErr := utils.IP(value)
+		castedFieldValue, castedFieldValueErr := utils.IP(fieldValue)
+		if castedValueErr == nil && castedFieldValueErr == nil && castedValue == castedFieldValue {
+			return true
+		}
+	}
+
+	return false
+}
+
+func CheckSubnet(fieldValue interface{}, value interface{}) (bool, bool) {
+	subnet, err := utils.Subnet(value)
+	if err != nil {
+		return false, false
+	}
+
+	castedFieldValue, err := utils.Subnet(fieldValue)
+	if err != nil {
+		return false, false
+	}
+
+	return subnet.Bits() <= castedFieldValue.Bits() && subnet.Contains(castedFieldValue.Addr()), true
+}
+
+func CheckGeoArea(fieldValue interface{}, value interface{}) (bool, bool) {
+	point, err := utils.Location(fieldValue)
+	if err != nil {
+		return false, false
+	}
+
+	area, err := utils.GeoArea(value)
+	if err != nil {
+		return false, false
+	}
+
+	switch a := area.(type) {
+	case *utils.GeoCircle:
+		return a.Contains(point), true
+	case *utils.GeoBox:
+		return a.Contains(point), true
+	}
+
+	return false, false
+}
+
+func Compare(fieldType constants.FieldType, collation constants.Collation, fieldValue interface{}, value interface{}) (int, bool) {
+	if IsNull(fieldValue) || IsNull(value) {
+		return 0, false
+	}
+
+	switch fieldType {
+	case constants.FieldTypeString, constants.FieldTypeStringArray:
+		castedFieldValue, castedFieldValueErr := utils.String(fieldValue)
+		castedValue, castedValueErr := utils.String(value)
+		if castedFieldValueErr == nil && castedValueErr == nil {
+			return strings.Compare(Collate(collation, castedFieldValue), Collate(collation, castedValue)), true
+		}
+	case constants.FieldTypeUUID, constants.FieldTypeUUIDArray:
+		castedFieldValue, castedFieldValueErr := utils.String(fieldValue)
+		castedValue, castedValueErr := utils.String(value)
+		if castedFieldValueErr == nil && castedValueErr == nil {
+			return strings.Compare(strings.ToLower(castedFieldValue), strings.ToLower(castedValue)), true
+		}
+	case constants.FieldTypeNumber, constants.FieldTypeNumberArray:
+		castedFieldValue, castedFieldValueErr := utils.Number(fieldValue)
+		castedValue, castedValueErr := utils.Number(value)
+		if castedFieldValueErr == nil && castedValueErr == nil {
+			return compareOrdered(castedFieldValue, castedValue), true
+		}
+	case constants.FieldTypeInteger, constants.FieldTypeIntegerArray:
+		castedFieldValue, castedFieldValueErr := utils.Integer(fieldValue)
+		castedValue, castedValueErr := utils.Integer(value)
+		if castedFieldValueErr == nil && castedValueErr == nil {
+			return compareOrdered(castedFieldValue, castedValue), true
+		}
+	case constants.FieldTypeDecimal, constants.FieldTypeDecimalArray:
+		result, err := utils.CompareDecimal(fieldValue, value)
+		if err == nil {
+			return result, true
+		}
+	case constants.FieldTypeBoolean, constants.FieldTypeBooleanArray:
+		castedFieldValue, castedFieldValueErr := utils.Boolean(fieldValue)
+		castedValue, castedValueErr := utils.Boolean(value)
+		if castedFieldValueErr == nil && castedValueErr == nil {
+			if castedFieldValue == castedValue {
+				return 0, true
+			}
+			if castedFieldValue {
+				return 1, true
+			}
+			return -1, true
+		}
+	case constants.FieldTypeDate, constants.FieldTypeDateArray:
+		castedFieldValue, castedValue, ok := CastDates(fieldValue, value)
+		if ok {
+			return compareOrdered(castedFieldValue.UnixNano(), castedValue.UnixNano()), true
+		}
+	case constants.FieldTypeTime, constants.FieldTypeTimeArray:
+		castedFieldValue, castedFieldValueErr := utils.Time(fieldValue)
+		castedValue, castedValueErr := utils.Time(value)
+		if castedFieldValueErr == nil && castedValueErr == nil {
+			return compareOrdered(*castedFieldValue, *castedValue), true
+		}
+	case constants.FieldTypeDuration, constants.FieldTypeDurationArray:
+		castedFieldValue, castedFieldValueErr := utils.Duration(fieldValue)
+		castedValue, castedValueErr := utils.Duration(value)
+		if castedFieldValueErr == nil && castedValueErr == nil {
+			return compareOrdered(int64(castedFieldValue), int64(castedValue)), true
+		}
+	case constants.FieldTypeDateTime, constants.FieldTypeDateTimeArray:
+		castedFieldValue, castedFieldValueErr := utils.DateTime(fieldValue)
+		castedValue, castedValueErr := utils.DateTime(value)
+		if castedFieldValueErr == nil && castedValueErr == nil {
+			return compareOrdered(castedFieldValue.UnixNano(), castedValue.UnixNano()), true
+		}
+	}
+
+	return 0, false
+}
+
+func CastDates(fieldValue interface{}, value interface{}) (*time.Time, *time.Time, bool) {
+	castedValue, err := utils.Date(value)
+	if err != nil {
+		return nil, nil, false
+	}
+
+	castedFieldValue, err := utils.DateIn(fieldValue, castedValue.Location())
+	if err != nil || castedFieldValue == nil {
+		return nil, nil, false
+	}
+
+	return castedFieldValue, castedValue, true
+}
+
+func CompareTimeRange(fieldValue interface{}, r *utils.TimeRange) (int, bool) {
+	castedFieldValue, err := utils.DateTime(fieldValue)
+	if err != nil || castedFieldValue == nil {
+		return 0, false
+	}
+
+	if castedFieldValue.Before(r.Start) {
+		return -1, true
+	}
+
+	if castedFieldValue.Before(r.End) {
+		return 0, true
+	}
+
+	return 1, true
+}
+
+func compareOrdered[T int | int64 | float64](a T, b T) int {
+	if a < b {
+		return -1
+	}
+
+	if a > b {
+		return 1
+	}
+
+	return 0
+}
+
+func Terms(text string) []string {
+	return strings.FieldsFunc(strings.ToLower(text), func(r rune) bool {
+		return !unicode.IsLetter(r) && !unicode.IsDigit(r)
+	})
+}
+
+func Collate(collation constants.Collation, str string) string {
+	if collation.IsCaseSensitive() {
+		return str
+	}
+
+	if collation.IsAccentInsensitive() {
+		return utils.RemoveAccents(str)
+	}
+
+	return strings.ToLower(str)
+}
+
+func IsNull(value interface{}) bool {
+	if value == nil {
+		return true
+	}
+
+	v := reflect.ValueOf(value)
+
+	switch v.Kind() {
+	case reflect.Pointer, reflect.Slice, reflect.Map, reflect.Interface:
+		return v.IsNil()
 	}
 
 	return false
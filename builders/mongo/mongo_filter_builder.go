@@ -1,21 +1,32 @@
 package mongo
 
 import (
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+
 	"github.com/filtex/filtex-go/builders/mongo/logics"
 	"github.com/filtex/filtex-go/builders/mongo/operators"
 	"github.com/filtex/filtex-go/builders/mongo/types"
 	"github.com/filtex/filtex-go/constants"
 	"github.com/filtex/filtex-go/errors"
 	"github.com/filtex/filtex-go/expressions"
+	"github.com/filtex/filtex-go/utils"
 )
 
 type MongoFilterBuilder struct {
-	logicsMap    map[constants.Logic]func(expressions []*types.MongoExpression) *types.MongoExpression
-	operatorsMap map[constants.Operator]func(fieldType constants.FieldType, field string, value interface{}) *types.MongoExpression
+	fields        map[string]string
+	fieldMapper   func(field string) string
+	uuidFormat    constants.UUIDFormat
+	regexSearch   bool
+	logicsMap     map[constants.Logic]func(expressions []*types.MongoExpression) *types.MongoExpression
+	operatorsMap  map[constants.Operator]func(fieldType constants.FieldType, field string, value interface{}) *types.MongoExpression
+	collationsMap map[constants.Collation]map[constants.Operator]func(fieldType constants.FieldType, field string, value interface{}) *types.MongoExpression
 }
 
 func NewMongoFilterBuilder() *MongoFilterBuilder {
 	return &MongoFilterBuilder{
+		fields:     map[string]string{},
+		uuidFormat: constants.UUIDFormatBinary,
 		logicsMap: map[constants.Logic]func(expressions []*types.MongoExpression) *types.MongoExpression{
 			constants.LogicAnd: logics.AndLogic{}.Build,
 			constants.LogicOr:  logics.OrLogic{}.Build,
@@ -31,16 +42,62 @@ func NewMongoFilterBuilder() *MongoFilterBuilder {
 			constants.OperatorNotEndWith:         operators.NotEndWithOperator{}.Build,
 			constants.OperatorBlank:              operators.BlankOperator{}.Build,
 			constants.OperatorNotBlank:           operators.NotBlankOperator{}.Build,
+			constants.OperatorIsNull:             operators.IsNullOperator{}.Build,
+			constants.OperatorIsNotNull:          operators.IsNotNullOperator{}.Build,
 			constants.OperatorGreaterThan:        operators.GreaterThanOperator{}.Build,
 			constants.OperatorGreaterThanOrEqual: operators.GreaterThanOrEqualOperator{}.Build,
 			constants.OperatorLessThan:           operators.LessThanOperator{}.Build,
 			constants.OperatorLessThanOrEqual:    operators.LessThanOrEqualOperator{}.Build,
 			constants.OperatorIn:                 operators.InOperator{}.Build,
 			constants.OperatorNotIn:              operators.NotInOperator{}.Build,
+			constants.OperatorInSubnet:           operators.InSubnetOperator{}.Build,
+			constants.OperatorNotInSubnet:        operators.NotInSubnetOperator{}.Build,
+			constants.OperatorWithin:             operators.WithinOperator{}.Build,
+		},
+		collationsMap: map[constants.Collation]map[constants.Operator]func(fieldType constants.FieldType, field string, value interface{}) *types.MongoExpression{
+			constants.CollationCaseSensitive:     newCollationOperatorsMap(constants.CollationCaseSensitive),
+			constants.CollationAccentInsensitive: newCollationOperatorsMap(constants.CollationAccentInsensitive),
 		},
 	}
 }
 
+func newCollationOperatorsMap(collation constants.Collation) map[constants.Operator]func(fieldType constants.FieldType, field string, value interface{}) *types.MongoExpression {
+	return map[constants.Operator]func(fieldType constants.FieldType, field string, value interface{}) *types.MongoExpression{
+		constants.OperatorEqual:        operators.EqualOperator{Collation: collation}.Build,
+		constants.OperatorNotEqual:     operators.NotEqualOperator{Collation: collation}.Build,
+		constants.OperatorContain:      operators.ContainOperator{Collation: collation}.Build,
+		constants.OperatorNotContain:   operators.NotContainOperator{Collation: collation}.Build,
+		constants.OperatorStartWith:    operators.StartWithOperator{Collation: collation}.Build,
+		constants.OperatorNotStartWith: operators.NotStartWithOperator{Collation: collation}.Build,
+		constants.OperatorEndWith:      operators.EndWithOperator{Collation: collation}.Build,
+		constants.OperatorNotEndWith:   operators.NotEndWithOperator{Collation: collation}.Build,
+		constants.OperatorIn:           operators.InOperator{Collation: collation}.Build,
+		constants.OperatorNotIn:        operators.NotInOperator{Collation: collation}.Build,
+	}
+}
+
+func (b *MongoFilterBuilder) Fields(fields map[string]string) *MongoFilterBuilder {
+	for k, v := range fields {
+		b.fields[k] = v
+	}
+	return b
+}
+
+func (b *MongoFilterBuilder) FieldMapper(mapper func(field string) string) *MongoFilterBuilder {
+	b.fieldMapper = mapper
+	return b
+}
+
+func (b *MongoFilterBuilder) UUIDFormat(format constants.UUIDFormat) *MongoFilterBuilder {
+	b.uuidFormat = format
+	return b
+}
+
+func (b *MongoFilterBuilder) RegexSearch() *MongoFilterBuilder {
+	b.regexSearch = true
+	return b
+}
+
 func (b *MongoFilterBuilder) Build(expression expressions.Expression) (*types.MongoExpression, error) {
 	switch exp := expression.(type) {
 	case *expressions.LogicExpression:
@@ -60,12 +117,105 @@ func (b *MongoFilterBuilder) Build(expression expressions.Expression) (*types.Mo
 
 		return nil, errors.NewCouldNotBeBuiltError()
 	case *expressions.OperatorExpression:
-		if fn, ok := b.operatorsMap[exp.Operator]; ok {
-			return fn(exp.Type, exp.Field, exp.Value), nil
+		if b.uuidFormat != constants.UUIDFormatBinary && b.uuidFormat != constants.UUIDFormatString {
+			return nil, errors.NewCouldNotBeBuiltError()
+		}
+
+		if (exp.Operator == constants.OperatorInSubnet || exp.Operator == constants.OperatorNotInSubnet) && isIPv6Subnet(exp.Value) {
+			return nil, errors.NewUnsupportedSubnetError()
+		}
+
+		if fn, ok := b.findOperator(exp.Operator, exp.Collation); ok {
+			result := fn(exp.Type, utils.MapField(b.fields, b.fieldMapper, exp.Field), b.castValue(exp.Type, exp.Value))
+			if result == nil {
+				return nil, errors.NewCouldNotBeBuiltError()
+			}
+
+			return result, nil
 		}
 
 		return nil, errors.NewCouldNotBeBuiltError()
+	case *expressions.SearchExpression:
+		result := operators.SearchOperator{Regex: b.regexSearch}.Build(utils.MapFields(b.fields, b.fieldMapper, exp.Fields), exp.Value)
+		if result == nil {
+			return nil, errors.NewCouldNotBeBuiltError()
+		}
+
+		return result, nil
 	}
 
 	return nil, errors.NewCouldNotBeBuiltError()
 }
+
+func (b *MongoFilterBuilder) findOperator(operator constants.Operator, collation constants.Collation) (func(fieldType constants.FieldType, field string, value interface{}) *types.MongoExpression, bool) {
+	if fn, ok := b.collationsMap[collation][operator]; ok {
+		return fn, true
+	}
+
+	fn, ok := b.operatorsMap[operator]
+	return fn, ok
+}
+
+func isIPv6Subnet(value interface{}) bool {
+	subnet, err := utils.Subnet(value)
+	return err == nil && !subnet.Addr().Is4()
+}
+
+func (b *MongoFilterBuilder) castValue(fieldType constants.FieldType, value interface{}) interface{} {
+	if values, ok := value.([]interface{}); ok {
+		result := make([]interface{}, 0)
+
+		for _, v := range values {
+			result = append(result, b.castValue(fieldType, v))
+		}
+
+		return result
+	}
+
+	if value == nil {
+		return value
+	}
+
+	switch fieldType {
+	case constants.FieldTypeDecimal, constants.FieldTypeDecimalArray:
+		d, err := utils.Decimal(value)
+		if err != nil {
+			return value
+		}
+
+		decimal, err := primitive.ParseDecimal128(d)
+		if err != nil {
+			return value
+		}
+
+		return decimal
+	case constants.FieldTypeUUID, constants.FieldTypeUUIDArray:
+		if b.uuidFormat == constants.UUIDFormatString {
+			s, err := utils.UUID(value)
+			if err != nil {
+				return value
+			}
+
+			return s
+		}
+
+		data, err := utils.UUIDBytes(value)
+		if err != nil {
+			return value
+		}
+
+		return primitive.Binary{
+			Subtype: bson.TypeBinaryUUID,
+			Data:    data,
+		}
+	case constants.FieldTypeDuration, constants.FieldTypeDurationArray:
+		duration, err := utils.Duration(value)
+		if err != nil {
+			return value
+		}
+
+		return duration
+	}
+
+	return value
+}
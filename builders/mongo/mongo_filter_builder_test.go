@@ -2,9 +2,14 @@ package mongo
 
 import (
 	"testing"
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
 
 	"github.com/filtex/filtex-go/builders/mongo/types"
 	"github.com/filtex/filtex-go/constants"
+	"github.com/filtex/filtex-go/errors"
 	"github.com/filtex/filtex-go/expressions"
 	"github.com/stretchr/testify/assert"
 )
@@ -90,3 +95,182 @@ func TestBuild_ShouldReturnExpression_WhenExpressionIsOperatorExpressionAndValid
 	assert.IsType(t, mongoExpression, expression)
 	assert.NoError(t, err)
 }
+
+func TestBuild_ShouldUseDecimal128Values_WhenFieldTypeIsDecimal(t *testing.T) {
+	// Arrange
+	builder := NewMongoFilterBuilder()
+	operatorExpression := expressions.NewOperatorExpression(constants.FieldTypeDecimal, "Value", constants.OperatorIn, []interface{}{"19.90", "0.1"})
+
+	// Act
+	expression, err := builder.Build(operatorExpression)
+
+	// Assert
+	price, _ := primitive.ParseDecimal128("19.90")
+	fraction, _ := primitive.ParseDecimal128("0.1")
+	assert.NoError(t, err)
+	assert.Equal(t, bson.M{
+		"Value": bson.M{
+			"$in": []interface{}{price, fraction},
+		},
+	}, expression.Condition)
+}
+
+func TestBuild_ShouldUseBinaryValues_WhenFieldTypeIsUUID(t *testing.T) {
+	// Arrange
+	builder := NewMongoFilterBuilder()
+	operatorExpression := expressions.NewOperatorExpression(constants.FieldTypeUUID, "Value", constants.OperatorEqual, "3f2504e0-4f89-11d3-9a0c-0305e82c3301")
+
+	// Act
+	expression, err := builder.Build(operatorExpression)
+
+	// Assert
+	assert.NoError(t, err)
+	assert.Equal(t, bson.M{
+		"Value": bson.M{
+			"$eq": primitive.Binary{
+				Subtype: bson.TypeBinaryUUID,
+				Data:    []byte{0x3f, 0x25, 0x04, 0xe0, 0x4f, 0x89, 0x11, 0xd3, 0x9a, 0x0c, 0x03, 0x05, 0xe8, 0x2c, 0x33, 0x01},
+			},
+		},
+	}, expression.Condition)
+}
+
+func TestBuild_ShouldUseStringValues_WhenUUIDFormatIsString(t *testing.T) {
+	// Arrange
+	builder := NewMongoFilterBuilder().UUIDFormat(constants.UUIDFormatString)
+	operatorExpression := expressions.NewOperatorExpression(constants.FieldTypeUUID, "Value", constants.OperatorIn, []interface{}{"3F2504E0-4F89-11D3-9A0C-0305E82C3301"})
+
+	// Act
+	expression, err := builder.Build(operatorExpression)
+
+	// Assert
+	assert.NoError(t, err)
+	assert.Equal(t, bson.M{
+		"Value": bson.M{
+			"$in": []interface{}{"3f2504e0-4f89-11d3-9a0c-0305e82c3301"},
+		},
+	}, expression.Condition)
+}
+
+func TestBuild_ShouldReturnError_WhenUUIDFormatIsNotValid(t *testing.T) {
+	// Arrange
+	builder := NewMongoFilterBuilder().UUIDFormat(constants.UUIDFormatUnknown)
+	operatorExpression := expressions.NewOperatorExpression(constants.FieldTypeUUID, "Value", constants.OperatorEqual, "3f2504e0-4f89-11d3-9a0c-0305e82c3301")
+
+	// Act
+	expression, err := builder.Build(operatorExpression)
+
+	// Assert
+	assert.Nil(t, expression)
+	assert.Error(t, err)
+}
+
+func TestBuild_ShouldReturnError_WhenOperatorCouldNotBeBuilt(t *testing.T) {
+	// Arrange
+	builder := NewMongoFilterBuilder()
+	operatorExpression := expressions.NewOperatorExpression(constants.FieldTypeIP, "Value", constants.OperatorInSubnet, "abc")
+
+	// Act
+	expression, err := builder.Build(operatorExpression)
+
+	// Assert
+	assert.Nil(t, expression)
+	assert.Error(t, err)
+}
+
+func TestBuild_ShouldReturnUnsupportedSubnetError_WhenSubnetIsIPv6(t *testing.T) {
+	// Arrange
+	builder := NewMongoFilterBuilder()
+	samples := []constants.Operator{
+		constants.OperatorInSubnet,
+		constants.OperatorNotInSubnet,
+	}
+
+	for _, v := range samples {
+		// Act
+		expression, err := builder.Build(expressions.NewOperatorExpression(constants.FieldTypeIP, "Value", v, "2001:db8::/32"))
+
+		// Assert
+		assert.Nil(t, expression)
+		assert.Equal(t, errors.NewUnsupportedSubnetError(), err)
+	}
+}
+
+func TestBuild_ShouldUseDurationValues_WhenFieldTypeIsDuration(t *testing.T) {
+	// Arrange
+	builder := NewMongoFilterBuilder()
+	operatorExpression := expressions.NewOperatorExpression(constants.FieldTypeDuration, "Value", constants.OperatorLessThan, "1d")
+
+	// Act
+	expression, err := builder.Build(operatorExpression)
+
+	// Assert
+	assert.NoError(t, err)
+	assert.Equal(t, bson.M{
+		"Value": bson.M{
+			"$lt": 24 * time.Hour,
+		},
+	}, expression.Condition)
+}
+
+func TestBuild_ShouldMapFields_WhenFieldsAreSet(t *testing.T) {
+	// Arrange
+	builder := NewMongoFilterBuilder().Fields(map[string]string{"createdAt": "meta.created"})
+	operatorExpression := expressions.NewOperatorExpression(constants.FieldTypeNumber, "createdAt", constants.OperatorEqual, float64(1))
+
+	// Act
+	expression, err := builder.Build(operatorExpression)
+
+	// Assert
+	assert.NoError(t, err)
+	assert.Equal(t, bson.M{"meta.created": bson.M{"$eq": float64(1)}}, expression.Condition)
+}
+
+func TestBuild_ShouldUseFieldMapper_WhenFieldIsNotInFields(t *testing.T) {
+	// Arrange
+	builder := NewMongoFilterBuilder().
+		Fields(map[string]string{"id": "_id"}).
+		FieldMapper(func(field string) string { return "data." + field })
+	logicExpression := expressions.NewLogicExpression(constants.LogicAnd, []expressions.Expression{
+		expressions.NewOperatorExpression(constants.FieldTypeNumber, "id", constants.OperatorEqual, float64(1)),
+		expressions.NewOperatorExpression(constants.FieldTypeNumber, "version", constants.OperatorEqual, float64(2)),
+	})
+
+	// Act
+	expression, err := builder.Build(logicExpression)
+
+	// Assert
+	assert.NoError(t, err)
+	assert.Equal(t, bson.M{"$and": []bson.M{
+		bson.M{"_id": bson.M{"$eq": float64(1)}},
+		bson.M{"data.version": bson.M{"$eq": float64(2)}},
+	}}, expression.Condition)
+}
+
+func TestBuild_ShouldReturnTextExpression_WhenExpressionIsSearch(t *testing.T) {
+	// Arrange
+	builder := NewMongoFilterBuilder()
+	searchExpression := expressions.NewSearchExpression([]string{"name"}, "red shoes")
+
+	// Act
+	expression, err := builder.Build(searchExpression)
+
+	// Assert
+	assert.NoError(t, err)
+	assert.Equal(t, bson.M{"$text": bson.M{"$search": "red shoes"}}, expression.Condition)
+}
+
+func TestBuild_ShouldReturnRegexExpression_WhenRegexSearchIsEnabled(t *testing.T) {
+	// Arrange
+	builder := NewMongoFilterBuilder().
+		Fields(map[string]string{"name": "title"}).
+		RegexSearch()
+	searchExpression := expressions.NewSearchExpression([]string{"name"}, "shoes")
+
+	// Act
+	expression, err := builder.Build(searchExpression)
+
+	// Assert
+	assert.NoError(t, err)
+	assert.Equal(t, bson.M{"title": bson.M{"$regex": `(?<![\p{L}\p{N}])shoes(?![\p{L}\p{N}])`, "$options": "i"}}, expression.Condition)
+}
@@ -0,0 +1,65 @@
+package mongo
+
+import (
+	"go.mongodb.org/mongo-driver/bson"
+
+	"github.com/filtex/filtex-go/builders/mongo/types"
+	"github.com/filtex/filtex-go/constants"
+	"github.com/filtex/filtex-go/errors"
+	"github.com/filtex/filtex-go/expressions"
+	"github.com/filtex/filtex-go/utils"
+)
+
+type MongoSortBuilder struct {
+	fields      map[string]string
+	fieldMapper func(field string) string
+}
+
+func NewMongoSortBuilder() *MongoSortBuilder {
+	return &MongoSortBuilder{
+		fields: map[string]string{},
+	}
+}
+
+func (b *MongoSortBuilder) Fields(fields map[string]string) *MongoSortBuilder {
+	for k, v := range fields {
+		b.fields[k] = v
+	}
+	return b
+}
+
+func (b *MongoSortBuilder) FieldMapper(mapper func(field string) string) *MongoSortBuilder {
+	b.fieldMapper = mapper
+	return b
+}
+
+func (b *MongoSortBuilder) Build(sorts []*expressions.SortExpression) (*types.MongoSort, error) {
+	result := bson.D{}
+
+	for _, v := range sorts {
+		if v == nil || v.Field == "" {
+			return nil, errors.NewCouldNotBeBuiltError()
+		}
+
+		field := utils.MapField(b.fields, b.fieldMapper, v.Field)
+
+		switch v.Direction {
+		case constants.SortDirectionAsc, constants.SortDirectionUnknown:
+			if v.Nulls == constants.SortNullsLast {
+				return nil, errors.NewCouldNotBeBuiltError()
+			}
+			result = append(result, bson.E{Key: field, Value: 1})
+		case constants.SortDirectionDesc:
+			if v.Nulls == constants.SortNullsFirst {
+				return nil, errors.NewCouldNotBeBuiltError()
+			}
+			result = append(result, bson.E{Key: field, Value: -1})
+		default:
+			return nil, errors.NewCouldNotBeBuiltError()
+		}
+	}
+
+	return &types.MongoSort{
+		Sort: result,
+	}, nil
+}
@@ -0,0 +1,73 @@
+package mongo
+
+import (
+	"testing"
+
+	"go.mongodb.org/mongo-driver/bson"
+
+	"github.com/filtex/filtex-go/constants"
+	"github.com/filtex/filtex-go/expressions"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSortBuild_ShouldReturnError_WhenSortIsNil(t *testing.T) {
+	// Arrange
+	builder := NewMongoSortBuilder()
+
+	// Act
+	sort, err := builder.Build([]*expressions.SortExpression{nil})
+
+	// Assert
+	assert.Nil(t, sort)
+	assert.Error(t, err)
+}
+
+func TestSortBuild_ShouldReturnError_WhenNullsCanNotBeHonoured(t *testing.T) {
+	// Arrange
+	builder := NewMongoSortBuilder()
+	samples := []*expressions.SortExpression{
+		expressions.NewSortExpression(constants.FieldTypeString, "name", constants.SortDirectionAsc, constants.SortNullsLast),
+		expressions.NewSortExpression(constants.FieldTypeString, "name", constants.SortDirectionDesc, constants.SortNullsFirst),
+	}
+
+	for _, v := range samples {
+		// Act
+		sort, err := builder.Build([]*expressions.SortExpression{v})
+
+		// Assert
+		assert.Nil(t, sort)
+		assert.Error(t, err)
+	}
+}
+
+func TestSortBuild_ShouldReturnSortDocument_WhenSortsAreValid(t *testing.T) {
+	// Arrange
+	builder := NewMongoSortBuilder()
+
+	// Act
+	sort, err := builder.Build([]*expressions.SortExpression{
+		expressions.NewSortExpression(constants.FieldTypeString, "name", constants.SortDirectionAsc, constants.SortNullsFirst),
+		expressions.NewSortExpression(constants.FieldTypeDateTime, "createdAt", constants.SortDirectionDesc, constants.SortNullsDefault),
+	})
+
+	// Assert
+	assert.NoError(t, err)
+	assert.Equal(t, bson.D{{Key: "name", Value: 1}, {Key: "createdAt", Value: -1}}, sort.Sort)
+}
+
+func TestSortBuild_ShouldMapFields_WhenFieldsAreSet(t *testing.T) {
+	// Arrange
+	builder := NewMongoSortBuilder().
+		Fields(map[string]string{"createdAt": "meta.created"}).
+		FieldMapper(func(field string) string { return "data." + field })
+
+	// Act
+	sort, err := builder.Build([]*expressions.SortExpression{
+		expressions.NewSortExpression(constants.FieldTypeDateTime, "createdAt", constants.SortDirectionDesc, constants.SortNullsDefault),
+		expressions.NewSortExpression(constants.FieldTypeString, "name", constants.SortDirectionAsc, constants.SortNullsDefault),
+	})
+
+	// Assert
+	assert.NoError(t, err)
+	assert.Equal(t, bson.D{{Key: "meta.created", Value: -1}, {Key: "data.name", Value: 1}}, sort.Sort)
+}
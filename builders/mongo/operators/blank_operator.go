@@ -27,12 +27,17 @@ func (BlankOperator) Build(fieldType constants.FieldType, field string, value in
 		return &types.MongoExpression{
 			Condition: bson.M{
 				field: bson.M{
-					"$exists": true,
-					"$eq":     "",
+					"$in": bson.A{nil, ""},
 				},
 			},
 		}
 	}
 
-	return nil
+	return &types.MongoExpression{
+		Condition: bson.M{
+			field: bson.M{
+				"$eq": nil,
+			},
+		},
+	}
 }
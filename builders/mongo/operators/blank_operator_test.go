@@ -24,15 +24,9 @@ func TestBlankExpression_ShouldReturnExpression_WhenFieldTypeIsString(t *testing
 	assert.True(t, ok)
 	assert.NotNil(t, inner)
 
-	exists, ok := inner["$exists"]
-	assert.True(t, ok)
-	assert.NotNil(t, exists)
-	assert.True(t, exists.(bool))
-
-	eq, ok := inner["$eq"]
+	in, ok := inner["$in"]
 	assert.True(t, ok)
-	assert.NotNil(t, eq)
-	assert.Equal(t, "", eq.(string))
+	assert.Equal(t, bson.A{nil, ""}, in)
 }
 
 func TestBlankExpression_ShouldReturnExpression_WhenFieldTypeIsStringArray(t *testing.T) {
@@ -167,47 +161,107 @@ func TestBlankExpression_ShouldReturnExpression_WhenFieldTypeIsDateTimeArray(t *
 	assert.False(t, exists.(bool))
 }
 
-func TestBlankExpression_ShouldReturnNil_WhenFieldTypeIsNumber(t *testing.T) {
+func TestBlankExpression_ShouldReturnExpression_WhenFieldTypeIsNumber(t *testing.T) {
 	// Arrange
 	// Act
 	expression := BlankOperator{}.Build(constants.FieldTypeNumber, "Value", nil)
 
 	// Assert
-	assert.Nil(t, expression)
+	assert.NotNil(t, expression)
+
+	value, ok := expression.Condition["Value"]
+	assert.True(t, ok)
+	assert.NotNil(t, value)
+
+	inner, ok := value.(bson.M)
+	assert.True(t, ok)
+	assert.NotNil(t, inner)
+
+	eq, ok := inner["$eq"]
+	assert.True(t, ok)
+	assert.Nil(t, eq)
 }
 
-func TestBlankExpression_ShouldReturnNil_WhenFieldTypeIsBoolean(t *testing.T) {
+func TestBlankExpression_ShouldReturnExpression_WhenFieldTypeIsBoolean(t *testing.T) {
 	// Arrange
 	// Act
 	expression := BlankOperator{}.Build(constants.FieldTypeBoolean, "Value", nil)
 
 	// Assert
-	assert.Nil(t, expression)
+	assert.NotNil(t, expression)
+
+	value, ok := expression.Condition["Value"]
+	assert.True(t, ok)
+	assert.NotNil(t, value)
+
+	inner, ok := value.(bson.M)
+	assert.True(t, ok)
+	assert.NotNil(t, inner)
+
+	eq, ok := inner["$eq"]
+	assert.True(t, ok)
+	assert.Nil(t, eq)
 }
 
-func TestBlankExpression_ShouldReturnNil_WhenFieldTypeIsDate(t *testing.T) {
+func TestBlankExpression_ShouldReturnExpression_WhenFieldTypeIsDate(t *testing.T) {
 	// Arrange
 	// Act
 	expression := BlankOperator{}.Build(constants.FieldTypeDate, "Value", nil)
 
 	// Assert
-	assert.Nil(t, expression)
+	assert.NotNil(t, expression)
+
+	value, ok := expression.Condition["Value"]
+	assert.True(t, ok)
+	assert.NotNil(t, value)
+
+	inner, ok := value.(bson.M)
+	assert.True(t, ok)
+	assert.NotNil(t, inner)
+
+	eq, ok := inner["$eq"]
+	assert.True(t, ok)
+	assert.Nil(t, eq)
 }
 
-func TestBlankExpression_ShouldReturnNil_WhenFieldTypeIsTime(t *testing.T) {
+func TestBlankExpression_ShouldReturnExpression_WhenFieldTypeIsTime(t *testing.T) {
 	// Arrange
 	// Act
 	expression := BlankOperator{}.Build(constants.FieldTypeTime, "Value", nil)
 
 	// Assert
-	assert.Nil(t, expression)
+	assert.NotNil(t, expression)
+
+	value, ok := expression.Condition["Value"]
+	assert.True(t, ok)
+	assert.NotNil(t, value)
+
+	inner, ok := value.(bson.M)
+	assert.True(t, ok)
+	assert.NotNil(t, inner)
+
+	eq, ok := inner["$eq"]
+	assert.True(t, ok)
+	assert.Nil(t, eq)
 }
 
-func TestBlankExpression_ShouldReturnNil_WhenFieldTypeIsDateTime(t *testing.T) {
+func TestBlankExpression_ShouldReturnExpression_WhenFieldTypeIsDateTime(t *testing.T) {
 	// Arrange
 	// Act
 	expression := BlankOperator{}.Build(constants.FieldTypeDateTime, "Value", nil)
 
 	// Assert
-	assert.Nil(t, expression)
+	assert.NotNil(t, expression)
+
+	value, ok := expression.Condition["Value"]
+	assert.True(t, ok)
+	assert.NotNil(t, value)
+
+	inner, ok := value.(bson.M)
+	assert.True(t, ok)
+	assert.NotNil(t, inner)
+
+	eq, ok := inner["$eq"]
+	assert.True(t, ok)
+	assert.Nil(t, eq)
 }
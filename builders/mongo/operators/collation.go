@@ -0,0 +1,57 @@
+package operators
+
+import (
+	"fmt"
+	"regexp"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+
+	"github.com/filtex/filtex-go/constants"
+	"github.com/filtex/filtex-go/utils"
+)
+
+func pattern(collation constants.Collation, value interface{}) string {
+	str := regexp.QuoteMeta(fmt.Sprintf("%s", value))
+
+	if collation.IsAccentInsensitive() {
+		return utils.AccentInsensitivePattern(str)
+	}
+
+	return str
+}
+
+func regex(collation constants.Collation, pattern string) bson.M {
+	if collation.IsCaseSensitive() {
+		return bson.M{
+			"$regex": pattern,
+		}
+	}
+
+	return bson.M{
+		"$regex":   pattern,
+		"$options": "i",
+	}
+}
+
+func hasCollatedValues(collation constants.Collation) bool {
+	return collation == constants.CollationCaseInsensitive || collation.IsAccentInsensitive()
+}
+
+func regexes(collation constants.Collation, values []interface{}) []interface{} {
+	result := make([]interface{}, 0)
+
+	for _, v := range values {
+		item := primitive.Regex{
+			Pattern: fmt.Sprintf("^%s$", pattern(collation, v)),
+		}
+
+		if hasCollatedValues(collation) {
+			item.Options = "i"
+		}
+
+		result = append(result, item)
+	}
+
+	return result
+}
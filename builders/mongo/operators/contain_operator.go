@@ -7,9 +7,21 @@ import (
 	"github.com/filtex/filtex-go/constants"
 )
 
-type ContainOperator struct{}
+type ContainOperator struct {
+	Collation constants.Collation
+}
+
+func (o ContainOperator) Build(fieldType constants.FieldType, field string, value interface{}) *types.MongoExpression {
+	if fieldType == constants.FieldTypeStringArray && hasCollatedValues(o.Collation) {
+		return &types.MongoExpression{
+			Condition: bson.M{
+				field: bson.M{
+					"$in": regexes(o.Collation, []interface{}{value}),
+				},
+			},
+		}
+	}
 
-func (ContainOperator) Build(fieldType constants.FieldType, field string, value interface{}) *types.MongoExpression {
 	if fieldType.IsArray() {
 		return &types.MongoExpression{
 			Condition: bson.M{
@@ -24,10 +36,7 @@ func (ContainOperator) Build(fieldType constants.FieldType, field string, value
 	case constants.FieldTypeString:
 		return &types.MongoExpression{
 			Condition: bson.M{
-				field: bson.M{
-					"$regex":   value,
-					"$options": "i",
-				},
+				field: regex(o.Collation, pattern(o.Collation, value)),
 			},
 		}
 	}
@@ -7,6 +7,7 @@ import (
 	"github.com/filtex/filtex-go/constants"
 	"github.com/stretchr/testify/assert"
 	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
 )
 
 func TestContainExpression_ShouldReturnExpression_WhenFieldTypeIsString(t *testing.T) {
@@ -250,3 +251,51 @@ func TestContainExpression_ShouldReturnNil_WhenFieldTypeIsDateTime(t *testing.T)
 	// Assert
 	assert.Nil(t, expression)
 }
+
+func TestContainExpression_ShouldReturnExpression_WhenFieldTypeIsStringArrayAndCollationIsCaseInsensitive(t *testing.T) {
+	// Arrange
+	value := "C++"
+
+	// Act
+	expression := ContainOperator{Collation: constants.CollationCaseInsensitive}.Build(constants.FieldTypeStringArray, "Value", value)
+
+	// Assert
+	assert.NotNil(t, expression)
+	assert.Equal(t, bson.M{"Value": bson.M{"$in": []interface{}{primitive.Regex{Pattern: `^C\+\+$`, Options: "i"}}}}, expression.Condition)
+}
+
+func TestContainExpression_ShouldEscapeValue_WhenValueContainsRegexCharacters(t *testing.T) {
+	// Arrange
+	value := "a.b"
+
+	// Act
+	expression := ContainOperator{}.Build(constants.FieldTypeString, "Value", value)
+
+	// Assert
+	assert.NotNil(t, expression)
+	assert.Equal(t, bson.M{"Value": bson.M{"$regex": `a\.b`, "$options": "i"}}, expression.Condition)
+}
+
+func TestContainExpression_ShouldReturnExpression_WhenFieldTypeIsStringAndCollationIsCaseSensitive(t *testing.T) {
+	// Arrange
+	value := "Café"
+
+	// Act
+	expression := ContainOperator{Collation: constants.CollationCaseSensitive}.Build(constants.FieldTypeString, "Value", value)
+
+	// Assert
+	assert.NotNil(t, expression)
+	assert.Equal(t, bson.M{"Value": bson.M{"$regex": "Café"}}, expression.Condition)
+}
+
+func TestContainExpression_ShouldReturnExpression_WhenFieldTypeIsStringAndCollationIsAccentInsensitive(t *testing.T) {
+	// Arrange
+	value := "Café"
+
+	// Act
+	expression := ContainOperator{Collation: constants.CollationAccentInsensitive}.Build(constants.FieldTypeString, "Value", value)
+
+	// Assert
+	assert.NotNil(t, expression)
+	assert.Equal(t, bson.M{"Value": bson.M{"$regex": "[cçćĉċč][aàáâãäåāăą]f[eèéêëēĕėęě]", "$options": "i"}}, expression.Condition)
+}
@@ -0,0 +1,122 @@
+package operators
+
+import (
+	"go.mongodb.org/mongo-driver/bson"
+
+	"github.com/filtex/filtex-go/builders/mongo/types"
+	"github.com/filtex/filtex-go/constants"
+	"github.com/filtex/filtex-go/utils"
+)
+
+func hasRange(fieldType constants.FieldType, value interface{}) bool {
+	if fieldType == constants.FieldTypeDate {
+		return true
+	}
+
+	if fieldType != constants.FieldTypeDateTime {
+		return false
+	}
+
+	values, ok := value.([]interface{})
+	if !ok {
+		values = []interface{}{value}
+	}
+
+	for _, v := range values {
+		if _, ok := utils.AsTimeRange(v); ok {
+			return true
+		}
+	}
+
+	return false
+}
+
+func valueRange(fieldType constants.FieldType, value interface{}) (*utils.TimeRange, bool) {
+	if r, ok := utils.AsTimeRange(value); ok {
+		return r, true
+	}
+
+	if fieldType == constants.FieldTypeDate {
+		r, err := utils.DayRange(value)
+		return r, err == nil
+	}
+
+	return nil, false
+}
+
+func equalRange(fieldType constants.FieldType, field string, value interface{}) *types.MongoExpression {
+	r, ok := valueRange(fieldType, value)
+	if !ok {
+		if fieldType != constants.FieldTypeDateTime {
+			return nil
+		}
+
+		return &types.MongoExpression{
+			Condition: bson.M{
+				field: bson.M{
+					"$eq": value,
+				},
+			},
+		}
+	}
+
+	return &types.MongoExpression{
+		Condition: bson.M{
+			field: bson.M{
+				"$gte": r.Start,
+				"$lt":  r.End,
+			},
+		},
+	}
+}
+
+func notEqualRange(fieldType constants.FieldType, field string, value interface{}) *types.MongoExpression {
+	r, ok := valueRange(fieldType, value)
+	if !ok {
+		if fieldType != constants.FieldTypeDateTime {
+			return nil
+		}
+
+		return &types.MongoExpression{
+			Condition: bson.M{
+				field: bson.M{
+					"$ne": value,
+				},
+			},
+		}
+	}
+
+	return &types.MongoExpression{
+		Condition: bson.M{
+			"$or": []bson.M{
+				{field: bson.M{"$lt": r.Start}},
+				{field: bson.M{"$gte": r.End}},
+				{field: nil},
+			},
+		},
+	}
+}
+
+func ranges(fieldType constants.FieldType, field string, value interface{}, fn func(fieldType constants.FieldType, field string, value interface{}) *types.MongoExpression, logic string) *types.MongoExpression {
+	values, ok := value.([]interface{})
+	if !ok {
+		values = []interface{}{value}
+	}
+
+	conditions := make([]bson.M, 0)
+
+	for _, v := range values {
+		expression := fn(fieldType, field, v)
+		if expression == nil {
+			return nil
+		}
+
+		conditions = append(conditions, expression.Condition)
+	}
+
+	return &types.MongoExpression{
+		Condition: bson.M{
+			logic: conditions,
+		},
+	}
+}
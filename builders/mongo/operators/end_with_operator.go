@@ -9,19 +9,18 @@ import (
 	"github.com/filtex/filtex-go/constants"
 )
 
-type EndWithOperator struct{}
+type EndWithOperator struct {
+	Collation constants.Collation
+}
 
-func (EndWithOperator) Build(fieldType constants.FieldType, field string, value interface{}) *types.MongoExpression {
+func (o EndWithOperator) Build(fieldType constants.FieldType, field string, value interface{}) *types.MongoExpression {
 	if fieldType != constants.FieldTypeString {
 		return nil
 	}
 
 	return &types.MongoExpression{
 		Condition: bson.M{
-			field: bson.M{
-				"$regex":   fmt.Sprintf("%s$", value),
-				"$options": "i",
-			},
+			field: regex(o.Collation, fmt.Sprintf("%s$", pattern(o.Collation, value))),
 		},
 	}
 }
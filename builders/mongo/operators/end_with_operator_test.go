@@ -135,3 +135,27 @@ func TestEndWithExpression_ShouldReturnNil_WhenFieldTypeIsDateTimeArray(t *testi
 	// Assert
 	assert.Nil(t, expression)
 }
+
+func TestEndWithExpression_ShouldReturnExpression_WhenFieldTypeIsStringAndCollationIsCaseSensitive(t *testing.T) {
+	// Arrange
+	value := "Café"
+
+	// Act
+	expression := EndWithOperator{Collation: constants.CollationCaseSensitive}.Build(constants.FieldTypeString, "Value", value)
+
+	// Assert
+	assert.NotNil(t, expression)
+	assert.Equal(t, bson.M{"Value": bson.M{"$regex": "Café$"}}, expression.Condition)
+}
+
+func TestEndWithExpression_ShouldReturnExpression_WhenFieldTypeIsStringAndCollationIsAccentInsensitive(t *testing.T) {
+	// Arrange
+	value := "Café"
+
+	// Act
+	expression := EndWithOperator{Collation: constants.CollationAccentInsensitive}.Build(constants.FieldTypeString, "Value", value)
+
+	// Assert
+	assert.NotNil(t, expression)
+	assert.Equal(t, bson.M{"Value": bson.M{"$regex": "[cçćĉċč][aàáâãäåāăą]f[eèéêëēĕėęě]$", "$options": "i"}}, expression.Condition)
+}
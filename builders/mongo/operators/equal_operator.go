@@ -8,20 +8,23 @@ import (
 	"github.com/filtex/filtex-go/constants"
 )
 
-type EqualOperator struct{}
+type EqualOperator struct {
+	Collation constants.Collation
+}
 
-func (EqualOperator) Build(fieldType constants.FieldType, field string, value interface{}) *types.MongoExpression {
+func (o EqualOperator) Build(fieldType constants.FieldType, field string, value interface{}) *types.MongoExpression {
 	if fieldType.IsArray() {
 		return nil
 	}
 
-	if fieldType == constants.FieldTypeString {
+	if hasRange(fieldType, value) {
+		return equalRange(fieldType, field, value)
+	}
+
+	if fieldType == constants.FieldTypeString && !o.Collation.IsCaseSensitive() {
 		return &types.MongoExpression{
 			Condition: bson.M{
-				field: bson.M{
-					"$regex":   fmt.Sprintf("^%s$", value),
-					"$options": "i",
-				},
+				field: regex(o.Collation, fmt.Sprintf("^%s$", pattern(o.Collation, value))),
 			},
 		}
 	}
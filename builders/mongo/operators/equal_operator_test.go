@@ -5,6 +5,7 @@ import (
 	"time"
 
 	"github.com/filtex/filtex-go/constants"
+	"github.com/filtex/filtex-go/utils"
 	"github.com/stretchr/testify/assert"
 	"go.mongodb.org/mongo-driver/bson"
 )
@@ -88,26 +89,20 @@ func TestEqualExpression_ShouldReturnExpression_WhenFieldTypeIsBoolean(t *testin
 
 func TestEqualExpression_ShouldReturnExpression_WhenFieldTypeIsDate(t *testing.T) {
 	// Arrange
-	value := time.Now()
+	location := time.FixedZone("UTC+3", 3*60*60)
+	value := time.Date(2024, 03, 10, 15, 30, 0, 0, location)
 
 	// Act
 	expression := EqualOperator{}.Build(constants.FieldTypeDate, "Value", value)
 
 	// Assert
 	assert.NotNil(t, expression)
-
-	valueInner, ok := expression.Condition["Value"]
-	assert.True(t, ok)
-	assert.NotNil(t, valueInner)
-
-	inner, ok := valueInner.(bson.M)
-	assert.True(t, ok)
-	assert.NotNil(t, inner)
-
-	eq, ok := inner["$eq"]
-	assert.True(t, ok)
-	assert.NotNil(t, eq)
-	assert.Equal(t, value, eq.(time.Time))
+	assert.Equal(t, bson.M{
+		"Value": bson.M{
+			"$gte": time.Date(2024, 03, 10, 0, 0, 0, 0, location),
+			"$lt":  time.Date(2024, 03, 11, 0, 0, 0, 0, location),
+		},
+	}, expression.Condition)
 }
 
 func TestEqualExpression_ShouldReturnExpression_WhenFieldTypeIsTime(t *testing.T) {
@@ -211,3 +206,59 @@ func TestEqualExpression_ShouldReturnNil_WhenFieldTypeIsDateTimeArray(t *testing
 	// Assert
 	assert.Nil(t, expression)
 }
+
+func TestEqualExpression_ShouldEscapeValue_WhenValueContainsRegexCharacters(t *testing.T) {
+	// Arrange
+	value := "C++ (beta)"
+
+	// Act
+	expression := EqualOperator{}.Build(constants.FieldTypeString, "Value", value)
+
+	// Assert
+	assert.NotNil(t, expression)
+	assert.Equal(t, bson.M{"Value": bson.M{"$regex": `^C\+\+ \(beta\)$`, "$options": "i"}}, expression.Condition)
+}
+
+func TestEqualExpression_ShouldReturnExpression_WhenFieldTypeIsStringAndCollationIsCaseSensitive(t *testing.T) {
+	// Arrange
+	value := "Café"
+
+	// Act
+	expression := EqualOperator{Collation: constants.CollationCaseSensitive}.Build(constants.FieldTypeString, "Value", value)
+
+	// Assert
+	assert.NotNil(t, expression)
+	assert.Equal(t, bson.M{"Value": bson.M{"$eq": "Café"}}, expression.Condition)
+}
+
+func TestEqualExpression_ShouldReturnExpression_WhenFieldTypeIsStringAndCollationIsAccentInsensitive(t *testing.T) {
+	// Arrange
+	value := "Café"
+
+	// Act
+	expression := EqualOperator{Collation: constants.CollationAccentInsensitive}.Build(constants.FieldTypeString, "Value", value)
+
+	// Assert
+	assert.NotNil(t, expression)
+	assert.Equal(t, bson.M{"Value": bson.M{"$regex": "^[cçćĉċč][aàáâãäåāăą]f[eèéêëēĕėęě]$", "$options": "i"}}, expression.Condition)
+}
+
+func TestEqualExpression_ShouldReturnRangeExpression_WhenFieldTypeIsDateTimeAndValueIsTimeRange(t *testing.T) {
+	// Arrange
+	value := &utils.TimeRange{
+		Start: time.Date(2024, 01, 05, 0, 0, 0, 0, time.UTC),
+		End:   time.Date(2024, 01, 06, 0, 0, 0, 0, time.UTC),
+	}
+
+	// Act
+	expression := EqualOperator{}.Build(constants.FieldTypeDateTime, "Value", value)
+
+	// Assert
+	assert.NotNil(t, expression)
+	assert.Equal(t, bson.M{
+		"Value": bson.M{
+			"$gte": value.Start,
+			"$lt":  value.End,
+		},
+	}, expression.Condition)
+}
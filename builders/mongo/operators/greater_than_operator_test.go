@@ -5,6 +5,7 @@ import (
 	"time"
 
 	"github.com/filtex/filtex-go/constants"
+	"github.com/filtex/filtex-go/utils"
 	"github.com/stretchr/testify/assert"
 	"go.mongodb.org/mongo-driver/bson"
 )
@@ -33,28 +34,16 @@ func TestGreaterThanExpression_ShouldReturnExpression_WhenFieldTypeIsNumber(t *t
 	assert.Equal(t, value, gt.(float64))
 }
 
-func TestGreaterThanExpression_ShouldReturnExpression_WhenFieldTypeIsDate(t *testing.T) {
+func TestGreaterThanExpression_ShouldReturnDayBoundExpression_WhenFieldTypeIsDate(t *testing.T) {
 	// Arrange
-	value := time.Now()
+	value := time.Date(2024, 3, 10, 15, 30, 0, 0, time.UTC)
 
 	// Act
 	expression := GreaterThanOperator{}.Build(constants.FieldTypeDate, "Value", value)
 
 	// Assert
 	assert.NotNil(t, expression)
-
-	field, ok := expression.Condition["Value"]
-	assert.True(t, ok)
-	assert.NotNil(t, field)
-
-	inner, ok := field.(bson.M)
-	assert.True(t, ok)
-	assert.NotNil(t, inner)
-
-	gt, ok := inner["$gt"]
-	assert.True(t, ok)
-	assert.NotNil(t, gt)
-	assert.Equal(t, value, gt.(time.Time))
+	assert.Equal(t, bson.M{"Value": bson.M{"$gte": time.Date(2024, 3, 11, 0, 0, 0, 0, time.UTC)}}, expression.Condition)
 }
 
 func TestGreaterThanExpression_ShouldReturnExpression_WhenFieldTypeIsTime(t *testing.T) {
@@ -105,13 +94,14 @@ func TestGreaterThanExpression_ShouldReturnExpression_WhenFieldTypeIsDateTime(t
 	assert.Equal(t, value, gt.(time.Time))
 }
 
-func TestGreaterThanExpression_ShouldReturnNil_WhenFieldTypeIsString(t *testing.T) {
+func TestGreaterThanExpression_ShouldReturnExpression_WhenFieldTypeIsString(t *testing.T) {
 	// Arrange
 	// Act
-	expression := GreaterThanOperator{}.Build(constants.FieldTypeString, "Value", nil)
+	expression := GreaterThanOperator{}.Build(constants.FieldTypeString, "Value", "Filtex")
 
 	// Assert
-	assert.Nil(t, expression)
+	assert.NotNil(t, expression)
+	assert.Equal(t, bson.M{"Value": bson.M{"$gt": "Filtex"}}, expression.Condition)
 }
 
 func TestGreaterThanExpression_ShouldReturnNil_WhenFieldTypeIsStringArray(t *testing.T) {
@@ -132,13 +122,14 @@ func TestGreaterThanExpression_ShouldReturnNil_WhenFieldTypeIsNumberArray(t *tes
 	assert.Nil(t, expression)
 }
 
-func TestGreaterThanExpression_ShouldReturnNil_WhenFieldTypeIsBoolean(t *testing.T) {
+func TestGreaterThanExpression_ShouldReturnExpression_WhenFieldTypeIsBoolean(t *testing.T) {
 	// Arrange
 	// Act
-	expression := GreaterThanOperator{}.Build(constants.FieldTypeBoolean, "Value", nil)
+	expression := GreaterThanOperator{}.Build(constants.FieldTypeBoolean, "Value", true)
 
 	// Assert
-	assert.Nil(t, expression)
+	assert.NotNil(t, expression)
+	assert.Equal(t, bson.M{"Value": bson.M{"$gt": true}}, expression.Condition)
 }
 
 func TestGreaterThanExpression_ShouldReturnNil_WhenFieldTypeIsBooleanArray(t *testing.T) {
@@ -176,3 +167,38 @@ func TestGreaterThanExpression_ShouldReturnNil_WhenFieldTypeIsDateTimeArray(t *t
 	// Assert
 	assert.Nil(t, expression)
 }
+
+func TestGreaterThanExpression_ShouldCompareWithRangeEnd_WhenFieldTypeIsDateTimeAndValueIsTimeRange(t *testing.T) {
+	// Arrange
+	value := &utils.TimeRange{
+		Start: time.Date(2024, 01, 05, 0, 0, 0, 0, time.UTC),
+		End:   time.Date(2024, 01, 06, 0, 0, 0, 0, time.UTC),
+	}
+
+	// Act
+	expression := GreaterThanOperator{}.Build(constants.FieldTypeDateTime, "Value", value)
+
+	// Assert
+	assert.NotNil(t, expression)
+	assert.Equal(t, bson.M{
+		"Value": bson.M{
+			"$gte": value.End,
+		},
+	}, expression.Condition)
+}
+
+func TestGreaterThanExpression_ShouldReturnExpression_WhenFieldTypeIsInteger(t *testing.T) {
+	// Arrange
+	value := int64(9007199254740993)
+
+	// Act
+	expression := GreaterThanOperator{}.Build(constants.FieldTypeInteger, "Value", value)
+
+	// Assert
+	assert.NotNil(t, expression)
+	assert.Equal(t, bson.M{
+		"Value": bson.M{
+			"$gt": value,
+		},
+	}, expression.Condition)
+}
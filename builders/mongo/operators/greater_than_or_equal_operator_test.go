@@ -5,6 +5,7 @@ import (
 	"time"
 
 	"github.com/filtex/filtex-go/constants"
+	"github.com/filtex/filtex-go/utils"
 	"github.com/stretchr/testify/assert"
 	"go.mongodb.org/mongo-driver/bson"
 )
@@ -33,28 +34,16 @@ func TestGreaterThanOrEqualExpression_ShouldReturnExpression_WhenFieldTypeIsNumb
 	assert.Equal(t, value, gte.(float64))
 }
 
-func TestGreaterThanOrEqualExpression_ShouldReturnExpression_WhenFieldTypeIsDate(t *testing.T) {
+func TestGreaterThanOrEqualExpression_ShouldReturnDayBoundExpression_WhenFieldTypeIsDate(t *testing.T) {
 	// Arrange
-	value := time.Now()
+	value := time.Date(2024, 3, 10, 15, 30, 0, 0, time.UTC)
 
 	// Act
 	expression := GreaterThanOrEqualOperator{}.Build(constants.FieldTypeDate, "Value", value)
 
 	// Assert
 	assert.NotNil(t, expression)
-
-	field, ok := expression.Condition["Value"]
-	assert.True(t, ok)
-	assert.NotNil(t, field)
-
-	inner, ok := field.(bson.M)
-	assert.True(t, ok)
-	assert.NotNil(t, inner)
-
-	gte, ok := inner["$gte"]
-	assert.True(t, ok)
-	assert.NotNil(t, gte)
-	assert.Equal(t, value, gte.(time.Time))
+	assert.Equal(t, bson.M{"Value": bson.M{"$gte": time.Date(2024, 3, 10, 0, 0, 0, 0, time.UTC)}}, expression.Condition)
 }
 
 func TestGreaterThanOrEqualExpression_ShouldReturnExpression_WhenFieldTypeIsTime(t *testing.T) {
@@ -105,13 +94,14 @@ func TestGreaterThanOrEqualExpression_ShouldReturnExpression_WhenFieldTypeIsDate
 	assert.Equal(t, value, gte.(time.Time))
 }
 
-func TestGreaterThanOrEqualExpression_ShouldReturnNil_WhenFieldTypeIsString(t *testing.T) {
+func TestGreaterThanOrEqualExpression_ShouldReturnExpression_WhenFieldTypeIsString(t *testing.T) {
 	// Arrange
 	// Act
-	expression := GreaterThanOrEqualOperator{}.Build(constants.FieldTypeString, "Value", nil)
+	expression := GreaterThanOrEqualOperator{}.Build(constants.FieldTypeString, "Value", "Filtex")
 
 	// Assert
-	assert.Nil(t, expression)
+	assert.NotNil(t, expression)
+	assert.Equal(t, bson.M{"Value": bson.M{"$gte": "Filtex"}}, expression.Condition)
 }
 
 func TestGreaterThanOrEqualExpression_ShouldReturnNil_WhenFieldTypeIsStringArray(t *testing.T) {
@@ -132,13 +122,14 @@ func TestGreaterThanOrEqualExpression_ShouldReturnNil_WhenFieldTypeIsNumberArray
 	assert.Nil(t, expression)
 }
 
-func TestGreaterThanOrEqualExpression_ShouldReturnNil_WhenFieldTypeIsBoolean(t *testing.T) {
+func TestGreaterThanOrEqualExpression_ShouldReturnExpression_WhenFieldTypeIsBoolean(t *testing.T) {
 	// Arrange
 	// Act
-	expression := GreaterThanOrEqualOperator{}.Build(constants.FieldTypeBoolean, "Value", nil)
+	expression := GreaterThanOrEqualOperator{}.Build(constants.FieldTypeBoolean, "Value", true)
 
 	// Assert
-	assert.Nil(t, expression)
+	assert.NotNil(t, expression)
+	assert.Equal(t, bson.M{"Value": bson.M{"$gte": true}}, expression.Condition)
 }
 
 func TestGreaterThanOrEqualExpression_ShouldReturnNil_WhenFieldTypeIsBooleanArray(t *testing.T) {
@@ -176,3 +167,22 @@ func TestGreaterThanOrEqualExpression_ShouldReturnNil_WhenFieldTypeIsDateTimeArr
 	// Assert
 	assert.Nil(t, expression)
 }
+
+func TestGreaterThanOrEqualExpression_ShouldCompareWithRangeStart_WhenFieldTypeIsDateTimeAndValueIsTimeRange(t *testing.T) {
+	// Arrange
+	value := &utils.TimeRange{
+		Start: time.Date(2024, 01, 05, 0, 0, 0, 0, time.UTC),
+		End:   time.Date(2024, 01, 06, 0, 0, 0, 0, time.UTC),
+	}
+
+	// Act
+	expression := GreaterThanOrEqualOperator{}.Build(constants.FieldTypeDateTime, "Value", value)
+
+	// Assert
+	assert.NotNil(t, expression)
+	assert.Equal(t, bson.M{
+		"Value": bson.M{
+			"$gte": value.Start,
+		},
+	}, expression.Condition)
+}
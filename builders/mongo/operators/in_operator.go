@@ -7,14 +7,35 @@ import (
 	"github.com/filtex/filtex-go/constants"
 )
 
-type InOperator struct{}
+type InOperator struct {
+	Collation constants.Collation
+}
 
-func (InOperator) Build(fieldType constants.FieldType, field string, value interface{}) *types.MongoExpression {
+func (o InOperator) Build(fieldType constants.FieldType, field string, value interface{}) *types.MongoExpression {
 	if fieldType.IsArray() || value == nil {
 		return nil
 	}
 
+	if hasRange(fieldType, value) {
+		return ranges(fieldType, field, value, equalRange, "$or")
+	}
+
 	items, ok := value.([]interface{})
+
+	if fieldType == constants.FieldTypeString && hasCollatedValues(o.Collation) {
+		if !ok {
+			items = []interface{}{value}
+		}
+
+		return &types.MongoExpression{
+			Condition: bson.M{
+				field: bson.M{
+					"$in": regexes(o.Collation, items),
+				},
+			},
+		}
+	}
+
 	if !ok {
 		return &types.MongoExpression{
 			Condition: bson.M{
@@ -5,8 +5,10 @@ import (
 	"time"
 
 	"github.com/filtex/filtex-go/constants"
+	"github.com/filtex/filtex-go/utils"
 	"github.com/stretchr/testify/assert"
 	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
 )
 
 func TestInExpression_ShouldReturnExpression_WhenFieldTypeIsString(t *testing.T) {
@@ -75,9 +77,9 @@ func TestInExpression_ShouldReturnExpression_WhenFieldTypeIsNumber(t *testing.T)
 
 func TestInExpression_ShouldReturnExpression_WhenFieldTypeIsDate(t *testing.T) {
 	// Arrange
-	item := time.Now()
+	location := time.FixedZone("UTC+3", 3*60*60)
 	value := []interface{}{
-		item,
+		time.Date(2024, 03, 10, 15, 30, 0, 0, location),
 	}
 
 	// Act
@@ -85,24 +87,14 @@ func TestInExpression_ShouldReturnExpression_WhenFieldTypeIsDate(t *testing.T) {
 
 	// Assert
 	assert.NotNil(t, expression)
-
-	field, ok := expression.Condition["Value"]
-	assert.True(t, ok)
-	assert.NotNil(t, field)
-
-	inner, ok := field.(bson.M)
-	assert.True(t, ok)
-	assert.NotNil(t, inner)
-
-	in, ok := inner["$in"]
-	assert.True(t, ok)
-	assert.NotNil(t, in)
-
-	items, ok := in.([]interface{})
-	assert.True(t, ok)
-	assert.NotNil(t, items)
-	assert.Len(t, items, 1)
-	assert.Equal(t, item, items[0].(time.Time))
+	assert.Equal(t, bson.M{
+		"$or": []bson.M{
+			{"Value": bson.M{
+				"$gte": time.Date(2024, 03, 10, 0, 0, 0, 0, location),
+				"$lt":  time.Date(2024, 03, 11, 0, 0, 0, 0, location),
+			}},
+		},
+	}, expression.Condition)
 }
 
 func TestInExpression_ShouldReturnExpression_WhenFieldTypeIsTime(t *testing.T) {
@@ -222,3 +214,82 @@ func TestInExpression_ShouldReturnNil_WhenFieldTypeIsDateTimeArray(t *testing.T)
 	// Assert
 	assert.Nil(t, expression)
 }
+
+func TestInExpression_ShouldReturnExpression_WhenFieldTypeIsStringAndCollationIsCaseSensitive(t *testing.T) {
+	// Arrange
+	value := []interface{}{"Café"}
+
+	// Act
+	expression := InOperator{Collation: constants.CollationCaseSensitive}.Build(constants.FieldTypeString, "Value", value)
+
+	// Assert
+	assert.NotNil(t, expression)
+	assert.Equal(t, bson.M{"Value": bson.M{"$in": []interface{}{"Café"}}}, expression.Condition)
+}
+
+func TestInExpression_ShouldReturnExpression_WhenFieldTypeIsStringAndCollationIsAccentInsensitive(t *testing.T) {
+	// Arrange
+	value := []interface{}{"Café"}
+
+	// Act
+	expression := InOperator{Collation: constants.CollationAccentInsensitive}.Build(constants.FieldTypeString, "Value", value)
+
+	// Assert
+	assert.NotNil(t, expression)
+	assert.Equal(t, bson.M{"Value": bson.M{"$in": []interface{}{primitive.Regex{Pattern: "^[cçćĉċč][aàáâãäåāăą]f[eèéêëēĕėęě]$", Options: "i"}}}}, expression.Condition)
+}
+
+func TestInExpression_ShouldReturnExpression_WhenFieldTypeIsStringAndCollationIsCaseInsensitive(t *testing.T) {
+	// Arrange
+	value := []interface{}{"a.b", "C++", "(x"}
+
+	// Act
+	expression := InOperator{Collation: constants.CollationCaseInsensitive}.Build(constants.FieldTypeString, "Value", value)
+
+	// Assert
+	assert.NotNil(t, expression)
+	assert.Equal(t, bson.M{"Value": bson.M{"$in": []interface{}{
+		primitive.Regex{Pattern: `^a\.b$`, Options: "i"},
+		primitive.Regex{Pattern: `^C\+\+$`, Options: "i"},
+		primitive.Regex{Pattern: `^\(x$`, Options: "i"},
+	}}}, expression.Condition)
+}
+
+func TestInExpression_ShouldReturnExactValues_WhenFieldTypeIsStringAndValuesContainRegexCharacters(t *testing.T) {
+	// Arrange
+	value := []interface{}{"a.b", "C++"}
+
+	// Act
+	expression := InOperator{}.Build(constants.FieldTypeString, "Value", value)
+
+	// Assert
+	assert.NotNil(t, expression)
+	assert.Equal(t, bson.M{"Value": bson.M{"$in": []interface{}{"a.b", "C++"}}}, expression.Condition)
+}
+
+func TestInExpression_ShouldReturnRangeExpressions_WhenFieldTypeIsDateTimeAndValuesContainTimeRange(t *testing.T) {
+	// Arrange
+	instant := time.Date(2024, 01, 07, 10, 30, 0, 0, time.UTC)
+	value := []interface{}{
+		&utils.TimeRange{
+			Start: time.Date(2024, 01, 01, 0, 0, 0, 0, time.UTC),
+			End:   time.Date(2024, 02, 01, 0, 0, 0, 0, time.UTC),
+		},
+		instant,
+	}
+
+	// Act
+	expression := InOperator{}.Build(constants.FieldTypeDateTime, "Value", value)
+
+	// Assert
+	assert.NotNil(t, expression)
+	assert.Equal(t, bson.M{
+		"$or": []bson.M{
+			{"Value": bson.M{
+				"$gte": time.Date(2024, 01, 01, 0, 0, 0, 0, time.UTC),
+				"$lt":  time.Date(2024, 02, 01, 0, 0, 0, 0, time.UTC),
+			}},
+			{"Value": bson.M{"$eq": instant}},
+		},
+	}, expression.Condition)
+}
@@ -0,0 +1,29 @@
+package operators
+
+import (
+	"go.mongodb.org/mongo-driver/bson"
+
+	"github.com/filtex/filtex-go/builders/mongo/types"
+	"github.com/filtex/filtex-go/constants"
+)
+
+type InSubnetOperator struct{}
+
+func (o InSubnetOperator) Build(fieldType constants.FieldType, field string, value interface{}) *types.MongoExpression {
+	if fieldType != constants.FieldTypeIP {
+		return nil
+	}
+
+	pattern, ok := subnetPattern(value)
+	if !ok {
+		return nil
+	}
+
+	return &types.MongoExpression{
+		Condition: bson.M{
+			field: bson.M{
+				"$regex": pattern,
+			},
+		},
+	}
+}
@@ -0,0 +1,99 @@
+package operators
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/filtex/filtex-go/constants"
+	"github.com/stretchr/testify/assert"
+	"go.mongodb.org/mongo-driver/bson"
+)
+
+func TestInSubnetExpression_ShouldReturnExpression_WhenPrefixIsOctetAligned(t *testing.T) {
+	// Act
+	expression := InSubnetOperator{}.Build(constants.FieldTypeIP, "Value", "10.1.2.3/16")
+
+	// Assert
+	assert.NotNil(t, expression)
+	assert.Equal(t, bson.M{"Value": bson.M{"$regex": `^10\.1\.\d{1,3}\.\d{1,3}$`}}, expression.Condition)
+}
+
+func TestInSubnetExpression_ShouldMatchAddressesInSubnet_WhenPrefixIsNotOctetAligned(t *testing.T) {
+	// Arrange
+	expression := InSubnetOperator{}.Build(constants.FieldTypeIP, "Value", "192.168.16.0/20")
+	re := regexp.MustCompile(expression.Condition["Value"].(bson.M)["$regex"].(string))
+	sampleMap := map[string]bool{
+		"192.168.16.1":   true,
+		"192.168.31.255": true,
+		"192.168.15.255": false,
+		"192.168.32.0":   false,
+		"192.168.160.1":  false,
+	}
+
+	for input, expected := range sampleMap {
+		// Act
+		result := re.MatchString(input)
+
+		// Assert
+		assert.Equal(t, expected, result, input)
+	}
+}
+
+func TestInSubnetExpression_ShouldReturnDigitRanges_WhenPrefixIsNotOctetAligned(t *testing.T) {
+	// Act
+	expression := InSubnetOperator{}.Build(constants.FieldTypeIP, "Value", "10.0.128.0/17")
+
+	// Assert
+	assert.NotNil(t, expression)
+	assert.Equal(t, bson.M{"Value": bson.M{"$regex": `^10\.0\.(?:12[8-9]|1[3-9]\d|2[0-4]\d|25[0-5])\.\d{1,3}$`}}, expression.Condition)
+}
+
+func TestInSubnetExpression_ShouldMatchOnlyAddressesInSubnet_WhenPrefixIsNotOctetAligned(t *testing.T) {
+	// Arrange
+	samples := map[string][2]int{
+		"10.0.0.0/9":    {0, 127},
+		"10.128.0.0/9":  {128, 255},
+		"10.96.0.0/11":  {96, 127},
+		"10.200.0.0/13": {200, 207},
+		"10.255.0.0/16": {255, 255},
+	}
+
+	for subnet, bounds := range samples {
+		expression := InSubnetOperator{}.Build(constants.FieldTypeIP, "Value", subnet)
+		re := regexp.MustCompile(expression.Condition["Value"].(bson.M)["$regex"].(string))
+
+		for octet := 0; octet <= 255; octet++ {
+			// Act
+			result := re.MatchString(fmt.Sprintf("10.%d.1.1", octet))
+
+			// Assert
+			assert.Equal(t, octet >= bounds[0] && octet <= bounds[1], result, subnet, octet)
+		}
+	}
+}
+
+func TestInSubnetExpression_ShouldReturnExpression_WhenValueIsAddress(t *testing.T) {
+	// Act
+	expression := InSubnetOperator{}.Build(constants.FieldTypeIP, "Value", "10.0.0.1")
+
+	// Assert
+	assert.NotNil(t, expression)
+	assert.Equal(t, bson.M{"Value": bson.M{"$regex": `^10\.0\.0\.1$`}}, expression.Condition)
+}
+
+func TestInSubnetExpression_ShouldReturnNil_WhenSubnetIsIPv6(t *testing.T) {
+	// Act
+	expression := InSubnetOperator{}.Build(constants.FieldTypeIP, "Value", "2001:db8::/32")
+
+	// Assert
+	assert.Nil(t, expression)
+}
+
+func TestInSubnetExpression_ShouldReturnNil_WhenFieldTypeIsNotIP(t *testing.T) {
+	// Act
+	expression := InSubnetOperator{}.Build(constants.FieldTypeString, "Value", "10.0.0.0/8")
+
+	// Assert
+	assert.Nil(t, expression)
+}
@@ -0,0 +1,20 @@
+package operators
+
+import (
+	"go.mongodb.org/mongo-driver/bson"
+
+	"github.com/filtex/filtex-go/builders/mongo/types"
+	"github.com/filtex/filtex-go/constants"
+)
+
+type IsNotNullOperator struct{}
+
+func (IsNotNullOperator) Build(fieldType constants.FieldType, field string, value interface{}) *types.MongoExpression {
+	return &types.MongoExpression{
+		Condition: bson.M{
+			field: bson.M{
+				"$ne": nil,
+			},
+		},
+	}
+}
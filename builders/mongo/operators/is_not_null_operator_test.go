@@ -0,0 +1,51 @@
+package operators
+
+import (
+	"testing"
+
+	"github.com/filtex/filtex-go/constants"
+	"github.com/stretchr/testify/assert"
+	"go.mongodb.org/mongo-driver/bson"
+)
+
+func TestIsNotNullExpression_ShouldReturnExpression_WhenFieldTypeIsString(t *testing.T) {
+	// Arrange
+	// Act
+	expression := IsNotNullOperator{}.Build(constants.FieldTypeString, "Value", nil)
+
+	// Assert
+	assert.NotNil(t, expression)
+
+	value, ok := expression.Condition["Value"]
+	assert.True(t, ok)
+	assert.NotNil(t, value)
+
+	inner, ok := value.(bson.M)
+	assert.True(t, ok)
+	assert.NotNil(t, inner)
+
+	ne, ok := inner["$ne"]
+	assert.True(t, ok)
+	assert.Nil(t, ne)
+}
+
+func TestIsNotNullExpression_ShouldReturnExpression_WhenFieldTypeIsStringArray(t *testing.T) {
+	// Arrange
+	// Act
+	expression := IsNotNullOperator{}.Build(constants.FieldTypeStringArray, "Value", nil)
+
+	// Assert
+	assert.NotNil(t, expression)
+
+	value, ok := expression.Condition["Value"]
+	assert.True(t, ok)
+	assert.NotNil(t, value)
+
+	inner, ok := value.(bson.M)
+	assert.True(t, ok)
+	assert.NotNil(t, inner)
+
+	ne, ok := inner["$ne"]
+	assert.True(t, ok)
+	assert.Nil(t, ne)
+}
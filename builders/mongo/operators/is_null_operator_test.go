@@ -0,0 +1,51 @@
+package operators
+
+import (
+	"testing"
+
+	"github.com/filtex/filtex-go/constants"
+	"github.com/stretchr/testify/assert"
+	"go.mongodb.org/mongo-driver/bson"
+)
+
+func TestIsNullExpression_ShouldReturnExpression_WhenFieldTypeIsString(t *testing.T) {
+	// Arrange
+	// Act
+	expression := IsNullOperator{}.Build(constants.FieldTypeString, "Value", nil)
+
+	// Assert
+	assert.NotNil(t, expression)
+
+	value, ok := expression.Condition["Value"]
+	assert.True(t, ok)
+	assert.NotNil(t, value)
+
+	inner, ok := value.(bson.M)
+	assert.True(t, ok)
+	assert.NotNil(t, inner)
+
+	eq, ok := inner["$eq"]
+	assert.True(t, ok)
+	assert.Nil(t, eq)
+}
+
+func TestIsNullExpression_ShouldReturnExpression_WhenFieldTypeIsStringArray(t *testing.T) {
+	// Arrange
+	// Act
+	expression := IsNullOperator{}.Build(constants.FieldTypeStringArray, "Value", nil)
+
+	// Assert
+	assert.NotNil(t, expression)
+
+	value, ok := expression.Condition["Value"]
+	assert.True(t, ok)
+	assert.NotNil(t, value)
+
+	inner, ok := value.(bson.M)
+	assert.True(t, ok)
+	assert.NotNil(t, inner)
+
+	eq, ok := inner["$eq"]
+	assert.True(t, ok)
+	assert.Nil(t, eq)
+}
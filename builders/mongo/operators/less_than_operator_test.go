@@ -5,6 +5,7 @@ import (
 	"time"
 
 	"github.com/filtex/filtex-go/constants"
+	"github.com/filtex/filtex-go/utils"
 	"github.com/stretchr/testify/assert"
 	"go.mongodb.org/mongo-driver/bson"
 )
@@ -33,28 +34,16 @@ func TestLessThanExpression_ShouldReturnExpression_WhenFieldTypeIsNumber(t *test
 	assert.Equal(t, value, lt.(float64))
 }
 
-func TestLessThanExpression_ShouldReturnExpression_WhenFieldTypeIsDate(t *testing.T) {
+func TestLessThanExpression_ShouldReturnDayBoundExpression_WhenFieldTypeIsDate(t *testing.T) {
 	// Arrange
-	value := time.Now()
+	value := time.Date(2024, 3, 10, 15, 30, 0, 0, time.UTC)
 
 	// Act
 	expression := LessThanOperator{}.Build(constants.FieldTypeDate, "Value", value)
 
 	// Assert
 	assert.NotNil(t, expression)
-
-	field, ok := expression.Condition["Value"]
-	assert.True(t, ok)
-	assert.NotNil(t, field)
-
-	inner, ok := field.(bson.M)
-	assert.True(t, ok)
-	assert.NotNil(t, inner)
-
-	lt, ok := inner["$lt"]
-	assert.True(t, ok)
-	assert.NotNil(t, lt)
-	assert.Equal(t, value, lt.(time.Time))
+	assert.Equal(t, bson.M{"Value": bson.M{"$lt": time.Date(2024, 3, 10, 0, 0, 0, 0, time.UTC)}}, expression.Condition)
 }
 
 func TestLessThanExpression_ShouldReturnExpression_WhenFieldTypeIsTime(t *testing.T) {
@@ -105,13 +94,14 @@ func TestLessThanExpression_ShouldReturnExpression_WhenFieldTypeIsDateTime(t *te
 	assert.Equal(t, value, lt.(time.Time))
 }
 
-func TestLessThanExpression_ShouldReturnNil_WhenFieldTypeIsString(t *testing.T) {
+func TestLessThanExpression_ShouldReturnExpression_WhenFieldTypeIsString(t *testing.T) {
 	// Arrange
 	// Act
-	expression := LessThanOperator{}.Build(constants.FieldTypeString, "Value", nil)
+	expression := LessThanOperator{}.Build(constants.FieldTypeString, "Value", "Filtex")
 
 	// Assert
-	assert.Nil(t, expression)
+	assert.NotNil(t, expression)
+	assert.Equal(t, bson.M{"Value": bson.M{"$lt": "Filtex"}}, expression.Condition)
 }
 
 func TestLessThanExpression_ShouldReturnNil_WhenFieldTypeIsStringArray(t *testing.T) {
@@ -132,13 +122,14 @@ func TestLessThanExpression_ShouldReturnNil_WhenFieldTypeIsNumberArray(t *testin
 	assert.Nil(t, expression)
 }
 
-func TestLessThanExpression_ShouldReturnNil_WhenFieldTypeIsBoolean(t *testing.T) {
+func TestLessThanExpression_ShouldReturnExpression_WhenFieldTypeIsBoolean(t *testing.T) {
 	// Arrange
 	// Act
-	expression := LessThanOperator{}.Build(constants.FieldTypeBoolean, "Value", nil)
+	expression := LessThanOperator{}.Build(constants.FieldTypeBoolean, "Value", true)
 
 	// Assert
-	assert.Nil(t, expression)
+	assert.NotNil(t, expression)
+	assert.Equal(t, bson.M{"Value": bson.M{"$lt": true}}, expression.Condition)
 }
 
 func TestLessThanExpression_ShouldReturnNil_WhenFieldTypeIsBooleanArray(t *testing.T) {
@@ -176,3 +167,22 @@ func TestLessThanExpression_ShouldReturnNil_WhenFieldTypeIsDateTimeArray(t *test
 	// Assert
 	assert.Nil(t, expression)
 }
+
+func TestLessThanExpression_ShouldCompareWithRangeStart_WhenFieldTypeIsDateTimeAndValueIsTimeRange(t *testing.T) {
+	// Arrange
+	value := &utils.TimeRange{
+		Start: time.Date(2024, 01, 05, 0, 0, 0, 0, time.UTC),
+		End:   time.Date(2024, 01, 06, 0, 0, 0, 0, time.UTC),
+	}
+
+	// Act
+	expression := LessThanOperator{}.Build(constants.FieldTypeDateTime, "Value", value)
+
+	// Assert
+	assert.NotNil(t, expression)
+	assert.Equal(t, bson.M{
+		"Value": bson.M{
+			"$lt": value.Start,
+		},
+	}, expression.Condition)
+}
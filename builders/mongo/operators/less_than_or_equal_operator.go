@@ -11,12 +11,28 @@ type LessThanOrEqualOperator struct{}
 
 func (LessThanOrEqualOperator) Build(fieldType constants.FieldType, field string, value interface{}) *types.MongoExpression {
 	if fieldType != constants.FieldTypeNumber &&
+		fieldType != constants.FieldTypeInteger &&
+		fieldType != constants.FieldTypeDecimal &&
 		fieldType != constants.FieldTypeDate &&
 		fieldType != constants.FieldTypeTime &&
-		fieldType != constants.FieldTypeDateTime {
+		fieldType != constants.FieldTypeDuration &&
+		fieldType != constants.FieldTypeDateTime &&
+		fieldType != constants.FieldTypeString &&
+		fieldType != constants.FieldTypeUUID &&
+		fieldType != constants.FieldTypeBoolean {
 		return nil
 	}
 
+	if r, ok := valueRange(fieldType, value); ok {
+		return &types.MongoExpression{
+			Condition: bson.M{
+				field: bson.M{
+					"$lt": r.End,
+				},
+			},
+		}
+	}
+
 	return &types.MongoExpression{
 		Condition: bson.M{
 			field: bson.M{
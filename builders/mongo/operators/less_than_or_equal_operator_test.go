@@ -5,6 +5,7 @@ import (
 	"time"
 
 	"github.com/filtex/filtex-go/constants"
+	"github.com/filtex/filtex-go/utils"
 	"github.com/stretchr/testify/assert"
 	"go.mongodb.org/mongo-driver/bson"
 )
@@ -33,28 +34,16 @@ func TestLessThanOrEqualExpression_ShouldReturnExpression_WhenFieldTypeIsNumber(
 	assert.Equal(t, value, lte.(float64))
 }
 
-func TestLessThanOrEqualExpression_ShouldReturnExpression_WhenFieldTypeIsDate(t *testing.T) {
+func TestLessThanOrEqualExpression_ShouldReturnDayBoundExpression_WhenFieldTypeIsDate(t *testing.T) {
 	// Arrange
-	value := time.Now()
+	value := time.Date(2024, 3, 10, 15, 30, 0, 0, time.UTC)
 
 	// Act
 	expression := LessThanOrEqualOperator{}.Build(constants.FieldTypeDate, "Value", value)
 
 	// Assert
 	assert.NotNil(t, expression)
-
-	field, ok := expression.Condition["Value"]
-	assert.True(t, ok)
-	assert.NotNil(t, field)
-
-	inner, ok := field.(bson.M)
-	assert.True(t, ok)
-	assert.NotNil(t, inner)
-
-	lte, ok := inner["$lte"]
-	assert.True(t, ok)
-	assert.NotNil(t, lte)
-	assert.Equal(t, value, lte.(time.Time))
+	assert.Equal(t, bson.M{"Value": bson.M{"$lt": time.Date(2024, 3, 11, 0, 0, 0, 0, time.UTC)}}, expression.Condition)
 }
 
 func TestLessThanOrEqualExpression_ShouldReturnExpression_WhenFieldTypeIsTime(t *testing.T) {
@@ -105,13 +94,14 @@ func TestLessThanOrEqualExpression_ShouldReturnExpression_WhenFieldTypeIsDateTim
 	assert.Equal(t, value, lte.(time.Time))
 }
 
-func TestLessThanOrEqualExpression_ShouldReturnNil_WhenFieldTypeIsString(t *testing.T) {
+func TestLessThanOrEqualExpression_ShouldReturnExpression_WhenFieldTypeIsString(t *testing.T) {
 	// Arrange
 	// Act
-	expression := LessThanOrEqualOperator{}.Build(constants.FieldTypeString, "Value", nil)
+	expression := LessThanOrEqualOperator{}.Build(constants.FieldTypeString, "Value", "Filtex")
 
 	// Assert
-	assert.Nil(t, expression)
+	assert.NotNil(t, expression)
+	assert.Equal(t, bson.M{"Value": bson.M{"$lte": "Filtex"}}, expression.Condition)
 }
 
 func TestLessThanOrEqualExpression_ShouldReturnNil_WhenFieldTypeIsStringArray(t *testing.T) {
@@ -132,13 +122,14 @@ func TestLessThanOrEqualExpression_ShouldReturnNil_WhenFieldTypeIsNumberArray(t
 	assert.Nil(t, expression)
 }
 
-func TestLessThanOrEqualExpression_ShouldReturnNil_WhenFieldTypeIsBoolean(t *testing.T) {
+func TestLessThanOrEqualExpression_ShouldReturnExpression_WhenFieldTypeIsBoolean(t *testing.T) {
 	// Arrange
 	// Act
-	expression := LessThanOrEqualOperator{}.Build(constants.FieldTypeBoolean, "Value", nil)
+	expression := LessThanOrEqualOperator{}.Build(constants.FieldTypeBoolean, "Value", true)
 
 	// Assert
-	assert.Nil(t, expression)
+	assert.NotNil(t, expression)
+	assert.Equal(t, bson.M{"Value": bson.M{"$lte": true}}, expression.Condition)
 }
 
 func TestLessThanOrEqualExpression_ShouldReturnNil_WhenFieldTypeIsBooleanArray(t *testing.T) {
@@ -176,3 +167,22 @@ func TestLessThanOrEqualExpression_ShouldReturnNil_WhenFieldTypeIsDateTimeArray(
 	// Assert
 	assert.Nil(t, expression)
 }
+
+func TestLessThanOrEqualExpression_ShouldCompareWithRangeEnd_WhenFieldTypeIsDateTimeAndValueIsTimeRange(t *testing.T) {
+	// Arrange
+	value := &utils.TimeRange{
+		Start: time.Date(2024, 01, 05, 0, 0, 0, 0, time.UTC),
+		End:   time.Date(2024, 01, 06, 0, 0, 0, 0, time.UTC),
+	}
+
+	// Act
+	expression := LessThanOrEqualOperator{}.Build(constants.FieldTypeDateTime, "Value", value)
+
+	// Assert
+	assert.NotNil(t, expression)
+	assert.Equal(t, bson.M{
+		"Value": bson.M{
+			"$lt": value.End,
+		},
+	}, expression.Condition)
+}
@@ -27,12 +27,17 @@ func (NotBlankOperator) Build(fieldType constants.FieldType, field string, value
 		return &types.MongoExpression{
 			Condition: bson.M{
 				field: bson.M{
-					"$exists": true,
-					"$ne":     "",
+					"$nin": bson.A{nil, ""},
 				},
 			},
 		}
 	}
 
-	return nil
+	return &types.MongoExpression{
+		Condition: bson.M{
+			field: bson.M{
+				"$ne": nil,
+			},
+		},
+	}
 }
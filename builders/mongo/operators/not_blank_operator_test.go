@@ -24,15 +24,9 @@ func TestNotBlankExpression_ShouldReturnExpression_WhenFieldTypeIsString(t *test
 	assert.True(t, ok)
 	assert.NotNil(t, inner)
 
-	exists, ok := inner["$exists"]
+	nin, ok := inner["$nin"]
 	assert.True(t, ok)
-	assert.NotNil(t, exists)
-	assert.True(t, exists.(bool))
-
-	ne, ok := inner["$ne"]
-	assert.True(t, ok)
-	assert.NotNil(t, ne)
-	assert.Equal(t, "", ne.(string))
+	assert.Equal(t, bson.A{nil, ""}, nin)
 }
 
 func TestNotBlankExpression_ShouldReturnExpression_WhenFieldTypeIsStringArray(t *testing.T) {
@@ -167,47 +161,107 @@ func TestNotBlankExpression_ShouldReturnExpression_WhenFieldTypeIsDateTimeArray(
 	assert.True(t, exists.(bool))
 }
 
-func TestNotBlankExpression_ShouldReturnNil_WhenFieldTypeIsNumber(t *testing.T) {
+func TestNotBlankExpression_ShouldReturnExpression_WhenFieldTypeIsNumber(t *testing.T) {
 	// Arrange
 	// Act
 	expression := NotBlankOperator{}.Build(constants.FieldTypeNumber, "Value", nil)
 
 	// Assert
-	assert.Nil(t, expression)
+	assert.NotNil(t, expression)
+
+	value, ok := expression.Condition["Value"]
+	assert.True(t, ok)
+	assert.NotNil(t, value)
+
+	inner, ok := value.(bson.M)
+	assert.True(t, ok)
+	assert.NotNil(t, inner)
+
+	ne, ok := inner["$ne"]
+	assert.True(t, ok)
+	assert.Nil(t, ne)
 }
 
-func TestNotBlankExpression_ShouldReturnNil_WhenFieldTypeIsBoolean(t *testing.T) {
+func TestNotBlankExpression_ShouldReturnExpression_WhenFieldTypeIsBoolean(t *testing.T) {
 	// Arrange
 	// Act
 	expression := NotBlankOperator{}.Build(constants.FieldTypeBoolean, "Value", nil)
 
 	// Assert
-	assert.Nil(t, expression)
+	assert.NotNil(t, expression)
+
+	value, ok := expression.Condition["Value"]
+	assert.True(t, ok)
+	assert.NotNil(t, value)
+
+	inner, ok := value.(bson.M)
+	assert.True(t, ok)
+	assert.NotNil(t, inner)
+
+	ne, ok := inner["$ne"]
+	assert.True(t, ok)
+	assert.Nil(t, ne)
 }
 
-func TestNotBlankExpression_ShouldReturnNil_WhenFieldTypeIsDate(t *testing.T) {
+func TestNotBlankExpression_ShouldReturnExpression_WhenFieldTypeIsDate(t *testing.T) {
 	// Arrange
 	// Act
 	expression := NotBlankOperator{}.Build(constants.FieldTypeDate, "Value", nil)
 
 	// Assert
-	assert.Nil(t, expression)
+	assert.NotNil(t, expression)
+
+	value, ok := expression.Condition["Value"]
+	assert.True(t, ok)
+	assert.NotNil(t, value)
+
+	inner, ok := value.(bson.M)
+	assert.True(t, ok)
+	assert.NotNil(t, inner)
+
+	ne, ok := inner["$ne"]
+	assert.True(t, ok)
+	assert.Nil(t, ne)
 }
 
-func TestNotBlankExpression_ShouldReturnNil_WhenFieldTypeIsTime(t *testing.T) {
+func TestNotBlankExpression_ShouldReturnExpression_WhenFieldTypeIsTime(t *testing.T) {
 	// Arrange
 	// Act
 	expression := NotBlankOperator{}.Build(constants.FieldTypeTime, "Value", nil)
 
 	// Assert
-	assert.Nil(t, expression)
+	assert.NotNil(t, expression)
+
+	value, ok := expression.Condition["Value"]
+	assert.True(t, ok)
+	assert.NotNil(t, value)
+
+	inner, ok := value.(bson.M)
+	assert.True(t, ok)
+	assert.NotNil(t, inner)
+
+	ne, ok := inner["$ne"]
+	assert.True(t, ok)
+	assert.Nil(t, ne)
 }
 
-func TestNotBlankExpression_ShouldReturnNil_WhenFieldTypeIsDateTime(t *testing.T) {
+func TestNotBlankExpression_ShouldReturnExpression_WhenFieldTypeIsDateTime(t *testing.T) {
 	// Arrange
 	// Act
 	expression := NotBlankOperator{}.Build(constants.FieldTypeDateTime, "Value", nil)
 
 	// Assert
-	assert.Nil(t, expression)
+	assert.NotNil(t, expression)
+
+	value, ok := expression.Condition["Value"]
+	assert.True(t, ok)
+	assert.NotNil(t, value)
+
+	inner, ok := value.(bson.M)
+	assert.True(t, ok)
+	assert.NotNil(t, inner)
+
+	ne, ok := inner["$ne"]
+	assert.True(t, ok)
+	assert.Nil(t, ne)
 }
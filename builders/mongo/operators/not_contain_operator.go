@@ -1,17 +1,27 @@
 package operators
 
 import (
-	"fmt"
-
 	"go.mongodb.org/mongo-driver/bson"
 
 	"github.com/filtex/filtex-go/builders/mongo/types"
 	"github.com/filtex/filtex-go/constants"
 )
 
-type NotContainOperator struct{}
+type NotContainOperator struct {
+	Collation constants.Collation
+}
+
+func (o NotContainOperator) Build(fieldType constants.FieldType, field string, value interface{}) *types.MongoExpression {
+	if fieldType == constants.FieldTypeStringArray && hasCollatedValues(o.Collation) {
+		return &types.MongoExpression{
+			Condition: bson.M{
+				field: bson.M{
+					"$nin": regexes(o.Collation, []interface{}{value}),
+				},
+			},
+		}
+	}
 
-func (NotContainOperator) Build(fieldType constants.FieldType, field string, value interface{}) *types.MongoExpression {
 	if fieldType.IsArray() {
 		return &types.MongoExpression{
 			Condition: bson.M{
@@ -27,8 +37,7 @@ func (NotContainOperator) Build(fieldType constants.FieldType, field string, val
 		return &types.MongoExpression{
 			Condition: bson.M{
 				field: bson.M{
-					"$regex":   fmt.Sprintf("^((?!%s).)*$", value),
-					"$options": "i",
+					"$not": regex(o.Collation, pattern(o.Collation, value)),
 				},
 			},
 		}
@@ -7,6 +7,7 @@ import (
 	"github.com/filtex/filtex-go/constants"
 	"github.com/stretchr/testify/assert"
 	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
 )
 
 func TestNotContainExpression_ShouldReturnExpression_WhenFieldTypeIsString(t *testing.T) {
@@ -27,15 +28,7 @@ func TestNotContainExpression_ShouldReturnExpression_WhenFieldTypeIsString(t *te
 	assert.True(t, ok)
 	assert.NotNil(t, inner)
 
-	regex, ok := inner["$regex"]
-	assert.True(t, ok)
-	assert.NotNil(t, regex)
-	assert.Equal(t, "^((?!"+value+").)*$", regex.(string))
-
-	options, ok := inner["$options"]
-	assert.True(t, ok)
-	assert.NotNil(t, options)
-	assert.Equal(t, "i", options.(string))
+	assert.Equal(t, bson.M{"$regex": value, "$options": "i"}, inner["$not"])
 }
 
 func TestNotContainExpression_ShouldReturnExpression_WhenFieldTypeIsStringArray(t *testing.T) {
@@ -250,3 +243,27 @@ func TestNotContainExpression_ShouldReturnNil_WhenFieldTypeIsDateTime(t *testing
 	// Assert
 	assert.Nil(t, expression)
 }
+
+func TestNotContainExpression_ShouldReturnExpression_WhenFieldTypeIsStringArrayAndCollationIsCaseSensitive(t *testing.T) {
+	// Arrange
+	value := "Café"
+
+	// Act
+	expression := NotContainOperator{Collation: constants.CollationCaseSensitive}.Build(constants.FieldTypeStringArray, "Value", value)
+
+	// Assert
+	assert.NotNil(t, expression)
+	assert.Equal(t, bson.M{"Value": bson.M{"$nin": []interface{}{"Café"}}}, expression.Condition)
+}
+
+func TestNotContainExpression_ShouldReturnExpression_WhenFieldTypeIsStringArrayAndCollationIsAccentInsensitive(t *testing.T) {
+	// Arrange
+	value := "Café"
+
+	// Act
+	expression := NotContainOperator{Collation: constants.CollationAccentInsensitive}.Build(constants.FieldTypeStringArray, "Value", value)
+
+	// Assert
+	assert.NotNil(t, expression)
+	assert.Equal(t, bson.M{"Value": bson.M{"$nin": []interface{}{primitive.Regex{Pattern: "^[cçćĉċč][aàáâãäåāăą]f[eèéêëēĕėęě]$", Options: "i"}}}}, expression.Condition)
+}
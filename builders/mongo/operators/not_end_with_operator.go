@@ -9,9 +9,11 @@ import (
 	"github.com/filtex/filtex-go/constants"
 )
 
-type NotEndWithOperator struct{}
+type NotEndWithOperator struct {
+	Collation constants.Collation
+}
 
-func (NotEndWithOperator) Build(fieldType constants.FieldType, field string, value interface{}) *types.MongoExpression {
+func (o NotEndWithOperator) Build(fieldType constants.FieldType, field string, value interface{}) *types.MongoExpression {
 	if fieldType != constants.FieldTypeString {
 		return nil
 	}
@@ -19,10 +21,7 @@ func (NotEndWithOperator) Build(fieldType constants.FieldType, field string, val
 	return &types.MongoExpression{
 		Condition: bson.M{
 			field: bson.M{
-				"$not": bson.M{
-					"$regex":   fmt.Sprintf("%s$", value),
-					"$options": "i",
-				},
+				"$not": regex(o.Collation, fmt.Sprintf("%s$", pattern(o.Collation, value))),
 			},
 		},
 	}
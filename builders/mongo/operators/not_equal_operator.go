@@ -8,21 +8,24 @@ import (
 	"github.com/filtex/filtex-go/constants"
 )
 
-type NotEqualOperator struct{}
+type NotEqualOperator struct {
+	Collation constants.Collation
+}
 
-func (NotEqualOperator) Build(fieldType constants.FieldType, field string, value interface{}) *types.MongoExpression {
+func (o NotEqualOperator) Build(fieldType constants.FieldType, field string, value interface{}) *types.MongoExpression {
 	if fieldType.IsArray() {
 		return nil
 	}
 
-	if fieldType == constants.FieldTypeString {
+	if hasRange(fieldType, value) {
+		return notEqualRange(fieldType, field, value)
+	}
+
+	if fieldType == constants.FieldTypeString && !o.Collation.IsCaseSensitive() {
 		return &types.MongoExpression{
 			Condition: bson.M{
 				field: bson.M{
-					"$not": bson.M{
-						"$regex":   fmt.Sprintf("^%s$", value),
-						"$options": "i",
-					},
+					"$not": regex(o.Collation, fmt.Sprintf("^%s$", pattern(o.Collation, value))),
 				},
 			},
 		}
@@ -5,6 +5,7 @@ import (
 	"time"
 
 	"github.com/filtex/filtex-go/constants"
+	"github.com/filtex/filtex-go/utils"
 	"github.com/stretchr/testify/assert"
 	"go.mongodb.org/mongo-driver/bson"
 )
@@ -96,26 +97,21 @@ func TestNotEqualExpression_ShouldReturnExpression_WhenFieldTypeIsBoolean(t *tes
 
 func TestNotEqualExpression_ShouldReturnExpression_WhenFieldTypeIsDate(t *testing.T) {
 	// Arrange
-	value := time.Now()
+	location := time.FixedZone("UTC+3", 3*60*60)
+	value := time.Date(2024, 03, 10, 15, 30, 0, 0, location)
 
 	// Act
 	expression := NotEqualOperator{}.Build(constants.FieldTypeDate, "Value", value)
 
 	// Assert
 	assert.NotNil(t, expression)
-
-	valueInner, ok := expression.Condition["Value"]
-	assert.True(t, ok)
-	assert.NotNil(t, valueInner)
-
-	inner, ok := valueInner.(bson.M)
-	assert.True(t, ok)
-	assert.NotNil(t, inner)
-
-	ne, ok := inner["$ne"]
-	assert.True(t, ok)
-	assert.NotNil(t, ne)
-	assert.Equal(t, value, ne.(time.Time))
+	assert.Equal(t, bson.M{
+		"$or": []bson.M{
+			{"Value": bson.M{"$lt": time.Date(2024, 03, 10, 0, 0, 0, 0, location)}},
+			{"Value": bson.M{"$gte": time.Date(2024, 03, 11, 0, 0, 0, 0, location)}},
+			{"Value": nil},
+		},
+	}, expression.Condition)
 }
 
 func TestNotEqualExpression_ShouldReturnExpression_WhenFieldTypeIsTime(t *testing.T) {
@@ -219,3 +215,48 @@ func TestNotEqualExpression_ShouldReturnNil_WhenFieldTypeIsDateTimeArray(t *test
 	// Assert
 	assert.Nil(t, expression)
 }
+
+func TestNotEqualExpression_ShouldReturnExpression_WhenFieldTypeIsStringAndCollationIsCaseSensitive(t *testing.T) {
+	// Arrange
+	value := "Café"
+
+	// Act
+	expression := NotEqualOperator{Collation: constants.CollationCaseSensitive}.Build(constants.FieldTypeString, "Value", value)
+
+	// Assert
+	assert.NotNil(t, expression)
+	assert.Equal(t, bson.M{"Value": bson.M{"$ne": "Café"}}, expression.Condition)
+}
+
+func TestNotEqualExpression_ShouldReturnExpression_WhenFieldTypeIsStringAndCollationIsAccentInsensitive(t *testing.T) {
+	// Arrange
+	value := "Café"
+
+	// Act
+	expression := NotEqualOperator{Collation: constants.CollationAccentInsensitive}.Build(constants.FieldTypeString, "Value", value)
+
+	// Assert
+	assert.NotNil(t, expression)
+	assert.Equal(t, bson.M{"Value": bson.M{"$not": bson.M{"$regex": "^[cçćĉċč][aàáâãäåāăą]f[eèéêëēĕėęě]$", "$options": "i"}}}, expression.Condition)
+}
+
+func TestNotEqualExpression_ShouldReturnRangeExpression_WhenFieldTypeIsDateTimeAndValueIsTimeRange(t *testing.T) {
+	// Arrange
+	value := &utils.TimeRange{
+		Start: time.Date(2024, 01, 05, 10, 0, 0, 0, time.UTC),
+		End:   time.Date(2024, 01, 05, 11, 0, 0, 0, time.UTC),
+	}
+
+	// Act
+	expression := NotEqualOperator{}.Build(constants.FieldTypeDateTime, "Value", value)
+
+	// Assert
+	assert.NotNil(t, expression)
+	assert.Equal(t, bson.M{
+		"$or": []bson.M{
+			{"Value": bson.M{"$lt": value.Start}},
+			{"Value": bson.M{"$gte": value.End}},
+			{"Value": nil},
+		},
+	}, expression.Condition)
+}
@@ -7,14 +7,35 @@ import (
 	"github.com/filtex/filtex-go/constants"
 )
 
-type NotInOperator struct{}
+type NotInOperator struct {
+	Collation constants.Collation
+}
 
-func (NotInOperator) Build(fieldType constants.FieldType, field string, value interface{}) *types.MongoExpression {
+func (o NotInOperator) Build(fieldType constants.FieldType, field string, value interface{}) *types.MongoExpression {
 	if fieldType.IsArray() || value == nil {
 		return nil
 	}
 
+	if hasRange(fieldType, value) {
+		return ranges(fieldType, field, value, notEqualRange, "$and")
+	}
+
 	items, ok := value.([]interface{})
+
+	if fieldType == constants.FieldTypeString && hasCollatedValues(o.Collation) {
+		if !ok {
+			items = []interface{}{value}
+		}
+
+		return &types.MongoExpression{
+			Condition: bson.M{
+				field: bson.M{
+					"$nin": regexes(o.Collation, items),
+				},
+			},
+		}
+	}
+
 	if !ok {
 		return &types.MongoExpression{
 			Condition: bson.M{
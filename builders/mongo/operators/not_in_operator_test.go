@@ -5,8 +5,10 @@ import (
 	"time"
 
 	"github.com/filtex/filtex-go/constants"
+	"github.com/filtex/filtex-go/utils"
 	"github.com/stretchr/testify/assert"
 	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
 )
 
 func TestNotInExpression_ShouldReturnExpression_WhenFieldTypeIsString(t *testing.T) {
@@ -41,6 +43,21 @@ func TestNotInExpression_ShouldReturnExpression_WhenFieldTypeIsString(t *testing
 	assert.Equal(t, item, items[0].(string))
 }
 
+func TestNotInExpression_ShouldReturnExpression_WhenFieldTypeIsStringAndCollationIsCaseInsensitive(t *testing.T) {
+	// Arrange
+	value := []interface{}{"a.b", "(x"}
+
+	// Act
+	expression := NotInOperator{Collation: constants.CollationCaseInsensitive}.Build(constants.FieldTypeString, "Value", value)
+
+	// Assert
+	assert.NotNil(t, expression)
+	assert.Equal(t, bson.M{"Value": bson.M{"$nin": []interface{}{
+		primitive.Regex{Pattern: `^a\.b$`, Options: "i"},
+		primitive.Regex{Pattern: `^\(x$`, Options: "i"},
+	}}}, expression.Condition)
+}
+
 func TestNotInExpression_ShouldReturnExpression_WhenFieldTypeIsNumber(t *testing.T) {
 	// Arrange
 	item := float64(100)
@@ -75,9 +92,9 @@ func TestNotInExpression_ShouldReturnExpression_WhenFieldTypeIsNumber(t *testing
 
 func TestNotInExpression_ShouldReturnExpression_WhenFieldTypeIsDate(t *testing.T) {
 	// Arrange
-	item := time.Now()
+	location := time.FixedZone("UTC+3", 3*60*60)
 	value := []interface{}{
-		item,
+		time.Date(2024, 03, 10, 15, 30, 0, 0, location),
 	}
 
 	// Act
@@ -85,24 +102,15 @@ func TestNotInExpression_ShouldReturnExpression_WhenFieldTypeIsDate(t *testing.T
 
 	// Assert
 	assert.NotNil(t, expression)
-
-	field, ok := expression.Condition["Value"]
-	assert.True(t, ok)
-	assert.NotNil(t, field)
-
-	inner, ok := field.(bson.M)
-	assert.True(t, ok)
-	assert.NotNil(t, inner)
-
-	nin, ok := inner["$nin"]
-	assert.True(t, ok)
-	assert.NotNil(t, nin)
-
-	items, ok := nin.([]interface{})
-	assert.True(t, ok)
-	assert.NotNil(t, items)
-	assert.Len(t, items, 1)
-	assert.Equal(t, item, items[0].(time.Time))
+	assert.Equal(t, bson.M{
+		"$and": []bson.M{
+			{"$or": []bson.M{
+				{"Value": bson.M{"$lt": time.Date(2024, 03, 10, 0, 0, 0, 0, location)}},
+				{"Value": bson.M{"$gte": time.Date(2024, 03, 11, 0, 0, 0, 0, location)}},
+				{"Value": nil},
+			}},
+		},
+	}, expression.Condition)
 }
 
 func TestNotInExpression_ShouldReturnExpression_WhenFieldTypeIsTime(t *testing.T) {
@@ -222,3 +230,55 @@ func TestNotInExpression_ShouldReturnNil_WhenFieldTypeIsDateTimeArray(t *testing
 	// Assert
 	assert.Nil(t, expression)
 }
+
+func TestNotInExpression_ShouldReturnExpression_WhenFieldTypeIsStringAndCollationIsCaseSensitive(t *testing.T) {
+	// Arrange
+	value := []interface{}{"Café"}
+
+	// Act
+	expression := NotInOperator{Collation: constants.CollationCaseSensitive}.Build(constants.FieldTypeString, "Value", value)
+
+	// Assert
+	assert.NotNil(t, expression)
+	assert.Equal(t, bson.M{"Value": bson.M{"$nin": []interface{}{"Café"}}}, expression.Condition)
+}
+
+func TestNotInExpression_ShouldReturnExpression_WhenFieldTypeIsStringAndCollationIsAccentInsensitive(t *testing.T) {
+	// Arrange
+	value := []interface{}{"Café"}
+
+	// Act
+	expression := NotInOperator{Collation: constants.CollationAccentInsensitive}.Build(constants.FieldTypeString, "Value", value)
+
+	// Assert
+	assert.NotNil(t, expression)
+	assert.Equal(t, bson.M{"Value": bson.M{"$nin": []interface{}{primitive.Regex{Pattern: "^[cçćĉċč][aàáâãäåāăą]f[eèéêëēĕėęě]$", Options: "i"}}}}, expression.Condition)
+}
+
+func TestNotInExpression_ShouldReturnRangeExpressions_WhenFieldTypeIsDateTimeAndValuesContainTimeRange(t *testing.T) {
+	// Arrange
+	instant := time.Date(2024, 01, 07, 10, 30, 0, 0, time.UTC)
+	value := []interface{}{
+		&utils.TimeRange{
+			Start: time.Date(2024, 01, 01, 0, 0, 0, 0, time.UTC),
+			End:   time.Date(2024, 02, 01, 0, 0, 0, 0, time.UTC),
+		},
+		instant,
+	}
+
+	// Act
+	expression := NotInOperator{}.Build(constants.FieldTypeDateTime, "Value", value)
+
+	// Assert
+	assert.NotNil(t, expression)
+	assert.Equal(t, bson.M{
+		"$and": []bson.M{
+			{"$or": []bson.M{
+				{"Value": bson.M{"$lt": time.Date(2024, 01, 01, 0, 0, 0, 0, time.UTC)}},
+				{"Value": bson.M{"$gte": time.Date(2024, 02, 01, 0, 0, 0, 0, time.UTC)}},
+				{"Value": nil},
+			}},
+			{"Value": bson.M{"$ne": instant}},
+		},
+	}, expression.Condition)
+}
@@ -0,0 +1,31 @@
+package operators
+
+import (
+	"go.mongodb.org/mongo-driver/bson"
+
+	"github.com/filtex/filtex-go/builders/mongo/types"
+	"github.com/filtex/filtex-go/constants"
+)
+
+type NotInSubnetOperator struct{}
+
+func (o NotInSubnetOperator) Build(fieldType constants.FieldType, field string, value interface{}) *types.MongoExpression {
+	if fieldType != constants.FieldTypeIP {
+		return nil
+	}
+
+	pattern, ok := subnetPattern(value)
+	if !ok {
+		return nil
+	}
+
+	return &types.MongoExpression{
+		Condition: bson.M{
+			field: bson.M{
+				"$not": bson.M{
+					"$regex": pattern,
+				},
+			},
+		},
+	}
+}
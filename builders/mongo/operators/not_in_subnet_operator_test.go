@@ -0,0 +1,26 @@
+package operators
+
+import (
+	"testing"
+
+	"github.com/filtex/filtex-go/constants"
+	"github.com/stretchr/testify/assert"
+	"go.mongodb.org/mongo-driver/bson"
+)
+
+func TestNotInSubnetExpression_ShouldReturnExpression_WhenFieldTypeIsIP(t *testing.T) {
+	// Act
+	expression := NotInSubnetOperator{}.Build(constants.FieldTypeIP, "Value", "10.0.0.0/8")
+
+	// Assert
+	assert.NotNil(t, expression)
+	assert.Equal(t, bson.M{"Value": bson.M{"$not": bson.M{"$regex": `^10\.\d{1,3}\.\d{1,3}\.\d{1,3}$`}}}, expression.Condition)
+}
+
+func TestNotInSubnetExpression_ShouldReturnNil_WhenValueIsNotValid(t *testing.T) {
+	// Act
+	expression := NotInSubnetOperator{}.Build(constants.FieldTypeIP, "Value", "abc")
+
+	// Assert
+	assert.Nil(t, expression)
+}
@@ -143,3 +143,27 @@ func TestNotStartWithExpression_ShouldReturnNil_WhenFieldTypeIsDateTimeArray(t *
 	// Assert
 	assert.Nil(t, expression)
 }
+
+func TestNotStartWithExpression_ShouldReturnExpression_WhenFieldTypeIsStringAndCollationIsCaseSensitive(t *testing.T) {
+	// Arrange
+	value := "Café"
+
+	// Act
+	expression := NotStartWithOperator{Collation: constants.CollationCaseSensitive}.Build(constants.FieldTypeString, "Value", value)
+
+	// Assert
+	assert.NotNil(t, expression)
+	assert.Equal(t, bson.M{"Value": bson.M{"$not": bson.M{"$regex": "^Café"}}}, expression.Condition)
+}
+
+func TestNotStartWithExpression_ShouldReturnExpression_WhenFieldTypeIsStringAndCollationIsAccentInsensitive(t *testing.T) {
+	// Arrange
+	value := "Café"
+
+	// Act
+	expression := NotStartWithOperator{Collation: constants.CollationAccentInsensitive}.Build(constants.FieldTypeString, "Value", value)
+
+	// Assert
+	assert.NotNil(t, expression)
+	assert.Equal(t, bson.M{"Value": bson.M{"$not": bson.M{"$regex": "^[cçćĉċč][aàáâãäåāăą]f[eèéêëēĕėęě]", "$options": "i"}}}, expression.Condition)
+}
@@ -0,0 +1,77 @@
+package operators
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+	"unicode"
+
+	"go.mongodb.org/mongo-driver/bson"
+
+	"github.com/filtex/filtex-go/builders/mongo/types"
+)
+
+type SearchOperator struct {
+	Regex bool
+}
+
+func (o SearchOperator) Build(fields []string, value string) *types.MongoExpression {
+	if len(fields) == 0 || strings.TrimSpace(value) == "" {
+		return nil
+	}
+
+	if o.Regex {
+		return o.buildRegex(fields, value)
+	}
+
+	return &types.MongoExpression{
+		Condition: bson.M{
+			"$text": bson.M{
+				"$search": value,
+			},
+		},
+	}
+}
+
+func (SearchOperator) buildRegex(fields []string, value string) *types.MongoExpression {
+	terms := strings.FieldsFunc(strings.ToLower(value), func(r rune) bool {
+		return !unicode.IsLetter(r) && !unicode.IsDigit(r)
+	})
+
+	if len(terms) == 0 {
+		return nil
+	}
+
+	conditions := make([]bson.M, 0)
+
+	for _, term := range terms {
+		matches := make([]bson.M, 0)
+
+		for _, field := range fields {
+			matches = append(matches, bson.M{
+				field: bson.M{
+					"$regex":   fmt.Sprintf(`(?<![\p{L}\p{N}])%s(?![\p{L}\p{N}])`, regexp.QuoteMeta(term)),
+					"$options": "i",
+				},
+			})
+		}
+
+		if len(matches) == 1 {
+			conditions = append(conditions, matches[0])
+		} else {
+			conditions = append(conditions, bson.M{"$or": matches})
+		}
+	}
+
+	if len(conditions) == 1 {
+		return &types.MongoExpression{
+			Condition: conditions[0],
+		}
+	}
+
+	return &types.MongoExpression{
+		Condition: bson.M{
+			"$and": conditions,
+		},
+	}
+}
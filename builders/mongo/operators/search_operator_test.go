@@ -0,0 +1,71 @@
+package operators
+
+import (
+	"testing"
+
+	"go.mongodb.org/mongo-driver/bson"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSearchExpression_ShouldReturnNil_WhenFieldsAreEmpty(t *testing.T) {
+	// Act
+	expression := SearchOperator{}.Build([]string{}, "red shoes")
+
+	// Assert
+	assert.Nil(t, expression)
+}
+
+func TestSearchExpression_ShouldReturnNil_WhenValueIsEmpty(t *testing.T) {
+	// Act
+	expression := SearchOperator{}.Build([]string{"Name"}, "  ")
+
+	// Assert
+	assert.Nil(t, expression)
+}
+
+func TestSearchExpression_ShouldReturnTextExpression_WhenFieldsAreDefined(t *testing.T) {
+	// Act
+	expression := SearchOperator{}.Build([]string{"Name", "Description"}, "red shoes")
+
+	// Assert
+	assert.NotNil(t, expression)
+	assert.Equal(t, bson.M{"$text": bson.M{"$search": "red shoes"}}, expression.Condition)
+}
+
+func TestSearchExpression_ShouldReturnNil_WhenRegexIsEnabledAndValueHasNoTerms(t *testing.T) {
+	// Act
+	expression := SearchOperator{Regex: true}.Build([]string{"Name"}, " -- ")
+
+	// Assert
+	assert.Nil(t, expression)
+}
+
+func TestSearchExpression_ShouldReturnFieldExpression_WhenRegexIsEnabledAndThereIsSingleFieldAndTerm(t *testing.T) {
+	// Act
+	expression := SearchOperator{Regex: true}.Build([]string{"Tags"}, "Café")
+
+	// Assert
+	assert.NotNil(t, expression)
+	assert.Equal(t, bson.M{"Tags": bson.M{"$regex": `(?<![\p{L}\p{N}])café(?![\p{L}\p{N}])`, "$options": "i"}}, expression.Condition)
+}
+
+func TestSearchExpression_ShouldMatchEveryTermInAnyField_WhenRegexIsEnabled(t *testing.T) {
+	// Act
+	expression := SearchOperator{Regex: true}.Build([]string{"Name", "Description"}, "red c++")
+
+	// Assert
+	assert.NotNil(t, expression)
+	assert.Equal(t, bson.M{
+		"$and": []bson.M{
+			{"$or": []bson.M{
+				{"Name": bson.M{"$regex": `(?<![\p{L}\p{N}])red(?![\p{L}\p{N}])`, "$options": "i"}},
+				{"Description": bson.M{"$regex": `(?<![\p{L}\p{N}])red(?![\p{L}\p{N}])`, "$options": "i"}},
+			}},
+			{"$or": []bson.M{
+				{"Name": bson.M{"$regex": `(?<![\p{L}\p{N}])c(?![\p{L}\p{N}])`, "$options": "i"}},
+				{"Description": bson.M{"$regex": `(?<![\p{L}\p{N}])c(?![\p{L}\p{N}])`, "$options": "i"}},
+			}},
+		},
+	}, expression.Condition)
+}
@@ -9,19 +9,18 @@ import (
 	"github.com/filtex/filtex-go/constants"
 )
 
-type StartWithOperator struct{}
+type StartWithOperator struct {
+	Collation constants.Collation
+}
 
-func (StartWithOperator) Build(fieldType constants.FieldType, field string, value interface{}) *types.MongoExpression {
+func (o StartWithOperator) Build(fieldType constants.FieldType, field string, value interface{}) *types.MongoExpression {
 	if fieldType != constants.FieldTypeString {
 		return nil
 	}
 
 	return &types.MongoExpression{
 		Condition: bson.M{
-			field: bson.M{
-				"$regex":   fmt.Sprintf("^%s", value),
-				"$options": "i",
-			},
+			field: regex(o.Collation, fmt.Sprintf("^%s", pattern(o.Collation, value))),
 		},
 	}
 }
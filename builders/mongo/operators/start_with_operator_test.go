@@ -135,3 +135,27 @@ func TestStartWithExpression_ShouldReturnNil_WhenFieldTypeIsDateTimeArray(t *tes
 	// Assert
 	assert.Nil(t, expression)
 }
+
+func TestStartWithExpression_ShouldReturnExpression_WhenFieldTypeIsStringAndCollationIsCaseSensitive(t *testing.T) {
+	// Arrange
+	value := "Café"
+
+	// Act
+	expression := StartWithOperator{Collation: constants.CollationCaseSensitive}.Build(constants.FieldTypeString, "Value", value)
+
+	// Assert
+	assert.NotNil(t, expression)
+	assert.Equal(t, bson.M{"Value": bson.M{"$regex": "^Café"}}, expression.Condition)
+}
+
+func TestStartWithExpression_ShouldReturnExpression_WhenFieldTypeIsStringAndCollationIsAccentInsensitive(t *testing.T) {
+	// Arrange
+	value := "Café"
+
+	// Act
+	expression := StartWithOperator{Collation: constants.CollationAccentInsensitive}.Build(constants.FieldTypeString, "Value", value)
+
+	// Assert
+	assert.NotNil(t, expression)
+	assert.Equal(t, bson.M{"Value": bson.M{"$regex": "^[cçćĉċč][aàáâãäåāăą]f[eèéêëēĕėęě]", "$options": "i"}}, expression.Condition)
+}
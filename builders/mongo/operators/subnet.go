@@ -0,0 +1,114 @@
+package operators
+
+import (
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/filtex/filtex-go/utils"
+)
+
+func subnetPattern(value interface{}) (string, bool) {
+	subnet, err := utils.Subnet(value)
+	if err != nil || !subnet.Addr().Is4() {
+		return "", false
+	}
+
+	octets := subnet.Addr().As4()
+	bits := subnet.Bits()
+	parts := make([]string, 0)
+
+	for i := 0; i < bits/8; i++ {
+		parts = append(parts, strconv.Itoa(int(octets[i])))
+	}
+
+	if remaining := bits % 8; remaining > 0 {
+		start := int(octets[bits/8])
+		parts = append(parts, octetRangePattern(start, start+(1<<(8-remaining))-1))
+	}
+
+	for len(parts) < 4 {
+		parts = append(parts, `\d{1,3}`)
+	}
+
+	return "^" + strings.Join(parts, `\.`) + "$", true
+}
+
+func octetRangePattern(min int, max int) string {
+	stops := map[int]bool{max: true}
+
+	for nines := 1; ; nines++ {
+		stop := fillNines(min, nines)
+		if stop < min || stop > max {
+			break
+		}
+		stops[stop] = true
+	}
+
+	for zeros := 1; ; zeros++ {
+		stop := fillZeros(max+1, zeros) - 1
+		if stop < min || stop > max {
+			break
+		}
+		stops[stop] = true
+	}
+
+	sorted := make([]int, 0)
+	for k := range stops {
+		sorted = append(sorted, k)
+	}
+	sort.Ints(sorted)
+
+	items := make([]string, 0)
+	start := min
+
+	for _, stop := range sorted {
+		items = append(items, digitRangePattern(strconv.Itoa(start), strconv.Itoa(stop)))
+		start = stop + 1
+	}
+
+	if len(items) == 1 {
+		return items[0]
+	}
+
+	return "(?:" + strings.Join(items, "|") + ")"
+}
+
+func digitRangePattern(start string, stop string) string {
+	var result strings.Builder
+
+	for i := range start {
+		switch {
+		case start[i] == stop[i]:
+			result.WriteByte(start[i])
+		case start[i] == '0' && stop[i] == '9':
+			result.WriteString(`\d`)
+		default:
+			result.WriteString(fmt.Sprintf("[%c-%c]", start[i], stop[i]))
+		}
+	}
+
+	return result.String()
+}
+
+func fillNines(value int, nines int) int {
+	prefix := strconv.Itoa(value)
+	if nines < len(prefix) {
+		prefix = prefix[:len(prefix)-nines]
+	} else {
+		prefix = ""
+	}
+
+	result, _ := strconv.Atoi(prefix + strings.Repeat("9", nines))
+	return result
+}
+
+func fillZeros(value int, zeros int) int {
+	factor := 1
+	for i := 0; i < zeros; i++ {
+		factor *= 10
+	}
+
+	return value - value%factor
+}
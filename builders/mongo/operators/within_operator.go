@@ -0,0 +1,68 @@
+package operators
+
+import (
+	"go.mongodb.org/mongo-driver/bson"
+
+	"github.com/filtex/filtex-go/builders/mongo/types"
+	"github.com/filtex/filtex-go/constants"
+	"github.com/filtex/filtex-go/utils"
+)
+
+type WithinOperator struct{}
+
+func (o WithinOperator) Build(fieldType constants.FieldType, field string, value interface{}) *types.MongoExpression {
+	if fieldType != constants.FieldTypeGeoPoint {
+		return nil
+	}
+
+	area, err := utils.GeoArea(value)
+	if err != nil {
+		return nil
+	}
+
+	switch a := area.(type) {
+	case *utils.GeoCircle:
+		return &types.MongoExpression{
+			Condition: bson.M{
+				field: bson.M{
+					"$geoWithin": bson.M{
+						"$centerSphere": bson.A{
+							bson.A{a.Center.Lng, a.Center.Lat},
+							a.Radius / utils.EarthRadius,
+						},
+					},
+				},
+			},
+		}
+	case *utils.GeoBox:
+		if a.SouthWest.Lng > a.NorthEast.Lng {
+			return &types.MongoExpression{
+				Condition: bson.M{
+					"$or": []bson.M{
+						geoBox(field, a.SouthWest.Lng, a.SouthWest.Lat, 180, a.NorthEast.Lat),
+						geoBox(field, -180, a.SouthWest.Lat, a.NorthEast.Lng, a.NorthEast.Lat),
+					},
+				},
+			}
+		}
+
+		return &types.MongoExpression{
+			Condition: geoBox(field, a.SouthWest.Lng, a.SouthWest.Lat, a.NorthEast.Lng, a.NorthEast.Lat),
+		}
+	}
+
+	return nil
+}
+
+func geoBox(field string, west, south, east, north float64) bson.M {
+	return bson.M{
+		field: bson.M{
+			"$geoWithin": bson.M{
+				"$box": bson.A{
+					bson.A{west, south},
+					bson.A{east, north},
+				},
+			},
+		},
+	}
+}
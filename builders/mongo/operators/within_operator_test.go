@@ -0,0 +1,91 @@
+package operators
+
+import (
+	"testing"
+
+	"github.com/filtex/filtex-go/constants"
+	"github.com/filtex/filtex-go/utils"
+	"github.com/stretchr/testify/assert"
+	"go.mongodb.org/mongo-driver/bson"
+)
+
+func TestWithinExpression_ShouldReturnCenterSphereExpression_WhenValueIsCircle(t *testing.T) {
+	// Act
+	expression := WithinOperator{}.Build(constants.FieldTypeGeoPoint, "Value", "10km of (40.7128, -74.006)")
+
+	// Assert
+	assert.NotNil(t, expression)
+	assert.Equal(t, bson.M{
+		"Value": bson.M{
+			"$geoWithin": bson.M{
+				"$centerSphere": bson.A{bson.A{-74.006, 40.7128}, 10000 / utils.EarthRadius},
+			},
+		},
+	}, expression.Condition)
+}
+
+func TestWithinExpression_ShouldReturnBoxExpression_WhenValueIsBox(t *testing.T) {
+	// Act
+	expression := WithinOperator{}.Build(constants.FieldTypeGeoPoint, "Value", "box(40, -75, 41, -73)")
+
+	// Assert
+	assert.NotNil(t, expression)
+	assert.Equal(t, bson.M{
+		"Value": bson.M{
+			"$geoWithin": bson.M{
+				"$box": bson.A{
+					bson.A{-75.0, 40.0},
+					bson.A{-73.0, 41.0},
+				},
+			},
+		},
+	}, expression.Condition)
+}
+
+func TestWithinExpression_ShouldSplitBox_WhenBoxCrossesAntimeridian(t *testing.T) {
+	// Act
+	expression := WithinOperator{}.Build(constants.FieldTypeGeoPoint, "Value", "box(-1, 170, 1, -170)")
+
+	// Assert
+	assert.NotNil(t, expression)
+	assert.Equal(t, bson.M{
+		"$or": []bson.M{
+			{
+				"Value": bson.M{
+					"$geoWithin": bson.M{
+						"$box": bson.A{
+							bson.A{170.0, -1.0},
+							bson.A{180.0, 1.0},
+						},
+					},
+				},
+			},
+			{
+				"Value": bson.M{
+					"$geoWithin": bson.M{
+						"$box": bson.A{
+							bson.A{-180.0, -1.0},
+							bson.A{-170.0, 1.0},
+						},
+					},
+				},
+			},
+		},
+	}, expression.Condition)
+}
+
+func TestWithinExpression_ShouldReturnNil_WhenValueIsNotValid(t *testing.T) {
+	// Act
+	expression := WithinOperator{}.Build(constants.FieldTypeGeoPoint, "Value", "box(40, 190, 41, 0)")
+
+	// Assert
+	assert.Nil(t, expression)
+}
+
+func TestWithinExpression_ShouldReturnNil_WhenFieldTypeIsNotGeoPoint(t *testing.T) {
+	// Act
+	expression := WithinOperator{}.Build(constants.FieldTypeString, "Value", "10km of (40, 0)")
+
+	// Assert
+	assert.Nil(t, expression)
+}
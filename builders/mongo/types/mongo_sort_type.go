@@ -0,0 +1,9 @@
+package types
+
+import (
+	"go.mongodb.org/mongo-driver/bson"
+)
+
+type MongoSort struct {
+	Sort bson.D
+}
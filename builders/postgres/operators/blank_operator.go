@@ -12,7 +12,7 @@ type BlankOperator struct{}
 func (BlankOperator) Build(fieldType constants.FieldType, field string, value interface{}, index int) *types.PostgresExpression {
 	if fieldType.IsArray() {
 		return &types.PostgresExpression{
-			Condition: fmt.Sprintf("ARRAY_LENGTH(%s, 1) = 0", field),
+			Condition: fmt.Sprintf("(%s IS NULL OR CARDINALITY(%s) = 0)", field, field),
 			Args:      []interface{}{},
 		}
 	}
@@ -20,10 +20,13 @@ func (BlankOperator) Build(fieldType constants.FieldType, field string, value in
 	switch fieldType {
 	case constants.FieldTypeString:
 		return &types.PostgresExpression{
-			Condition: fmt.Sprintf("%s IS NULL OR %s = ''", field, field),
+			Condition: fmt.Sprintf("(%s IS NULL OR %s = '')", field, field),
 			Args:      []interface{}{},
 		}
 	}
 
-	return nil
+	return &types.PostgresExpression{
+		Condition: fmt.Sprintf("%s IS NULL", field),
+		Args:      []interface{}{},
+	}
 }
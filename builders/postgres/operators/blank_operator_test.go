@@ -14,7 +14,7 @@ func TestBlankExpression_ShouldReturnExpression_WhenFieldTypeIsString(t *testing
 
 	// Assert
 	assert.NotNil(t, expression)
-	assert.Equal(t, "Value IS NULL OR Value = ''", expression.Condition)
+	assert.Equal(t, "(Value IS NULL OR Value = '')", expression.Condition)
 	assert.Empty(t, expression.Args)
 }
 
@@ -25,7 +25,7 @@ func TestBlankExpression_ShouldReturnExpression_WhenFieldTypeIsStringArray(t *te
 
 	// Assert
 	assert.NotNil(t, expression)
-	assert.Equal(t, "ARRAY_LENGTH(Value, 1) = 0", expression.Condition)
+	assert.Equal(t, "(Value IS NULL OR CARDINALITY(Value) = 0)", expression.Condition)
 	assert.Empty(t, expression.Args)
 }
 
@@ -36,7 +36,7 @@ func TestBlankExpression_ShouldReturnExpression_WhenFieldTypeIsNumberArray(t *te
 
 	// Assert
 	assert.NotNil(t, expression)
-	assert.Equal(t, "ARRAY_LENGTH(Value, 1) = 0", expression.Condition)
+	assert.Equal(t, "(Value IS NULL OR CARDINALITY(Value) = 0)", expression.Condition)
 	assert.Empty(t, expression.Args)
 }
 
@@ -47,7 +47,7 @@ func TestBlankExpression_ShouldReturnExpression_WhenFieldTypeIsBooleanArray(t *t
 
 	// Assert
 	assert.NotNil(t, expression)
-	assert.Equal(t, "ARRAY_LENGTH(Value, 1) = 0", expression.Condition)
+	assert.Equal(t, "(Value IS NULL OR CARDINALITY(Value) = 0)", expression.Condition)
 	assert.Empty(t, expression.Args)
 }
 
@@ -58,7 +58,7 @@ func TestBlankExpression_ShouldReturnExpression_WhenFieldTypeIsDateArray(t *test
 
 	// Assert
 	assert.NotNil(t, expression)
-	assert.Equal(t, "ARRAY_LENGTH(Value, 1) = 0", expression.Condition)
+	assert.Equal(t, "(Value IS NULL OR CARDINALITY(Value) = 0)", expression.Condition)
 	assert.Empty(t, expression.Args)
 }
 
@@ -69,7 +69,7 @@ func TestBlankExpression_ShouldReturnExpression_WhenFieldTypeIsTimeArray(t *test
 
 	// Assert
 	assert.NotNil(t, expression)
-	assert.Equal(t, "ARRAY_LENGTH(Value, 1) = 0", expression.Condition)
+	assert.Equal(t, "(Value IS NULL OR CARDINALITY(Value) = 0)", expression.Condition)
 	assert.Empty(t, expression.Args)
 }
 
@@ -80,51 +80,61 @@ func TestBlankExpression_ShouldReturnExpression_WhenFieldTypeIsDateTimeArray(t *
 
 	// Assert
 	assert.NotNil(t, expression)
-	assert.Equal(t, "ARRAY_LENGTH(Value, 1) = 0", expression.Condition)
+	assert.Equal(t, "(Value IS NULL OR CARDINALITY(Value) = 0)", expression.Condition)
 	assert.Empty(t, expression.Args)
 }
 
-func TestBlankExpression_ShouldReturnNil_WhenFieldTypeIsNumber(t *testing.T) {
+func TestBlankExpression_ShouldReturnExpression_WhenFieldTypeIsNumber(t *testing.T) {
 	// Arrange
 	// Act
 	expression := BlankOperator{}.Build(constants.FieldTypeNumber, "Value", nil, 0)
 
 	// Assert
-	assert.Nil(t, expression)
+	assert.NotNil(t, expression)
+	assert.Equal(t, "Value IS NULL", expression.Condition)
+	assert.Empty(t, expression.Args)
 }
 
-func TestBlankExpression_ShouldReturnNil_WhenFieldTypeIsBoolean(t *testing.T) {
+func TestBlankExpression_ShouldReturnExpression_WhenFieldTypeIsBoolean(t *testing.T) {
 	// Arrange
 	// Act
 	expression := BlankOperator{}.Build(constants.FieldTypeBoolean, "Value", nil, 0)
 
 	// Assert
-	assert.Nil(t, expression)
+	assert.NotNil(t, expression)
+	assert.Equal(t, "Value IS NULL", expression.Condition)
+	assert.Empty(t, expression.Args)
 }
 
-func TestBlankExpression_ShouldReturnNil_WhenFieldTypeIsDate(t *testing.T) {
+func TestBlankExpression_ShouldReturnExpression_WhenFieldTypeIsDate(t *testing.T) {
 	// Arrange
 	// Act
 	expression := BlankOperator{}.Build(constants.FieldTypeDate, "Value", nil, 0)
 
 	// Assert
-	assert.Nil(t, expression)
+	assert.NotNil(t, expression)
+	assert.Equal(t, "Value IS NULL", expression.Condition)
+	assert.Empty(t, expression.Args)
 }
 
-func TestBlankExpression_ShouldReturnNil_WhenFieldTypeIsTime(t *testing.T) {
+func TestBlankExpression_ShouldReturnExpression_WhenFieldTypeIsTime(t *testing.T) {
 	// Arrange
 	// Act
 	expression := BlankOperator{}.Build(constants.FieldTypeTime, "Value", nil, 0)
 
 	// Assert
-	assert.Nil(t, expression)
+	assert.NotNil(t, expression)
+	assert.Equal(t, "Value IS NULL", expression.Condition)
+	assert.Empty(t, expression.Args)
 }
 
-func TestBlankExpression_ShouldReturnNil_WhenFieldTypeIsDateTime(t *testing.T) {
+func TestBlankExpression_ShouldReturnExpression_WhenFieldTypeIsDateTime(t *testing.T) {
 	// Arrange
 	// Act
 	expression := BlankOperator{}.Build(constants.FieldTypeDateTime, "Value", nil, 0)
 
 	// Assert
-	assert.Nil(t, expression)
+	assert.NotNil(t, expression)
+	assert.Equal(t, "Value IS NULL", expression.Condition)
+	assert.Empty(t, expression.Args)
 }
@@ -0,0 +1,46 @@
+package operators
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/filtex/filtex-go/constants"
+)
+
+var likeEscaper = strings.NewReplacer(`\`, `\\`, "%", `\%`, "_", `\_`)
+
+func collate(collation constants.Collation, expression string) string {
+	if collation.IsAccentInsensitive() {
+		return fmt.Sprintf("unaccent(%s)", expression)
+	}
+
+	return expression
+}
+
+func lower(collation constants.Collation, expression string) string {
+	if collation.IsCaseSensitive() {
+		return expression
+	}
+
+	return fmt.Sprintf("LOWER(%s)", collate(collation, expression))
+}
+
+func like(collation constants.Collation) string {
+	if collation.IsCaseSensitive() {
+		return "LIKE"
+	}
+
+	return "ILIKE"
+}
+
+func param(index int) string {
+	return fmt.Sprintf("$%v", index)
+}
+
+func escape(value interface{}) interface{} {
+	if str, ok := value.(string); ok {
+		return likeEscaper.Replace(str)
+	}
+
+	return value
+}
@@ -7,19 +7,21 @@ import (
 	"github.com/filtex/filtex-go/constants"
 )
 
-type ContainOperator struct{}
+type ContainOperator struct {
+	Collation constants.Collation
+}
 
-func (ContainOperator) Build(fieldType constants.FieldType, field string, value interface{}, index int) *types.PostgresExpression {
+func (o ContainOperator) Build(fieldType constants.FieldType, field string, value interface{}, index int) *types.PostgresExpression {
 	if fieldType.IsArray() {
-		if fieldType == constants.FieldTypeStringArray {
+		if fieldType == constants.FieldTypeStringArray && !o.Collation.IsCaseSensitive() {
 			return &types.PostgresExpression{
-				Condition: fmt.Sprintf("LOWER($%v) = ANY (LOWER(%s::TEXT)::TEXT[])", index, field),
+				Condition: fmt.Sprintf("%s = ANY (%s::TEXT[])", lower(o.Collation, param(index)), lower(o.Collation, field+"::TEXT")),
 				Args:      []interface{}{value},
 			}
 		}
 
 		return &types.PostgresExpression{
-			Condition: fmt.Sprintf("$%v = ANY (%s)", index, field),
+			Condition: fmt.Sprintf("%s = ANY (%s)", typed(fieldType, param(index)), field),
 			Args:      []interface{}{value},
 		}
 	}
@@ -27,8 +29,8 @@ func (ContainOperator) Build(fieldType constants.FieldType, field string, value
 	switch fieldType {
 	case constants.FieldTypeString:
 		return &types.PostgresExpression{
-			Condition: fmt.Sprintf("%s ILIKE '%%' || $%v || '%%'", field, index),
-			Args:      []interface{}{value},
+			Condition: fmt.Sprintf("%s %s '%%' || %s || '%%'", collate(o.Collation, field), like(o.Collation), collate(o.Collation, param(index))),
+			Args:      []interface{}{escape(value)},
 		}
 	}
 
@@ -87,7 +87,7 @@ func TestContainExpression_ShouldReturnExpression_WhenFieldTypeIsTimeArray(t *te
 
 	// Assert
 	assert.NotNil(t, expression)
-	assert.Equal(t, "$1 = ANY (Value)", expression.Condition)
+	assert.Equal(t, "$1::time = ANY (Value)", expression.Condition)
 	assert.Len(t, expression.Args, 1)
 	assert.Equal(t, value, expression.Args[0])
 }
@@ -150,3 +150,52 @@ func TestContainExpression_ShouldReturnNil_WhenFieldTypeIsDateTime(t *testing.T)
 	// Assert
 	assert.Nil(t, expression)
 }
+
+func TestContainExpression_ShouldReturnExpression_WhenFieldTypeIsStringAndCollationIsCaseSensitive(t *testing.T) {
+	// Arrange
+	value := "Filtex"
+
+	// Act
+	expression := ContainOperator{Collation: constants.CollationCaseSensitive}.Build(constants.FieldTypeString, "Value", value, 1)
+
+	// Assert
+	assert.NotNil(t, expression)
+	assert.Equal(t, `Value LIKE '%' || $1 || '%'`, expression.Condition)
+	assert.NotEmpty(t, expression.Args)
+}
+
+func TestContainExpression_ShouldReturnExpression_WhenFieldTypeIsStringAndCollationIsAccentInsensitive(t *testing.T) {
+	// Arrange
+	value := "Filtex"
+
+	// Act
+	expression := ContainOperator{Collation: constants.CollationAccentInsensitive}.Build(constants.FieldTypeString, "Value", value, 1)
+
+	// Assert
+	assert.NotNil(t, expression)
+	assert.Equal(t, `unaccent(Value) ILIKE '%' || unaccent($1) || '%'`, expression.Condition)
+	assert.NotEmpty(t, expression.Args)
+}
+
+func TestContainExpression_ShouldReturnExpression_WhenFieldTypeIsIPArray(t *testing.T) {
+	// Arrange
+	value := "10.0.0.1"
+
+	// Act
+	expression := ContainOperator{}.Build(constants.FieldTypeIPArray, "Value", value, 1)
+
+	// Assert
+	assert.NotNil(t, expression)
+	assert.Equal(t, "$1::inet = ANY (Value)", expression.Condition)
+	assert.Equal(t, []interface{}{value}, expression.Args)
+}
+
+func TestContainExpression_ShouldEscapeWildcards_WhenFieldTypeIsString(t *testing.T) {
+	// Act
+	expression := ContainOperator{Collation: constants.CollationCaseSensitive}.Build(constants.FieldTypeString, "Value", "100%", 1)
+
+	// Assert
+	assert.NotNil(t, expression)
+	assert.Equal(t, "Value LIKE '%' || $1 || '%'", expression.Condition)
+	assert.Equal(t, []interface{}{`100\%`}, expression.Args)
+}
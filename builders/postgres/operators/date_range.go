@@ -0,0 +1,114 @@
+package operators
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/filtex/filtex-go/builders/postgres/types"
+	"github.com/filtex/filtex-go/constants"
+	"github.com/filtex/filtex-go/utils"
+)
+
+func hasRange(fieldType constants.FieldType, value interface{}) bool {
+	if fieldType == constants.FieldTypeDate {
+		return true
+	}
+
+	if fieldType != constants.FieldTypeDateTime {
+		return false
+	}
+
+	values, ok := value.([]interface{})
+	if !ok {
+		values = []interface{}{value}
+	}
+
+	for _, v := range values {
+		if _, ok := utils.AsTimeRange(v); ok {
+			return true
+		}
+	}
+
+	return false
+}
+
+func valueRange(fieldType constants.FieldType, value interface{}) (*utils.TimeRange, bool) {
+	if r, ok := utils.AsTimeRange(value); ok {
+		return r, true
+	}
+
+	if fieldType == constants.FieldTypeDate {
+		r, err := utils.DayRange(value)
+		return r, err == nil
+	}
+
+	return nil, false
+}
+
+func equalRange(fieldType constants.FieldType, field string, value interface{}, index int) *types.PostgresExpression {
+	r, ok := valueRange(fieldType, value)
+	if !ok {
+		if fieldType != constants.FieldTypeDateTime {
+			return nil
+		}
+
+		return &types.PostgresExpression{
+			Condition: fmt.Sprintf("%s = %s", field, param(index)),
+			Args:      []interface{}{value},
+		}
+	}
+
+	return &types.PostgresExpression{
+		Condition: fmt.Sprintf("(%s >= %s AND %s < %s)", field, param(index), field, param(index+1)),
+		Args:      []interface{}{r.Start, r.End},
+	}
+}
+
+func notEqualRange(fieldType constants.FieldType, field string, value interface{}, index int) *types.PostgresExpression {
+	r, ok := valueRange(fieldType, value)
+	if !ok {
+		if fieldType != constants.FieldTypeDateTime {
+			return nil
+		}
+
+		return &types.PostgresExpression{
+			Condition: orNull(field, fmt.Sprintf("%s <> %s", field, param(index))),
+			Args:      []interface{}{value},
+		}
+	}
+
+	return &types.PostgresExpression{
+		Condition: fmt.Sprintf("(%s < %s OR %s >= %s OR %s IS NULL)", field, param(index), field, param(index+1), field),
+		Args:      []interface{}{r.Start, r.End},
+	}
+}
+
+func ranges(fieldType constants.FieldType, field string, value interface{}, index int, fn func(fieldType constants.FieldType, field string, value interface{}, index int) *types.PostgresExpression, separator string) *types.PostgresExpression {
+	values, ok := value.([]interface{})
+	if !ok {
+		values = []interface{}{value}
+	}
+
+	conditions := make([]string, 0)
+	args := make([]interface{}, 0)
+
+	for _, v := range values {
+		expression := fn(fieldType, field, v, index+len(args))
+		if expression == nil {
+			return nil
+		}
+
+		conditions = append(conditions, expression.Condition)
+		args = append(args, expression.Args...)
+	}
+
+	condition := strings.Join(conditions, separator)
+	if len(conditions) > 1 {
+		condition = fmt.Sprintf("(%s)", condition)
+	}
+
+	return &types.PostgresExpression{
+		Condition: condition,
+		Args:      args,
+	}
+}
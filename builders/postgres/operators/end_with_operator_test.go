@@ -119,3 +119,29 @@ func TestEndWithExpression_ShouldReturnNil_WhenFieldTypeIsDateTimeArray(t *testi
 	// Assert
 	assert.Nil(t, expression)
 }
+
+func TestEndWithExpression_ShouldReturnExpression_WhenFieldTypeIsStringAndCollationIsCaseSensitive(t *testing.T) {
+	// Arrange
+	value := "Filtex"
+
+	// Act
+	expression := EndWithOperator{Collation: constants.CollationCaseSensitive}.Build(constants.FieldTypeString, "Value", value, 1)
+
+	// Assert
+	assert.NotNil(t, expression)
+	assert.Equal(t, `Value LIKE '%' || $1`, expression.Condition)
+	assert.NotEmpty(t, expression.Args)
+}
+
+func TestEndWithExpression_ShouldReturnExpression_WhenFieldTypeIsStringAndCollationIsAccentInsensitive(t *testing.T) {
+	// Arrange
+	value := "Filtex"
+
+	// Act
+	expression := EndWithOperator{Collation: constants.CollationAccentInsensitive}.Build(constants.FieldTypeString, "Value", value, 1)
+
+	// Assert
+	assert.NotNil(t, expression)
+	assert.Equal(t, `unaccent(Value) ILIKE '%' || unaccent($1)`, expression.Condition)
+	assert.NotEmpty(t, expression.Args)
+}
@@ -5,6 +5,7 @@ import (
 	"time"
 
 	"github.com/filtex/filtex-go/constants"
+	"github.com/filtex/filtex-go/utils"
 	"github.com/stretchr/testify/assert"
 )
 
@@ -52,16 +53,18 @@ func TestEqualExpression_ShouldReturnExpression_WhenFieldTypeIsBoolean(t *testin
 
 func TestEqualExpression_ShouldReturnExpression_WhenFieldTypeIsDate(t *testing.T) {
 	// Arrange
-	value := time.Now()
+	location := time.FixedZone("UTC+3", 3*60*60)
+	value := time.Date(2024, 03, 10, 15, 30, 0, 0, location)
 
 	// Act
 	expression := EqualOperator{}.Build(constants.FieldTypeDate, "Value", value, 1)
 
 	// Assert
 	assert.NotNil(t, expression)
-	assert.Equal(t, "Value = $1", expression.Condition)
-	assert.Len(t, expression.Args, 1)
-	assert.Equal(t, value, expression.Args[0])
+	assert.Equal(t, "(Value >= $1 AND Value < $2)", expression.Condition)
+	assert.Len(t, expression.Args, 2)
+	assert.Equal(t, time.Date(2024, 03, 10, 0, 0, 0, 0, location), expression.Args[0])
+	assert.Equal(t, time.Date(2024, 03, 11, 0, 0, 0, 0, location), expression.Args[1])
 }
 
 func TestEqualExpression_ShouldReturnExpression_WhenFieldTypeIsTime(t *testing.T) {
@@ -73,7 +76,7 @@ func TestEqualExpression_ShouldReturnExpression_WhenFieldTypeIsTime(t *testing.T
 
 	// Assert
 	assert.NotNil(t, expression)
-	assert.Equal(t, "Value = $1", expression.Condition)
+	assert.Equal(t, "Value = $1::time", expression.Condition)
 	assert.Len(t, expression.Args, 1)
 	assert.Equal(t, value, expression.Args[0])
 }
@@ -145,3 +148,104 @@ func TestEqualExpression_ShouldReturnNil_WhenFieldTypeIsDateTimeArray(t *testing
 	// Assert
 	assert.Nil(t, expression)
 }
+
+func TestEqualExpression_ShouldReturnExpression_WhenFieldTypeIsStringAndCollationIsCaseSensitive(t *testing.T) {
+	// Arrange
+	value := "Filtex"
+
+	// Act
+	expression := EqualOperator{Collation: constants.CollationCaseSensitive}.Build(constants.FieldTypeString, "Value", value, 1)
+
+	// Assert
+	assert.NotNil(t, expression)
+	assert.Equal(t, `Value = $1`, expression.Condition)
+	assert.NotEmpty(t, expression.Args)
+}
+
+func TestEqualExpression_ShouldReturnExpression_WhenFieldTypeIsStringAndCollationIsAccentInsensitive(t *testing.T) {
+	// Arrange
+	value := "Filtex"
+
+	// Act
+	expression := EqualOperator{Collation: constants.CollationAccentInsensitive}.Build(constants.FieldTypeString, "Value", value, 1)
+
+	// Assert
+	assert.NotNil(t, expression)
+	assert.Equal(t, `unaccent(Value) ILIKE unaccent($1)`, expression.Condition)
+	assert.NotEmpty(t, expression.Args)
+}
+
+func TestEqualExpression_ShouldReturnRangeExpression_WhenFieldTypeIsDateTimeAndValueIsTimeRange(t *testing.T) {
+	// Arrange
+	value := &utils.TimeRange{
+		Start: time.Date(2024, 01, 05, 0, 0, 0, 0, time.UTC),
+		End:   time.Date(2024, 01, 06, 0, 0, 0, 0, time.UTC),
+	}
+
+	// Act
+	expression := EqualOperator{}.Build(constants.FieldTypeDateTime, "Value", value, 1)
+
+	// Assert
+	assert.NotNil(t, expression)
+	assert.Equal(t, "(Value >= $1 AND Value < $2)", expression.Condition)
+	assert.Equal(t, []interface{}{value.Start, value.End}, expression.Args)
+}
+
+func TestEqualExpression_ShouldReturnExpression_WhenFieldTypeIsUUID(t *testing.T) {
+	// Arrange
+	value := "3f2504e0-4f89-11d3-9a0c-0305e82c3301"
+
+	// Act
+	expression := EqualOperator{}.Build(constants.FieldTypeUUID, "Value", value, 1)
+
+	// Assert
+	assert.NotNil(t, expression)
+	assert.Equal(t, "Value = $1::uuid", expression.Condition)
+	assert.Equal(t, []interface{}{value}, expression.Args)
+}
+
+func TestEqualExpression_ShouldReturnExpression_WhenFieldTypeIsIP(t *testing.T) {
+	// Arrange
+	value := "10.0.0.1"
+
+	// Act
+	expression := EqualOperator{}.Build(constants.FieldTypeIP, "Value", value, 1)
+
+	// Assert
+	assert.NotNil(t, expression)
+	assert.Equal(t, "Value = $1::inet", expression.Condition)
+	assert.Equal(t, []interface{}{value}, expression.Args)
+}
+
+func TestEqualExpression_ShouldReturnExpression_WhenFieldTypeIsEnum(t *testing.T) {
+	// Arrange
+	value := "active"
+
+	// Act
+	expression := EqualOperator{}.Build(constants.FieldTypeEnum, "Value", value, 1)
+
+	// Assert
+	assert.NotNil(t, expression)
+	assert.Equal(t, "Value = $1", expression.Condition)
+	assert.Equal(t, []interface{}{value}, expression.Args)
+}
+
+func TestEqualExpression_ShouldEscapeWildcards_WhenFieldTypeIsStringAndCollationIsNotCaseSensitive(t *testing.T) {
+	// Act
+	expression := EqualOperator{}.Build(constants.FieldTypeString, "Value", `a%_\b`, 1)
+
+	// Assert
+	assert.NotNil(t, expression)
+	assert.Equal(t, "Value ILIKE $1", expression.Condition)
+	assert.Equal(t, []interface{}{`a\%\_\\b`}, expression.Args)
+}
+
+func TestEqualExpression_ShouldNotEscapeWildcards_WhenCollationIsCaseSensitive(t *testing.T) {
+	// Act
+	expression := EqualOperator{Collation: constants.CollationCaseSensitive}.Build(constants.FieldTypeString, "Value", "a%", 1)
+
+	// Assert
+	assert.NotNil(t, expression)
+	assert.Equal(t, "Value = $1", expression.Condition)
+	assert.Equal(t, []interface{}{"a%"}, expression.Args)
+}
@@ -7,18 +7,40 @@ import (
 	"github.com/filtex/filtex-go/constants"
 )
 
-type GreaterThanOrEqualOperator struct{}
+type GreaterThanOrEqualOperator struct {
+	Collation constants.Collation
+}
 
-func (GreaterThanOrEqualOperator) Build(fieldType constants.FieldType, field string, value interface{}, index int) *types.PostgresExpression {
+func (o GreaterThanOrEqualOperator) Build(fieldType constants.FieldType, field string, value interface{}, index int) *types.PostgresExpression {
 	if fieldType != constants.FieldTypeNumber &&
+		fieldType != constants.FieldTypeInteger &&
+		fieldType != constants.FieldTypeDecimal &&
 		fieldType != constants.FieldTypeDate &&
 		fieldType != constants.FieldTypeTime &&
-		fieldType != constants.FieldTypeDateTime {
+		fieldType != constants.FieldTypeDuration &&
+		fieldType != constants.FieldTypeDateTime &&
+		fieldType != constants.FieldTypeString &&
+		fieldType != constants.FieldTypeUUID &&
+		fieldType != constants.FieldTypeBoolean {
 		return nil
 	}
 
+	if fieldType == constants.FieldTypeString {
+		return &types.PostgresExpression{
+			Condition: fmt.Sprintf("%s >= %s", lower(o.Collation, field), lower(o.Collation, param(index))),
+			Args:      []interface{}{value},
+		}
+	}
+
+	if r, ok := valueRange(fieldType, value); ok {
+		return &types.PostgresExpression{
+			Condition: fmt.Sprintf("%s >= $%v", field, index),
+			Args:      []interface{}{r.Start},
+		}
+	}
+
 	return &types.PostgresExpression{
-		Condition: fmt.Sprintf("%s >= $%v", field, index),
+		Condition: fmt.Sprintf("%s >= %s", field, typed(fieldType, param(index))),
 		Args:      []interface{}{value},
 	}
 }
@@ -9,22 +9,28 @@ import (
 	"github.com/filtex/filtex-go/utils"
 )
 
-type InOperator struct{}
+type InOperator struct {
+	Collation constants.Collation
+}
 
-func (InOperator) Build(fieldType constants.FieldType, field string, value interface{}, index int) *types.PostgresExpression {
+func (o InOperator) Build(fieldType constants.FieldType, field string, value interface{}, index int) *types.PostgresExpression {
 	if fieldType.IsArray() || value == nil {
 		return nil
 	}
 
+	if hasRange(fieldType, value) {
+		return ranges(fieldType, field, value, index, equalRange, " OR ")
+	}
+
 	if !utils.IsArray(value) {
 		if fieldType == constants.FieldTypeString {
 			return &types.PostgresExpression{
-				Condition: fmt.Sprintf("LOWER(%s) IN (LOWER($%v))", field, index),
+				Condition: fmt.Sprintf("%s IN (%s)", lower(o.Collation, field), lower(o.Collation, param(index))),
 				Args:      []interface{}{value},
 			}
 		} else {
 			return &types.PostgresExpression{
-				Condition: fmt.Sprintf("%s IN ($%v)", field, index),
+				Condition: fmt.Sprintf("%s IN (%s)", field, typed(fieldType, param(index))),
 				Args:      []interface{}{value},
 			}
 		}
@@ -34,18 +40,18 @@ func (InOperator) Build(fieldType constants.FieldType, field string, value inter
 		indexes := make([]string, 0)
 
 		for i := index; i < index+len(value.([]interface{})); i++ {
-			indexes = append(indexes, fmt.Sprintf("LOWER($%v)", i))
+			indexes = append(indexes, lower(o.Collation, param(i)))
 		}
 
 		return &types.PostgresExpression{
-			Condition: fmt.Sprintf("LOWER(%s) IN (%s)", field, strings.Join(indexes, ",")),
+			Condition: fmt.Sprintf("%s IN (%s)", lower(o.Collation, field), strings.Join(indexes, ",")),
 			Args:      value.([]interface{}),
 		}
 	} else {
 		indexes := make([]string, 0)
 
 		for i := index; i < index+len(value.([]interface{})); i++ {
-			indexes = append(indexes, fmt.Sprintf("$%v", i))
+			indexes = append(indexes, typed(fieldType, param(i)))
 		}
 
 		return &types.PostgresExpression{
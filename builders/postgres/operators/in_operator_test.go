@@ -5,6 +5,7 @@ import (
 	"time"
 
 	"github.com/filtex/filtex-go/constants"
+	"github.com/filtex/filtex-go/utils"
 	"github.com/stretchr/testify/assert"
 )
 
@@ -52,16 +53,18 @@ func TestInExpression_ShouldReturnExpression_WhenFieldTypeIsBoolean(t *testing.T
 
 func TestInExpression_ShouldReturnExpression_WhenFieldTypeIsDate(t *testing.T) {
 	// Arrange
-	value := time.Now()
+	location := time.FixedZone("UTC+3", 3*60*60)
+	value := time.Date(2024, 03, 10, 15, 30, 0, 0, location)
 
 	// Act
 	expression := InOperator{}.Build(constants.FieldTypeDate, "Value", value, 1)
 
 	// Assert
 	assert.NotNil(t, expression)
-	assert.Equal(t, "Value IN ($1)", expression.Condition)
-	assert.Len(t, expression.Args, 1)
-	assert.Equal(t, value, expression.Args[0])
+	assert.Equal(t, "(Value >= $1 AND Value < $2)", expression.Condition)
+	assert.Len(t, expression.Args, 2)
+	assert.Equal(t, time.Date(2024, 03, 10, 0, 0, 0, 0, location), expression.Args[0])
+	assert.Equal(t, time.Date(2024, 03, 11, 0, 0, 0, 0, location), expression.Args[1])
 }
 
 func TestInExpression_ShouldReturnExpression_WhenFieldTypeIsTime(t *testing.T) {
@@ -73,7 +76,7 @@ func TestInExpression_ShouldReturnExpression_WhenFieldTypeIsTime(t *testing.T) {
 
 	// Assert
 	assert.NotNil(t, expression)
-	assert.Equal(t, "Value IN ($1)", expression.Condition)
+	assert.Equal(t, "Value IN ($1::time)", expression.Condition)
 	assert.Len(t, expression.Args, 1)
 	assert.Equal(t, value, expression.Args[0])
 }
@@ -145,3 +148,87 @@ func TestInExpression_ShouldReturnNil_WhenFieldTypeIsDateTimeArray(t *testing.T)
 	// Assert
 	assert.Nil(t, expression)
 }
+
+func TestInExpression_ShouldReturnExpression_WhenFieldTypeIsStringAndCollationIsCaseSensitive(t *testing.T) {
+	// Arrange
+	value := []interface{}{"Filtex", "Go"}
+
+	// Act
+	expression := InOperator{Collation: constants.CollationCaseSensitive}.Build(constants.FieldTypeString, "Value", value, 1)
+
+	// Assert
+	assert.NotNil(t, expression)
+	assert.Equal(t, `Value IN ($1,$2)`, expression.Condition)
+	assert.NotEmpty(t, expression.Args)
+}
+
+func TestInExpression_ShouldReturnExpression_WhenFieldTypeIsStringAndCollationIsAccentInsensitive(t *testing.T) {
+	// Arrange
+	value := []interface{}{"Filtex", "Go"}
+
+	// Act
+	expression := InOperator{Collation: constants.CollationAccentInsensitive}.Build(constants.FieldTypeString, "Value", value, 1)
+
+	// Assert
+	assert.NotNil(t, expression)
+	assert.Equal(t, `LOWER(unaccent(Value)) IN (LOWER(unaccent($1)),LOWER(unaccent($2)))`, expression.Condition)
+	assert.NotEmpty(t, expression.Args)
+}
+
+func TestInExpression_ShouldReturnDayRanges_WhenFieldTypeIsDateAndValueIsArray(t *testing.T) {
+	// Arrange
+	value := []interface{}{
+		time.Date(2024, 03, 10, 0, 0, 0, 0, time.UTC),
+		time.Date(2024, 03, 12, 0, 0, 0, 0, time.UTC),
+	}
+
+	// Act
+	expression := InOperator{}.Build(constants.FieldTypeDate, "Value", value, 3)
+
+	// Assert
+	assert.NotNil(t, expression)
+	assert.Equal(t, "((Value >= $3 AND Value < $4) OR (Value >= $5 AND Value < $6))", expression.Condition)
+	assert.Equal(t, []interface{}{
+		time.Date(2024, 03, 10, 0, 0, 0, 0, time.UTC),
+		time.Date(2024, 03, 11, 0, 0, 0, 0, time.UTC),
+		time.Date(2024, 03, 12, 0, 0, 0, 0, time.UTC),
+		time.Date(2024, 03, 13, 0, 0, 0, 0, time.UTC),
+	}, expression.Args)
+}
+
+func TestInExpression_ShouldReturnRangeExpressions_WhenFieldTypeIsDateTimeAndValuesContainTimeRange(t *testing.T) {
+	// Arrange
+	instant := time.Date(2024, 01, 07, 10, 30, 0, 0, time.UTC)
+	value := []interface{}{
+		&utils.TimeRange{
+			Start: time.Date(2024, 01, 01, 0, 0, 0, 0, time.UTC),
+			End:   time.Date(2024, 02, 01, 0, 0, 0, 0, time.UTC),
+		},
+		instant,
+	}
+
+	// Act
+	expression := InOperator{}.Build(constants.FieldTypeDateTime, "Value", value, 1)
+
+	// Assert
+	assert.NotNil(t, expression)
+	assert.Equal(t, "((Value >= $1 AND Value < $2) OR Value = $3)", expression.Condition)
+	assert.Equal(t, []interface{}{
+		time.Date(2024, 01, 01, 0, 0, 0, 0, time.UTC),
+		time.Date(2024, 02, 01, 0, 0, 0, 0, time.UTC),
+		instant,
+	}, expression.Args)
+}
+
+func TestInExpression_ShouldReturnExpression_WhenFieldTypeIsUUID(t *testing.T) {
+	// Arrange
+	value := []interface{}{"3f2504e0-4f89-11d3-9a0c-0305e82c3301", "6ba7b810-9dad-11d1-80b4-00c04fd430c8"}
+
+	// Act
+	expression := InOperator{}.Build(constants.FieldTypeUUID, "Value", value, 1)
+
+	// Assert
+	assert.NotNil(t, expression)
+	assert.Equal(t, "Value IN ($1::uuid,$2::uuid)", expression.Condition)
+	assert.Equal(t, value, expression.Args)
+}
@@ -0,0 +1,27 @@
+package operators
+
+import (
+	"fmt"
+
+	"github.com/filtex/filtex-go/builders/postgres/types"
+	"github.com/filtex/filtex-go/constants"
+	"github.com/filtex/filtex-go/utils"
+)
+
+type InSubnetOperator struct{}
+
+func (o InSubnetOperator) Build(fieldType constants.FieldType, field string, value interface{}, index int) *types.PostgresExpression {
+	if fieldType != constants.FieldTypeIP {
+		return nil
+	}
+
+	subnet, err := utils.Subnet(value)
+	if err != nil {
+		return nil
+	}
+
+	return &types.PostgresExpression{
+		Condition: fmt.Sprintf("%s <<= %s", field, typed(fieldType, param(index))),
+		Args:      []interface{}{subnet.String()},
+	}
+}
@@ -0,0 +1,37 @@
+package operators
+
+import (
+	"testing"
+
+	"github.com/filtex/filtex-go/constants"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestInSubnetExpression_ShouldReturnExpression_WhenFieldTypeIsIP(t *testing.T) {
+	// Arrange
+	value := "10.1.2.3/8"
+
+	// Act
+	expression := InSubnetOperator{}.Build(constants.FieldTypeIP, "Value", value, 1)
+
+	// Assert
+	assert.NotNil(t, expression)
+	assert.Equal(t, "Value <<= $1::inet", expression.Condition)
+	assert.Equal(t, []interface{}{"10.0.0.0/8"}, expression.Args)
+}
+
+func TestInSubnetExpression_ShouldReturnNil_WhenValueIsNotValid(t *testing.T) {
+	// Act
+	expression := InSubnetOperator{}.Build(constants.FieldTypeIP, "Value", "10.0.0.0/33", 1)
+
+	// Assert
+	assert.Nil(t, expression)
+}
+
+func TestInSubnetExpression_ShouldReturnNil_WhenFieldTypeIsNotIP(t *testing.T) {
+	// Act
+	expression := InSubnetOperator{}.Build(constants.FieldTypeString, "Value", "10.0.0.0/8", 1)
+
+	// Assert
+	assert.Nil(t, expression)
+}
@@ -0,0 +1,17 @@
+package operators
+
+import (
+	"fmt"
+
+	"github.com/filtex/filtex-go/builders/postgres/types"
+	"github.com/filtex/filtex-go/constants"
+)
+
+type IsNotNullOperator struct{}
+
+func (IsNotNullOperator) Build(fieldType constants.FieldType, field string, value interface{}, index int) *types.PostgresExpression {
+	return &types.PostgresExpression{
+		Condition: fmt.Sprintf("%s IS NOT NULL", field),
+		Args:      []interface{}{},
+	}
+}
@@ -0,0 +1,41 @@
+package operators
+
+import (
+	"testing"
+
+	"github.com/filtex/filtex-go/constants"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestIsNotNullExpression_ShouldReturnExpression_WhenFieldTypeIsString(t *testing.T) {
+	// Arrange
+	// Act
+	expression := IsNotNullOperator{}.Build(constants.FieldTypeString, "Value", nil, 0)
+
+	// Assert
+	assert.NotNil(t, expression)
+	assert.Equal(t, "Value IS NOT NULL", expression.Condition)
+	assert.Empty(t, expression.Args)
+}
+
+func TestIsNotNullExpression_ShouldReturnExpression_WhenFieldTypeIsNumber(t *testing.T) {
+	// Arrange
+	// Act
+	expression := IsNotNullOperator{}.Build(constants.FieldTypeNumber, "Value", nil, 0)
+
+	// Assert
+	assert.NotNil(t, expression)
+	assert.Equal(t, "Value IS NOT NULL", expression.Condition)
+	assert.Empty(t, expression.Args)
+}
+
+func TestIsNotNullExpression_ShouldReturnExpression_WhenFieldTypeIsStringArray(t *testing.T) {
+	// Arrange
+	// Act
+	expression := IsNotNullOperator{}.Build(constants.FieldTypeStringArray, "Value", nil, 0)
+
+	// Assert
+	assert.NotNil(t, expression)
+	assert.Equal(t, "Value IS NOT NULL", expression.Condition)
+	assert.Empty(t, expression.Args)
+}
@@ -0,0 +1,17 @@
+package operators
+
+import (
+	"fmt"
+
+	"github.com/filtex/filtex-go/builders/postgres/types"
+	"github.com/filtex/filtex-go/constants"
+)
+
+type IsNullOperator struct{}
+
+func (IsNullOperator) Build(fieldType constants.FieldType, field string, value interface{}, index int) *types.PostgresExpression {
+	return &types.PostgresExpression{
+		Condition: fmt.Sprintf("%s IS NULL", field),
+		Args:      []interface{}{},
+	}
+}
@@ -0,0 +1,41 @@
+package operators
+
+import (
+	"testing"
+
+	"github.com/filtex/filtex-go/constants"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestIsNullExpression_ShouldReturnExpression_WhenFieldTypeIsString(t *testing.T) {
+	// Arrange
+	// Act
+	expression := IsNullOperator{}.Build(constants.FieldTypeString, "Value", nil, 0)
+
+	// Assert
+	assert.NotNil(t, expression)
+	assert.Equal(t, "Value IS NULL", expression.Condition)
+	assert.Empty(t, expression.Args)
+}
+
+func TestIsNullExpression_ShouldReturnExpression_WhenFieldTypeIsNumber(t *testing.T) {
+	// Arrange
+	// Act
+	expression := IsNullOperator{}.Build(constants.FieldTypeNumber, "Value", nil, 0)
+
+	// Assert
+	assert.NotNil(t, expression)
+	assert.Equal(t, "Value IS NULL", expression.Condition)
+	assert.Empty(t, expression.Args)
+}
+
+func TestIsNullExpression_ShouldReturnExpression_WhenFieldTypeIsStringArray(t *testing.T) {
+	// Arrange
+	// Act
+	expression := IsNullOperator{}.Build(constants.FieldTypeStringArray, "Value", nil, 0)
+
+	// Assert
+	assert.NotNil(t, expression)
+	assert.Equal(t, "Value IS NULL", expression.Condition)
+	assert.Empty(t, expression.Args)
+}
@@ -7,18 +7,40 @@ import (
 	"github.com/filtex/filtex-go/constants"
 )
 
-type LessThanOrEqualOperator struct{}
+type LessThanOrEqualOperator struct {
+	Collation constants.Collation
+}
 
-func (LessThanOrEqualOperator) Build(fieldType constants.FieldType, field string, value interface{}, index int) *types.PostgresExpression {
+func (o LessThanOrEqualOperator) Build(fieldType constants.FieldType, field string, value interface{}, index int) *types.PostgresExpression {
 	if fieldType != constants.FieldTypeNumber &&
+		fieldType != constants.FieldTypeInteger &&
+		fieldType != constants.FieldTypeDecimal &&
 		fieldType != constants.FieldTypeDate &&
 		fieldType != constants.FieldTypeTime &&
-		fieldType != constants.FieldTypeDateTime {
+		fieldType != constants.FieldTypeDuration &&
+		fieldType != constants.FieldTypeDateTime &&
+		fieldType != constants.FieldTypeString &&
+		fieldType != constants.FieldTypeUUID &&
+		fieldType != constants.FieldTypeBoolean {
 		return nil
 	}
 
+	if fieldType == constants.FieldTypeString {
+		return &types.PostgresExpression{
+			Condition: fmt.Sprintf("%s <= %s", lower(o.Collation, field), lower(o.Collation, param(index))),
+			Args:      []interface{}{value},
+		}
+	}
+
+	if r, ok := valueRange(fieldType, value); ok {
+		return &types.PostgresExpression{
+			Condition: fmt.Sprintf("%s < $%v", field, index),
+			Args:      []interface{}{r.End},
+		}
+	}
+
 	return &types.PostgresExpression{
-		Condition: fmt.Sprintf("%s <= $%v", field, index),
+		Condition: fmt.Sprintf("%s <= %s", field, typed(fieldType, param(index))),
 		Args:      []interface{}{value},
 	}
 }
@@ -5,6 +5,7 @@ import (
 	"time"
 
 	"github.com/filtex/filtex-go/constants"
+	"github.com/filtex/filtex-go/utils"
 	"github.com/stretchr/testify/assert"
 )
 
@@ -22,18 +23,17 @@ func TestLessThanOrEqualExpression_ShouldReturnExpression_WhenFieldTypeIsNumber(
 	assert.Equal(t, value, expression.Args[0])
 }
 
-func TestLessThanOrEqualExpression_ShouldReturnExpression_WhenFieldTypeIsDate(t *testing.T) {
+func TestLessThanOrEqualExpression_ShouldReturnDayBoundExpression_WhenFieldTypeIsDate(t *testing.T) {
 	// Arrange
-	value := time.Now()
+	value := time.Date(2024, 3, 10, 15, 30, 0, 0, time.UTC)
 
 	// Act
 	expression := LessThanOrEqualOperator{}.Build(constants.FieldTypeDate, "Value", value, 1)
 
 	// Assert
 	assert.NotNil(t, expression)
-	assert.Equal(t, "Value <= $1", expression.Condition)
-	assert.Len(t, expression.Args, 1)
-	assert.Equal(t, value, expression.Args[0])
+	assert.Equal(t, "Value < $1", expression.Condition)
+	assert.Equal(t, []interface{}{time.Date(2024, 3, 11, 0, 0, 0, 0, time.UTC)}, expression.Args)
 }
 
 func TestLessThanOrEqualExpression_ShouldReturnExpression_WhenFieldTypeIsTime(t *testing.T) {
@@ -45,7 +45,7 @@ func TestLessThanOrEqualExpression_ShouldReturnExpression_WhenFieldTypeIsTime(t
 
 	// Assert
 	assert.NotNil(t, expression)
-	assert.Equal(t, "Value <= $1", expression.Condition)
+	assert.Equal(t, "Value <= $1::time", expression.Condition)
 	assert.Len(t, expression.Args, 1)
 	assert.Equal(t, value, expression.Args[0])
 }
@@ -64,13 +64,43 @@ func TestLessThanOrEqualExpression_ShouldReturnExpression_WhenFieldTypeIsDateTim
 	assert.Equal(t, value, expression.Args[0])
 }
 
-func TestLessThanOrEqualExpression_ShouldReturnNil_WhenFieldTypeIsString(t *testing.T) {
+func TestLessThanOrEqualExpression_ShouldReturnLowerExpression_WhenFieldTypeIsString(t *testing.T) {
 	// Arrange
+	value := "Filtex"
+
 	// Act
-	expression := LessThanOrEqualOperator{}.Build(constants.FieldTypeString, "Value", nil, 0)
+	expression := LessThanOrEqualOperator{}.Build(constants.FieldTypeString, "Value", value, 1)
 
 	// Assert
-	assert.Nil(t, expression)
+	assert.NotNil(t, expression)
+	assert.Equal(t, "LOWER(Value) <= LOWER($1)", expression.Condition)
+	assert.Equal(t, []interface{}{value}, expression.Args)
+}
+
+func TestLessThanOrEqualExpression_ShouldReturnExpression_WhenFieldTypeIsCaseSensitiveString(t *testing.T) {
+	// Arrange
+	value := "Filtex"
+
+	// Act
+	expression := LessThanOrEqualOperator{Collation: constants.CollationCaseSensitive}.Build(constants.FieldTypeString, "Value", value, 1)
+
+	// Assert
+	assert.NotNil(t, expression)
+	assert.Equal(t, "Value <= $1", expression.Condition)
+	assert.Equal(t, []interface{}{value}, expression.Args)
+}
+
+func TestLessThanOrEqualExpression_ShouldReturnUnaccentExpression_WhenFieldTypeIsAccentInsensitiveString(t *testing.T) {
+	// Arrange
+	value := "Filtex"
+
+	// Act
+	expression := LessThanOrEqualOperator{Collation: constants.CollationAccentInsensitive}.Build(constants.FieldTypeString, "Value", value, 1)
+
+	// Assert
+	assert.NotNil(t, expression)
+	assert.Equal(t, "LOWER(unaccent(Value)) <= LOWER(unaccent($1))", expression.Condition)
+	assert.Equal(t, []interface{}{value}, expression.Args)
 }
 
 func TestLessThanOrEqualExpression_ShouldReturnNil_WhenFieldTypeIsStringArray(t *testing.T) {
@@ -91,13 +121,28 @@ func TestLessThanOrEqualExpression_ShouldReturnNil_WhenFieldTypeIsNumberArray(t
 	assert.Nil(t, expression)
 }
 
-func TestLessThanOrEqualExpression_ShouldReturnNil_WhenFieldTypeIsBoolean(t *testing.T) {
+func TestLessThanOrEqualExpression_ShouldReturnExpression_WhenFieldTypeIsBoolean(t *testing.T) {
 	// Arrange
 	// Act
-	expression := LessThanOrEqualOperator{}.Build(constants.FieldTypeBoolean, "Value", nil, 0)
+	expression := LessThanOrEqualOperator{}.Build(constants.FieldTypeBoolean, "Value", true, 1)
 
 	// Assert
-	assert.Nil(t, expression)
+	assert.NotNil(t, expression)
+	assert.Equal(t, "Value <= $1", expression.Condition)
+	assert.Equal(t, []interface{}{true}, expression.Args)
+}
+
+func TestLessThanOrEqualExpression_ShouldReturnExpression_WhenFieldTypeIsUUID(t *testing.T) {
+	// Arrange
+	value := "7a1e5b4c-0c5e-4a8e-9f5e-2d6b1c3a4e5f"
+
+	// Act
+	expression := LessThanOrEqualOperator{}.Build(constants.FieldTypeUUID, "Value", value, 1)
+
+	// Assert
+	assert.NotNil(t, expression)
+	assert.Equal(t, "Value <= $1::uuid", expression.Condition)
+	assert.Equal(t, []interface{}{value}, expression.Args)
 }
 
 func TestLessThanOrEqualExpression_ShouldReturnNil_WhenFieldTypeIsBooleanArray(t *testing.T) {
@@ -135,3 +180,19 @@ func TestLessThanOrEqualExpression_ShouldReturnNil_WhenFieldTypeIsDateTimeArray(
 	// Assert
 	assert.Nil(t, expression)
 }
+
+func TestLessThanOrEqualExpression_ShouldCompareWithRangeEnd_WhenFieldTypeIsDateTimeAndValueIsTimeRange(t *testing.T) {
+	// Arrange
+	value := &utils.TimeRange{
+		Start: time.Date(2024, 01, 05, 0, 0, 0, 0, time.UTC),
+		End:   time.Date(2024, 01, 06, 0, 0, 0, 0, time.UTC),
+	}
+
+	// Act
+	expression := LessThanOrEqualOperator{}.Build(constants.FieldTypeDateTime, "Value", value, 1)
+
+	// Assert
+	assert.NotNil(t, expression)
+	assert.Equal(t, "Value < $1", expression.Condition)
+	assert.Equal(t, []interface{}{value.End}, expression.Args)
+}
@@ -14,7 +14,7 @@ func TestNotBlankExpression_ShouldReturnExpression_WhenFieldTypeIsString(t *test
 
 	// Assert
 	assert.NotNil(t, expression)
-	assert.Equal(t, "Value IS NOT NULL AND Value <> ''", expression.Condition)
+	assert.Equal(t, "(Value IS NOT NULL AND Value <> '')", expression.Condition)
 	assert.Empty(t, expression.Args)
 }
 
@@ -25,7 +25,7 @@ func TestNotBlankExpression_ShouldReturnExpression_WhenFieldTypeIsStringArray(t
 
 	// Assert
 	assert.NotNil(t, expression)
-	assert.Equal(t, "ARRAY_LENGTH(Value, 1) <> 0", expression.Condition)
+	assert.Equal(t, "(Value IS NOT NULL AND CARDINALITY(Value) <> 0)", expression.Condition)
 	assert.Empty(t, expression.Args)
 }
 
@@ -36,7 +36,7 @@ func TestNotBlankExpression_ShouldReturnExpression_WhenFieldTypeIsNumberArray(t
 
 	// Assert
 	assert.NotNil(t, expression)
-	assert.Equal(t, "ARRAY_LENGTH(Value, 1) <> 0", expression.Condition)
+	assert.Equal(t, "(Value IS NOT NULL AND CARDINALITY(Value) <> 0)", expression.Condition)
 	assert.Empty(t, expression.Args)
 }
 
@@ -47,7 +47,7 @@ func TestNotBlankExpression_ShouldReturnExpression_WhenFieldTypeIsBooleanArray(t
 
 	// Assert
 	assert.NotNil(t, expression)
-	assert.Equal(t, "ARRAY_LENGTH(Value, 1) <> 0", expression.Condition)
+	assert.Equal(t, "(Value IS NOT NULL AND CARDINALITY(Value) <> 0)", expression.Condition)
 	assert.Empty(t, expression.Args)
 }
 
@@ -58,7 +58,7 @@ func TestNotBlankExpression_ShouldReturnExpression_WhenFieldTypeIsDateArray(t *t
 
 	// Assert
 	assert.NotNil(t, expression)
-	assert.Equal(t, "ARRAY_LENGTH(Value, 1) <> 0", expression.Condition)
+	assert.Equal(t, "(Value IS NOT NULL AND CARDINALITY(Value) <> 0)", expression.Condition)
 	assert.Empty(t, expression.Args)
 }
 
@@ -69,7 +69,7 @@ func TestNotBlankExpression_ShouldReturnExpression_WhenFieldTypeIsTimeArray(t *t
 
 	// Assert
 	assert.NotNil(t, expression)
-	assert.Equal(t, "ARRAY_LENGTH(Value, 1) <> 0", expression.Condition)
+	assert.Equal(t, "(Value IS NOT NULL AND CARDINALITY(Value) <> 0)", expression.Condition)
 	assert.Empty(t, expression.Args)
 }
 
@@ -80,51 +80,61 @@ func TestNotBlankExpression_ShouldReturnExpression_WhenFieldTypeIsDateTimeArray(
 
 	// Assert
 	assert.NotNil(t, expression)
-	assert.Equal(t, "ARRAY_LENGTH(Value, 1) <> 0", expression.Condition)
+	assert.Equal(t, "(Value IS NOT NULL AND CARDINALITY(Value) <> 0)", expression.Condition)
 	assert.Empty(t, expression.Args)
 }
 
-func TestNotBlankExpression_ShouldReturnNil_WhenFieldTypeIsNumber(t *testing.T) {
+func TestNotBlankExpression_ShouldReturnExpression_WhenFieldTypeIsNumber(t *testing.T) {
 	// Arrange
 	// Act
 	expression := NotBlankOperator{}.Build(constants.FieldTypeNumber, "Value", nil, 0)
 
 	// Assert
-	assert.Nil(t, expression)
+	assert.NotNil(t, expression)
+	assert.Equal(t, "Value IS NOT NULL", expression.Condition)
+	assert.Empty(t, expression.Args)
 }
 
-func TestNotBlankExpression_ShouldReturnNil_WhenFieldTypeIsBoolean(t *testing.T) {
+func TestNotBlankExpression_ShouldReturnExpression_WhenFieldTypeIsBoolean(t *testing.T) {
 	// Arrange
 	// Act
 	expression := NotBlankOperator{}.Build(constants.FieldTypeBoolean, "Value", nil, 0)
 
 	// Assert
-	assert.Nil(t, expression)
+	assert.NotNil(t, expression)
+	assert.Equal(t, "Value IS NOT NULL", expression.Condition)
+	assert.Empty(t, expression.Args)
 }
 
-func TestNotBlankExpression_ShouldReturnNil_WhenFieldTypeIsDate(t *testing.T) {
+func TestNotBlankExpression_ShouldReturnExpression_WhenFieldTypeIsDate(t *testing.T) {
 	// Arrange
 	// Act
 	expression := NotBlankOperator{}.Build(constants.FieldTypeDate, "Value", nil, 0)
 
 	// Assert
-	assert.Nil(t, expression)
+	assert.NotNil(t, expression)
+	assert.Equal(t, "Value IS NOT NULL", expression.Condition)
+	assert.Empty(t, expression.Args)
 }
 
-func TestNotBlankExpression_ShouldReturnNil_WhenFieldTypeIsTime(t *testing.T) {
+func TestNotBlankExpression_ShouldReturnExpression_WhenFieldTypeIsTime(t *testing.T) {
 	// Arrange
 	// Act
 	expression := NotBlankOperator{}.Build(constants.FieldTypeTime, "Value", nil, 0)
 
 	// Assert
-	assert.Nil(t, expression)
+	assert.NotNil(t, expression)
+	assert.Equal(t, "Value IS NOT NULL", expression.Condition)
+	assert.Empty(t, expression.Args)
 }
 
-func TestNotBlankExpression_ShouldReturnNil_WhenFieldTypeIsDateTime(t *testing.T) {
+func TestNotBlankExpression_ShouldReturnExpression_WhenFieldTypeIsDateTime(t *testing.T) {
 	// Arrange
 	// Act
 	expression := NotBlankOperator{}.Build(constants.FieldTypeDateTime, "Value", nil, 0)
 
 	// Assert
-	assert.Nil(t, expression)
+	assert.NotNil(t, expression)
+	assert.Equal(t, "Value IS NOT NULL", expression.Condition)
+	assert.Empty(t, expression.Args)
 }
@@ -7,19 +7,21 @@ import (
 	"github.com/filtex/filtex-go/constants"
 )
 
-type NotContainOperator struct{}
+type NotContainOperator struct {
+	Collation constants.Collation
+}
 
-func (NotContainOperator) Build(fieldType constants.FieldType, field string, value interface{}, index int) *types.PostgresExpression {
+func (o NotContainOperator) Build(fieldType constants.FieldType, field string, value interface{}, index int) *types.PostgresExpression {
 	if fieldType.IsArray() {
-		if fieldType == constants.FieldTypeStringArray {
+		if fieldType == constants.FieldTypeStringArray && !o.Collation.IsCaseSensitive() {
 			return &types.PostgresExpression{
-				Condition: fmt.Sprintf("NOT (LOWER($%v) = ANY (LOWER(%s::TEXT)::TEXT[]))", index, field),
+				Condition: orNull(field, fmt.Sprintf("NOT (%s = ANY (%s::TEXT[]))", lower(o.Collation, param(index)), lower(o.Collation, field+"::TEXT"))),
 				Args:      []interface{}{value},
 			}
 		}
 
 		return &types.PostgresExpression{
-			Condition: fmt.Sprintf("NOT ($%v = ANY (%s))", index, field),
+			Condition: orNull(field, fmt.Sprintf("NOT (%s = ANY (%s))", typed(fieldType, param(index)), field)),
 			Args:      []interface{}{value},
 		}
 	}
@@ -27,8 +29,8 @@ func (NotContainOperator) Build(fieldType constants.FieldType, field string, val
 	switch fieldType {
 	case constants.FieldTypeString:
 		return &types.PostgresExpression{
-			Condition: fmt.Sprintf("%s NOT ILIKE '%%' || $%v || '%%'", field, index),
-			Args:      []interface{}{value},
+			Condition: orNull(field, fmt.Sprintf("%s NOT %s '%%' || %s || '%%'", collate(o.Collation, field), like(o.Collation), collate(o.Collation, param(index)))),
+			Args:      []interface{}{escape(value)},
 		}
 	}
 
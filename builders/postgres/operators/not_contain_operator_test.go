@@ -17,7 +17,7 @@ func TestNotContainExpression_ShouldReturnExpression_WhenFieldTypeIsString(t *te
 
 	// Assert
 	assert.NotNil(t, expression)
-	assert.Equal(t, "Value NOT ILIKE '%' || $1 || '%'", expression.Condition)
+	assert.Equal(t, "(Value NOT ILIKE '%' || $1 || '%' OR Value IS NULL)", expression.Condition)
 	assert.Len(t, expression.Args, 1)
 	assert.Equal(t, value, expression.Args[0])
 }
@@ -31,7 +31,7 @@ func TestNotContainExpression_ShouldReturnExpression_WhenFieldTypeIsStringArray(
 
 	// Assert
 	assert.NotNil(t, expression)
-	assert.Equal(t, "NOT (LOWER($1) = ANY (LOWER(Value::TEXT)::TEXT[]))", expression.Condition)
+	assert.Equal(t, "(NOT (LOWER($1) = ANY (LOWER(Value::TEXT)::TEXT[])) OR Value IS NULL)", expression.Condition)
 	assert.Len(t, expression.Args, 1)
 	assert.Equal(t, value, expression.Args[0])
 }
@@ -45,7 +45,7 @@ func TestNotContainExpression_ShouldReturnExpression_WhenFieldTypeIsNumberArray(
 
 	// Assert
 	assert.NotNil(t, expression)
-	assert.Equal(t, "NOT ($1 = ANY (Value))", expression.Condition)
+	assert.Equal(t, "(NOT ($1 = ANY (Value)) OR Value IS NULL)", expression.Condition)
 	assert.Len(t, expression.Args, 1)
 	assert.Equal(t, value, expression.Args[0])
 }
@@ -59,7 +59,7 @@ func TestNotContainExpression_ShouldReturnExpression_WhenFieldTypeIsBooleanArray
 
 	// Assert
 	assert.NotNil(t, expression)
-	assert.Equal(t, "NOT ($1 = ANY (Value))", expression.Condition)
+	assert.Equal(t, "(NOT ($1 = ANY (Value)) OR Value IS NULL)", expression.Condition)
 	assert.Len(t, expression.Args, 1)
 	assert.Equal(t, value, expression.Args[0])
 }
@@ -73,7 +73,7 @@ func TestNotContainExpression_ShouldReturnExpression_WhenFieldTypeIsDateArray(t
 
 	// Assert
 	assert.NotNil(t, expression)
-	assert.Equal(t, "NOT ($1 = ANY (Value))", expression.Condition)
+	assert.Equal(t, "(NOT ($1 = ANY (Value)) OR Value IS NULL)", expression.Condition)
 	assert.Len(t, expression.Args, 1)
 	assert.Equal(t, value, expression.Args[0])
 }
@@ -87,7 +87,7 @@ func TestNotContainExpression_ShouldReturnExpression_WhenFieldTypeIsTimeArray(t
 
 	// Assert
 	assert.NotNil(t, expression)
-	assert.Equal(t, "NOT ($1 = ANY (Value))", expression.Condition)
+	assert.Equal(t, "(NOT ($1::time = ANY (Value)) OR Value IS NULL)", expression.Condition)
 	assert.Len(t, expression.Args, 1)
 	assert.Equal(t, value, expression.Args[0])
 }
@@ -101,7 +101,7 @@ func TestNotContainExpression_ShouldReturnExpression_WhenFieldTypeIsDateTimeArra
 
 	// Assert
 	assert.NotNil(t, expression)
-	assert.Equal(t, "NOT ($1 = ANY (Value))", expression.Condition)
+	assert.Equal(t, "(NOT ($1 = ANY (Value)) OR Value IS NULL)", expression.Condition)
 	assert.Len(t, expression.Args, 1)
 	assert.Equal(t, value, expression.Args[0])
 }
@@ -150,3 +150,29 @@ func TestNotContainExpression_ShouldReturnNil_WhenFieldTypeIsDateTime(t *testing
 	// Assert
 	assert.Nil(t, expression)
 }
+
+func TestNotContainExpression_ShouldReturnExpression_WhenFieldTypeIsStringArrayAndCollationIsCaseSensitive(t *testing.T) {
+	// Arrange
+	value := "Filtex"
+
+	// Act
+	expression := NotContainOperator{Collation: constants.CollationCaseSensitive}.Build(constants.FieldTypeStringArray, "Value", value, 1)
+
+	// Assert
+	assert.NotNil(t, expression)
+	assert.Equal(t, `(NOT ($1 = ANY (Value)) OR Value IS NULL)`, expression.Condition)
+	assert.NotEmpty(t, expression.Args)
+}
+
+func TestNotContainExpression_ShouldReturnExpression_WhenFieldTypeIsStringArrayAndCollationIsAccentInsensitive(t *testing.T) {
+	// Arrange
+	value := "Filtex"
+
+	// Act
+	expression := NotContainOperator{Collation: constants.CollationAccentInsensitive}.Build(constants.FieldTypeStringArray, "Value", value, 1)
+
+	// Assert
+	assert.NotNil(t, expression)
+	assert.Equal(t, `(NOT (LOWER(unaccent($1)) = ANY (LOWER(unaccent(Value::TEXT))::TEXT[])) OR Value IS NULL)`, expression.Condition)
+	assert.NotEmpty(t, expression.Args)
+}
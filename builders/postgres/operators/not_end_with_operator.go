@@ -7,15 +7,17 @@ import (
 	"github.com/filtex/filtex-go/constants"
 )
 
-type NotEndWithOperator struct{}
+type NotEndWithOperator struct {
+	Collation constants.Collation
+}
 
-func (NotEndWithOperator) Build(fieldType constants.FieldType, field string, value interface{}, index int) *types.PostgresExpression {
+func (o NotEndWithOperator) Build(fieldType constants.FieldType, field string, value interface{}, index int) *types.PostgresExpression {
 	if fieldType != constants.FieldTypeString {
 		return nil
 	}
 
 	return &types.PostgresExpression{
-		Condition: fmt.Sprintf("%s NOT ILIKE '%%' || $%v", field, index),
-		Args:      []interface{}{value},
+		Condition: orNull(field, fmt.Sprintf("%s NOT %s '%%' || %s", collate(o.Collation, field), like(o.Collation), collate(o.Collation, param(index)))),
+		Args:      []interface{}{escape(value)},
 	}
 }
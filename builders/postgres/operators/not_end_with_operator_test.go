@@ -16,7 +16,7 @@ func TestNotEndWithExpression_ShouldReturnExpression_WhenFieldTypeIsString(t *te
 
 	// Assert
 	assert.NotNil(t, expression)
-	assert.Equal(t, "Value NOT ILIKE '%' || $1", expression.Condition)
+	assert.Equal(t, "(Value NOT ILIKE '%' || $1 OR Value IS NULL)", expression.Condition)
 	assert.Len(t, expression.Args, 1)
 	assert.Equal(t, value, expression.Args[0])
 }
@@ -119,3 +119,29 @@ func TestNotEndWithExpression_ShouldReturnNil_WhenFieldTypeIsDateTimeArray(t *te
 	// Assert
 	assert.Nil(t, expression)
 }
+
+func TestNotEndWithExpression_ShouldReturnExpression_WhenFieldTypeIsStringAndCollationIsCaseSensitive(t *testing.T) {
+	// Arrange
+	value := "Filtex"
+
+	// Act
+	expression := NotEndWithOperator{Collation: constants.CollationCaseSensitive}.Build(constants.FieldTypeString, "Value", value, 1)
+
+	// Assert
+	assert.NotNil(t, expression)
+	assert.Equal(t, `(Value NOT LIKE '%' || $1 OR Value IS NULL)`, expression.Condition)
+	assert.NotEmpty(t, expression.Args)
+}
+
+func TestNotEndWithExpression_ShouldReturnExpression_WhenFieldTypeIsStringAndCollationIsAccentInsensitive(t *testing.T) {
+	// Arrange
+	value := "Filtex"
+
+	// Act
+	expression := NotEndWithOperator{Collation: constants.CollationAccentInsensitive}.Build(constants.FieldTypeString, "Value", value, 1)
+
+	// Assert
+	assert.NotNil(t, expression)
+	assert.Equal(t, `(unaccent(Value) NOT ILIKE '%' || unaccent($1) OR Value IS NULL)`, expression.Condition)
+	assert.NotEmpty(t, expression.Args)
+}
@@ -7,22 +7,28 @@ import (
 	"github.com/filtex/filtex-go/constants"
 )
 
-type NotEqualOperator struct{}
+type NotEqualOperator struct {
+	Collation constants.Collation
+}
 
-func (NotEqualOperator) Build(fieldType constants.FieldType, field string, value interface{}, index int) *types.PostgresExpression {
+func (o NotEqualOperator) Build(fieldType constants.FieldType, field string, value interface{}, index int) *types.PostgresExpression {
 	if fieldType.IsArray() {
 		return nil
 	}
 
-	if fieldType == constants.FieldTypeString {
+	if hasRange(fieldType, value) {
+		return notEqualRange(fieldType, field, value, index)
+	}
+
+	if fieldType == constants.FieldTypeString && !o.Collation.IsCaseSensitive() {
 		return &types.PostgresExpression{
-			Condition: fmt.Sprintf("%s NOT ILIKE $%v", field, index),
-			Args:      []interface{}{value},
+			Condition: orNull(field, fmt.Sprintf("%s NOT ILIKE %s", collate(o.Collation, field), collate(o.Collation, param(index)))),
+			Args:      []interface{}{escape(value)},
 		}
 	}
 
 	return &types.PostgresExpression{
-		Condition: fmt.Sprintf("%s <> $%v", field, index),
+		Condition: orNull(field, fmt.Sprintf("%s <> %s", field, typed(fieldType, param(index)))),
 		Args:      []interface{}{value},
 	}
 }
@@ -5,6 +5,7 @@ import (
 	"time"
 
 	"github.com/filtex/filtex-go/constants"
+	"github.com/filtex/filtex-go/utils"
 	"github.com/stretchr/testify/assert"
 )
 
@@ -17,7 +18,7 @@ func TestNotEqualExpression_ShouldReturnExpression_WhenFieldTypeIsString(t *test
 
 	// Assert
 	assert.NotNil(t, expression)
-	assert.Equal(t, "Value NOT ILIKE $1", expression.Condition)
+	assert.Equal(t, "(Value NOT ILIKE $1 OR Value IS NULL)", expression.Condition)
 	assert.Len(t, expression.Args, 1)
 	assert.Equal(t, value, expression.Args[0])
 }
@@ -31,7 +32,7 @@ func TestNotEqualExpression_ShouldReturnExpression_WhenFieldTypeIsNumber(t *test
 
 	// Assert
 	assert.NotNil(t, expression)
-	assert.Equal(t, "Value <> $1", expression.Condition)
+	assert.Equal(t, "(Value <> $1 OR Value IS NULL)", expression.Condition)
 	assert.Len(t, expression.Args, 1)
 	assert.Equal(t, value, expression.Args[0])
 }
@@ -45,23 +46,25 @@ func TestNotEqualExpression_ShouldReturnExpression_WhenFieldTypeIsBoolean(t *tes
 
 	// Assert
 	assert.NotNil(t, expression)
-	assert.Equal(t, "Value <> $1", expression.Condition)
+	assert.Equal(t, "(Value <> $1 OR Value IS NULL)", expression.Condition)
 	assert.Len(t, expression.Args, 1)
 	assert.Equal(t, value, expression.Args[0])
 }
 
 func TestNotEqualExpression_ShouldReturnExpression_WhenFieldTypeIsDate(t *testing.T) {
 	// Arrange
-	value := time.Now()
+	location := time.FixedZone("UTC+3", 3*60*60)
+	value := time.Date(2024, 03, 10, 15, 30, 0, 0, location)
 
 	// Act
 	expression := NotEqualOperator{}.Build(constants.FieldTypeDate, "Value", value, 1)
 
 	// Assert
 	assert.NotNil(t, expression)
-	assert.Equal(t, "Value <> $1", expression.Condition)
-	assert.Len(t, expression.Args, 1)
-	assert.Equal(t, value, expression.Args[0])
+	assert.Equal(t, "(Value < $1 OR Value >= $2 OR Value IS NULL)", expression.Condition)
+	assert.Len(t, expression.Args, 2)
+	assert.Equal(t, time.Date(2024, 03, 10, 0, 0, 0, 0, location), expression.Args[0])
+	assert.Equal(t, time.Date(2024, 03, 11, 0, 0, 0, 0, location), expression.Args[1])
 }
 
 func TestNotEqualExpression_ShouldReturnExpression_WhenFieldTypeIsTime(t *testing.T) {
@@ -73,7 +76,7 @@ func TestNotEqualExpression_ShouldReturnExpression_WhenFieldTypeIsTime(t *testin
 
 	// Assert
 	assert.NotNil(t, expression)
-	assert.Equal(t, "Value <> $1", expression.Condition)
+	assert.Equal(t, "(Value <> $1::time OR Value IS NULL)", expression.Condition)
 	assert.Len(t, expression.Args, 1)
 	assert.Equal(t, value, expression.Args[0])
 }
@@ -87,7 +90,7 @@ func TestNotEqualExpression_ShouldReturnExpression_WhenFieldTypeIsDateTime(t *te
 
 	// Assert
 	assert.NotNil(t, expression)
-	assert.Equal(t, "Value <> $1", expression.Condition)
+	assert.Equal(t, "(Value <> $1 OR Value IS NULL)", expression.Condition)
 	assert.Len(t, expression.Args, 1)
 	assert.Equal(t, value, expression.Args[0])
 }
@@ -145,3 +148,45 @@ func TestNotEqualExpression_ShouldReturnNil_WhenFieldTypeIsDateTimeArray(t *test
 	// Assert
 	assert.Nil(t, expression)
 }
+
+func TestNotEqualExpression_ShouldReturnExpression_WhenFieldTypeIsStringAndCollationIsCaseSensitive(t *testing.T) {
+	// Arrange
+	value := "Filtex"
+
+	// Act
+	expression := NotEqualOperator{Collation: constants.CollationCaseSensitive}.Build(constants.FieldTypeString, "Value", value, 1)
+
+	// Assert
+	assert.NotNil(t, expression)
+	assert.Equal(t, `(Value <> $1 OR Value IS NULL)`, expression.Condition)
+	assert.NotEmpty(t, expression.Args)
+}
+
+func TestNotEqualExpression_ShouldReturnExpression_WhenFieldTypeIsStringAndCollationIsAccentInsensitive(t *testing.T) {
+	// Arrange
+	value := "Filtex"
+
+	// Act
+	expression := NotEqualOperator{Collation: constants.CollationAccentInsensitive}.Build(constants.FieldTypeString, "Value", value, 1)
+
+	// Assert
+	assert.NotNil(t, expression)
+	assert.Equal(t, `(unaccent(Value) NOT ILIKE unaccent($1) OR Value IS NULL)`, expression.Condition)
+	assert.NotEmpty(t, expression.Args)
+}
+
+func TestNotEqualExpression_ShouldReturnRangeExpression_WhenFieldTypeIsDateTimeAndValueIsTimeRange(t *testing.T) {
+	// Arrange
+	value := &utils.TimeRange{
+		Start: time.Date(2024, 01, 05, 10, 0, 0, 0, time.UTC),
+		End:   time.Date(2024, 01, 05, 11, 0, 0, 0, time.UTC),
+	}
+
+	// Act
+	expression := NotEqualOperator{}.Build(constants.FieldTypeDateTime, "Value", value, 1)
+
+	// Assert
+	assert.NotNil(t, expression)
+	assert.Equal(t, "(Value < $1 OR Value >= $2 OR Value IS NULL)", expression.Condition)
+	assert.Equal(t, []interface{}{value.Start, value.End}, expression.Args)
+}
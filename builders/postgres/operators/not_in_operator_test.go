@@ -5,6 +5,7 @@ import (
 	"time"
 
 	"github.com/filtex/filtex-go/constants"
+	"github.com/filtex/filtex-go/utils"
 	"github.com/stretchr/testify/assert"
 )
 
@@ -17,7 +18,7 @@ func TestNotInExpression_ShouldReturnExpression_WhenFieldTypeIsString(t *testing
 
 	// Assert
 	assert.NotNil(t, expression)
-	assert.Equal(t, "LOWER(Value) NOT IN (LOWER($1))", expression.Condition)
+	assert.Equal(t, "(LOWER(Value) NOT IN (LOWER($1)) OR Value IS NULL)", expression.Condition)
 	assert.Len(t, expression.Args, 1)
 	assert.Equal(t, value, expression.Args[0])
 }
@@ -31,7 +32,7 @@ func TestNotInExpression_ShouldReturnExpression_WhenFieldTypeIsNumber(t *testing
 
 	// Assert
 	assert.NotNil(t, expression)
-	assert.Equal(t, "Value NOT IN ($1)", expression.Condition)
+	assert.Equal(t, "(Value NOT IN ($1) OR Value IS NULL)", expression.Condition)
 	assert.Len(t, expression.Args, 1)
 	assert.Equal(t, value, expression.Args[0])
 }
@@ -45,23 +46,25 @@ func TestNotInExpression_ShouldReturnExpression_WhenFieldTypeIsBoolean(t *testin
 
 	// Assert
 	assert.NotNil(t, expression)
-	assert.Equal(t, "Value NOT IN ($1)", expression.Condition)
+	assert.Equal(t, "(Value NOT IN ($1) OR Value IS NULL)", expression.Condition)
 	assert.Len(t, expression.Args, 1)
 	assert.Equal(t, value, expression.Args[0])
 }
 
 func TestNotInExpression_ShouldReturnExpression_WhenFieldTypeIsDate(t *testing.T) {
 	// Arrange
-	value := time.Now()
+	location := time.FixedZone("UTC+3", 3*60*60)
+	value := time.Date(2024, 03, 10, 15, 30, 0, 0, location)
 
 	// Act
 	expression := NotInOperator{}.Build(constants.FieldTypeDate, "Value", value, 1)
 
 	// Assert
 	assert.NotNil(t, expression)
-	assert.Equal(t, "Value NOT IN ($1)", expression.Condition)
-	assert.Len(t, expression.Args, 1)
-	assert.Equal(t, value, expression.Args[0])
+	assert.Equal(t, "(Value < $1 OR Value >= $2 OR Value IS NULL)", expression.Condition)
+	assert.Len(t, expression.Args, 2)
+	assert.Equal(t, time.Date(2024, 03, 10, 0, 0, 0, 0, location), expression.Args[0])
+	assert.Equal(t, time.Date(2024, 03, 11, 0, 0, 0, 0, location), expression.Args[1])
 }
 
 func TestNotInExpression_ShouldReturnExpression_WhenFieldTypeIsTime(t *testing.T) {
@@ -73,7 +76,7 @@ func TestNotInExpression_ShouldReturnExpression_WhenFieldTypeIsTime(t *testing.T
 
 	// Assert
 	assert.NotNil(t, expression)
-	assert.Equal(t, "Value NOT IN ($1)", expression.Condition)
+	assert.Equal(t, "(Value NOT IN ($1::time) OR Value IS NULL)", expression.Condition)
 	assert.Len(t, expression.Args, 1)
 	assert.Equal(t, value, expression.Args[0])
 }
@@ -87,7 +90,7 @@ func TestNotInExpression_ShouldReturnExpression_WhenFieldTypeIsDateTime(t *testi
 
 	// Assert
 	assert.NotNil(t, expression)
-	assert.Equal(t, "Value NOT IN ($1)", expression.Condition)
+	assert.Equal(t, "(Value NOT IN ($1) OR Value IS NULL)", expression.Condition)
 	assert.Len(t, expression.Args, 1)
 	assert.Equal(t, value, expression.Args[0])
 }
@@ -145,3 +148,74 @@ func TestNotInExpression_ShouldReturnNil_WhenFieldTypeIsDateTimeArray(t *testing
 	// Assert
 	assert.Nil(t, expression)
 }
+
+func TestNotInExpression_ShouldReturnExpression_WhenFieldTypeIsStringAndCollationIsCaseSensitive(t *testing.T) {
+	// Arrange
+	value := []interface{}{"Filtex", "Go"}
+
+	// Act
+	expression := NotInOperator{Collation: constants.CollationCaseSensitive}.Build(constants.FieldTypeString, "Value", value, 1)
+
+	// Assert
+	assert.NotNil(t, expression)
+	assert.Equal(t, `(Value NOT IN ($1,$2) OR Value IS NULL)`, expression.Condition)
+	assert.NotEmpty(t, expression.Args)
+}
+
+func TestNotInExpression_ShouldReturnExpression_WhenFieldTypeIsStringAndCollationIsAccentInsensitive(t *testing.T) {
+	// Arrange
+	value := []interface{}{"Filtex", "Go"}
+
+	// Act
+	expression := NotInOperator{Collation: constants.CollationAccentInsensitive}.Build(constants.FieldTypeString, "Value", value, 1)
+
+	// Assert
+	assert.NotNil(t, expression)
+	assert.Equal(t, `(LOWER(unaccent(Value)) NOT IN (LOWER(unaccent($1)),LOWER(unaccent($2))) OR Value IS NULL)`, expression.Condition)
+	assert.NotEmpty(t, expression.Args)
+}
+
+func TestNotInExpression_ShouldReturnDayRanges_WhenFieldTypeIsDateAndValueIsArray(t *testing.T) {
+	// Arrange
+	value := []interface{}{
+		time.Date(2024, 03, 10, 0, 0, 0, 0, time.UTC),
+		time.Date(2024, 03, 12, 0, 0, 0, 0, time.UTC),
+	}
+
+	// Act
+	expression := NotInOperator{}.Build(constants.FieldTypeDate, "Value", value, 3)
+
+	// Assert
+	assert.NotNil(t, expression)
+	assert.Equal(t, "((Value < $3 OR Value >= $4 OR Value IS NULL) AND (Value < $5 OR Value >= $6 OR Value IS NULL))", expression.Condition)
+	assert.Equal(t, []interface{}{
+		time.Date(2024, 03, 10, 0, 0, 0, 0, time.UTC),
+		time.Date(2024, 03, 11, 0, 0, 0, 0, time.UTC),
+		time.Date(2024, 03, 12, 0, 0, 0, 0, time.UTC),
+		time.Date(2024, 03, 13, 0, 0, 0, 0, time.UTC),
+	}, expression.Args)
+}
+
+func TestNotInExpression_ShouldReturnRangeExpressions_WhenFieldTypeIsDateTimeAndValuesContainTimeRange(t *testing.T) {
+	// Arrange
+	instant := time.Date(2024, 01, 07, 10, 30, 0, 0, time.UTC)
+	value := []interface{}{
+		&utils.TimeRange{
+			Start: time.Date(2024, 01, 01, 0, 0, 0, 0, time.UTC),
+			End:   time.Date(2024, 02, 01, 0, 0, 0, 0, time.UTC),
+		},
+		instant,
+	}
+
+	// Act
+	expression := NotInOperator{}.Build(constants.FieldTypeDateTime, "Value", value, 1)
+
+	// Assert
+	assert.NotNil(t, expression)
+	assert.Equal(t, "((Value < $1 OR Value >= $2 OR Value IS NULL) AND (Value <> $3 OR Value IS NULL))", expression.Condition)
+	assert.Equal(t, []interface{}{
+		time.Date(2024, 01, 01, 0, 0, 0, 0, time.UTC),
+		time.Date(2024, 02, 01, 0, 0, 0, 0, time.UTC),
+		instant,
+	}, expression.Args)
+}
@@ -0,0 +1,29 @@
+package operators
+
+import (
+	"testing"
+
+	"github.com/filtex/filtex-go/constants"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNotInSubnetExpression_ShouldReturnExpression_WhenFieldTypeIsIP(t *testing.T) {
+	// Arrange
+	value := "2001:db8::1/32"
+
+	// Act
+	expression := NotInSubnetOperator{}.Build(constants.FieldTypeIP, "Value", value, 1)
+
+	// Assert
+	assert.NotNil(t, expression)
+	assert.Equal(t, "(NOT (Value <<= $1::inet) OR Value IS NULL)", expression.Condition)
+	assert.Equal(t, []interface{}{"2001:db8::/32"}, expression.Args)
+}
+
+func TestNotInSubnetExpression_ShouldReturnNil_WhenFieldTypeIsNotIP(t *testing.T) {
+	// Act
+	expression := NotInSubnetOperator{}.Build(constants.FieldTypeIPArray, "Value", "10.0.0.0/8", 1)
+
+	// Assert
+	assert.Nil(t, expression)
+}
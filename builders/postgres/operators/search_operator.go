@@ -0,0 +1,34 @@
+package operators
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/filtex/filtex-go/builders/postgres/types"
+)
+
+type SearchOperator struct{}
+
+func (SearchOperator) Build(fields []string, value string, index int) *types.PostgresExpression {
+	if len(fields) == 0 {
+		return nil
+	}
+
+	if len(fields) == 1 {
+		return &types.PostgresExpression{
+			Condition: fmt.Sprintf("to_tsvector(%s::TEXT) @@ plainto_tsquery($%v)", fields[0], index),
+			Args:      []interface{}{value},
+		}
+	}
+
+	documents := make([]string, 0)
+
+	for _, v := range fields {
+		documents = append(documents, fmt.Sprintf("COALESCE(%s::TEXT, '')", v))
+	}
+
+	return &types.PostgresExpression{
+		Condition: fmt.Sprintf("to_tsvector(%s) @@ plainto_tsquery($%v)", strings.Join(documents, " || ' ' || "), index),
+		Args:      []interface{}{value},
+	}
+}
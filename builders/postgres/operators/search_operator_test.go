@@ -0,0 +1,35 @@
+package operators
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSearchExpression_ShouldReturnNil_WhenFieldsAreEmpty(t *testing.T) {
+	// Act
+	expression := SearchOperator{}.Build([]string{}, "red shoes", 1)
+
+	// Assert
+	assert.Nil(t, expression)
+}
+
+func TestSearchExpression_ShouldReturnExpression_WhenThereIsSingleField(t *testing.T) {
+	// Act
+	expression := SearchOperator{}.Build([]string{"Description"}, "red shoes", 1)
+
+	// Assert
+	assert.NotNil(t, expression)
+	assert.Equal(t, "to_tsvector(Description::TEXT) @@ plainto_tsquery($1)", expression.Condition)
+	assert.Equal(t, []interface{}{"red shoes"}, expression.Args)
+}
+
+func TestSearchExpression_ShouldReturnExpression_WhenThereAreMultipleFields(t *testing.T) {
+	// Act
+	expression := SearchOperator{}.Build([]string{"Name", "Description"}, "red shoes", 2)
+
+	// Assert
+	assert.NotNil(t, expression)
+	assert.Equal(t, "to_tsvector(COALESCE(Name::TEXT, '') || ' ' || COALESCE(Description::TEXT, '')) @@ plainto_tsquery($2)", expression.Condition)
+	assert.Equal(t, []interface{}{"red shoes"}, expression.Args)
+}
@@ -7,15 +7,17 @@ import (
 	"github.com/filtex/filtex-go/constants"
 )
 
-type StartWithOperator struct{}
+type StartWithOperator struct {
+	Collation constants.Collation
+}
 
-func (StartWithOperator) Build(fieldType constants.FieldType, field string, value interface{}, index int) *types.PostgresExpression {
+func (o StartWithOperator) Build(fieldType constants.FieldType, field string, value interface{}, index int) *types.PostgresExpression {
 	if fieldType != constants.FieldTypeString {
 		return nil
 	}
 
 	return &types.PostgresExpression{
-		Condition: fmt.Sprintf("%s ILIKE $%v || '%%'", field, index),
-		Args:      []interface{}{value},
+		Condition: fmt.Sprintf("%s %s %s || '%%'", collate(o.Collation, field), like(o.Collation), collate(o.Collation, param(index))),
+		Args:      []interface{}{escape(value)},
 	}
 }
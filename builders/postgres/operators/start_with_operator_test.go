@@ -119,3 +119,29 @@ func TestStartWithExpression_ShouldReturnNil_WhenFieldTypeIsDateTimeArray(t *tes
 	// Assert
 	assert.Nil(t, expression)
 }
+
+func TestStartWithExpression_ShouldReturnExpression_WhenFieldTypeIsStringAndCollationIsCaseSensitive(t *testing.T) {
+	// Arrange
+	value := "Filtex"
+
+	// Act
+	expression := StartWithOperator{Collation: constants.CollationCaseSensitive}.Build(constants.FieldTypeString, "Value", value, 1)
+
+	// Assert
+	assert.NotNil(t, expression)
+	assert.Equal(t, `Value LIKE $1 || '%'`, expression.Condition)
+	assert.NotEmpty(t, expression.Args)
+}
+
+func TestStartWithExpression_ShouldReturnExpression_WhenFieldTypeIsStringAndCollationIsAccentInsensitive(t *testing.T) {
+	// Arrange
+	value := "Filtex"
+
+	// Act
+	expression := StartWithOperator{Collation: constants.CollationAccentInsensitive}.Build(constants.FieldTypeString, "Value", value, 1)
+
+	// Assert
+	assert.NotNil(t, expression)
+	assert.Equal(t, `unaccent(Value) ILIKE unaccent($1) || '%'`, expression.Condition)
+	assert.NotEmpty(t, expression.Args)
+}
@@ -0,0 +1,26 @@
+package operators
+
+import (
+	"fmt"
+
+	"github.com/filtex/filtex-go/constants"
+)
+
+func typed(fieldType constants.FieldType, expression string) string {
+	switch fieldType {
+	case constants.FieldTypeUUID, constants.FieldTypeUUIDArray:
+		return fmt.Sprintf("%s::uuid", expression)
+	case constants.FieldTypeIP, constants.FieldTypeIPArray:
+		return fmt.Sprintf("%s::inet", expression)
+	case constants.FieldTypeTime, constants.FieldTypeTimeArray:
+		return fmt.Sprintf("%s::time", expression)
+	case constants.FieldTypeDuration, constants.FieldTypeDurationArray:
+		return fmt.Sprintf("%s::interval", expression)
+	}
+
+	return expression
+}
+
+func orNull(field string, condition string) string {
+	return fmt.Sprintf("(%s OR %s IS NULL)", condition, field)
+}
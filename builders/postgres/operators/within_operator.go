@@ -0,0 +1,47 @@
+package operators
+
+import (
+	"fmt"
+
+	"github.com/filtex/filtex-go/builders/postgres/types"
+	"github.com/filtex/filtex-go/constants"
+	"github.com/filtex/filtex-go/utils"
+)
+
+type WithinOperator struct{}
+
+func (o WithinOperator) Build(fieldType constants.FieldType, field string, value interface{}, index int) *types.PostgresExpression {
+	if fieldType != constants.FieldTypeGeoPoint {
+		return nil
+	}
+
+	area, err := utils.GeoArea(value)
+	if err != nil {
+		return nil
+	}
+
+	switch a := area.(type) {
+	case *utils.GeoCircle:
+		return &types.PostgresExpression{
+			Condition: fmt.Sprintf("ST_DWithin(%s::geography, ST_SetSRID(ST_MakePoint(%s, %s), 4326)::geography, %s)",
+				field, param(index), param(index+1), param(index+2)),
+			Args: []interface{}{a.Center.Lng, a.Center.Lat, a.Radius},
+		}
+	case *utils.GeoBox:
+		if a.SouthWest.Lng > a.NorthEast.Lng {
+			return &types.PostgresExpression{
+				Condition: fmt.Sprintf("(ST_Intersects(%[1]s::geometry, ST_MakeEnvelope(%[2]s, %[3]s, 180, %[5]s, 4326)) OR ST_Intersects(%[1]s::geometry, ST_MakeEnvelope(-180, %[3]s, %[4]s, %[5]s, 4326)))",
+					field, param(index), param(index+1), param(index+2), param(index+3)),
+				Args: []interface{}{a.SouthWest.Lng, a.SouthWest.Lat, a.NorthEast.Lng, a.NorthEast.Lat},
+			}
+		}
+
+		return &types.PostgresExpression{
+			Condition: fmt.Sprintf("ST_Intersects(%s::geometry, ST_MakeEnvelope(%s, %s, %s, %s, 4326))",
+				field, param(index), param(index+1), param(index+2), param(index+3)),
+			Args: []interface{}{a.SouthWest.Lng, a.SouthWest.Lat, a.NorthEast.Lng, a.NorthEast.Lat},
+		}
+	}
+
+	return nil
+}
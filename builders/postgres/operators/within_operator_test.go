@@ -0,0 +1,63 @@
+package operators
+
+import (
+	"testing"
+
+	"github.com/filtex/filtex-go/constants"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestWithinExpression_ShouldReturnDistanceExpression_WhenValueIsCircle(t *testing.T) {
+	// Arrange
+	value := "2.5km of (40.7128, -74.006)"
+
+	// Act
+	expression := WithinOperator{}.Build(constants.FieldTypeGeoPoint, "Value", value, 2)
+
+	// Assert
+	assert.NotNil(t, expression)
+	assert.Equal(t, "ST_DWithin(Value::geography, ST_SetSRID(ST_MakePoint($2, $3), 4326)::geography, $4)", expression.Condition)
+	assert.Equal(t, []interface{}{-74.006, 40.7128, 2500.0}, expression.Args)
+}
+
+func TestWithinExpression_ShouldReturnEnvelopeExpression_WhenValueIsBox(t *testing.T) {
+	// Arrange
+	value := "box(40, -75, 41, -73)"
+
+	// Act
+	expression := WithinOperator{}.Build(constants.FieldTypeGeoPoint, "Value", value, 1)
+
+	// Assert
+	assert.NotNil(t, expression)
+	assert.Equal(t, "ST_Intersects(Value::geometry, ST_MakeEnvelope($1, $2, $3, $4, 4326))", expression.Condition)
+	assert.Equal(t, []interface{}{-75.0, 40.0, -73.0, 41.0}, expression.Args)
+}
+
+func TestWithinExpression_ShouldSplitEnvelope_WhenBoxCrossesAntimeridian(t *testing.T) {
+	// Arrange
+	value := "box(-20, 170, -10, -170)"
+
+	// Act
+	expression := WithinOperator{}.Build(constants.FieldTypeGeoPoint, "Value", value, 1)
+
+	// Assert
+	assert.NotNil(t, expression)
+	assert.Equal(t, "(ST_Intersects(Value::geometry, ST_MakeEnvelope($1, $2, 180, $4, 4326)) OR ST_Intersects(Value::geometry, ST_MakeEnvelope(-180, $2, $3, $4, 4326)))", expression.Condition)
+	assert.Equal(t, []interface{}{170.0, -20.0, -170.0, -10.0}, expression.Args)
+}
+
+func TestWithinExpression_ShouldReturnNil_WhenValueIsNotValid(t *testing.T) {
+	// Act
+	expression := WithinOperator{}.Build(constants.FieldTypeGeoPoint, "Value", "5km of (91, 0)", 1)
+
+	// Assert
+	assert.Nil(t, expression)
+}
+
+func TestWithinExpression_ShouldReturnNil_WhenFieldTypeIsNotGeoPoint(t *testing.T) {
+	// Act
+	expression := WithinOperator{}.Build(constants.FieldTypeString, "Value", "5km of (40, 0)", 1)
+
+	// Assert
+	assert.Nil(t, expression)
+}
@@ -1,21 +1,31 @@
 package postgres
 
 import (
+	"fmt"
+	"strconv"
+
 	"github.com/filtex/filtex-go/builders/postgres/logics"
 	"github.com/filtex/filtex-go/builders/postgres/operators"
 	"github.com/filtex/filtex-go/builders/postgres/types"
 	"github.com/filtex/filtex-go/constants"
 	"github.com/filtex/filtex-go/errors"
 	"github.com/filtex/filtex-go/expressions"
+	"github.com/filtex/filtex-go/utils"
 )
 
 type PostgresFilterBuilder struct {
-	logicsMap    map[constants.Logic]func(expressions []types.PostgresExpression) *types.PostgresExpression
-	operatorsMap map[constants.Operator]func(fieldType constants.FieldType, field string, value interface{}, index int) *types.PostgresExpression
+	startIndex    int
+	columns       map[string]string
+	columnMapper  func(field string) string
+	logicsMap     map[constants.Logic]func(expressions []types.PostgresExpression) *types.PostgresExpression
+	operatorsMap  map[constants.Operator]func(fieldType constants.FieldType, field string, value interface{}, index int) *types.PostgresExpression
+	collationsMap map[constants.Collation]map[constants.Operator]func(fieldType constants.FieldType, field string, value interface{}, index int) *types.PostgresExpression
 }
 
 func NewPostgresFilterBuilder() *PostgresFilterBuilder {
 	return &PostgresFilterBuilder{
+		startIndex: 1,
+		columns:    map[string]string{},
 		logicsMap: map[constants.Logic]func(expressions []types.PostgresExpression) *types.PostgresExpression{
 			constants.LogicAnd: logics.AndLogic{}.Build,
 			constants.LogicOr:  logics.OrLogic{}.Build,
@@ -31,18 +41,67 @@ func NewPostgresFilterBuilder() *PostgresFilterBuilder {
 			constants.OperatorNotEndWith:         operators.NotEndWithOperator{}.Build,
 			constants.OperatorBlank:              operators.BlankOperator{}.Build,
 			constants.OperatorNotBlank:           operators.NotBlankOperator{}.Build,
+			constants.OperatorIsNull:             operators.IsNullOperator{}.Build,
+			constants.OperatorIsNotNull:          operators.IsNotNullOperator{}.Build,
 			constants.OperatorGreaterThan:        operators.GreaterThanOperator{}.Build,
 			constants.OperatorGreaterThanOrEqual: operators.GreaterThanOrEqualOperator{}.Build,
 			constants.OperatorLessThan:           operators.LessThanOperator{}.Build,
 			constants.OperatorLessThanOrEqual:    operators.LessThanOrEqualOperator{}.Build,
 			constants.OperatorIn:                 operators.InOperator{}.Build,
 			constants.OperatorNotIn:              operators.NotInOperator{}.Build,
+			constants.OperatorInSubnet:           operators.InSubnetOperator{}.Build,
+			constants.OperatorNotInSubnet:        operators.NotInSubnetOperator{}.Build,
+			constants.OperatorWithin:             operators.WithinOperator{}.Build,
+		},
+		collationsMap: map[constants.Collation]map[constants.Operator]func(fieldType constants.FieldType, field string, value interface{}, index int) *types.PostgresExpression{
+			constants.CollationCaseSensitive:     newCollationOperatorsMap(constants.CollationCaseSensitive),
+			constants.CollationAccentInsensitive: newCollationOperatorsMap(constants.CollationAccentInsensitive),
 		},
 	}
 }
 
+func newCollationOperatorsMap(collation constants.Collation) map[constants.Operator]func(fieldType constants.FieldType, field string, value interface{}, index int) *types.PostgresExpression {
+	return map[constants.Operator]func(fieldType constants.FieldType, field string, value interface{}, index int) *types.PostgresExpression{
+		constants.OperatorEqual:              operators.EqualOperator{Collation: collation}.Build,
+		constants.OperatorNotEqual:           operators.NotEqualOperator{Collation: collation}.Build,
+		constants.OperatorContain:            operators.ContainOperator{Collation: collation}.Build,
+		constants.OperatorNotContain:         operators.NotContainOperator{Collation: collation}.Build,
+		constants.OperatorStartWith:          operators.StartWithOperator{Collation: collation}.Build,
+		constants.OperatorNotStartWith:       operators.NotStartWithOperator{Collation: collation}.Build,
+		constants.OperatorEndWith:            operators.EndWithOperator{Collation: collation}.Build,
+		constants.OperatorNotEndWith:         operators.NotEndWithOperator{Collation: collation}.Build,
+		constants.OperatorIn:                 operators.InOperator{Collation: collation}.Build,
+		constants.OperatorNotIn:              operators.NotInOperator{Collation: collation}.Build,
+		constants.OperatorGreaterThan:        operators.GreaterThanOperator{Collation: collation}.Build,
+		constants.OperatorGreaterThanOrEqual: operators.GreaterThanOrEqualOperator{Collation: collation}.Build,
+		constants.OperatorLessThan:           operators.LessThanOperator{Collation: collation}.Build,
+		constants.OperatorLessThanOrEqual:    operators.LessThanOrEqualOperator{Collation: collation}.Build,
+	}
+}
+
+func (b *PostgresFilterBuilder) StartIndex(index int) *PostgresFilterBuilder {
+	b.startIndex = index
+	return b
+}
+
+func (b *PostgresFilterBuilder) Columns(columns map[string]string) *PostgresFilterBuilder {
+	for k, v := range columns {
+		b.columns[k] = v
+	}
+	return b
+}
+
+func (b *PostgresFilterBuilder) ColumnMapper(mapper func(field string) string) *PostgresFilterBuilder {
+	b.columnMapper = mapper
+	return b
+}
+
 func (b *PostgresFilterBuilder) Build(ex expressions.Expression) (*types.PostgresExpression, error) {
-	index := 1
+	if b.startIndex < 1 {
+		return nil, errors.NewCouldNotBeBuiltError()
+	}
+
+	index := b.startIndex
 	return b.buildInternal(ex, &index)
 }
 
@@ -65,14 +124,80 @@ func (b *PostgresFilterBuilder) buildInternal(ex expressions.Expression, index *
 
 		return nil, errors.NewCouldNotBeBuiltError()
 	case *expressions.OperatorExpression:
-		if fn, ok := b.operatorsMap[exp.Operator]; ok {
-			result := fn(exp.Type, exp.Field, exp.Value, *index)
+		if fn, ok := b.findOperator(exp.Operator, exp.Collation); ok {
+			result := fn(exp.Type, b.column(exp.Field), castValue(exp.Type, exp.Value), *index)
+			if result == nil {
+				return nil, errors.NewCouldNotBeBuiltError()
+			}
+
 			*index += len(result.Args)
 			return result, nil
 		}
 
 		return nil, errors.NewCouldNotBeBuiltError()
+	case *expressions.SearchExpression:
+		fields := make([]string, 0)
+
+		for _, v := range exp.Fields {
+			fields = append(fields, b.column(v))
+		}
+
+		result := operators.SearchOperator{}.Build(fields, exp.Value, *index)
+		if result == nil {
+			return nil, errors.NewCouldNotBeBuiltError()
+		}
+
+		*index += len(result.Args)
+		return result, nil
 	}
 
 	return nil, errors.NewCouldNotBeBuiltError()
 }
+
+func (b *PostgresFilterBuilder) findOperator(operator constants.Operator, collation constants.Collation) (func(fieldType constants.FieldType, field string, value interface{}, index int) *types.PostgresExpression, bool) {
+	if fn, ok := b.collationsMap[collation][operator]; ok {
+		return fn, true
+	}
+
+	fn, ok := b.operatorsMap[operator]
+	return fn, ok
+}
+
+func (b *PostgresFilterBuilder) column(field string) string {
+	return quoteIdentifier(utils.MapField(b.columns, b.columnMapper, field))
+}
+
+func castValue(fieldType constants.FieldType, value interface{}) interface{} {
+	if values, ok := value.([]interface{}); ok {
+		result := make([]interface{}, 0)
+
+		for _, v := range values {
+			result = append(result, castValue(fieldType, v))
+		}
+
+		return result
+	}
+
+	if value == nil {
+		return value
+	}
+
+	switch fieldType {
+	case constants.FieldTypeTime, constants.FieldTypeTimeArray:
+		seconds, err := utils.Time(value)
+		if err != nil {
+			return value
+		}
+
+		return fmt.Sprintf("%02d:%02d:%02d", *seconds/3600, *seconds%3600/60, *seconds%60)
+	case constants.FieldTypeDuration, constants.FieldTypeDurationArray:
+		duration, err := utils.Duration(value)
+		if err != nil {
+			return value
+		}
+
+		return strconv.FormatFloat(duration.Seconds(), 'f', -1, 64) + " seconds"
+	}
+
+	return value
+}
@@ -1,7 +1,9 @@
 package postgres
 
 import (
+	"strings"
 	"testing"
+	"time"
 
 	"github.com/filtex/filtex-go/builders/postgres/types"
 	"github.com/filtex/filtex-go/constants"
@@ -76,6 +78,19 @@ func TestBuild_ShouldReturnError_WhenExpressionIsOperatorExpressionAndNotValidOp
 	assert.Error(t, err)
 }
 
+func TestBuild_ShouldReturnError_WhenOperatorDoesNotSupportFieldType(t *testing.T) {
+	// Arrange
+	builder := NewPostgresFilterBuilder()
+	operatorExpression := expressions.NewOperatorExpression(constants.FieldTypeIP, "Value", constants.OperatorGreaterThan, "127.0.0.1")
+
+	// Act
+	expression, err := builder.Build(operatorExpression)
+
+	// Assert
+	assert.Nil(t, expression)
+	assert.Error(t, err)
+}
+
 func TestBuild_ShouldReturnExpression_WhenExpressionIsOperatorExpressionAndValid(t *testing.T) {
 	// Arrange
 	builder := NewPostgresFilterBuilder()
@@ -90,3 +105,156 @@ func TestBuild_ShouldReturnExpression_WhenExpressionIsOperatorExpressionAndValid
 	assert.IsType(t, postgresExpression, expression)
 	assert.NoError(t, err)
 }
+
+func TestBuild_ShouldUseCollation_WhenExpressionHasCollation(t *testing.T) {
+	// Arrange
+	builder := NewPostgresFilterBuilder()
+	samples := map[constants.Collation]string{
+		constants.CollationDefault:           `Value ILIKE $1`,
+		constants.CollationCaseInsensitive:   `Value ILIKE $1`,
+		constants.CollationCaseSensitive:     `Value = $1`,
+		constants.CollationAccentInsensitive: `unaccent(Value) ILIKE unaccent($1)`,
+	}
+
+	for k, v := range samples {
+		// Act
+		expression, err := builder.Build(expressions.NewCollatedOperatorExpression(constants.FieldTypeString, "Value", constants.OperatorEqual, "Filtex", k))
+
+		// Assert
+		assert.NoError(t, err)
+		assert.Equal(t, v, expression.Condition)
+	}
+}
+
+func TestBuild_ShouldUseTimeValues_WhenFieldTypeIsTime(t *testing.T) {
+	// Arrange
+	builder := NewPostgresFilterBuilder()
+	seconds := 9*60*60 + 5*60 + 7
+	operatorExpression := expressions.NewOperatorExpression(constants.FieldTypeTime, "Value", constants.OperatorGreaterThan, &seconds)
+
+	// Act
+	expression, err := builder.Build(operatorExpression)
+
+	// Assert
+	assert.NoError(t, err)
+	assert.Equal(t, &types.PostgresExpression{
+		Condition: `Value > $1::time`,
+		Args:      []interface{}{"09:05:07"},
+	}, expression)
+}
+
+func TestBuild_ShouldUseIntervalValues_WhenFieldTypeIsDuration(t *testing.T) {
+	// Arrange
+	builder := NewPostgresFilterBuilder()
+	operatorExpression := expressions.NewOperatorExpression(constants.FieldTypeDuration, "Value", constants.OperatorIn, []interface{}{36 * time.Hour, 1500 * time.Millisecond})
+
+	// Act
+	expression, err := builder.Build(operatorExpression)
+
+	// Assert
+	assert.NoError(t, err)
+	assert.Equal(t, &types.PostgresExpression{
+		Condition: `Value IN ($1::interval,$2::interval)`,
+		Args:      []interface{}{"129600 seconds", "1.5 seconds"},
+	}, expression)
+}
+
+func TestBuild_ShouldStartPlaceholdersFromIndex_WhenStartIndexIsSet(t *testing.T) {
+	// Arrange
+	builder := NewPostgresFilterBuilder().StartIndex(3)
+	logicExpression := expressions.NewLogicExpression(constants.LogicAnd, []expressions.Expression{
+		expressions.NewOperatorExpression(constants.FieldTypeNumber, "Version", constants.OperatorGreaterThan, float64(1)),
+		expressions.NewOperatorExpression(constants.FieldTypeNumber, "Version", constants.OperatorLessThan, float64(5)),
+	})
+
+	// Act
+	expression, err := builder.Build(logicExpression)
+
+	// Assert
+	assert.NoError(t, err)
+	assert.Equal(t, `(Version > $3) AND (Version < $4)`, expression.Condition)
+	assert.Equal(t, []interface{}{float64(1), float64(5)}, expression.Args)
+}
+
+func TestBuild_ShouldReturnError_WhenStartIndexIsNotPositive(t *testing.T) {
+	// Arrange
+	builder := NewPostgresFilterBuilder().StartIndex(0)
+	operatorExpression := expressions.NewOperatorExpression(constants.FieldTypeString, "Value", constants.OperatorEqual, "Filtex")
+
+	// Act
+	expression, err := builder.Build(operatorExpression)
+
+	// Assert
+	assert.Nil(t, expression)
+	assert.Error(t, err)
+}
+
+func TestBuild_ShouldMapColumns_WhenColumnsAreSet(t *testing.T) {
+	// Arrange
+	builder := NewPostgresFilterBuilder().Columns(map[string]string{
+		"createdAt": `"Orders"."created_at"`,
+		"title":     "projects.title",
+	})
+	logicExpression := expressions.NewLogicExpression(constants.LogicAnd, []expressions.Expression{
+		expressions.NewOperatorExpression(constants.FieldTypeNumber, "createdAt", constants.OperatorIsNull, nil),
+		expressions.NewSearchExpression([]string{"title"}, "filtex"),
+	})
+
+	// Act
+	expression, err := builder.Build(logicExpression)
+
+	// Assert
+	assert.NoError(t, err)
+	assert.Equal(t, `("Orders"."created_at" IS NULL) AND (to_tsvector(projects.title::TEXT) @@ plainto_tsquery($1))`, expression.Condition)
+}
+
+func TestBuild_ShouldUseColumnMapper_WhenFieldIsNotInColumns(t *testing.T) {
+	// Arrange
+	builder := NewPostgresFilterBuilder().
+		Columns(map[string]string{"id": "project_id"}).
+		ColumnMapper(func(field string) string { return "p." + strings.ToLower(field) })
+	logicExpression := expressions.NewLogicExpression(constants.LogicAnd, []expressions.Expression{
+		expressions.NewOperatorExpression(constants.FieldTypeInteger, "id", constants.OperatorEqual, int64(1)),
+		expressions.NewOperatorExpression(constants.FieldTypeInteger, "Version", constants.OperatorEqual, int64(2)),
+	})
+
+	// Act
+	expression, err := builder.Build(logicExpression)
+
+	// Assert
+	assert.NoError(t, err)
+	assert.Equal(t, `(project_id = $1) AND (p.version = $2)`, expression.Condition)
+}
+
+func TestBuild_ShouldQuoteColumns_WhenColumnsAreNotPlainIdentifiers(t *testing.T) {
+	// Arrange
+	builder := NewPostgresFilterBuilder()
+	operatorExpression := expressions.NewOperatorExpression(constants.FieldTypeNumber, "1=1 OR value", constants.OperatorIsNull, nil)
+
+	// Act
+	expression, err := builder.Build(operatorExpression)
+
+	// Assert
+	assert.NoError(t, err)
+	assert.Equal(t, `"1=1 OR value" IS NULL`, expression.Condition)
+}
+
+func TestBuild_ShouldParenthesizeRangeConditions_WhenDateIsNegatedOrListed(t *testing.T) {
+	// Arrange
+	builder := NewPostgresFilterBuilder()
+	day := time.Date(2024, 3, 10, 0, 0, 0, 0, time.UTC)
+	samples := map[string]expressions.Expression{
+		`(Day < $1 OR Day >= $2 OR Day IS NULL)`:                                              expressions.NewOperatorExpression(constants.FieldTypeDate, "Day", constants.OperatorNotEqual, day),
+		`((Day >= $1 AND Day < $2) OR (Day >= $3 AND Day < $4))`:                              expressions.NewOperatorExpression(constants.FieldTypeDate, "Day", constants.OperatorIn, []interface{}{day, day.AddDate(0, 0, 2)}),
+		`((Day < $1 OR Day >= $2 OR Day IS NULL) AND (Day < $3 OR Day >= $4 OR Day IS NULL))`: expressions.NewOperatorExpression(constants.FieldTypeDate, "Day", constants.OperatorNotIn, []interface{}{day, day.AddDate(0, 0, 2)}),
+	}
+
+	for expected, v := range samples {
+		// Act
+		expression, err := builder.Build(v)
+
+		// Assert
+		assert.NoError(t, err)
+		assert.Equal(t, expected, expression.Condition)
+	}
+}
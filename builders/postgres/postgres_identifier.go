@@ -0,0 +1,71 @@
+package postgres
+
+import (
+	"regexp"
+	"strings"
+)
+
+var plainIdentifierRegex = regexp.MustCompile(`^[A-Za-z_][A-Za-z0-9_$]*$`)
+
+var reservedWords = map[string]bool{
+	"all": true, "analyse": true, "analyze": true, "and": true, "any": true, "array": true, "as": true, "asc": true,
+	"asymmetric": true, "authorization": true, "binary": true, "both": true, "case": true, "cast": true, "check": true,
+	"collate": true, "collation": true, "column": true, "concurrently": true, "constraint": true, "create": true,
+	"cross": true, "current_catalog": true, "current_date": true, "current_role": true, "current_schema": true,
+	"current_time": true, "current_timestamp": true, "current_user": true, "default": true, "deferrable": true,
+	"desc": true, "distinct": true, "do": true, "else": true, "end": true, "except": true, "false": true, "fetch": true,
+	"for": true, "foreign": true, "freeze": true, "from": true, "full": true, "grant": true, "group": true,
+	"having": true, "ilike": true, "in": true, "initially": true, "inner": true, "intersect": true, "into": true,
+	"is": true, "isnull": true, "join": true, "lateral": true, "leading": true, "left": true, "like": true,
+	"limit": true, "localtime": true, "localtimestamp": true, "natural": true, "not": true, "notnull": true,
+	"null": true, "offset": true, "on": true, "only": true, "or": true, "order": true, "outer": true,
+	"overlaps": true, "placing": true, "primary": true, "references": true, "returning": true, "right": true,
+	"select": true, "session_user": true, "similar": true, "some": true, "symmetric": true, "table": true,
+	"tablesample": true, "then": true, "to": true, "trailing": true, "true": true, "union": true, "unique": true,
+	"user": true, "using": true, "variadic": true, "verbose": true, "when": true, "where": true, "window": true,
+	"with": true,
+}
+
+func quoteIdentifier(name string) string {
+	segments := splitIdentifier(name)
+
+	for i, v := range segments {
+		if isQuotedIdentifier(v) || isPlainIdentifier(v) {
+			continue
+		}
+
+		segments[i] = `"` + strings.ReplaceAll(v, `"`, `""`) + `"`
+	}
+
+	return strings.Join(segments, ".")
+}
+
+func splitIdentifier(name string) []string {
+	segments := make([]string, 0)
+	quoted := false
+	start := 0
+
+	for i, r := range name {
+		switch {
+		case r == '"':
+			quoted = !quoted
+		case r == '.' && !quoted:
+			segments = append(segments, name[start:i])
+			start = i + 1
+		}
+	}
+
+	return append(segments, name[start:])
+}
+
+func isQuotedIdentifier(segment string) bool {
+	if len(segment) < 3 || !strings.HasPrefix(segment, `"`) || !strings.HasSuffix(segment, `"`) {
+		return false
+	}
+
+	return !strings.Contains(strings.ReplaceAll(segment[1:len(segment)-1], `""`, ""), `"`)
+}
+
+func isPlainIdentifier(segment string) bool {
+	return plainIdentifierRegex.MatchString(segment) && !reservedWords[strings.ToLower(segment)]
+}
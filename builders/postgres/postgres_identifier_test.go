@@ -0,0 +1,85 @@
+package postgres
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestQuoteIdentifier_ShouldKeepSegments_WhenIdentifierIsPlain(t *testing.T) {
+	// Arrange
+	samples := map[string]string{
+		"Value":             `Value`,
+		"created_at":        `created_at`,
+		"orders.created_at": `orders.created_at`,
+		"_id":               `_id`,
+	}
+
+	for k, v := range samples {
+		// Act
+		result := quoteIdentifier(k)
+
+		// Assert
+		assert.Equal(t, v, result)
+	}
+}
+
+func TestQuoteIdentifier_ShouldQuoteReservedSegments_WhenIdentifierIsReservedWord(t *testing.T) {
+	// Arrange
+	samples := map[string]string{
+		"order":      `"order"`,
+		"user":       `"user"`,
+		"users.user": `users."user"`,
+		"Order":      `"Order"`,
+	}
+
+	for k, v := range samples {
+		// Act
+		result := quoteIdentifier(k)
+
+		// Assert
+		assert.Equal(t, v, result)
+	}
+}
+
+func TestQuoteIdentifier_ShouldNotQuote_WhenIdentifierIsCamelCase(t *testing.T) {
+	// Act
+	result := quoteIdentifier("projects.createdAt")
+
+	// Assert
+	assert.Equal(t, `projects.createdAt`, result)
+}
+
+func TestQuoteIdentifier_ShouldKeepIdentifier_WhenIdentifierIsQuoted(t *testing.T) {
+	// Arrange
+	samples := []string{`"Orders"."created_at"`, `"Order Items"."total"`, `"say ""hi"""`, `"a.b"`}
+
+	for _, v := range samples {
+		// Act
+		result := quoteIdentifier(v)
+
+		// Assert
+		assert.Equal(t, v, result)
+	}
+}
+
+func TestQuoteIdentifier_ShouldQuoteSegments_WhenIdentifierIsNotPlain(t *testing.T) {
+	// Arrange
+	samples := map[string]string{
+		"created at":                  `"created at"`,
+		"1st":                         `"1st"`,
+		"orders.total-price":          `orders."total-price"`,
+		"x = 1 OR 1":                  `"x = 1 OR 1"`,
+		`name"; DROP TABLE users; --`: `"name""; DROP TABLE users; --"`,
+		`"a"b"`:                       `"""a""b"""`,
+		"":                            `""`,
+	}
+
+	for k, v := range samples {
+		// Act
+		result := quoteIdentifier(k)
+
+		// Assert
+		assert.Equal(t, v, result)
+	}
+}
@@ -0,0 +1,81 @@
+package postgres
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/filtex/filtex-go/builders/postgres/types"
+	"github.com/filtex/filtex-go/constants"
+	"github.com/filtex/filtex-go/errors"
+	"github.com/filtex/filtex-go/expressions"
+	"github.com/filtex/filtex-go/utils"
+)
+
+type PostgresSortBuilder struct {
+	columns      map[string]string
+	columnMapper func(field string) string
+}
+
+func NewPostgresSortBuilder() *PostgresSortBuilder {
+	return &PostgresSortBuilder{
+		columns: map[string]string{},
+	}
+}
+
+func (b *PostgresSortBuilder) Columns(columns map[string]string) *PostgresSortBuilder {
+	for k, v := range columns {
+		b.columns[k] = v
+	}
+	return b
+}
+
+func (b *PostgresSortBuilder) ColumnMapper(mapper func(field string) string) *PostgresSortBuilder {
+	b.columnMapper = mapper
+	return b
+}
+
+func (b *PostgresSortBuilder) Build(sorts []*expressions.SortExpression) (*types.PostgresSort, error) {
+	items := make([]string, 0)
+
+	for _, v := range sorts {
+		if v == nil || v.Field == "" {
+			return nil, errors.NewCouldNotBeBuiltError()
+		}
+
+		item := quoteIdentifier(utils.MapField(b.columns, b.columnMapper, v.Field))
+
+		if v.Type == constants.FieldTypeString && !v.Collation.IsCaseSensitive() {
+			item = collateSortItem(v.Collation, item)
+		}
+
+		switch v.Direction {
+		case constants.SortDirectionAsc, constants.SortDirectionUnknown:
+			item = fmt.Sprintf("%s ASC", item)
+		case constants.SortDirectionDesc:
+			item = fmt.Sprintf("%s DESC", item)
+		default:
+			return nil, errors.NewCouldNotBeBuiltError()
+		}
+
+		switch v.Nulls {
+		case constants.SortNullsFirst:
+			item = fmt.Sprintf("%s NULLS FIRST", item)
+		case constants.SortNullsLast:
+			item = fmt.Sprintf("%s NULLS LAST", item)
+		}
+
+		items = append(items, item)
+	}
+
+	return &types.PostgresSort{
+		OrderBy: strings.Join(items, ", "),
+	}, nil
+}
+
+func collateSortItem(collation constants.Collation, item string) string {
+	if collation.IsAccentInsensitive() {
+		return fmt.Sprintf("LOWER(unaccent(%s))", item)
+	}
+
+	return fmt.Sprintf("LOWER(%s)", item)
+}
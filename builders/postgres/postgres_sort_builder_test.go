@@ -0,0 +1,81 @@
+package postgres
+
+import (
+	"testing"
+
+	"github.com/filtex/filtex-go/constants"
+	"github.com/filtex/filtex-go/expressions"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSortBuild_ShouldReturnError_WhenSortIsNil(t *testing.T) {
+	// Arrange
+	builder := NewPostgresSortBuilder()
+
+	// Act
+	sort, err := builder.Build([]*expressions.SortExpression{nil})
+
+	// Assert
+	assert.Nil(t, sort)
+	assert.Error(t, err)
+}
+
+func TestSortBuild_ShouldReturnEmptyOrderBy_WhenSortsAreEmpty(t *testing.T) {
+	// Arrange
+	builder := NewPostgresSortBuilder()
+
+	// Act
+	sort, err := builder.Build([]*expressions.SortExpression{})
+
+	// Assert
+	assert.NoError(t, err)
+	assert.Equal(t, "", sort.OrderBy)
+}
+
+func TestSortBuild_ShouldReturnOrderBy_WhenSortsAreValid(t *testing.T) {
+	// Arrange
+	builder := NewPostgresSortBuilder()
+
+	// Act
+	sort, err := builder.Build([]*expressions.SortExpression{
+		expressions.NewSortExpression(constants.FieldTypeString, "name", constants.SortDirectionAsc, constants.SortNullsDefault),
+		expressions.NewSortExpression(constants.FieldTypeDateTime, "created_at", constants.SortDirectionDesc, constants.SortNullsLast),
+		expressions.NewSortExpression(constants.FieldTypeNumber, "version", constants.SortDirectionAsc, constants.SortNullsFirst),
+	})
+
+	// Assert
+	assert.NoError(t, err)
+	assert.Equal(t, `LOWER(name) ASC, created_at DESC NULLS LAST, version ASC NULLS FIRST`, sort.OrderBy)
+}
+
+func TestSortBuild_ShouldOrderStringsByCollation_WhenCollationIsSet(t *testing.T) {
+	// Arrange
+	builder := NewPostgresSortBuilder()
+
+	// Act
+	sort, err := builder.Build([]*expressions.SortExpression{
+		expressions.NewCollatedSortExpression(constants.FieldTypeString, "code", constants.SortDirectionAsc, constants.SortNullsDefault, constants.CollationCaseSensitive),
+		expressions.NewCollatedSortExpression(constants.FieldTypeString, "name", constants.SortDirectionDesc, constants.SortNullsDefault, constants.CollationAccentInsensitive),
+	})
+
+	// Assert
+	assert.NoError(t, err)
+	assert.Equal(t, `code ASC, LOWER(unaccent(name)) DESC`, sort.OrderBy)
+}
+
+func TestSortBuild_ShouldMapColumns_WhenColumnsAreSet(t *testing.T) {
+	// Arrange
+	builder := NewPostgresSortBuilder().
+		Columns(map[string]string{"createdAt": `"Orders"."created_at"`}).
+		ColumnMapper(func(field string) string { return "orders." + field })
+
+	// Act
+	sort, err := builder.Build([]*expressions.SortExpression{
+		{Field: "createdAt", Direction: constants.SortDirectionDesc},
+		{Field: "total price", Direction: constants.SortDirectionAsc},
+	})
+
+	// Assert
+	assert.NoError(t, err)
+	assert.Equal(t, `"Orders"."created_at" DESC, orders."total price" ASC`, sort.OrderBy)
+}
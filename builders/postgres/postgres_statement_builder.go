@@ -0,0 +1,131 @@
+package postgres
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/filtex/filtex-go/builders/postgres/types"
+	"github.com/filtex/filtex-go/errors"
+	"github.com/filtex/filtex-go/expressions"
+)
+
+type PostgresStatementBuilder struct {
+	base         string
+	args         []interface{}
+	filter       expressions.Expression
+	sorts        []*expressions.SortExpression
+	limit        int
+	offset       int
+	columns      map[string]string
+	columnMapper func(field string) string
+}
+
+func NewPostgresStatementBuilder(base string, args ...interface{}) *PostgresStatementBuilder {
+	return &PostgresStatementBuilder{
+		base:    base,
+		args:    args,
+		columns: map[string]string{},
+	}
+}
+
+func (b *PostgresStatementBuilder) Filter(filter expressions.Expression) *PostgresStatementBuilder {
+	b.filter = filter
+	return b
+}
+
+func (b *PostgresStatementBuilder) Sort(sorts ...*expressions.SortExpression) *PostgresStatementBuilder {
+	b.sorts = sorts
+	return b
+}
+
+func (b *PostgresStatementBuilder) Limit(limit int) *PostgresStatementBuilder {
+	b.limit = limit
+	return b
+}
+
+func (b *PostgresStatementBuilder) Offset(offset int) *PostgresStatementBuilder {
+	b.offset = offset
+	return b
+}
+
+func (b *PostgresStatementBuilder) Columns(columns map[string]string) *PostgresStatementBuilder {
+	for k, v := range columns {
+		b.columns[k] = v
+	}
+	return b
+}
+
+func (b *PostgresStatementBuilder) ColumnMapper(mapper func(field string) string) *PostgresStatementBuilder {
+	b.columnMapper = mapper
+	return b
+}
+
+func (b *PostgresStatementBuilder) Build() (*types.PostgresStatement, error) {
+	statement, index, err := b.buildSelect("*")
+	if err != nil {
+		return nil, err
+	}
+
+	if len(b.sorts) > 0 {
+		sort, err := NewPostgresSortBuilder().Columns(b.columns).ColumnMapper(b.columnMapper).Build(b.sorts)
+		if err != nil {
+			return nil, err
+		}
+
+		statement.Query += " ORDER BY " + sort.OrderBy
+	}
+
+	if b.limit < 0 || b.offset < 0 {
+		return nil, errors.NewCouldNotBeBuiltError()
+	}
+
+	if b.limit > 0 {
+		statement.Query += fmt.Sprintf(" LIMIT $%v", index)
+		statement.Args = append(statement.Args, b.limit)
+		index++
+	}
+
+	if b.offset > 0 {
+		statement.Query += fmt.Sprintf(" OFFSET $%v", index)
+		statement.Args = append(statement.Args, b.offset)
+	}
+
+	return statement, nil
+}
+
+func (b *PostgresStatementBuilder) BuildCount() (*types.PostgresStatement, error) {
+	statement, _, err := b.buildSelect("COUNT(*)")
+	return statement, err
+}
+
+func (b *PostgresStatementBuilder) buildSelect(columns string) (*types.PostgresStatement, int, error) {
+	base := strings.TrimRight(strings.TrimSpace(b.base), ";")
+	if base == "" {
+		return nil, 0, errors.NewCouldNotBeBuiltError()
+	}
+
+	index := len(b.args) + 1
+
+	statement := &types.PostgresStatement{
+		Query: fmt.Sprintf("SELECT %s FROM (%s) AS filtered", columns, base),
+		Args:  append([]interface{}{}, b.args...),
+	}
+
+	if b.filter == nil {
+		return statement, index, nil
+	}
+
+	expression, err := NewPostgresFilterBuilder().
+		Columns(b.columns).
+		ColumnMapper(b.columnMapper).
+		StartIndex(index).
+		Build(b.filter)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	statement.Query += " WHERE " + expression.Condition
+	statement.Args = append(statement.Args, expression.Args...)
+
+	return statement, index + len(expression.Args), nil
+}
@@ -0,0 +1,142 @@
+package postgres
+
+import (
+	"testing"
+
+	"github.com/filtex/filtex-go/builders/postgres/types"
+	"github.com/filtex/filtex-go/constants"
+	"github.com/filtex/filtex-go/expressions"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestStatementBuild_ShouldReturnError_WhenBaseIsEmpty(t *testing.T) {
+	// Arrange
+	builder := NewPostgresStatementBuilder(" ; ")
+
+	// Act
+	statement, err := builder.Build()
+
+	// Assert
+	assert.Nil(t, statement)
+	assert.Error(t, err)
+}
+
+func TestStatementBuild_ShouldReturnBase_WhenThereIsNoFilter(t *testing.T) {
+	// Arrange
+	builder := NewPostgresStatementBuilder("SELECT * FROM projects WHERE tenant_id = $1;", 7)
+
+	// Act
+	statement, err := builder.Build()
+
+	// Assert
+	assert.NoError(t, err)
+	assert.Equal(t, &types.PostgresStatement{
+		Query: "SELECT * FROM (SELECT * FROM projects WHERE tenant_id = $1) AS filtered",
+		Args:  []interface{}{7},
+	}, statement)
+}
+
+func TestStatementBuild_ShouldOffsetPlaceholders_WhenBaseHasArgs(t *testing.T) {
+	// Arrange
+	filter := expressions.NewLogicExpression(constants.LogicAnd, []expressions.Expression{
+		expressions.NewOperatorExpression(constants.FieldTypeNumber, "version", constants.OperatorGreaterThan, float64(1)),
+		expressions.NewOperatorExpression(constants.FieldTypeBoolean, "enabled", constants.OperatorEqual, true),
+	})
+	builder := NewPostgresStatementBuilder("SELECT * FROM projects WHERE tenant_id = $1 AND deleted = $2", 7, false).
+		Filter(filter).
+		Sort(&expressions.SortExpression{Field: "createdAt", Direction: constants.SortDirectionDesc}).
+		Limit(20).
+		Offset(40).
+		Columns(map[string]string{"createdAt": "created_at"})
+
+	// Act
+	statement, err := builder.Build()
+
+	// Assert
+	assert.NoError(t, err)
+	assert.Equal(t, &types.PostgresStatement{
+		Query: "SELECT * FROM (SELECT * FROM projects WHERE tenant_id = $1 AND deleted = $2) AS filtered " +
+			`WHERE (version > $3) AND (enabled = $4) ORDER BY created_at DESC LIMIT $5 OFFSET $6`,
+		Args: []interface{}{7, false, float64(1), true, 20, 40},
+	}, statement)
+}
+
+func TestStatementBuild_ShouldMapColumns_WhenColumnsAreSet(t *testing.T) {
+	// Arrange
+	filter := expressions.NewLogicExpression(constants.LogicOr, []expressions.Expression{
+		expressions.NewOperatorExpression(constants.FieldTypeInteger, "ownerId", constants.OperatorEqual, int64(3)),
+		expressions.NewSearchExpression([]string{"title"}, "filtex"),
+	})
+	builder := NewPostgresStatementBuilder("SELECT * FROM projects").
+		Filter(filter).
+		Columns(map[string]string{"ownerId": "owner_id", "title": "project_title"})
+
+	// Act
+	statement, err := builder.Build()
+
+	// Assert
+	assert.NoError(t, err)
+	assert.Equal(t, "SELECT * FROM (SELECT * FROM projects) AS filtered "+
+		`WHERE (owner_id = $1) OR (to_tsvector(project_title::TEXT) @@ plainto_tsquery($2))`, statement.Query)
+	assert.Equal(t, []interface{}{int64(3), "filtex"}, statement.Args)
+}
+
+func TestStatementBuild_ShouldUseColumnMapper_WhenColumnMapperIsSet(t *testing.T) {
+	// Arrange
+	filter := expressions.NewOperatorExpression(constants.FieldTypeInteger, "ownerId", constants.OperatorEqual, int64(3))
+	builder := NewPostgresStatementBuilder("SELECT * FROM projects").
+		Filter(filter).
+		Sort(&expressions.SortExpression{Field: "createdAt"}).
+		ColumnMapper(func(field string) string { return `"` + field + `"` })
+
+	// Act
+	statement, err := builder.Build()
+
+	// Assert
+	assert.NoError(t, err)
+	assert.Equal(t, `SELECT * FROM (SELECT * FROM projects) AS filtered WHERE "ownerId" = $1 ORDER BY "createdAt" ASC`, statement.Query)
+}
+
+func TestStatementBuild_ShouldReturnError_WhenFilterIsNotValid(t *testing.T) {
+	// Arrange
+	filter := expressions.NewOperatorExpression(constants.FieldTypeIP, "address", constants.OperatorGreaterThan, "127.0.0.1")
+	builder := NewPostgresStatementBuilder("SELECT * FROM projects").Filter(filter)
+
+	// Act
+	statement, err := builder.Build()
+
+	// Assert
+	assert.Nil(t, statement)
+	assert.Error(t, err)
+}
+
+func TestStatementBuild_ShouldReturnError_WhenLimitIsNegative(t *testing.T) {
+	// Arrange
+	builder := NewPostgresStatementBuilder("SELECT * FROM projects").Limit(-1)
+
+	// Act
+	statement, err := builder.Build()
+
+	// Assert
+	assert.Nil(t, statement)
+	assert.Error(t, err)
+}
+
+func TestStatementBuildCount_ShouldReturnCountStatement_WhenFilterIsSet(t *testing.T) {
+	// Arrange
+	filter := expressions.NewOperatorExpression(constants.FieldTypeBoolean, "enabled", constants.OperatorEqual, true)
+	builder := NewPostgresStatementBuilder("SELECT * FROM projects WHERE tenant_id = $1", 7).
+		Filter(filter).
+		Sort(&expressions.SortExpression{Field: "name"}).
+		Limit(20)
+
+	// Act
+	statement, err := builder.BuildCount()
+
+	// Assert
+	assert.NoError(t, err)
+	assert.Equal(t, &types.PostgresStatement{
+		Query: `SELECT COUNT(*) FROM (SELECT * FROM projects WHERE tenant_id = $1) AS filtered WHERE enabled = $2`,
+		Args:  []interface{}{7, true},
+	}, statement)
+}
@@ -0,0 +1,5 @@
+package types
+
+type PostgresSort struct {
+	OrderBy string
+}
@@ -0,0 +1,6 @@
+package types
+
+type PostgresStatement struct {
+	Query string
+	Args  []interface{}
+}
@@ -0,0 +1,189 @@
+package url
+
+import (
+	"fmt"
+	goUrl "net/url"
+	"strings"
+	"time"
+
+	"github.com/filtex/filtex-go/constants"
+	"github.com/filtex/filtex-go/errors"
+	"github.com/filtex/filtex-go/expressions"
+	"github.com/filtex/filtex-go/utils"
+)
+
+type UrlFilterBuilder struct {
+	style constants.UrlStyle
+}
+
+func NewUrlFilterBuilder() *UrlFilterBuilder {
+	return &UrlFilterBuilder{
+		style: constants.UrlStyleBracket,
+	}
+}
+
+func (b *UrlFilterBuilder) Style(style constants.UrlStyle) *UrlFilterBuilder {
+	b.style = style
+	return b
+}
+
+func (b *UrlFilterBuilder) Build(ex expressions.Expression) (goUrl.Values, error) {
+	if b.style != constants.UrlStyleBracket && b.style != constants.UrlStyleColon {
+		return nil, errors.NewCouldNotBeBuiltError()
+	}
+
+	values := goUrl.Values{}
+
+	if err := b.buildInternal(ex, values); err != nil {
+		return nil, err
+	}
+
+	return values, nil
+}
+
+func (b *UrlFilterBuilder) buildInternal(ex expressions.Expression, values goUrl.Values) error {
+	switch exp := ex.(type) {
+	case *expressions.LogicExpression:
+		if key, value, ok := b.buildContainAny(exp); ok {
+			values.Add(key, value)
+			return nil
+		}
+
+		if exp.Logic != constants.LogicAnd && !(exp.Logic == constants.LogicOr && len(exp.Expressions) == 1) {
+			return errors.NewCouldNotBeBuiltError()
+		}
+
+		for _, v := range exp.Expressions {
+			if err := b.buildInternal(v, values); err != nil {
+				return err
+			}
+		}
+
+		return nil
+	case *expressions.OperatorExpression:
+		code := exp.Operator.ToUrlCode()
+		if code == "" {
+			return errors.NewCouldNotBeBuiltError()
+		}
+
+		key := exp.Field
+		if exp.Operator != constants.OperatorEqual {
+			key = b.style.Key(exp.Field, code)
+		}
+
+		value, err := b.buildValue(exp)
+		if err != nil {
+			return err
+		}
+
+		values.Add(key, value)
+		return nil
+	}
+
+	return errors.NewCouldNotBeBuiltError()
+}
+
+func (b *UrlFilterBuilder) buildContainAny(exp *expressions.LogicExpression) (string, string, bool) {
+	if exp.Logic != constants.LogicOr || len(exp.Expressions) < 2 {
+		return "", "", false
+	}
+
+	field := ""
+	list := make([]string, 0)
+
+	for _, v := range exp.Expressions {
+		item, ok := v.(*expressions.OperatorExpression)
+		if !ok || item.Operator != constants.OperatorContain || !item.Type.IsArray() || (field != "" && item.Field != field) {
+			return "", "", false
+		}
+
+		str, err := formatValue(item.Type, item.Value)
+		if err != nil || strings.Contains(str, ",") {
+			return "", "", false
+		}
+
+		field = item.Field
+		list = append(list, str)
+	}
+
+	return b.style.Key(field, constants.OperatorIn.ToUrlCode()), strings.Join(list, ","), true
+}
+
+func (b *UrlFilterBuilder) buildValue(exp *expressions.OperatorExpression) (string, error) {
+	switch exp.Operator {
+	case constants.OperatorBlank,
+		constants.OperatorNotBlank,
+		constants.OperatorIsNull,
+		constants.OperatorIsNotNull:
+		return "true", nil
+	case constants.OperatorIn, constants.OperatorNotIn:
+		items, ok := exp.Value.([]interface{})
+		if !ok || len(items) == 0 {
+			return "", errors.NewCouldNotBeBuiltError()
+		}
+
+		list := make([]string, 0)
+
+		for _, v := range items {
+			str, err := formatValue(exp.Type, v)
+			if err != nil || strings.Contains(str, ",") {
+				return "", errors.NewCouldNotBeBuiltError()
+			}
+			list = append(list, str)
+		}
+
+		return strings.Join(list, ","), nil
+	}
+
+	str, err := formatValue(exp.Type, exp.Value)
+	if err != nil {
+		return "", errors.NewCouldNotBeBuiltError()
+	}
+
+	return str, nil
+}
+
+func formatValue(fieldType constants.FieldType, value interface{}) (string, error) {
+	if value == nil {
+		return "", errors.NewCouldNotBeBuiltError()
+	}
+
+	if _, ok := utils.AsTimeRange(value); ok {
+		return utils.FormatTimeRange(value)
+	}
+
+	switch fieldType {
+	case constants.FieldTypeDate, constants.FieldTypeDateArray:
+		date, err := utils.Date(value)
+		if err != nil {
+			return "", err
+		}
+
+		return date.Format("2006-01-02"), nil
+	case constants.FieldTypeDateTime, constants.FieldTypeDateTimeArray:
+		datetime, err := utils.DateTime(value)
+		if err != nil || datetime == nil {
+			return "", errors.NewCouldNotBeBuiltError()
+		}
+
+		return datetime.Format(time.RFC3339Nano), nil
+	case constants.FieldTypeTime, constants.FieldTypeTimeArray:
+		seconds, err := utils.Time(value)
+		if err != nil {
+			return "", err
+		}
+
+		return fmt.Sprintf("%02d:%02d:%02d", *seconds/3600, *seconds%3600/60, *seconds%60), nil
+	case constants.FieldTypeDuration, constants.FieldTypeDurationArray:
+		duration, err := utils.Duration(value)
+		if err != nil {
+			return "", err
+		}
+
+		return duration.String(), nil
+	case constants.FieldTypeGeoPoint:
+		return utils.FormatGeoArea(value)
+	}
+
+	return utils.String(value)
+}
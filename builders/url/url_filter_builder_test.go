@@ -0,0 +1,135 @@
+package url
+
+import (
+	goUrl "net/url"
+	"testing"
+	"time"
+
+	"github.com/filtex/filtex-go/constants"
+	"github.com/filtex/filtex-go/expressions"
+	"github.com/filtex/filtex-go/utils"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestBuild_ShouldReturnError_WhenExpressionIsNil(t *testing.T) {
+	// Arrange
+	builder := NewUrlFilterBuilder()
+
+	// Act
+	values, err := builder.Build(nil)
+
+	// Assert
+	assert.Nil(t, values)
+	assert.Error(t, err)
+}
+
+func TestBuild_ShouldReturnError_WhenStyleIsNotValid(t *testing.T) {
+	// Arrange
+	builder := NewUrlFilterBuilder().Style(constants.UrlStyleUnknown)
+
+	// Act
+	values, err := builder.Build(expressions.NewOperatorExpression(constants.FieldTypeNumber, "age", constants.OperatorEqual, 18.0))
+
+	// Assert
+	assert.Nil(t, values)
+	assert.Error(t, err)
+}
+
+func TestBuild_ShouldReturnError_WhenExpressionCannotBeRepresented(t *testing.T) {
+	// Arrange
+	samples := []expressions.Expression{
+		expressions.NewLogicExpression(constants.LogicOr, []expressions.Expression{
+			expressions.NewOperatorExpression(constants.FieldTypeNumber, "age", constants.OperatorEqual, 1.0),
+			expressions.NewOperatorExpression(constants.FieldTypeNumber, "age", constants.OperatorEqual, 2.0),
+		}),
+		expressions.NewLogicExpression(constants.LogicOr, []expressions.Expression{
+			expressions.NewOperatorExpression(constants.FieldTypeStringArray, "tags", constants.OperatorContain, "a"),
+			expressions.NewOperatorExpression(constants.FieldTypeStringArray, "labels", constants.OperatorContain, "b"),
+		}),
+		expressions.NewSearchExpression([]string{"name"}, "filtex"),
+		expressions.NewOperatorExpression(constants.FieldTypeNumber, "age", constants.OperatorUnknown, 1.0),
+		expressions.NewOperatorExpression(constants.FieldTypeString, "tags", constants.OperatorIn, []interface{}{"a,b"}),
+		expressions.NewOperatorExpression(constants.FieldTypeString, "tags", constants.OperatorIn, []interface{}{}),
+		expressions.NewOperatorExpression(constants.FieldTypeString, "name", constants.OperatorEqual, nil),
+		expressions.NewOperatorExpression(constants.FieldTypeDateTime, "createdAt", constants.OperatorEqual, &utils.TimeRange{
+			Start: time.Date(2024, 1, 2, 0, 0, 0, 0, time.UTC),
+			End:   time.Date(2024, 1, 5, 0, 0, 0, 0, time.UTC),
+		}),
+	}
+
+	for _, v := range samples {
+		// Act
+		values, err := NewUrlFilterBuilder().Build(v)
+
+		// Assert
+		assert.Nil(t, values)
+		assert.Error(t, err)
+	}
+}
+
+func TestBuild_ShouldReturnValues_WhenExpressionIsOperatorExpression(t *testing.T) {
+	// Arrange
+	date := time.Date(2024, 1, 2, 0, 0, 0, 0, time.UTC)
+	datetime := time.Date(2024, 1, 2, 15, 4, 5, 0, time.UTC)
+	precise := time.Date(2024, 1, 2, 10, 0, 0, 500000000, time.UTC)
+	seconds := 9*3600 + 30*60
+	sampleMap := map[string]expressions.Expression{
+		"status=active":                                expressions.NewOperatorExpression(constants.FieldTypeString, "status", constants.OperatorEqual, "active"),
+		"age%5Bgte%5D=18":                              expressions.NewOperatorExpression(constants.FieldTypeNumber, "age", constants.OperatorGreaterThanOrEqual, 18.0),
+		"status%5Bin%5D=active%2Cpassive":              expressions.NewOperatorExpression(constants.FieldTypeString, "status", constants.OperatorIn, []interface{}{"active", "passive"}),
+		"name%5Bnull%5D=true":                          expressions.NewOperatorExpression(constants.FieldTypeString, "name", constants.OperatorIsNull, ""),
+		"name%5Bnnull%5D=true":                         expressions.NewOperatorExpression(constants.FieldTypeString, "name", constants.OperatorIsNotNull, ""),
+		"createdAt%5Bgte%5D=2024-01-02":                expressions.NewOperatorExpression(constants.FieldTypeDate, "createdAt", constants.OperatorGreaterThanOrEqual, &date),
+		"updatedAt=2024-01-02T15%3A04%3A05Z":           expressions.NewOperatorExpression(constants.FieldTypeDateTime, "updatedAt", constants.OperatorEqual, &datetime),
+		"updatedAt%5Bgt%5D=2024-01-02T10%3A00%3A00.5Z": expressions.NewOperatorExpression(constants.FieldTypeDateTime, "updatedAt", constants.OperatorGreaterThan, &precise),
+		"tags%5Bin%5D=a%2Cb": expressions.NewLogicExpression(constants.LogicOr, []expressions.Expression{
+			expressions.NewOperatorExpression(constants.FieldTypeStringArray, "tags", constants.OperatorContain, "a"),
+			expressions.NewOperatorExpression(constants.FieldTypeStringArray, "tags", constants.OperatorContain, "b"),
+		}),
+		"updatedAt=2024-01": expressions.NewOperatorExpression(constants.FieldTypeDateTime, "updatedAt", constants.OperatorEqual, &utils.TimeRange{
+			Start: time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC),
+			End:   time.Date(2024, 2, 1, 0, 0, 0, 0, time.UTC),
+		}),
+		"opensAt%5Blt%5D=09%3A30%3A00": expressions.NewOperatorExpression(constants.FieldTypeTime, "opensAt", constants.OperatorLessThan, &seconds),
+		"timeout%5Bgt%5D=1h30m0s":      expressions.NewOperatorExpression(constants.FieldTypeDuration, "timeout", constants.OperatorGreaterThan, 90*time.Minute),
+		"location%5Bwithin%5D=5000m+of+%2840.7%2C+-74%29": expressions.NewOperatorExpression(constants.FieldTypeGeoPoint, "location", constants.OperatorWithin, &utils.GeoCircle{
+			Center: utils.GeoPoint{Lat: 40.7, Lng: -74},
+			Radius: 5000,
+		}),
+	}
+
+	for expected, ex := range sampleMap {
+		// Act
+		values, err := NewUrlFilterBuilder().Build(ex)
+
+		// Assert
+		assert.NoError(t, err, expected)
+		assert.Equal(t, expected, values.Encode())
+	}
+}
+
+func TestBuild_ShouldReturnValues_WhenExpressionIsAndLogicExpression(t *testing.T) {
+	// Arrange
+	expression := expressions.NewLogicExpression(constants.LogicAnd, []expressions.Expression{
+		expressions.NewOperatorExpression(constants.FieldTypeString, "status", constants.OperatorEqual, "active"),
+		expressions.NewLogicExpression(constants.LogicAnd, []expressions.Expression{
+			expressions.NewOperatorExpression(constants.FieldTypeNumber, "age", constants.OperatorGreaterThanOrEqual, 18.0),
+			expressions.NewOperatorExpression(constants.FieldTypeNumber, "age", constants.OperatorLessThan, 65.0),
+		}),
+		expressions.NewLogicExpression(constants.LogicOr, []expressions.Expression{
+			expressions.NewOperatorExpression(constants.FieldTypeString, "name", constants.OperatorContain, "fil"),
+		}),
+	})
+
+	// Act
+	values, err := NewUrlFilterBuilder().Style(constants.UrlStyleColon).Build(expression)
+
+	// Assert
+	assert.NoError(t, err)
+	assert.Equal(t, goUrl.Values{
+		"status":        {"active"},
+		"age:gte":       {"18"},
+		"age:lt":        {"65"},
+		"name:contains": {"fil"},
+	}, values)
+}
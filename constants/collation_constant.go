@@ -0,0 +1,40 @@
+package constants
+
+import (
+	"strings"
+)
+
+type Collation string
+
+const (
+	CollationDefault           Collation = ""
+	CollationCaseSensitive     Collation = "case-sensitive"
+	CollationCaseInsensitive   Collation = "case-insensitive"
+	CollationAccentInsensitive Collation = "accent-insensitive"
+)
+
+func (c Collation) String() string {
+	return string(c)
+}
+
+func (c Collation) IsCaseSensitive() bool {
+	return c == CollationCaseSensitive
+}
+
+func (c Collation) IsAccentInsensitive() bool {
+	return c == CollationAccentInsensitive
+}
+
+func ParseCollation(str string) Collation {
+	str = strings.ToLower(strings.TrimSpace(str))
+	switch str {
+	case string(CollationCaseSensitive):
+		return CollationCaseSensitive
+	case string(CollationCaseInsensitive):
+		return CollationCaseInsensitive
+	case string(CollationAccentInsensitive):
+		return CollationAccentInsensitive
+	default:
+		return CollationDefault
+	}
+}
@@ -0,0 +1,60 @@
+package constants
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCollation_ParseCollation_ShouldReturnDefault_WhenValueIsNotValid(t *testing.T) {
+	// Arrange
+	samples := []string{
+		"",
+		"binary",
+		"sensitive",
+	}
+
+	for _, v := range samples {
+		// Act
+		result := ParseCollation(v)
+
+		// Assert
+		assert.Equal(t, CollationDefault, result)
+	}
+}
+
+func TestCollation_ParseCollation_ShouldReturnCollation_WhenValueIsValid(t *testing.T) {
+	// Arrange
+	samples := map[string]Collation{
+		"case-sensitive":      CollationCaseSensitive,
+		"Case-Insensitive":    CollationCaseInsensitive,
+		" accent-insensitive": CollationAccentInsensitive,
+	}
+
+	for k, v := range samples {
+		// Act
+		result := ParseCollation(k)
+
+		// Assert
+		assert.Equal(t, v, result)
+	}
+}
+
+func TestCollation_IsCaseSensitive_ShouldReturnTrue_WhenCollationIsCaseSensitive(t *testing.T) {
+	// Arrange
+	// Act
+	// Assert
+	assert.True(t, CollationCaseSensitive.IsCaseSensitive())
+	assert.False(t, CollationDefault.IsCaseSensitive())
+	assert.False(t, CollationCaseInsensitive.IsCaseSensitive())
+	assert.False(t, CollationAccentInsensitive.IsCaseSensitive())
+}
+
+func TestCollation_IsAccentInsensitive_ShouldReturnTrue_WhenCollationIsAccentInsensitive(t *testing.T) {
+	// Arrange
+	// Act
+	// Assert
+	assert.True(t, CollationAccentInsensitive.IsAccentInsensitive())
+	assert.False(t, CollationDefault.IsAccentInsensitive())
+	assert.False(t, CollationCaseSensitive.IsAccentInsensitive())
+}
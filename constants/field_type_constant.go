@@ -6,16 +6,29 @@ const (
 	FieldTypeUnknown       FieldType = ""
 	FieldTypeString        FieldType = "string"
 	FieldTypeNumber        FieldType = "number"
+	FieldTypeInteger       FieldType = "integer"
+	FieldTypeDecimal       FieldType = "decimal"
 	FieldTypeBoolean       FieldType = "boolean"
 	FieldTypeDate          FieldType = "date"
 	FieldTypeTime          FieldType = "time"
+	FieldTypeDuration      FieldType = "duration"
 	FieldTypeDateTime      FieldType = "datetime"
+	FieldTypeUUID          FieldType = "uuid"
+	FieldTypeEnum          FieldType = "enum"
+	FieldTypeIP            FieldType = "ip"
+	FieldTypeGeoPoint      FieldType = "geo-point"
 	FieldTypeStringArray   FieldType = "string-array"
 	FieldTypeNumberArray   FieldType = "number-array"
+	FieldTypeIntegerArray  FieldType = "integer-array"
+	FieldTypeDecimalArray  FieldType = "decimal-array"
 	FieldTypeBooleanArray  FieldType = "boolean-array"
 	FieldTypeDateArray     FieldType = "date-array"
 	FieldTypeTimeArray     FieldType = "time-array"
+	FieldTypeDurationArray FieldType = "duration-array"
 	FieldTypeDateTimeArray FieldType = "datetime-array"
+	FieldTypeUUIDArray     FieldType = "uuid-array"
+	FieldTypeEnumArray     FieldType = "enum-array"
+	FieldTypeIPArray       FieldType = "ip-array"
 )
 
 func (f FieldType) String() string {
@@ -25,8 +38,22 @@ func (f FieldType) String() string {
 func (f FieldType) IsArray() bool {
 	return f == FieldTypeStringArray ||
 		f == FieldTypeNumberArray ||
+		f == FieldTypeIntegerArray ||
+		f == FieldTypeDecimalArray ||
 		f == FieldTypeBooleanArray ||
 		f == FieldTypeDateArray ||
 		f == FieldTypeTimeArray ||
-		f == FieldTypeDateTimeArray
+		f == FieldTypeDurationArray ||
+		f == FieldTypeDateTimeArray ||
+		f == FieldTypeUUIDArray ||
+		f == FieldTypeEnumArray ||
+		f == FieldTypeIPArray
+}
+
+func (f FieldType) ToArray() FieldType {
+	if f.IsArray() || f == FieldTypeUnknown || f == FieldTypeGeoPoint {
+		return f
+	}
+
+	return FieldType(f.String() + "-array")
 }
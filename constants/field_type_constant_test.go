@@ -12,10 +12,17 @@ func TestFieldType_IsArray_ShouldReturnFalse_WhenValueIsNotArray(t *testing.T) {
 		FieldTypeUnknown,
 		FieldTypeString,
 		FieldTypeNumber,
+		FieldTypeInteger,
+		FieldTypeDecimal,
 		FieldTypeBoolean,
 		FieldTypeDate,
 		FieldTypeTime,
+		FieldTypeDuration,
 		FieldTypeDateTime,
+		FieldTypeUUID,
+		FieldTypeEnum,
+		FieldTypeIP,
+		FieldTypeGeoPoint,
 	}
 
 	for _, v := range samples {
@@ -32,10 +39,16 @@ func TestFieldType_IsArray_ShouldReturnTrue_WhenValueIsArray(t *testing.T) {
 	samples := []FieldType{
 		FieldTypeStringArray,
 		FieldTypeNumberArray,
+		FieldTypeIntegerArray,
+		FieldTypeDecimalArray,
 		FieldTypeBooleanArray,
 		FieldTypeDateArray,
 		FieldTypeTimeArray,
+		FieldTypeDurationArray,
 		FieldTypeDateTimeArray,
+		FieldTypeUUIDArray,
+		FieldTypeEnumArray,
+		FieldTypeIPArray,
 	}
 
 	for _, v := range samples {
@@ -46,3 +59,27 @@ func TestFieldType_IsArray_ShouldReturnTrue_WhenValueIsArray(t *testing.T) {
 		assert.True(t, result)
 	}
 }
+
+func TestFieldType_ToArray_ShouldReturnArrayType(t *testing.T) {
+	// Arrange
+	sampleMap := map[FieldType]FieldType{
+		FieldTypeUnknown:      FieldTypeUnknown,
+		FieldTypeString:       FieldTypeStringArray,
+		FieldTypeInteger:      FieldTypeIntegerArray,
+		FieldTypeDecimal:      FieldTypeDecimalArray,
+		FieldTypeDateTime:     FieldTypeDateTimeArray,
+		FieldTypeUUID:         FieldTypeUUIDArray,
+		FieldTypeEnum:         FieldTypeEnumArray,
+		FieldTypeIP:           FieldTypeIPArray,
+		FieldTypeGeoPoint:     FieldTypeGeoPoint,
+		FieldTypeIntegerArray: FieldTypeIntegerArray,
+	}
+
+	for input, output := range sampleMap {
+		// Act
+		result := input.ToArray()
+
+		// Assert
+		assert.Equal(t, output, result)
+	}
+}
@@ -28,23 +28,84 @@ var (
 	OperatorNotEndWith         = NewOperator("not-end-with", "Not End With")
 	OperatorBlank              = NewOperator("blank", "Blank")
 	OperatorNotBlank           = NewOperator("not-blank", "Not Blank")
+	OperatorIsNull             = NewOperator("is-null", "Is Null")
+	OperatorIsNotNull          = NewOperator("is-not-null", "Is Not Null")
 	OperatorGreaterThan        = NewOperator("greater-than", "Greater Than")
 	OperatorGreaterThanOrEqual = NewOperator("greater-than-or-equal", "Greater Than Or Equal")
 	OperatorLessThan           = NewOperator("less-than", "Less Than")
 	OperatorLessThanOrEqual    = NewOperator("less-than-or-equal", "Less Than Or Equal")
 	OperatorIn                 = NewOperator("in", "In")
 	OperatorNotIn              = NewOperator("not-in", "Not In")
+	OperatorInSubnet           = NewOperator("in-subnet", "In Subnet")
+	OperatorNotInSubnet        = NewOperator("not-in-subnet", "Not In Subnet")
+	OperatorWithin             = NewOperator("within", "Within")
+	OperatorSearch             = NewOperator("search", "Search")
 )
 
 func (o Operator) String() string {
 	return o.name
 }
 
+func (o Operator) Label() string {
+	return o.label
+}
+
 func (o Operator) Equals(str string) bool {
 	return strings.ToLower(str) == strings.ToLower(o.name) ||
 		strings.ToLower(str) == strings.ToLower(o.label)
 }
 
+func (o Operator) ToTokenType() TokenType {
+	switch o {
+	case OperatorEqual:
+		return TokenTypeEqual
+	case OperatorNotEqual:
+		return TokenTypeNotEqual
+	case OperatorGreaterThan:
+		return TokenTypeGreaterThan
+	case OperatorGreaterThanOrEqual:
+		return TokenTypeGreaterThanOrEqual
+	case OperatorLessThan:
+		return TokenTypeLessThan
+	case OperatorLessThanOrEqual:
+		return TokenTypeLessThanOrEqual
+	case OperatorBlank:
+		return TokenTypeBlank
+	case OperatorNotBlank:
+		return TokenTypeNotBlank
+	case OperatorIsNull:
+		return TokenTypeIsNull
+	case OperatorIsNotNull:
+		return TokenTypeIsNotNull
+	case OperatorContain:
+		return TokenTypeContain
+	case OperatorNotContain:
+		return TokenTypeNotContain
+	case OperatorStartWith:
+		return TokenTypeStartWith
+	case OperatorNotStartWith:
+		return TokenTypeNotStartWith
+	case OperatorEndWith:
+		return TokenTypeEndWith
+	case OperatorNotEndWith:
+		return TokenTypeNotEndWith
+	case OperatorNotIn:
+		return TokenTypeNotIn
+	case OperatorIn:
+		return TokenTypeIn
+	case OperatorInSubnet:
+		return TokenTypeInSubnet
+	case OperatorNotInSubnet:
+		return TokenTypeNotInSubnet
+	case OperatorWithin:
+		return TokenTypeWithin
+	case OperatorSearch:
+		return TokenTypeSearch
+	}
+
+	return TokenTypeNone
+}
+
 func ParseOperator(str string) Operator {
 	list := []Operator{
 		OperatorEqual,
@@ -57,12 +118,18 @@ func ParseOperator(str string) Operator {
 		OperatorNotEndWith,
 		OperatorBlank,
 		OperatorNotBlank,
+		OperatorIsNull,
+		OperatorIsNotNull,
 		OperatorGreaterThan,
 		OperatorGreaterThanOrEqual,
 		OperatorLessThan,
 		OperatorLessThanOrEqual,
 		OperatorIn,
 		OperatorNotIn,
+		OperatorInSubnet,
+		OperatorNotInSubnet,
+		OperatorWithin,
+		OperatorSearch,
 	}
 
 	for _, item := range list {
@@ -19,12 +19,18 @@ func TestOperator_String_ShouldReturnCorrectValue(t *testing.T) {
 		OperatorNotEndWith:         "not-end-with",
 		OperatorBlank:              "blank",
 		OperatorNotBlank:           "not-blank",
+		OperatorIsNull:             "is-null",
+		OperatorIsNotNull:          "is-not-null",
 		OperatorGreaterThan:        "greater-than",
 		OperatorGreaterThanOrEqual: "greater-than-or-equal",
 		OperatorLessThan:           "less-than",
 		OperatorLessThanOrEqual:    "less-than-or-equal",
 		OperatorIn:                 "in",
 		OperatorNotIn:              "not-in",
+		OperatorInSubnet:           "in-subnet",
+		OperatorNotInSubnet:        "not-in-subnet",
+		OperatorWithin:             "within",
+		OperatorSearch:             "search",
 	}
 
 	for k, v := range samples {
@@ -36,6 +42,26 @@ func TestOperator_String_ShouldReturnCorrectValue(t *testing.T) {
 	}
 }
 
+func TestOperator_Label_ShouldReturnCorrectValue(t *testing.T) {
+	// Arrange
+	samples := map[Operator]string{
+		OperatorUnknown:            "",
+		OperatorEqual:              "Equal",
+		OperatorNotStartWith:       "Not Start With",
+		OperatorGreaterThanOrEqual: "Greater Than Or Equal",
+		OperatorIsNotNull:          "Is Not Null",
+		OperatorSearch:             "Search",
+	}
+
+	for k, v := range samples {
+		// Act
+		result := k.Label()
+
+		// Assert
+		assert.Equal(t, v, result)
+	}
+}
+
 func TestOperator_Equals_ShouldReturnFalse_WhenValueIsNotMatched(t *testing.T) {
 	// Arrange
 	samples := map[Operator]string{
@@ -67,12 +93,17 @@ func TestOperator_Equals_ShouldReturnTrue_WhenValueIsMatched(t *testing.T) {
 		OperatorNotEndWith:         "not End With",
 		OperatorBlank:              "BLANK",
 		OperatorNotBlank:           "not-blank",
+		OperatorIsNull:             "is-null",
+		OperatorIsNotNull:          "is-not-null",
 		OperatorGreaterThan:        "greater-than",
 		OperatorGreaterThanOrEqual: "greater-than-or-equal",
 		OperatorLessThan:           "less-than",
 		OperatorLessThanOrEqual:    "less-than-or-equal",
 		OperatorIn:                 "IN",
 		OperatorNotIn:              "NOT-IN",
+		OperatorInSubnet:           "In Subnet",
+		OperatorNotInSubnet:        "not-in-subnet",
+		OperatorWithin:             "Within",
 	}
 
 	for k, v := range samples {
@@ -114,12 +145,18 @@ func TestOperator_ParseOperator_ShouldReturnCorrectValue_WhenValueIsMatched(t *t
 		"not End With":          OperatorNotEndWith,
 		"BLANK":                 OperatorBlank,
 		"not-blank":             OperatorNotBlank,
+		"Is Null":               OperatorIsNull,
+		"is-not-null":           OperatorIsNotNull,
 		"greater-than":          OperatorGreaterThan,
 		"greater-than-or-equal": OperatorGreaterThanOrEqual,
 		"less-than":             OperatorLessThan,
 		"less-than-or-equal":    OperatorLessThanOrEqual,
 		"IN":                    OperatorIn,
 		"NOT-IN":                OperatorNotIn,
+		"In Subnet":             OperatorInSubnet,
+		"not-in-subnet":         OperatorNotInSubnet,
+		"within":                OperatorWithin,
+		"Search":                OperatorSearch,
 	}
 
 	for k, v := range samples {
@@ -130,3 +167,35 @@ func TestOperator_ParseOperator_ShouldReturnCorrectValue_WhenValueIsMatched(t *t
 		assert.Equal(t, v, result)
 	}
 }
+
+func TestOperator_ToTokenType_ShouldReturnNone_WhenValueIsUnknown(t *testing.T) {
+	// Act
+	result := OperatorUnknown.ToTokenType()
+
+	// Assert
+	assert.Equal(t, TokenTypeNone, result)
+}
+
+func TestOperator_ToTokenType_ShouldReturnTokenType_WhenValueIsValid(t *testing.T) {
+	// Arrange
+	samples := []TokenType{
+		TokenTypeEqual,
+		TokenTypeNotEqual,
+		TokenTypeGreaterThan,
+		TokenTypeLessThanOrEqual,
+		TokenTypeIsNotNull,
+		TokenTypeNotStartWith,
+		TokenTypeIn,
+		TokenTypeNotInSubnet,
+		TokenTypeWithin,
+		TokenTypeSearch,
+	}
+
+	for _, v := range samples {
+		// Act
+		result := v.ToOperator().ToTokenType()
+
+		// Assert
+		assert.Equal(t, v, result)
+	}
+}
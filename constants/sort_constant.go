@@ -0,0 +1,53 @@
+package constants
+
+import (
+	"strings"
+)
+
+type SortDirection string
+
+const (
+	SortDirectionUnknown SortDirection = ""
+	SortDirectionAsc     SortDirection = "asc"
+	SortDirectionDesc    SortDirection = "desc"
+)
+
+func (s SortDirection) String() string {
+	return string(s)
+}
+
+func ParseSortDirection(str string) SortDirection {
+	str = strings.ToLower(strings.TrimSpace(str))
+	switch str {
+	case string(SortDirectionAsc), "ascending":
+		return SortDirectionAsc
+	case string(SortDirectionDesc), "descending":
+		return SortDirectionDesc
+	default:
+		return SortDirectionUnknown
+	}
+}
+
+type SortNulls string
+
+const (
+	SortNullsDefault SortNulls = ""
+	SortNullsFirst   SortNulls = "first"
+	SortNullsLast    SortNulls = "last"
+)
+
+func (s SortNulls) String() string {
+	return string(s)
+}
+
+func ParseSortNulls(str string) SortNulls {
+	str = strings.ToLower(strings.TrimSpace(str))
+	switch str {
+	case "nulls " + string(SortNullsFirst):
+		return SortNullsFirst
+	case "nulls " + string(SortNullsLast):
+		return SortNullsLast
+	default:
+		return SortNullsDefault
+	}
+}
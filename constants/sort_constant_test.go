@@ -0,0 +1,79 @@
+package constants
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSortDirection_ParseSortDirection_ShouldReturnUnknown_WhenValueIsNotValid(t *testing.T) {
+	// Arrange
+	samples := []string{
+		"",
+		"up",
+		"ascend",
+	}
+
+	for _, v := range samples {
+		// Act
+		result := ParseSortDirection(v)
+
+		// Assert
+		assert.Equal(t, SortDirectionUnknown, result)
+	}
+}
+
+func TestSortDirection_ParseSortDirection_ShouldReturnDirection_WhenValueIsValid(t *testing.T) {
+	// Arrange
+	samples := map[string]SortDirection{
+		"asc":        SortDirectionAsc,
+		"ASC":        SortDirectionAsc,
+		"Ascending":  SortDirectionAsc,
+		"desc":       SortDirectionDesc,
+		"DESC":       SortDirectionDesc,
+		"descending": SortDirectionDesc,
+	}
+
+	for k, v := range samples {
+		// Act
+		result := ParseSortDirection(k)
+
+		// Assert
+		assert.Equal(t, v, result)
+	}
+}
+
+func TestSortNulls_ParseSortNulls_ShouldReturnDefault_WhenValueIsNotValid(t *testing.T) {
+	// Arrange
+	samples := []string{
+		"",
+		"first",
+		"nulls middle",
+	}
+
+	for _, v := range samples {
+		// Act
+		result := ParseSortNulls(v)
+
+		// Assert
+		assert.Equal(t, SortNullsDefault, result)
+	}
+}
+
+func TestSortNulls_ParseSortNulls_ShouldReturnNulls_WhenValueIsValid(t *testing.T) {
+	// Arrange
+	samples := map[string]SortNulls{
+		"nulls first": SortNullsFirst,
+		"NULLS FIRST": SortNullsFirst,
+		"nulls last":  SortNullsLast,
+		"Nulls Last":  SortNullsLast,
+	}
+
+	for k, v := range samples {
+		// Act
+		result := ParseSortNulls(k)
+
+		// Assert
+		assert.Equal(t, v, result)
+	}
+}
@@ -22,6 +22,8 @@ const (
 	TokenTypeLessThanOrEqual    TokenType = "less-than-or-equal"
 	TokenTypeBlank              TokenType = "blank"
 	TokenTypeNotBlank           TokenType = "not-blank"
+	TokenTypeIsNull             TokenType = "is-null"
+	TokenTypeIsNotNull          TokenType = "is-not-null"
 	TokenTypeContain            TokenType = "contain"
 	TokenTypeNotContain         TokenType = "not-contain"
 	TokenTypeStartWith          TokenType = "start-with"
@@ -30,6 +32,10 @@ const (
 	TokenTypeNotEndWith         TokenType = "not-end-with"
 	TokenTypeIn                 TokenType = "in"
 	TokenTypeNotIn              TokenType = "not-in"
+	TokenTypeInSubnet           TokenType = "in-subnet"
+	TokenTypeNotInSubnet        TokenType = "not-in-subnet"
+	TokenTypeWithin             TokenType = "within"
+	TokenTypeSearch             TokenType = "search"
 	TokenTypeComma              TokenType = "comma"
 	TokenTypeSlash              TokenType = "slash"
 	TokenTypeStringValue        TokenType = "string-value"
@@ -37,7 +43,11 @@ const (
 	TokenTypeBooleanValue       TokenType = "boolean-value"
 	TokenTypeDateValue          TokenType = "date-value"
 	TokenTypeTimeValue          TokenType = "time-value"
+	TokenTypeDurationValue      TokenType = "duration-value"
 	TokenTypeDateTimeValue      TokenType = "datetime-value"
+	TokenTypeGeoValue           TokenType = "geo-value"
+	TokenTypeNullValue          TokenType = "null-value"
+	TokenTypeFreeTextValue      TokenType = "free-text-value"
 	TokenTypeLiteral            TokenType = "literal"
 	TokenTypeSpace              TokenType = "space"
 )
@@ -60,6 +70,10 @@ func (t TokenType) ToOperator() Operator {
 		return OperatorBlank
 	case TokenTypeNotBlank:
 		return OperatorNotBlank
+	case TokenTypeIsNull:
+		return OperatorIsNull
+	case TokenTypeIsNotNull:
+		return OperatorIsNotNull
 	case TokenTypeContain:
 		return OperatorContain
 	case TokenTypeNotContain:
@@ -76,6 +90,14 @@ func (t TokenType) ToOperator() Operator {
 		return OperatorNotIn
 	case TokenTypeIn:
 		return OperatorIn
+	case TokenTypeInSubnet:
+		return OperatorInSubnet
+	case TokenTypeNotInSubnet:
+		return OperatorNotInSubnet
+	case TokenTypeWithin:
+		return OperatorWithin
+	case TokenTypeSearch:
+		return OperatorSearch
 	}
 
 	return OperatorUnknown
@@ -93,6 +115,8 @@ func (t TokenType) IsOperatorTokenType() bool {
 		TokenTypeNotEqual,
 		TokenTypeBlank,
 		TokenTypeNotBlank,
+		TokenTypeIsNull,
+		TokenTypeIsNotNull,
 		TokenTypeLessThan,
 		TokenTypeLessThanOrEqual,
 		TokenTypeGreaterThan,
@@ -105,6 +129,10 @@ func (t TokenType) IsOperatorTokenType() bool {
 		TokenTypeNotEndWith,
 		TokenTypeIn,
 		TokenTypeNotIn,
+		TokenTypeInSubnet,
+		TokenTypeNotInSubnet,
+		TokenTypeWithin,
+		TokenTypeSearch,
 	})
 }
 
@@ -124,6 +152,10 @@ func (t TokenType) IsComparerTokenType() bool {
 		TokenTypeNotEndWith,
 		TokenTypeIn,
 		TokenTypeNotIn,
+		TokenTypeInSubnet,
+		TokenTypeNotInSubnet,
+		TokenTypeWithin,
+		TokenTypeSearch,
 	})
 }
 
@@ -131,6 +163,8 @@ func (t TokenType) IsNotComparerTokenType() bool {
 	return utils.IsInAny(t, []TokenType{
 		TokenTypeBlank,
 		TokenTypeNotBlank,
+		TokenTypeIsNull,
+		TokenTypeIsNotNull,
 	})
 }
 
@@ -157,7 +191,23 @@ func (t TokenType) IsValueTokenType() bool {
 		TokenTypeBooleanValue,
 		TokenTypeDateValue,
 		TokenTypeTimeValue,
+		TokenTypeDurationValue,
 		TokenTypeDateTimeValue,
+		TokenTypeGeoValue,
+		TokenTypeNullValue,
+		TokenTypeFreeTextValue,
+	})
+}
+
+func (t TokenType) IsNullTokenType() bool {
+	return utils.IsInAny(t, []TokenType{
+		TokenTypeNullValue,
+	})
+}
+
+func (t TokenType) IsFreeTextTokenType() bool {
+	return utils.IsInAny(t, []TokenType{
+		TokenTypeFreeTextValue,
 	})
 }
 
@@ -20,6 +20,9 @@ func TestTokenType_ToOperator_ShouldReturnUnknown_WhenValueIsNotValid(t *testing
 		TokenTypeDateValue,
 		TokenTypeTimeValue,
 		TokenTypeDateTimeValue,
+		TokenTypeGeoValue,
+		TokenTypeNullValue,
+		TokenTypeFreeTextValue,
 		TokenTypeLiteral,
 		TokenTypeAnd,
 		TokenTypeOr,
@@ -48,6 +51,8 @@ func TestTokenType_ToOperator_ShouldReturnOperator_WhenValueIsValid(t *testing.T
 		TokenTypeLessThanOrEqual:    OperatorLessThanOrEqual,
 		TokenTypeBlank:              OperatorBlank,
 		TokenTypeNotBlank:           OperatorNotBlank,
+		TokenTypeIsNull:             OperatorIsNull,
+		TokenTypeIsNotNull:          OperatorIsNotNull,
 		TokenTypeContain:            OperatorContain,
 		TokenTypeNotContain:         OperatorNotContain,
 		TokenTypeStartWith:          OperatorStartWith,
@@ -56,6 +61,10 @@ func TestTokenType_ToOperator_ShouldReturnOperator_WhenValueIsValid(t *testing.T
 		TokenTypeNotEndWith:         OperatorNotEndWith,
 		TokenTypeIn:                 OperatorIn,
 		TokenTypeNotIn:              OperatorNotIn,
+		TokenTypeInSubnet:           OperatorInSubnet,
+		TokenTypeNotInSubnet:        OperatorNotInSubnet,
+		TokenTypeWithin:             OperatorWithin,
+		TokenTypeSearch:             OperatorSearch,
 	}
 
 	for k, v := range samples {
@@ -0,0 +1,105 @@
+package constants
+
+import (
+	"strings"
+)
+
+type UrlStyle string
+
+const (
+	UrlStyleUnknown UrlStyle = ""
+	UrlStyleBracket UrlStyle = "bracket"
+	UrlStyleColon   UrlStyle = "colon"
+)
+
+var (
+	urlOperatorCodes = map[Operator]string{
+		OperatorEqual:              "eq",
+		OperatorNotEqual:           "ne",
+		OperatorContain:            "contains",
+		OperatorNotContain:         "ncontains",
+		OperatorStartWith:          "startswith",
+		OperatorNotStartWith:       "nstartswith",
+		OperatorEndWith:            "endswith",
+		OperatorNotEndWith:         "nendswith",
+		OperatorBlank:              "blank",
+		OperatorNotBlank:           "nblank",
+		OperatorIsNull:             "null",
+		OperatorIsNotNull:          "nnull",
+		OperatorGreaterThan:        "gt",
+		OperatorGreaterThanOrEqual: "gte",
+		OperatorLessThan:           "lt",
+		OperatorLessThanOrEqual:    "lte",
+		OperatorIn:                 "in",
+		OperatorNotIn:              "nin",
+		OperatorInSubnet:           "insubnet",
+		OperatorNotInSubnet:        "ninsubnet",
+		OperatorWithin:             "within",
+	}
+	urlOperatorAliases = map[string]Operator{
+		"neq":   OperatorNotEqual,
+		"ge":    OperatorGreaterThanOrEqual,
+		"le":    OperatorLessThanOrEqual,
+		"notin": OperatorNotIn,
+	}
+)
+
+func (s UrlStyle) String() string {
+	return string(s)
+}
+
+func (s UrlStyle) Key(field string, code string) string {
+	switch s {
+	case UrlStyleColon:
+		return field + ":" + code
+	default:
+		return field + "[" + code + "]"
+	}
+}
+
+func (s UrlStyle) SplitKey(key string) (string, string) {
+	switch s {
+	case UrlStyleColon:
+		if i := strings.LastIndex(key, ":"); i > 0 {
+			return key[:i], key[i+1:]
+		}
+	default:
+		if i := strings.LastIndex(key, "["); i > 0 && strings.HasSuffix(key, "]") {
+			return key[:i], key[i+1 : len(key)-1]
+		}
+	}
+
+	return key, ""
+}
+
+func ParseUrlStyle(str string) UrlStyle {
+	str = strings.ToLower(strings.TrimSpace(str))
+	switch str {
+	case string(UrlStyleBracket):
+		return UrlStyleBracket
+	case string(UrlStyleColon):
+		return UrlStyleColon
+	default:
+		return UrlStyleUnknown
+	}
+}
+
+func (o Operator) ToUrlCode() string {
+	return urlOperatorCodes[o]
+}
+
+func ParseUrlOperator(str string) Operator {
+	str = strings.ToLower(strings.TrimSpace(str))
+
+	if operator, ok := urlOperatorAliases[str]; ok {
+		return operator
+	}
+
+	for operator, code := range urlOperatorCodes {
+		if code == str {
+			return operator
+		}
+	}
+
+	return OperatorUnknown
+}
@@ -0,0 +1,155 @@
+package constants
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestUrlStyle_ParseUrlStyle_ShouldReturnUnknown_WhenValueIsNotValid(t *testing.T) {
+	// Arrange
+	samples := []string{
+		"",
+		"dot",
+		"brackets",
+	}
+
+	for _, v := range samples {
+		// Act
+		result := ParseUrlStyle(v)
+
+		// Assert
+		assert.Equal(t, UrlStyleUnknown, result)
+	}
+}
+
+func TestUrlStyle_ParseUrlStyle_ShouldReturnStyle_WhenValueIsValid(t *testing.T) {
+	// Arrange
+	samples := map[string]UrlStyle{
+		"bracket":  UrlStyleBracket,
+		" Bracket": UrlStyleBracket,
+		"colon":    UrlStyleColon,
+		"COLON":    UrlStyleColon,
+	}
+
+	for k, v := range samples {
+		// Act
+		result := ParseUrlStyle(k)
+
+		// Assert
+		assert.Equal(t, v, result)
+	}
+}
+
+func TestUrlStyle_Key_ShouldReturnKey(t *testing.T) {
+	// Arrange
+	samples := map[UrlStyle]string{
+		UrlStyleUnknown: "age[gte]",
+		UrlStyleBracket: "age[gte]",
+		UrlStyleColon:   "age:gte",
+	}
+
+	for style, expected := range samples {
+		// Act
+		result := style.Key("age", "gte")
+
+		// Assert
+		assert.Equal(t, expected, result)
+	}
+}
+
+func TestUrlStyle_SplitKey_ShouldReturnFieldAndCode(t *testing.T) {
+	// Arrange
+	samples := []struct {
+		style UrlStyle
+		key   string
+		field string
+		code  string
+	}{
+		{UrlStyleBracket, "age[gte]", "age", "gte"},
+		{UrlStyleBracket, "age[]", "age", ""},
+		{UrlStyleBracket, "age", "age", ""},
+		{UrlStyleBracket, "[gte]", "[gte]", ""},
+		{UrlStyleBracket, "age:gte", "age:gte", ""},
+		{UrlStyleColon, "age:gte", "age", "gte"},
+		{UrlStyleColon, "age", "age", ""},
+		{UrlStyleColon, ":gte", ":gte", ""},
+		{UrlStyleColon, "age[gte]", "age[gte]", ""},
+	}
+
+	for _, v := range samples {
+		// Act
+		field, code := v.style.SplitKey(v.key)
+
+		// Assert
+		assert.Equal(t, v.field, field, v.key)
+		assert.Equal(t, v.code, code, v.key)
+	}
+}
+
+func TestOperator_ToUrlCode_ShouldReturnEmpty_WhenOperatorHasNoCode(t *testing.T) {
+	// Arrange
+	samples := []Operator{
+		OperatorUnknown,
+		OperatorSearch,
+	}
+
+	for _, v := range samples {
+		// Act
+		result := v.ToUrlCode()
+
+		// Assert
+		assert.Empty(t, result)
+	}
+}
+
+func TestOperator_ParseUrlOperator_ShouldReturnUnknown_WhenValueIsNotMatched(t *testing.T) {
+	// Arrange
+	samples := []string{
+		"",
+		"equal",
+		"search",
+		"between",
+	}
+
+	for _, v := range samples {
+		// Act
+		result := ParseUrlOperator(v)
+
+		// Assert
+		assert.Equal(t, OperatorUnknown, result)
+	}
+}
+
+func TestOperator_ParseUrlOperator_ShouldReturnOperator_WhenValueIsMatched(t *testing.T) {
+	// Arrange
+	samples := map[string]Operator{
+		"eq":    OperatorEqual,
+		"NE":    OperatorNotEqual,
+		"neq":   OperatorNotEqual,
+		"gte":   OperatorGreaterThanOrEqual,
+		"ge":    OperatorGreaterThanOrEqual,
+		"le":    OperatorLessThanOrEqual,
+		"nin":   OperatorNotIn,
+		"notin": OperatorNotIn,
+		"null":  OperatorIsNull,
+	}
+
+	for k, v := range samples {
+		// Act
+		result := ParseUrlOperator(k)
+
+		// Assert
+		assert.Equal(t, v, result, k)
+	}
+}
+
+func TestOperator_ToUrlCode_ShouldRoundTrip_WhenOperatorHasCode(t *testing.T) {
+	for operator, code := range urlOperatorCodes {
+		// Act
+		result := ParseUrlOperator(operator.ToUrlCode())
+
+		// Assert
+		assert.Equal(t, operator, result, code)
+	}
+}
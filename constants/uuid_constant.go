@@ -0,0 +1,9 @@
+package constants
+
+type UUIDFormat string
+
+const (
+	UUIDFormatUnknown UUIDFormat = ""
+	UUIDFormatBinary  UUIDFormat = "binary"
+	UUIDFormatString  UUIDFormat = "string"
+)
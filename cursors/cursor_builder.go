@@ -0,0 +1,286 @@
+package cursors
+
+import (
+	"bytes"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"reflect"
+	"strings"
+	"time"
+
+	"github.com/filtex/filtex-go/constants"
+	"github.com/filtex/filtex-go/errors"
+	"github.com/filtex/filtex-go/expressions"
+	"github.com/filtex/filtex-go/utils"
+)
+
+type CursorBuilder struct {
+	sorts  []*expressions.SortExpression
+	secret []byte
+}
+
+type cursorPayload struct {
+	Fields []string      `json:"f"`
+	Values []interface{} `json:"v"`
+}
+
+func NewCursorBuilder(sorts []*expressions.SortExpression) *CursorBuilder {
+	return &CursorBuilder{
+		sorts: sorts,
+	}
+}
+
+func (b *CursorBuilder) Secret(secret []byte) *CursorBuilder {
+	b.secret = secret
+	return b
+}
+
+func (b *CursorBuilder) Encode(row map[string]interface{}) (string, error) {
+	if err := b.validateSorts(); err != nil {
+		return "", err
+	}
+
+	payload := cursorPayload{
+		Fields: make([]string, 0),
+		Values: make([]interface{}, 0),
+	}
+
+	for _, v := range b.sorts {
+		value, err := b.castValue(v.Type, row[v.Field])
+		if err != nil {
+			return "", err
+		}
+
+		if t, ok := value.(*time.Time); ok {
+			value = t.Format(time.RFC3339Nano)
+		} else if d, ok := value.(time.Duration); ok {
+			value = d.String()
+		}
+
+		payload.Fields = append(payload.Fields, v.Field)
+		payload.Values = append(payload.Values, value)
+	}
+
+	data, err := json.Marshal(payload)
+	if err != nil {
+		return "", err
+	}
+
+	cursor := base64.RawURLEncoding.EncodeToString(data)
+
+	if len(b.secret) > 0 {
+		cursor = cursor + "." + base64.RawURLEncoding.EncodeToString(b.sign(data))
+	}
+
+	return cursor, nil
+}
+
+func (b *CursorBuilder) Decode(cursor string) ([]interface{}, error) {
+	if err := b.validateSorts(); err != nil {
+		return nil, err
+	}
+
+	data, err := b.verify(cursor)
+	if err != nil {
+		return nil, err
+	}
+
+	var payload cursorPayload
+
+	decoder := json.NewDecoder(bytes.NewReader(data))
+	decoder.UseNumber()
+
+	if err := decoder.Decode(&payload); err != nil {
+		return nil, errors.NewInvalidCursorError()
+	}
+
+	if len(payload.Fields) != len(b.sorts) || len(payload.Values) != len(b.sorts) {
+		return nil, errors.NewInvalidCursorError()
+	}
+
+	values := make([]interface{}, 0)
+
+	for i, v := range b.sorts {
+		if payload.Fields[i] != v.Field {
+			return nil, errors.NewInvalidCursorError()
+		}
+
+		value, err := b.castValue(v.Type, payload.Values[i])
+		if err != nil {
+			return nil, errors.NewInvalidCursorError()
+		}
+
+		values = append(values, value)
+	}
+
+	return values, nil
+}
+
+func (b *CursorBuilder) Expression(cursor string) (expressions.Expression, error) {
+	values, err := b.Decode(cursor)
+	if err != nil {
+		return nil, err
+	}
+
+	alternatives := make([]expressions.Expression, 0)
+
+	for i, v := range b.sorts {
+		conditions := make([]expressions.Expression, 0)
+
+		for j := 0; j < i; j++ {
+			conditions = append(conditions, b.equal(b.sorts[j], values[j])...)
+		}
+
+		operator := constants.OperatorGreaterThan
+		if v.Direction == constants.SortDirectionDesc {
+			operator = constants.OperatorLessThan
+		}
+
+		conditions = append(conditions, expressions.NewCollatedOperatorExpression(v.Type, v.Field, operator, values[i], v.Collation))
+
+		if len(conditions) == 1 {
+			alternatives = append(alternatives, conditions[0])
+		} else {
+			alternatives = append(alternatives, expressions.NewLogicExpression(constants.LogicAnd, conditions))
+		}
+	}
+
+	if len(alternatives) == 1 {
+		return alternatives[0], nil
+	}
+
+	return expressions.NewLogicExpression(constants.LogicOr, alternatives), nil
+}
+
+func (b *CursorBuilder) equal(sort *expressions.SortExpression, value interface{}) []expressions.Expression {
+	if sort.Type != constants.FieldTypeString {
+		return []expressions.Expression{
+			expressions.NewOperatorExpression(sort.Type, sort.Field, constants.OperatorEqual, value),
+		}
+	}
+
+	return []expressions.Expression{
+		expressions.NewCollatedOperatorExpression(sort.Type, sort.Field, constants.OperatorGreaterThanOrEqual, value, sort.Collation),
+		expressions.NewCollatedOperatorExpression(sort.Type, sort.Field, constants.OperatorLessThanOrEqual, value, sort.Collation),
+	}
+}
+
+func (b *CursorBuilder) Apply(filter expressions.Expression, cursor string) (expressions.Expression, error) {
+	if cursor == "" {
+		return filter, nil
+	}
+
+	cursorExpression, err := b.Expression(cursor)
+	if err != nil {
+		return nil, err
+	}
+
+	if filter == nil {
+		return cursorExpression, nil
+	}
+
+	return expressions.NewLogicExpression(constants.LogicAnd, []expressions.Expression{
+		filter,
+		cursorExpression,
+	}), nil
+}
+
+func (b *CursorBuilder) validateSorts() error {
+	if len(b.sorts) == 0 {
+		return errors.NewCursorSortIsEmptyError()
+	}
+
+	for _, v := range b.sorts {
+		if v == nil || v.Field == "" {
+			return errors.NewCursorSortIsEmptyError()
+		}
+
+		if v.Nulls != constants.SortNullsDefault {
+			return errors.NewUnsupportedCursorFieldError()
+		}
+
+		if v.Type != constants.FieldTypeNumber &&
+			v.Type != constants.FieldTypeInteger &&
+			v.Type != constants.FieldTypeDecimal &&
+			v.Type != constants.FieldTypeDate &&
+			v.Type != constants.FieldTypeTime &&
+			v.Type != constants.FieldTypeDuration &&
+			v.Type != constants.FieldTypeDateTime &&
+			v.Type != constants.FieldTypeString &&
+			v.Type != constants.FieldTypeBoolean &&
+			v.Type != constants.FieldTypeUUID {
+			return errors.NewUnsupportedCursorFieldError()
+		}
+	}
+
+	return nil
+}
+
+func (b *CursorBuilder) sign(data []byte) []byte {
+	mac := hmac.New(sha256.New, b.secret)
+	mac.Write(data)
+	return mac.Sum(nil)
+}
+
+func (b *CursorBuilder) verify(cursor string) ([]byte, error) {
+	payload, signature, signed := strings.Cut(cursor, ".")
+
+	data, err := base64.RawURLEncoding.DecodeString(payload)
+	if err != nil {
+		return nil, errors.NewInvalidCursorError()
+	}
+
+	if len(b.secret) == 0 {
+		if signed {
+			return nil, errors.NewInvalidCursorError()
+		}
+		return data, nil
+	}
+
+	if !signed {
+		return nil, errors.NewInvalidCursorError()
+	}
+
+	mac, err := base64.RawURLEncoding.DecodeString(signature)
+	if err != nil || !hmac.Equal(mac, b.sign(data)) {
+		return nil, errors.NewInvalidCursorError()
+	}
+
+	return data, nil
+}
+
+func (b *CursorBuilder) castValue(fieldType constants.FieldType, value interface{}) (interface{}, error) {
+	if value == nil || (reflect.ValueOf(value).Kind() == reflect.Pointer && reflect.ValueOf(value).IsNil()) {
+		return nil, errors.NewCursorValueIsNullError()
+	}
+
+	switch fieldType {
+	case constants.FieldTypeString:
+		return utils.String(value)
+	case constants.FieldTypeBoolean:
+		return utils.Boolean(value)
+	case constants.FieldTypeUUID:
+		return utils.UUID(value)
+	case constants.FieldTypeNumber:
+		return utils.Number(value)
+	case constants.FieldTypeInteger:
+		return utils.Integer(value)
+	case constants.FieldTypeDecimal:
+		return utils.Decimal(value)
+	case constants.FieldTypeDate:
+		return utils.Date(value)
+	case constants.FieldTypeTime:
+		return utils.Time(value)
+	case constants.FieldTypeDuration:
+		return utils.Duration(value)
+	case constants.FieldTypeDateTime:
+		if t, ok := value.(time.Time); ok {
+			return &t, nil
+		}
+		return utils.DateTime(value)
+	}
+
+	return nil, errors.NewUnsupportedCursorFieldError()
+}
@@ -0,0 +1,415 @@
+package cursors
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/filtex/filtex-go/builders/memory"
+	"github.com/filtex/filtex-go/builders/mongo"
+	"github.com/filtex/filtex-go/builders/postgres"
+	"github.com/filtex/filtex-go/constants"
+	"github.com/filtex/filtex-go/expressions"
+	"github.com/stretchr/testify/assert"
+	"go.mongodb.org/mongo-driver/bson"
+)
+
+func newTestSorts() []*expressions.SortExpression {
+	return []*expressions.SortExpression{
+		expressions.NewSortExpression(constants.FieldTypeDateTime, "CreatedAt", constants.SortDirectionDesc, constants.SortNullsDefault),
+		expressions.NewSortExpression(constants.FieldTypeNumber, "Id", constants.SortDirectionAsc, constants.SortNullsDefault),
+	}
+}
+
+func TestCursorBuilder_Encode_ShouldReturnError_WhenSortsAreEmpty(t *testing.T) {
+	// Arrange
+	builder := NewCursorBuilder(nil)
+
+	// Act
+	cursor, err := builder.Encode(map[string]interface{}{"Id": 1})
+
+	// Assert
+	assert.Empty(t, cursor)
+	assert.Error(t, err)
+}
+
+func TestCursorBuilder_Encode_ShouldReturnError_WhenFieldTypeIsNotSupported(t *testing.T) {
+	// Arrange
+	builder := NewCursorBuilder([]*expressions.SortExpression{
+		expressions.NewSortExpression(constants.FieldTypeIP, "Address", constants.SortDirectionAsc, constants.SortNullsDefault),
+	})
+
+	// Act
+	cursor, err := builder.Encode(map[string]interface{}{"Address": "127.0.0.1"})
+
+	// Assert
+	assert.Empty(t, cursor)
+	assert.Error(t, err)
+}
+
+func TestCursorBuilder_Encode_ShouldReturnError_WhenValueIsNull(t *testing.T) {
+	// Arrange
+	builder := NewCursorBuilder(newTestSorts())
+
+	// Act
+	cursor, err := builder.Encode(map[string]interface{}{"Id": 1, "CreatedAt": nil})
+
+	// Assert
+	assert.Empty(t, cursor)
+	assert.Error(t, err)
+}
+
+func TestCursorBuilder_Decode_ShouldReturnError_WhenCursorIsNotValid(t *testing.T) {
+	// Arrange
+	builder := NewCursorBuilder(newTestSorts())
+	otherBuilder := NewCursorBuilder([]*expressions.SortExpression{
+		expressions.NewSortExpression(constants.FieldTypeNumber, "Id", constants.SortDirectionAsc, constants.SortNullsDefault),
+	})
+	otherCursor, _ := otherBuilder.Encode(map[string]interface{}{"Id": 1})
+	samples := []string{
+		"!!!",
+		"e30",
+		otherCursor,
+	}
+
+	for _, v := range samples {
+		// Act
+		values, err := builder.Decode(v)
+
+		// Assert
+		assert.Nil(t, values)
+		assert.Error(t, err)
+	}
+}
+
+func TestCursorBuilder_Decode_ShouldReturnValues_WhenCursorIsEncoded(t *testing.T) {
+	// Arrange
+	builder := NewCursorBuilder(newTestSorts())
+	createdAt := time.Date(2024, 1, 5, 10, 30, 0, 123000000, time.UTC)
+
+	// Act
+	cursor, encodeErr := builder.Encode(map[string]interface{}{"Id": 42, "CreatedAt": createdAt})
+	values, decodeErr := builder.Decode(cursor)
+
+	// Assert
+	assert.NoError(t, encodeErr)
+	assert.NoError(t, decodeErr)
+	assert.Len(t, values, 2)
+	assert.True(t, createdAt.Equal(*values[0].(*time.Time)))
+	assert.Equal(t, float64(42), values[1])
+}
+
+func TestCursorBuilder_Decode_ShouldPreservePrecision_WhenFieldTypesAreIntegerAndDecimal(t *testing.T) {
+	// Arrange
+	builder := NewCursorBuilder([]*expressions.SortExpression{
+		expressions.NewSortExpression(constants.FieldTypeDecimal, "Price", constants.SortDirectionAsc, constants.SortNullsDefault),
+		expressions.NewSortExpression(constants.FieldTypeInteger, "Id", constants.SortDirectionAsc, constants.SortNullsDefault),
+	})
+
+	// Act
+	cursor, encodeErr := builder.Encode(map[string]interface{}{"Price": "19.90", "Id": int64(9007199254740993)})
+	values, decodeErr := builder.Decode(cursor)
+
+	// Assert
+	assert.NoError(t, encodeErr)
+	assert.NoError(t, decodeErr)
+	assert.Equal(t, []interface{}{"19.90", int64(9007199254740993)}, values)
+}
+
+func TestCursorBuilder_Decode_ShouldReturnDuration_WhenFieldTypeIsDuration(t *testing.T) {
+	// Arrange
+	builder := NewCursorBuilder([]*expressions.SortExpression{
+		expressions.NewSortExpression(constants.FieldTypeDuration, "Timeout", constants.SortDirectionAsc, constants.SortNullsDefault),
+	})
+
+	// Act
+	cursor, encodeErr := builder.Encode(map[string]interface{}{"Timeout": 36*time.Hour + 1500*time.Millisecond})
+	values, decodeErr := builder.Decode(cursor)
+
+	// Assert
+	assert.NoError(t, encodeErr)
+	assert.NoError(t, decodeErr)
+	assert.Equal(t, []interface{}{36*time.Hour + 1500*time.Millisecond}, values)
+}
+
+func TestCursorBuilder_Decode_ShouldReturnValues_WhenFieldTypesAreStringBooleanAndUUID(t *testing.T) {
+	// Arrange
+	builder := NewCursorBuilder([]*expressions.SortExpression{
+		expressions.NewSortExpression(constants.FieldTypeString, "Name", constants.SortDirectionAsc, constants.SortNullsDefault),
+		expressions.NewSortExpression(constants.FieldTypeBoolean, "Active", constants.SortDirectionDesc, constants.SortNullsDefault),
+		expressions.NewSortExpression(constants.FieldTypeUUID, "Id", constants.SortDirectionAsc, constants.SortNullsDefault),
+	})
+
+	// Act
+	cursor, encodeErr := builder.Encode(map[string]interface{}{"Name": "Filtex", "Active": true, "Id": "7A1E5B4C-0C5E-4A8E-9F5E-2D6B1C3A4E5F"})
+	values, decodeErr := builder.Decode(cursor)
+
+	// Assert
+	assert.NoError(t, encodeErr)
+	assert.NoError(t, decodeErr)
+	assert.Equal(t, []interface{}{"Filtex", true, "7a1e5b4c-0c5e-4a8e-9f5e-2d6b1c3a4e5f"}, values)
+}
+
+func TestCursorBuilder_Decode_ShouldReturnValues_WhenCursorIsSigned(t *testing.T) {
+	// Arrange
+	builder := NewCursorBuilder(newTestSorts()).Secret([]byte("secret"))
+
+	// Act
+	cursor, encodeErr := builder.Encode(map[string]interface{}{"Id": 42, "CreatedAt": "2024-01-05T10:30:00Z"})
+	values, decodeErr := builder.Decode(cursor)
+
+	// Assert
+	assert.NoError(t, encodeErr)
+	assert.NoError(t, decodeErr)
+	assert.Len(t, values, 2)
+	assert.Equal(t, float64(42), values[1])
+}
+
+func TestCursorBuilder_Decode_ShouldReturnError_WhenSignatureIsNotValid(t *testing.T) {
+	// Arrange
+	builder := NewCursorBuilder(newTestSorts()).Secret([]byte("secret"))
+	row := map[string]interface{}{"Id": 42, "CreatedAt": "2024-01-05T10:30:00Z"}
+	unsignedCursor, _ := NewCursorBuilder(newTestSorts()).Encode(row)
+	otherCursor, _ := NewCursorBuilder(newTestSorts()).Secret([]byte("other")).Encode(row)
+	tamperedCursor, _ := NewCursorBuilder(newTestSorts()).Encode(map[string]interface{}{"Id": 43, "CreatedAt": "2024-01-05T10:30:00Z"})
+	signedCursor, _ := builder.Encode(row)
+	samples := []string{
+		unsignedCursor,
+		otherCursor,
+		tamperedCursor + signedCursor[strings.Index(signedCursor, "."):],
+		signedCursor + "x",
+	}
+
+	for _, v := range samples {
+		// Act
+		values, err := builder.Decode(v)
+
+		// Assert
+		assert.Nil(t, values)
+		assert.Error(t, err)
+	}
+}
+
+func TestCursorBuilder_Decode_ShouldReturnError_WhenSignedCursorIsDecodedWithoutSecret(t *testing.T) {
+	// Arrange
+	cursor, _ := NewCursorBuilder(newTestSorts()).Secret([]byte("secret")).Encode(map[string]interface{}{"Id": 42, "CreatedAt": "2024-01-05T10:30:00Z"})
+
+	// Act
+	values, err := NewCursorBuilder(newTestSorts()).Decode(cursor)
+
+	// Assert
+	assert.Nil(t, values)
+	assert.Error(t, err)
+}
+
+func TestCursorBuilder_Expression_ShouldReturnKeysetExpression_WhenCursorIsValid(t *testing.T) {
+	// Arrange
+	builder := NewCursorBuilder(newTestSorts())
+	cursor, _ := builder.Encode(map[string]interface{}{"Id": 42, "CreatedAt": "2024-01-05T10:30:00Z"})
+
+	// Act
+	expression, err := builder.Expression(cursor)
+	postgresExpression, buildErr := postgres.NewPostgresFilterBuilder().Build(expression)
+
+	// Assert
+	assert.NoError(t, err)
+	assert.NoError(t, buildErr)
+	assert.Equal(t, `(CreatedAt < $1) OR ((CreatedAt = $2) AND (Id > $3))`, postgresExpression.Condition)
+	assert.Len(t, postgresExpression.Args, 3)
+	assert.Equal(t, float64(42), postgresExpression.Args[2])
+}
+
+func TestCursorBuilder_Apply_ShouldReturnFilter_WhenCursorIsEmpty(t *testing.T) {
+	// Arrange
+	builder := NewCursorBuilder(newTestSorts())
+	filter := expressions.NewOperatorExpression(constants.FieldTypeString, "Name", constants.OperatorEqual, "Filtex")
+
+	// Act
+	expression, err := builder.Apply(filter, "")
+
+	// Assert
+	assert.NoError(t, err)
+	assert.Equal(t, filter, expression)
+}
+
+func TestCursorBuilder_Apply_ShouldReturnRowsAfterCursor_WhenUsedWithMemoryBuilder(t *testing.T) {
+	// Arrange
+	builder := NewCursorBuilder(newTestSorts())
+	filter := expressions.NewOperatorExpression(constants.FieldTypeString, "Name", constants.OperatorEqual, "Filtex")
+	items := []map[string]interface{}{
+		{"Id": 1, "Name": "Filtex", "CreatedAt": "2024-01-03T00:00:00Z"},
+		{"Id": 2, "Name": "Filtex", "CreatedAt": "2024-01-02T00:00:00Z"},
+		{"Id": 3, "Name": "Filtex", "CreatedAt": "2024-01-02T00:00:00Z"},
+		{"Id": 4, "Name": "Other", "CreatedAt": "2024-01-01T00:00:00Z"},
+		{"Id": 5, "Name": "Filtex", "CreatedAt": "2024-01-01T00:00:00Z"},
+	}
+	cursor, _ := builder.Encode(items[1])
+
+	// Act
+	expression, err := builder.Apply(filter, cursor)
+	memoryExpression, buildErr := memory.NewMemoryFilterBuilder().Build(expression)
+	result := make([]interface{}, 0)
+	for _, v := range items {
+		if memoryExpression.Fn(v) {
+			result = append(result, v["Id"])
+		}
+	}
+
+	// Assert
+	assert.NoError(t, err)
+	assert.NoError(t, buildErr)
+	assert.Equal(t, []interface{}{3, 5}, result)
+}
+
+func TestCursorBuilder_Apply_ShouldKeepSubSecondPrecision_WhenUsedWithMemoryBuilder(t *testing.T) {
+	// Arrange
+	builder := NewCursorBuilder(newTestSorts())
+	items := []map[string]interface{}{
+		{"Id": 3, "CreatedAt": time.Date(2024, 1, 5, 10, 0, 0, 700000000, time.UTC)},
+		{"Id": 2, "CreatedAt": time.Date(2024, 1, 5, 10, 0, 0, 500000000, time.UTC)},
+		{"Id": 1, "CreatedAt": time.Date(2024, 1, 5, 10, 0, 0, 300000000, time.UTC)},
+	}
+	cursor, _ := builder.Encode(items[1])
+
+	// Act
+	expression, err := builder.Apply(nil, cursor)
+	memoryExpression, buildErr := memory.NewMemoryFilterBuilder().Build(expression)
+	result := make([]interface{}, 0)
+	for _, v := range items {
+		if memoryExpression.Fn(v) {
+			result = append(result, v["Id"])
+		}
+	}
+
+	// Assert
+	assert.NoError(t, err)
+	assert.NoError(t, buildErr)
+	assert.Equal(t, []interface{}{1}, result)
+}
+
+func TestCursorBuilder_Expression_ShouldCompareStringsUnderCollation_WhenFieldTypeIsString(t *testing.T) {
+	// Arrange
+	builder := NewCursorBuilder([]*expressions.SortExpression{
+		expressions.NewSortExpression(constants.FieldTypeString, "Name", constants.SortDirectionAsc, constants.SortNullsDefault),
+		expressions.NewCollatedSortExpression(constants.FieldTypeString, "Code", constants.SortDirectionAsc, constants.SortNullsDefault, constants.CollationCaseSensitive),
+	})
+	cursor, _ := builder.Encode(map[string]interface{}{"Name": "Filtex", "Code": "A1"})
+
+	// Act
+	expression, err := builder.Expression(cursor)
+	postgresExpression, buildErr := postgres.NewPostgresFilterBuilder().Build(expression)
+
+	// Assert
+	assert.NoError(t, err)
+	assert.NoError(t, buildErr)
+	assert.Equal(t, `(LOWER(Name) > LOWER($1)) OR ((LOWER(Name) >= LOWER($2)) AND (LOWER(Name) <= LOWER($3)) AND (Code > $4))`, postgresExpression.Condition)
+}
+
+func TestCursorBuilder_Apply_ShouldReturnRowsAfterCursor_WhenStringKeysDifferInCase(t *testing.T) {
+	// Arrange
+	sorts := []*expressions.SortExpression{
+		expressions.NewSortExpression(constants.FieldTypeString, "Name", constants.SortDirectionAsc, constants.SortNullsDefault),
+		expressions.NewSortExpression(constants.FieldTypeBoolean, "Active", constants.SortDirectionAsc, constants.SortNullsDefault),
+	}
+	builder := NewCursorBuilder(sorts)
+	items := []map[string]interface{}{
+		{"Name": "alpha", "Active": false},
+		{"Name": "Beta", "Active": false},
+		{"Name": "beta", "Active": true},
+		{"Name": "Gamma", "Active": true},
+	}
+	sort, _ := memory.NewMemorySortBuilder().Build(sorts)
+	cursor, _ := builder.Encode(items[1])
+
+	// Act
+	expression, err := builder.Apply(nil, cursor)
+	memoryExpression, buildErr := memory.NewMemoryFilterBuilder().Build(expression)
+	result := make([]interface{}, 0)
+	for _, v := range items {
+		if memoryExpression.Fn(v) {
+			result = append(result, v["Name"])
+		}
+	}
+
+	// Assert
+	assert.NoError(t, err)
+	assert.NoError(t, buildErr)
+	assert.True(t, sort.Less(items[1], items[2]))
+	assert.Equal(t, []interface{}{"beta", "Gamma"}, result)
+}
+
+func TestCursorBuilder_Expression_ShouldCompareKeysExactly_WhenStringKeyHasWildcards(t *testing.T) {
+	// Arrange
+	builder := NewCursorBuilder([]*expressions.SortExpression{
+		expressions.NewSortExpression(constants.FieldTypeString, "Name", constants.SortDirectionAsc, constants.SortNullsDefault),
+		expressions.NewSortExpression(constants.FieldTypeNumber, "Id", constants.SortDirectionAsc, constants.SortNullsDefault),
+	})
+	cursor, _ := builder.Encode(map[string]interface{}{"Name": "50%_off", "Id": 1})
+
+	// Act
+	expression, err := builder.Expression(cursor)
+	postgresExpression, buildErr := postgres.NewPostgresFilterBuilder().Build(expression)
+
+	// Assert
+	assert.NoError(t, err)
+	assert.NoError(t, buildErr)
+	assert.NotContains(t, postgresExpression.Condition, "LIKE")
+	assert.Equal(t, `(LOWER(Name) > LOWER($1)) OR ((LOWER(Name) >= LOWER($2)) AND (LOWER(Name) <= LOWER($3)) AND (Id > $4))`, postgresExpression.Condition)
+	assert.Equal(t, []interface{}{"50%_off", "50%_off", "50%_off", float64(1)}, postgresExpression.Args)
+}
+
+func TestCursorBuilder_Apply_ShouldNotTreatWildcardsAsPatterns_WhenUsedWithMemoryBuilder(t *testing.T) {
+	// Arrange
+	builder := NewCursorBuilder([]*expressions.SortExpression{
+		expressions.NewSortExpression(constants.FieldTypeString, "Name", constants.SortDirectionAsc, constants.SortNullsDefault),
+		expressions.NewSortExpression(constants.FieldTypeNumber, "Id", constants.SortDirectionAsc, constants.SortNullsDefault),
+	})
+	items := []map[string]interface{}{
+		{"Name": "50%_off", "Id": 1},
+		{"Name": "50%_off", "Id": 2},
+		{"Name": "50xyoff", "Id": 0},
+	}
+	cursor, _ := builder.Encode(items[0])
+
+	// Act
+	expression, err := builder.Expression(cursor)
+	memoryExpression, buildErr := memory.NewMemoryFilterBuilder().Build(expression)
+	result := make([]interface{}, 0)
+	for _, v := range items {
+		if memoryExpression.Fn(v) {
+			result = append(result, v["Id"])
+		}
+	}
+
+	// Assert
+	assert.NoError(t, err)
+	assert.NoError(t, buildErr)
+	assert.Equal(t, []interface{}{2, 0}, result)
+}
+
+func TestCursorBuilder_Expression_ShouldBuildMongoFilter_WhenStringKeyHasDefaultCollation(t *testing.T) {
+	// Arrange
+	builder := NewCursorBuilder([]*expressions.SortExpression{
+		expressions.NewSortExpression(constants.FieldTypeString, "Name", constants.SortDirectionAsc, constants.SortNullsDefault),
+		expressions.NewSortExpression(constants.FieldTypeNumber, "Id", constants.SortDirectionAsc, constants.SortNullsDefault),
+	})
+	cursor, _ := builder.Encode(map[string]interface{}{"Name": "a.*", "Id": 1})
+
+	// Act
+	expression, err := builder.Expression(cursor)
+	mongoExpression, buildErr := mongo.NewMongoFilterBuilder().Build(expression)
+
+	// Assert
+	assert.NoError(t, err)
+	assert.NoError(t, buildErr)
+	assert.Equal(t, bson.M{
+		"$or": []bson.M{
+			{"Name": bson.M{"$gt": "a.*"}},
+			{"$and": []bson.M{
+				{"Name": bson.M{"$gte": "a.*"}},
+				{"Name": bson.M{"$lte": "a.*"}},
+				{"Id": bson.M{"$gt": float64(1)}},
+			}},
+		},
+	}, mongoExpression.Condition)
+}
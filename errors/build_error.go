@@ -5,9 +5,14 @@ import (
 )
 
 var (
-	errCouldNotBeBuilt = "could not be built"
+	errCouldNotBeBuilt   = "could not be built"
+	errUnsupportedSubnet = "unsupported subnet"
 )
 
 func NewCouldNotBeBuiltError() error {
 	return errors.New(errCouldNotBeBuilt)
 }
+
+func NewUnsupportedSubnetError() error {
+	return errors.New(errUnsupportedSubnet)
+}
@@ -0,0 +1,28 @@
+package errors
+
+import (
+	"errors"
+)
+
+var (
+	errInvalidCursor          = "invalid cursor"
+	errCursorSortIsEmpty      = "cursor sort is empty"
+	errUnsupportedCursorField = "unsupported cursor field"
+	errCursorValueIsNull      = "cursor value is null"
+)
+
+func NewInvalidCursorError() error {
+	return errors.New(errInvalidCursor)
+}
+
+func NewCursorSortIsEmptyError() error {
+	return errors.New(errCursorSortIsEmpty)
+}
+
+func NewUnsupportedCursorFieldError() error {
+	return errors.New(errUnsupportedCursorField)
+}
+
+func NewCursorValueIsNullError() error {
+	return errors.New(errCursorValueIsNull)
+}
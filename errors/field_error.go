@@ -8,6 +8,7 @@ var (
 	errInvalidFieldType  = "invalid field type"
 	errInvalidFieldName  = "invalid field name"
 	errInvalidFieldLabel = "invalid field label"
+	errInvalidFieldEnum  = "invalid field enum"
 )
 
 func NewInvalidFieldTypeError() error {
@@ -21,3 +22,7 @@ func NewInvalidFieldNameError() error {
 func NewInvalidFieldLabelError() error {
 	return errors.New(errInvalidFieldLabel)
 }
+
+func NewInvalidFieldEnumError() error {
+	return errors.New(errInvalidFieldEnum)
+}
@@ -0,0 +1,18 @@
+package errors
+
+import (
+	"errors"
+)
+
+var (
+	errInvalidFreeTextFields   = "invalid free text fields"
+	errInvalidFreeTextOperator = "invalid free text operator"
+)
+
+func NewInvalidFreeTextFieldsError() error {
+	return errors.New(errInvalidFreeTextFields)
+}
+
+func NewInvalidFreeTextOperatorError() error {
+	return errors.New(errInvalidFreeTextOperator)
+}
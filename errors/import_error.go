@@ -0,0 +1,34 @@
+package errors
+
+import (
+	"errors"
+	"fmt"
+)
+
+var (
+	errUnsupportedConstruct = "unsupported construct"
+)
+
+type ImportError struct {
+	Construct string
+	Err       error
+}
+
+func (e *ImportError) Error() string {
+	if e.Construct == "" {
+		return e.Err.Error()
+	}
+
+	return fmt.Sprintf("%s: %s", e.Construct, e.Err.Error())
+}
+
+func (e *ImportError) Unwrap() error {
+	return e.Err
+}
+
+func NewUnsupportedConstructError(construct string) error {
+	return &ImportError{
+		Construct: construct,
+		Err:       errors.New(errUnsupportedConstruct),
+	}
+}
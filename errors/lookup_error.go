@@ -9,6 +9,19 @@ var (
 	errInvalidLookupValues = "invalid field values"
 )
 
+type LookupError struct {
+	Field string
+	Err   error
+}
+
+func (e *LookupError) Error() string {
+	return e.Err.Error()
+}
+
+func (e *LookupError) Unwrap() error {
+	return e.Err
+}
+
 func NewInvalidLookupKeyError() error {
 	return errors.New(errInvalidLookupKey)
 }
@@ -16,3 +29,10 @@ func NewInvalidLookupKeyError() error {
 func NewInvalidLookupValuesError() error {
 	return errors.New(errInvalidLookupValues)
 }
+
+func NewLookupError(field string, err error) error {
+	return &LookupError{
+		Field: field,
+		Err:   err,
+	}
+}
@@ -8,6 +8,7 @@ var (
 	errOperatorCouldNotBeParsed = "invalid operator"
 	errLogicCouldNotBeParsed    = "invalid logic"
 	errCouldNotBeParsed         = "could not be parsed"
+	errSortCouldNotBeParsed     = "sort could not be parsed"
 )
 
 func NewOperatorCouldNotBeParsedError() error {
@@ -21,3 +22,7 @@ func NewLogicCouldNotBeParsedError() error {
 func NewCouldNotBeParsedError() error {
 	return errors.New(errCouldNotBeParsed)
 }
+
+func NewSortCouldNotBeParsedError() error {
+	return errors.New(errSortCouldNotBeParsed)
+}
@@ -0,0 +1,68 @@
+package errors
+
+import (
+	"errors"
+	"fmt"
+)
+
+var (
+	errUnknownQueryField    = "unknown query field"
+	errUnsupportedOperator  = "unsupported operator"
+	errInvalidQueryValue    = "invalid query value"
+	errEmptyQueryExpression = "empty query expression"
+)
+
+type QueryError struct {
+	Field    string
+	Operator string
+	Err      error
+}
+
+func (e *QueryError) Error() string {
+	if e.Field == "" && e.Operator == "" {
+		return e.Err.Error()
+	}
+
+	if e.Field == "" {
+		return fmt.Sprintf("%s: %s", e.Operator, e.Err.Error())
+	}
+
+	if e.Operator == "" {
+		return fmt.Sprintf("%s: %s", e.Field, e.Err.Error())
+	}
+
+	return fmt.Sprintf("%s %s: %s", e.Field, e.Operator, e.Err.Error())
+}
+
+func (e *QueryError) Unwrap() error {
+	return e.Err
+}
+
+func NewUnknownQueryFieldError(field string) error {
+	return &QueryError{
+		Field: field,
+		Err:   errors.New(errUnknownQueryField),
+	}
+}
+
+func NewUnsupportedOperatorError(field string, operator string) error {
+	return &QueryError{
+		Field:    field,
+		Operator: operator,
+		Err:      errors.New(errUnsupportedOperator),
+	}
+}
+
+func NewInvalidQueryValueError(field string, operator string) error {
+	return &QueryError{
+		Field:    field,
+		Operator: operator,
+		Err:      errors.New(errInvalidQueryValue),
+	}
+}
+
+func NewEmptyQueryExpressionError() error {
+	return &QueryError{
+		Err: errors.New(errEmptyQueryExpression),
+	}
+}
@@ -0,0 +1,56 @@
+package errors
+
+import (
+	"errors"
+	"fmt"
+)
+
+var (
+	errInvalidVersion  = "invalid version"
+	errInvalidMetadata = "invalid metadata"
+	errRemovedField    = "removed field"
+	errRetypedField    = "retyped field"
+)
+
+type SchemaError struct {
+	Field        string
+	PreviousType string
+	CurrentType  string
+	Err          error
+}
+
+func (e *SchemaError) Error() string {
+	if e.PreviousType != "" && e.CurrentType != "" {
+		return fmt.Sprintf("%s: %s from %s to %s", e.Field, e.Err.Error(), e.PreviousType, e.CurrentType)
+	}
+
+	return fmt.Sprintf("%s: %s", e.Field, e.Err.Error())
+}
+
+func (e *SchemaError) Unwrap() error {
+	return e.Err
+}
+
+func NewInvalidVersionError() error {
+	return errors.New(errInvalidVersion)
+}
+
+func NewInvalidMetadataError() error {
+	return errors.New(errInvalidMetadata)
+}
+
+func NewRemovedFieldError(field string) error {
+	return &SchemaError{
+		Field: field,
+		Err:   errors.New(errRemovedField),
+	}
+}
+
+func NewRetypedFieldError(field string, previousType string, currentType string) error {
+	return &SchemaError{
+		Field:        field,
+		PreviousType: previousType,
+		CurrentType:  currentType,
+		Err:          errors.New(errRetypedField),
+	}
+}
@@ -0,0 +1,13 @@
+package errors
+
+import (
+	"errors"
+)
+
+var (
+	errInvalidTimeZone = "invalid time zone"
+)
+
+func NewInvalidTimeZoneError() error {
+	return errors.New(errInvalidTimeZone)
+}
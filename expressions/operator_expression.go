@@ -5,17 +5,23 @@ import (
 )
 
 type OperatorExpression struct {
-	Type     constants.FieldType
-	Field    string
-	Operator constants.Operator
-	Value    interface{}
+	Type      constants.FieldType
+	Field     string
+	Operator  constants.Operator
+	Value     interface{}
+	Collation constants.Collation
 }
 
 func NewOperatorExpression(fieldType constants.FieldType, field string, operator constants.Operator, value interface{}) Expression {
+	return NewCollatedOperatorExpression(fieldType, field, operator, value, constants.CollationDefault)
+}
+
+func NewCollatedOperatorExpression(fieldType constants.FieldType, field string, operator constants.Operator, value interface{}, collation constants.Collation) Expression {
 	return &OperatorExpression{
-		Type:     fieldType,
-		Field:    field,
-		Operator: operator,
-		Value:    value,
+		Type:      fieldType,
+		Field:     field,
+		Operator:  operator,
+		Value:     value,
+		Collation: collation,
 	}
 }
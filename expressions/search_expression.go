@@ -0,0 +1,13 @@
+package expressions
+
+type SearchExpression struct {
+	Fields []string
+	Value  string
+}
+
+func NewSearchExpression(fields []string, value string) Expression {
+	return &SearchExpression{
+		Fields: fields,
+		Value:  value,
+	}
+}
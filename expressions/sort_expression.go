@@ -0,0 +1,27 @@
+package expressions
+
+import (
+	"github.com/filtex/filtex-go/constants"
+)
+
+type SortExpression struct {
+	Type      constants.FieldType
+	Field     string
+	Direction constants.SortDirection
+	Nulls     constants.SortNulls
+	Collation constants.Collation
+}
+
+func NewSortExpression(fieldType constants.FieldType, field string, direction constants.SortDirection, nulls constants.SortNulls) *SortExpression {
+	return NewCollatedSortExpression(fieldType, field, direction, nulls, constants.CollationDefault)
+}
+
+func NewCollatedSortExpression(fieldType constants.FieldType, field string, direction constants.SortDirection, nulls constants.SortNulls, collation constants.Collation) *SortExpression {
+	return &SortExpression{
+		Type:      fieldType,
+		Field:     field,
+		Direction: direction,
+		Nulls:     nulls,
+		Collation: collation,
+	}
+}
@@ -1,22 +1,50 @@
 package filtex
 
 import (
+	"context"
+	"net/url"
+	"strings"
+	"sync/atomic"
+	"time"
+
+	"github.com/filtex/filtex-go/constants"
+	"github.com/filtex/filtex-go/errors"
 	"github.com/filtex/filtex-go/expressions"
 	"github.com/filtex/filtex-go/models"
 	"github.com/filtex/filtex-go/options"
 	"github.com/filtex/filtex-go/parsers"
+	"github.com/filtex/filtex-go/queries"
+	"github.com/filtex/filtex-go/schemas"
 	"github.com/filtex/filtex-go/tokenizers"
 	"github.com/filtex/filtex-go/validators"
 )
 
 type Filtex struct {
-	metadata *models.Metadata
+	metadata    *atomic.Pointer[models.Metadata]
+	ctx         context.Context
+	location    *time.Location
+	hasLocation bool
 }
 
 func New(opts ...options.Option) (*Filtex, error) {
-	f := &Filtex{}
+	metadata, err := buildMetadata(opts...)
+	if err != nil {
+		return nil, err
+	}
+
+	f := &Filtex{
+		metadata: &atomic.Pointer[models.Metadata]{},
+		ctx:      context.Background(),
+	}
+
+	f.metadata.Store(metadata)
 
+	return f, nil
+}
+
+func buildMetadata(opts ...options.Option) (*models.Metadata, error) {
 	lookups := make(map[string][]models.Lookup)
+	providers := make(map[string]models.LookupProvider)
 
 	for _, v := range opts {
 		if lookupOption, ok := v.(*options.LookupOption); ok {
@@ -27,6 +55,14 @@ func New(opts ...options.Option) (*Filtex, error) {
 			for lk, lv := range build {
 				lookups[lk] = lv
 			}
+
+			provider, err := lookupOption.BuildProvider()
+			if err != nil {
+				return nil, err
+			}
+			for pk, pv := range provider {
+				providers[pk] = pv
+			}
 		}
 	}
 
@@ -34,7 +70,7 @@ func New(opts ...options.Option) (*Filtex, error) {
 
 	for _, v := range opts {
 		if fieldOption, ok := v.(*options.FieldOption); ok {
-			build, err := fieldOption.Build(lookups)
+			build, err := fieldOption.BuildWithProviders(lookups, providers)
 			if err != nil {
 				return nil, err
 			}
@@ -42,29 +78,345 @@ func New(opts ...options.Option) (*Filtex, error) {
 		}
 	}
 
-	f.metadata = &models.Metadata{
-		Fields: fields,
+	var freeText *models.FreeText
+
+	for _, v := range opts {
+		if freeTextOption, ok := v.(*options.FreeTextOption); ok {
+			build, err := freeTextOption.Build(fields)
+			if err != nil {
+				return nil, err
+			}
+			freeText = build
+		}
 	}
 
-	return f, nil
+	var location *time.Location
+
+	for _, v := range opts {
+		if timeZoneOption, ok := v.(*options.TimeZoneOption); ok {
+			build, err := timeZoneOption.Build()
+			if err != nil {
+				return nil, err
+			}
+			location = build
+		}
+	}
+
+	version := ""
+
+	for _, v := range opts {
+		if versionOption, ok := v.(*options.VersionOption); ok {
+			build, err := versionOption.Build()
+			if err != nil {
+				return nil, err
+			}
+			version = build
+		}
+	}
+
+	return (&models.Metadata{
+		Version:  version,
+		Fields:   fields,
+		FreeText: freeText,
+	}).WithLocation(location), nil
+}
+
+func (f *Filtex) WithLocation(location *time.Location) *Filtex {
+	return &Filtex{
+		metadata:    f.metadata,
+		ctx:         f.ctx,
+		location:    location,
+		hasLocation: true,
+	}
+}
+
+func (f *Filtex) WithContext(ctx context.Context) *Filtex {
+	return &Filtex{
+		metadata:    f.metadata,
+		ctx:         ctx,
+		location:    f.location,
+		hasLocation: f.hasLocation,
+	}
+}
+
+func (f *Filtex) Reload(opts ...options.Option) error {
+	metadata, err := buildMetadata(opts...)
+	if err != nil {
+		return err
+	}
+
+	f.metadata.Store(metadata)
+
+	return nil
+}
+
+func (f *Filtex) SetMetadata(metadata *models.Metadata) error {
+	if metadata == nil {
+		return errors.NewInvalidMetadataError()
+	}
+
+	copied, err := withTimeZone(metadata.Clone())
+	if err != nil {
+		return err
+	}
+
+	f.metadata.Store(copied)
+
+	return nil
+}
+
+func (f *Filtex) Version() string {
+	return f.current().Version
 }
 
 func (f *Filtex) Metadata() (*models.Metadata, error) {
-	return f.metadata, nil
+	return f.current().WithLookups(f.ctx)
 }
 
 func (f *Filtex) ExpressionFromJson(query string) (expressions.Expression, error) {
-	return parsers.NewJsonQueryParser(f.metadata, tokenizers.NewJsonQueryTokenizer(f.metadata)).Parse(query)
+	metadata, err := f.jsonMetadata(f.current(), query)
+	if err != nil {
+		return nil, err
+	}
+
+	return parsers.NewJsonQueryParser(metadata, tokenizers.NewJsonQueryTokenizer(metadata)).Parse(query)
 }
 
 func (f *Filtex) ExpressionFromText(query string) (expressions.Expression, error) {
-	return parsers.NewTextQueryParser(f.metadata, tokenizers.NewTextQueryTokenizer(f.metadata)).Parse(query)
+	metadata, err := f.textMetadata(f.current(), query)
+	if err != nil {
+		return nil, err
+	}
+
+	return parsers.NewTextQueryParser(metadata, tokenizers.NewTextQueryTokenizer(metadata)).Parse(query)
+}
+
+func (f *Filtex) ExpressionFromOData(query string) (expressions.Expression, error) {
+	return parsers.NewODataQueryParser(f.current()).Context(f.ctx).Parse(query)
+}
+
+func (f *Filtex) ExpressionFromRsql(query string) (expressions.Expression, error) {
+	metadata, err := f.rsqlMetadata(f.current(), query)
+	if err != nil {
+		return nil, err
+	}
+
+	return parsers.NewRsqlQueryParser(metadata, tokenizers.NewRsqlQueryTokenizer(metadata)).Parse(query)
+}
+
+func (f *Filtex) ExpressionFromSql(query string) (expressions.Expression, error) {
+	return parsers.NewSqlQueryParser(f.current()).Context(f.ctx).Parse(query)
+}
+
+func (f *Filtex) ExpressionFromMongo(filter interface{}) (expressions.Expression, error) {
+	return parsers.NewMongoFilterParser(f.current()).Context(f.ctx).Parse(filter)
+}
+
+func (f *Filtex) ExpressionFromUrl(values url.Values, style constants.UrlStyle, ignore ...string) (expressions.Expression, error) {
+	return parsers.NewUrlQueryParser(f.current()).Context(f.ctx).Style(style).Ignore(ignore...).Parse(values)
+}
+
+func (f *Filtex) SortFromText(query string) ([]*expressions.SortExpression, error) {
+	return parsers.NewSortQueryParser(f.current()).Parse(query)
 }
 
 func (f *Filtex) ValidateFromJson(query string) error {
-	return validators.NewJsonQueryValidator(f.metadata, tokenizers.NewJsonQueryTokenizer(f.metadata)).Validate(query)
+	metadata, err := f.jsonMetadata(f.current(), query)
+	if err != nil {
+		return err
+	}
+
+	return validators.NewJsonQueryValidator(metadata, tokenizers.NewJsonQueryTokenizer(metadata)).Validate(query)
 }
 
 func (f *Filtex) ValidateFromText(query string) error {
-	return validators.NewTextQueryValidator(f.metadata, tokenizers.NewTextQueryTokenizer(f.metadata)).Validate(query)
+	metadata, err := f.textMetadata(f.current(), query)
+	if err != nil {
+		return err
+	}
+
+	return validators.NewTextQueryValidator(metadata, tokenizers.NewTextQueryTokenizer(metadata)).Validate(query)
+}
+
+func (f *Filtex) ValidateFromRsql(query string) error {
+	metadata, err := f.rsqlMetadata(f.current(), query)
+	if err != nil {
+		return err
+	}
+
+	return validators.NewRsqlQueryValidator(metadata, tokenizers.NewRsqlQueryTokenizer(metadata)).Validate(query)
+}
+
+func (f *Filtex) CheckFromJson(previous *models.Metadata, query string) error {
+	if previous == nil {
+		return errors.NewInvalidMetadataError()
+	}
+
+	previous, err := withTimeZone(previous)
+	if err != nil {
+		return err
+	}
+
+	metadata, err := f.jsonMetadata(previous, query)
+	if err != nil {
+		return err
+	}
+
+	expression, err := parsers.NewJsonQueryParser(metadata, tokenizers.NewJsonQueryTokenizer(metadata)).Parse(query)
+	if err != nil {
+		return err
+	}
+
+	return validators.NewSchemaValidator(f.current()).Validate(expression)
+}
+
+func (f *Filtex) CheckFromText(previous *models.Metadata, query string) error {
+	if previous == nil {
+		return errors.NewInvalidMetadataError()
+	}
+
+	previous, err := withTimeZone(previous)
+	if err != nil {
+		return err
+	}
+
+	metadata, err := f.textMetadata(previous, query)
+	if err != nil {
+		return err
+	}
+
+	expression, err := parsers.NewTextQueryParser(metadata, tokenizers.NewTextQueryTokenizer(metadata)).Parse(query)
+	if err != nil {
+		return err
+	}
+
+	return validators.NewSchemaValidator(f.current()).Validate(expression)
+}
+
+func (f *Filtex) JsonSchema() (map[string]interface{}, error) {
+	metadata, err := f.Metadata()
+	if err != nil {
+		return nil, err
+	}
+
+	return schemas.NewJsonSchemaBuilder().Build(metadata)
+}
+
+func (f *Filtex) OpenApiSchema(name string) (map[string]interface{}, error) {
+	metadata, err := f.Metadata()
+	if err != nil {
+		return nil, err
+	}
+
+	return schemas.NewOpenApiSchemaBuilder().Name(name).Build(metadata)
+}
+
+func (f *Filtex) Query() *queries.QueryBuilder {
+	return queries.NewQueryBuilder(f.current()).Context(f.ctx)
+}
+
+func (f *Filtex) Where(field string) *queries.FieldQuery {
+	return f.Query().Where(field)
+}
+
+func withTimeZone(metadata *models.Metadata) (*models.Metadata, error) {
+	if metadata.Location != nil || metadata.TimeZone == "" {
+		return metadata, nil
+	}
+
+	location, err := options.NewTimeZoneOption().Name(metadata.TimeZone).Build()
+	if err != nil {
+		return nil, err
+	}
+
+	return metadata.WithLocation(location), nil
+}
+
+func (f *Filtex) jsonMetadata(metadata *models.Metadata, query string) (*models.Metadata, error) {
+	return f.queryLookups(metadata, func(metadata *models.Metadata) []string {
+		tokens, _ := tokenizers.NewJsonQueryTokenizer(metadata).Tokenize(query)
+		return tokenFields(tokens)
+	})
+}
+
+func (f *Filtex) textMetadata(metadata *models.Metadata, query string) (*models.Metadata, error) {
+	return f.queryLookups(metadata, func(metadata *models.Metadata) []string {
+		tokens, _ := tokenizers.NewTextQueryTokenizer(metadata).Tokenize(query)
+		return tokenFields(tokens)
+	})
+}
+
+func (f *Filtex) rsqlMetadata(metadata *models.Metadata, query string) (*models.Metadata, error) {
+	return f.queryLookups(metadata, func(metadata *models.Metadata) []string {
+		tokens, _ := tokenizers.NewRsqlQueryTokenizer(metadata).Tokenize(query)
+		return tokenFields(tokens)
+	})
+}
+
+func (f *Filtex) queryLookups(metadata *models.Metadata, fields func(metadata *models.Metadata) []string) (*models.Metadata, error) {
+	resolved := make(map[string]bool)
+	used := make([]string, 0)
+
+	if metadata.FreeText != nil {
+		used = append(used, metadata.FreeText.Fields...)
+	}
+
+	for {
+		for _, v := range fields(metadata) {
+			if !resolved[strings.ToLower(v)] {
+				used = append(used, v)
+			}
+		}
+
+		if len(used) == 0 {
+			return metadata, nil
+		}
+
+		next, err := metadata.WithFieldLookups(f.ctx, used)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, v := range used {
+			resolved[strings.ToLower(v)] = true
+		}
+
+		metadata = next
+		used = make([]string, 0)
+	}
+}
+
+func tokenFields(tokens interface{}) []string {
+	fields := make([]string, 0)
+
+	switch v := tokens.(type) {
+	case *[]models.Token:
+		if v != nil {
+			fields = append(fields, tokenFields(*v)...)
+		}
+	case []models.Token:
+		for _, token := range v {
+			fields = append(fields, tokenFields(token)...)
+		}
+	case []interface{}:
+		for _, item := range v {
+			fields = append(fields, tokenFields(item)...)
+		}
+	case models.Token:
+		if str, ok := v.Value.(string); ok && v.Type.IsFieldTokenType() {
+			fields = append(fields, str)
+		}
+	}
+
+	return fields
+}
+
+func (f *Filtex) current() *models.Metadata {
+	metadata := f.metadata.Load()
+
+	if f.hasLocation {
+		return metadata.WithLocation(f.location)
+	}
+
+	return metadata
 }
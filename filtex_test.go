@@ -0,0 +1,154 @@
+package filtex
+
+import (
+	"context"
+	"net/url"
+	"testing"
+
+	"github.com/filtex/filtex-go/constants"
+	"github.com/filtex/filtex-go/expressions"
+	"github.com/filtex/filtex-go/lookups"
+	"github.com/filtex/filtex-go/models"
+	"github.com/filtex/filtex-go/options"
+	"github.com/stretchr/testify/assert"
+	"go.mongodb.org/mongo-driver/bson"
+)
+
+type testLookupKey struct{}
+
+func newLookupTestFiltex(t *testing.T, calls map[string]int, tenants map[string]interface{}) *Filtex {
+	provider := func(key string, values []models.Lookup) models.LookupProvider {
+		return lookups.ProviderFunc(func(ctx context.Context) ([]models.Lookup, error) {
+			calls[key]++
+			tenants[key] = ctx.Value(testLookupKey{})
+			return values, nil
+		})
+	}
+
+	fx, err := New(
+		options.NewFieldOption().String().Name("status").Label("Status").Lookup("statuses"),
+		options.NewFieldOption().String().Name("country").Label("Country").Lookup("countries"),
+		options.NewFieldOption().String().Name("name").Label("Name"),
+		options.NewLookupOption().Key("statuses").Provider(provider("statuses", []models.Lookup{{Name: "Enabled", Value: "enabled"}})),
+		options.NewLookupOption().Key("countries").Provider(provider("countries", []models.Lookup{{Name: "Germany", Value: "de"}})),
+	)
+	assert.NoError(t, err)
+
+	return fx
+}
+
+func TestFiltex_ExpressionFromJson_ShouldResolveLookups_WhenFieldNameIsEscaped(t *testing.T) {
+	// Arrange
+	calls := map[string]int{}
+	fx := newLookupTestFiltex(t, calls, map[string]interface{}{})
+
+	// Act
+	expression, err := fx.ExpressionFromJson(`["\u0053tatus", "Equal", "Enabled"]`)
+
+	// Assert
+	assert.NoError(t, err)
+	assert.Equal(t, expressions.NewOperatorExpression(constants.FieldTypeString, "status", constants.OperatorEqual, "enabled"), expression)
+	assert.Equal(t, 1, calls["statuses"])
+	assert.Equal(t, 0, calls["countries"])
+}
+
+func TestFiltex_ExpressionFromText_ShouldResolveLookups_WhenFieldsAreUsed(t *testing.T) {
+	// Arrange
+	calls := map[string]int{}
+	fx := newLookupTestFiltex(t, calls, map[string]interface{}{})
+
+	// Act
+	expression, err := fx.ExpressionFromText("Country Equal Germany And Status Equal Enabled")
+
+	// Assert
+	assert.NoError(t, err)
+	assert.Equal(t, expressions.NewLogicExpression(constants.LogicAnd, []expressions.Expression{
+		expressions.NewOperatorExpression(constants.FieldTypeString, "country", constants.OperatorEqual, "de"),
+		expressions.NewOperatorExpression(constants.FieldTypeString, "status", constants.OperatorEqual, "enabled"),
+	}), expression)
+	assert.Equal(t, 1, calls["statuses"])
+	assert.Equal(t, 1, calls["countries"])
+}
+
+func TestFiltex_ExpressionFromText_ShouldNotResolveLookups_WhenFieldNameIsOnlyInValue(t *testing.T) {
+	// Arrange
+	calls := map[string]int{}
+	fx := newLookupTestFiltex(t, calls, map[string]interface{}{})
+
+	// Act
+	_, err := fx.ExpressionFromText(`Name Equal "Country Status"`)
+
+	// Assert
+	assert.NoError(t, err)
+	assert.Equal(t, 0, calls["statuses"])
+	assert.Equal(t, 0, calls["countries"])
+}
+
+func TestFiltex_ExpressionFromMongo_ShouldResolveLookupsWithContext_WhenFieldIsUsed(t *testing.T) {
+	// Arrange
+	calls := map[string]int{}
+	tenants := map[string]interface{}{}
+	fx := newLookupTestFiltex(t, calls, tenants)
+	ctx := context.WithValue(context.Background(), testLookupKey{}, "tenant")
+
+	// Act
+	expression, err := fx.WithContext(ctx).ExpressionFromMongo(bson.M{"status": "Enabled", "name": "Country"})
+
+	// Assert
+	assert.NoError(t, err)
+	assert.NotNil(t, expression)
+	assert.Equal(t, 1, calls["statuses"])
+	assert.Equal(t, 0, calls["countries"])
+	assert.Equal(t, "tenant", tenants["statuses"])
+}
+
+func TestFiltex_ExpressionFromUrl_ShouldResolveLookupsWithContext_WhenFieldIsUsed(t *testing.T) {
+	// Arrange
+	calls := map[string]int{}
+	tenants := map[string]interface{}{}
+	fx := newLookupTestFiltex(t, calls, tenants)
+	ctx := context.WithValue(context.Background(), testLookupKey{}, "tenant")
+
+	// Act
+	expression, err := fx.WithContext(ctx).ExpressionFromUrl(url.Values{"country": {"Germany"}, "name": {"status"}}, constants.UrlStyleBracket)
+
+	// Assert
+	assert.NoError(t, err)
+	assert.Equal(t, expressions.NewLogicExpression(constants.LogicAnd, []expressions.Expression{
+		expressions.NewOperatorExpression(constants.FieldTypeString, "country", constants.OperatorEqual, "de"),
+		expressions.NewOperatorExpression(constants.FieldTypeString, "name", constants.OperatorEqual, "status"),
+	}), expression)
+	assert.Equal(t, 0, calls["statuses"])
+	assert.Equal(t, 1, calls["countries"])
+	assert.Equal(t, "tenant", tenants["countries"])
+}
+
+func TestFiltex_CheckFromJson_ShouldLoadTimeZone_WhenPreviousMetadataHasNoLocation(t *testing.T) {
+	// Arrange
+	fx, err := New(options.NewFieldOption().Date().Name("date").Label("Date"))
+	assert.NoError(t, err)
+
+	previous, err := fx.Metadata()
+	assert.NoError(t, err)
+
+	berlin := previous.Clone()
+	berlin.TimeZone = "Europe/Berlin"
+
+	invalid := previous.Clone()
+	invalid.TimeZone = "Invalid/Zone"
+
+	// Act
+	normalized, normalizeErr := withTimeZone(berlin)
+	jsonErr := fx.CheckFromJson(berlin, `["Date", "Equal", "2024-03-10"]`)
+	textErr := fx.CheckFromText(berlin, `Date Equal 2024-03-10`)
+	invalidJsonErr := fx.CheckFromJson(invalid, `["Date", "Equal", "2024-03-10"]`)
+	invalidTextErr := fx.CheckFromText(invalid, `Date Equal 2024-03-10`)
+
+	// Assert
+	assert.NoError(t, normalizeErr)
+	assert.Equal(t, "Europe/Berlin", normalized.Location.String())
+	assert.NoError(t, jsonErr)
+	assert.NoError(t, textErr)
+	assert.Error(t, invalidJsonErr)
+	assert.Error(t, invalidTextErr)
+}
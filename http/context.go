@@ -0,0 +1,18 @@
+package http
+
+import (
+	"context"
+
+	"github.com/filtex/filtex-go/expressions"
+)
+
+type expressionContextKey struct{}
+
+func WithExpression(ctx context.Context, expression expressions.Expression) context.Context {
+	return context.WithValue(ctx, expressionContextKey{}, expression)
+}
+
+func ExpressionFromContext(ctx context.Context) (expressions.Expression, bool) {
+	expression, ok := ctx.Value(expressionContextKey{}).(expressions.Expression)
+	return expression, ok && expression != nil
+}
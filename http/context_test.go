@@ -0,0 +1,47 @@
+package http
+
+import (
+	"context"
+	"testing"
+
+	"github.com/filtex/filtex-go/constants"
+	"github.com/filtex/filtex-go/expressions"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestExpressionFromContext_ShouldReturnExpression_WhenContextHasExpression(t *testing.T) {
+	// Arrange
+	expression := expressions.NewOperatorExpression(constants.FieldTypeString, "name", constants.OperatorEqual, "Filtex")
+	ctx := WithExpression(context.Background(), expression)
+
+	// Act
+	result, ok := ExpressionFromContext(ctx)
+
+	// Assert
+	assert.True(t, ok)
+	assert.Equal(t, expression, result)
+}
+
+func TestExpressionFromContext_ShouldReturnFalse_WhenContextHasNoExpression(t *testing.T) {
+	// Arrange
+	ctx := context.Background()
+
+	// Act
+	result, ok := ExpressionFromContext(ctx)
+
+	// Assert
+	assert.False(t, ok)
+	assert.Nil(t, result)
+}
+
+func TestExpressionFromContext_ShouldReturnFalse_WhenExpressionIsNil(t *testing.T) {
+	// Arrange
+	ctx := WithExpression(context.Background(), nil)
+
+	// Act
+	result, ok := ExpressionFromContext(ctx)
+
+	// Assert
+	assert.False(t, ok)
+	assert.Nil(t, result)
+}
@@ -0,0 +1,143 @@
+package http
+
+import (
+	"bytes"
+	goErrors "errors"
+	"io"
+	goHttp "net/http"
+	"strings"
+
+	"github.com/filtex/filtex-go"
+	"github.com/filtex/filtex-go/errors"
+	"github.com/filtex/filtex-go/expressions"
+)
+
+type FilterMiddleware struct {
+	filtex       *filtex.Filtex
+	parameter    string
+	isJson       bool
+	isBody       bool
+	isRequired   bool
+	maxBytes     int64
+	errorHandler func(w goHttp.ResponseWriter, r *goHttp.Request, status int, err error)
+}
+
+func NewFilterMiddleware(fx *filtex.Filtex) *FilterMiddleware {
+	return &FilterMiddleware{
+		filtex:    fx,
+		parameter: "filter",
+		maxBytes:  1 << 20,
+		errorHandler: func(w goHttp.ResponseWriter, r *goHttp.Request, status int, err error) {
+			WriteProblem(w, status, err)
+		},
+	}
+}
+
+func (m *FilterMiddleware) Text() *FilterMiddleware {
+	m.isJson = false
+	return m
+}
+
+func (m *FilterMiddleware) Json() *FilterMiddleware {
+	m.isJson = true
+	return m
+}
+
+func (m *FilterMiddleware) Query(parameter string) *FilterMiddleware {
+	m.isBody = false
+	m.parameter = parameter
+	return m
+}
+
+func (m *FilterMiddleware) Body() *FilterMiddleware {
+	m.isBody = true
+	return m
+}
+
+func (m *FilterMiddleware) Required() *FilterMiddleware {
+	m.isRequired = true
+	return m
+}
+
+func (m *FilterMiddleware) MaxBytes(maxBytes int64) *FilterMiddleware {
+	m.maxBytes = maxBytes
+	return m
+}
+
+func (m *FilterMiddleware) ErrorHandler(fn func(w goHttp.ResponseWriter, r *goHttp.Request, status int, err error)) *FilterMiddleware {
+	m.errorHandler = fn
+	return m
+}
+
+func (m *FilterMiddleware) Handler(next goHttp.Handler) goHttp.Handler {
+	return goHttp.HandlerFunc(func(w goHttp.ResponseWriter, r *goHttp.Request) {
+		query, err := m.read(w, r)
+		if err != nil {
+			var maxBytesError *goHttp.MaxBytesError
+			if goErrors.As(err, &maxBytesError) {
+				m.errorHandler(w, r, goHttp.StatusRequestEntityTooLarge, err)
+			} else {
+				m.errorHandler(w, r, goHttp.StatusBadRequest, err)
+			}
+			return
+		}
+
+		if query == "" {
+			if m.isRequired {
+				m.errorHandler(w, r, goHttp.StatusBadRequest, errors.NewEmptyQueryExpressionError())
+				return
+			}
+
+			next.ServeHTTP(w, r)
+			return
+		}
+
+		expression, err := m.parse(m.filtex.WithContext(r.Context()), query)
+		if err != nil {
+			var lookupError *errors.LookupError
+			if goErrors.As(err, &lookupError) {
+				m.errorHandler(w, r, goHttp.StatusInternalServerError, err)
+			} else {
+				m.errorHandler(w, r, goHttp.StatusBadRequest, err)
+			}
+			return
+		}
+
+		next.ServeHTTP(w, r.WithContext(WithExpression(r.Context(), expression)))
+	})
+}
+
+func (m *FilterMiddleware) read(w goHttp.ResponseWriter, r *goHttp.Request) (string, error) {
+	if !m.isBody {
+		return strings.TrimSpace(r.URL.Query().Get(m.parameter)), nil
+	}
+
+	if r.Body == nil {
+		return "", nil
+	}
+
+	body, err := io.ReadAll(goHttp.MaxBytesReader(w, r.Body, m.maxBytes))
+	if err != nil {
+		return "", err
+	}
+
+	r.Body = io.NopCloser(bytes.NewReader(body))
+
+	return strings.TrimSpace(string(body)), nil
+}
+
+func (m *FilterMiddleware) parse(fx *filtex.Filtex, query string) (expressions.Expression, error) {
+	if m.isJson {
+		if err := fx.ValidateFromJson(query); err != nil {
+			return nil, err
+		}
+
+		return fx.ExpressionFromJson(query)
+	}
+
+	if err := fx.ValidateFromText(query); err != nil {
+		return nil, err
+	}
+
+	return fx.ExpressionFromText(query)
+}
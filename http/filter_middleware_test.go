@@ -0,0 +1,220 @@
+package http
+
+import (
+	"context"
+	goErrors "errors"
+	"io"
+	goHttp "net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"testing"
+
+	"github.com/filtex/filtex-go"
+	"github.com/filtex/filtex-go/expressions"
+	"github.com/filtex/filtex-go/lookups"
+	"github.com/filtex/filtex-go/models"
+	"github.com/filtex/filtex-go/options"
+	"github.com/stretchr/testify/assert"
+)
+
+func newTestFiltex(t *testing.T) *filtex.Filtex {
+	fx, err := filtex.New(
+		options.NewFieldOption().String().Name("name").Label("Name"),
+		options.NewFieldOption().Number().Name("version").Label("Version"),
+	)
+	assert.NoError(t, err)
+
+	return fx
+}
+
+func serve(middleware *FilterMiddleware, request *goHttp.Request) (*httptest.ResponseRecorder, expressions.Expression, bool) {
+	var expression expressions.Expression
+	var called bool
+
+	next := goHttp.HandlerFunc(func(w goHttp.ResponseWriter, r *goHttp.Request) {
+		called = true
+		expression, _ = ExpressionFromContext(r.Context())
+		w.WriteHeader(goHttp.StatusNoContent)
+	})
+
+	recorder := httptest.NewRecorder()
+	middleware.Handler(next).ServeHTTP(recorder, request)
+
+	return recorder, expression, called
+}
+
+func TestHandler_ShouldStoreExpression_WhenQueryHasTextFilter(t *testing.T) {
+	// Arrange
+	middleware := NewFilterMiddleware(newTestFiltex(t))
+	request := httptest.NewRequest(goHttp.MethodGet, "/items?filter="+url.QueryEscape("Name Equal Filtex"), nil)
+
+	// Act
+	recorder, expression, called := serve(middleware, request)
+
+	// Assert
+	assert.True(t, called)
+	assert.Equal(t, goHttp.StatusNoContent, recorder.Code)
+	assert.NotNil(t, expression)
+}
+
+func TestHandler_ShouldUseParameter_WhenQueryParameterIsConfigured(t *testing.T) {
+	// Arrange
+	middleware := NewFilterMiddleware(newTestFiltex(t)).Query("q")
+	request := httptest.NewRequest(goHttp.MethodGet, "/items?q="+url.QueryEscape("Version Greater Than 1"), nil)
+
+	// Act
+	_, expression, called := serve(middleware, request)
+
+	// Assert
+	assert.True(t, called)
+	assert.NotNil(t, expression)
+}
+
+func TestHandler_ShouldCallNext_WhenFilterIsMissing(t *testing.T) {
+	// Arrange
+	middleware := NewFilterMiddleware(newTestFiltex(t))
+	request := httptest.NewRequest(goHttp.MethodGet, "/items", nil)
+
+	// Act
+	recorder, expression, called := serve(middleware, request)
+
+	// Assert
+	assert.True(t, called)
+	assert.Equal(t, goHttp.StatusNoContent, recorder.Code)
+	assert.Nil(t, expression)
+}
+
+func TestHandler_ShouldWriteProblem_WhenFilterIsMissingAndRequired(t *testing.T) {
+	// Arrange
+	middleware := NewFilterMiddleware(newTestFiltex(t)).Required()
+	request := httptest.NewRequest(goHttp.MethodGet, "/items", nil)
+
+	// Act
+	recorder, _, called := serve(middleware, request)
+
+	// Assert
+	assert.False(t, called)
+	assert.Equal(t, goHttp.StatusBadRequest, recorder.Code)
+	assert.Equal(t, ProblemContentType, recorder.Header().Get("Content-Type"))
+	assert.Contains(t, recorder.Body.String(), "empty query expression")
+}
+
+func TestHandler_ShouldWriteProblem_WhenFilterIsNotValid(t *testing.T) {
+	// Arrange
+	middleware := NewFilterMiddleware(newTestFiltex(t))
+	request := httptest.NewRequest(goHttp.MethodGet, "/items?filter="+url.QueryEscape("Name Equal"), nil)
+
+	// Act
+	recorder, _, called := serve(middleware, request)
+
+	// Assert
+	assert.False(t, called)
+	assert.Equal(t, goHttp.StatusBadRequest, recorder.Code)
+	assert.Equal(t, ProblemContentType, recorder.Header().Get("Content-Type"))
+}
+
+func TestHandler_ShouldStoreExpression_WhenBodyHasJsonFilter(t *testing.T) {
+	// Arrange
+	middleware := NewFilterMiddleware(newTestFiltex(t)).Json().Body()
+	body := `["And", [["Name", "Contain", "Filtex"], ["Version", "Equal", 1]]]`
+	request := httptest.NewRequest(goHttp.MethodPost, "/items/search", strings.NewReader(body))
+
+	var restored string
+	next := goHttp.HandlerFunc(func(w goHttp.ResponseWriter, r *goHttp.Request) {
+		content, _ := io.ReadAll(r.Body)
+		restored = string(content)
+
+		_, ok := ExpressionFromContext(r.Context())
+		assert.True(t, ok)
+		w.WriteHeader(goHttp.StatusNoContent)
+	})
+
+	recorder := httptest.NewRecorder()
+
+	// Act
+	middleware.Handler(next).ServeHTTP(recorder, request)
+
+	// Assert
+	assert.Equal(t, goHttp.StatusNoContent, recorder.Code)
+	assert.Equal(t, body, restored)
+}
+
+func TestHandler_ShouldWriteProblem_WhenBodyIsTooLarge(t *testing.T) {
+	// Arrange
+	middleware := NewFilterMiddleware(newTestFiltex(t)).Body().MaxBytes(8)
+	request := httptest.NewRequest(goHttp.MethodPost, "/items/search", strings.NewReader("Name Equal Filtex"))
+
+	// Act
+	recorder, _, called := serve(middleware, request)
+
+	// Assert
+	assert.False(t, called)
+	assert.Equal(t, goHttp.StatusRequestEntityTooLarge, recorder.Code)
+}
+
+func TestHandler_ShouldWriteServerError_WhenLookupsCouldNotBeLoaded(t *testing.T) {
+	// Arrange
+	fx, err := filtex.New(
+		options.NewFieldOption().String().Name("country").Label("Country").Lookup("countries"),
+		options.NewLookupOption().Key("countries").Provider(lookups.ProviderFunc(func(ctx context.Context) ([]models.Lookup, error) {
+			return nil, goErrors.New("connection refused")
+		})),
+	)
+	assert.NoError(t, err)
+
+	middleware := NewFilterMiddleware(fx)
+	request := httptest.NewRequest(goHttp.MethodGet, "/items?filter="+url.QueryEscape("Country Equal Germany"), nil)
+
+	// Act
+	recorder, _, called := serve(middleware, request)
+
+	// Assert
+	assert.False(t, called)
+	assert.Equal(t, goHttp.StatusInternalServerError, recorder.Code)
+	assert.NotContains(t, recorder.Body.String(), "connection refused")
+}
+
+func TestHandler_ShouldOnlyLoadLookups_WhenFieldIsUsedInQuery(t *testing.T) {
+	// Arrange
+	calls := 0
+	fx, err := filtex.New(
+		options.NewFieldOption().String().Name("name").Label("Name"),
+		options.NewFieldOption().String().Name("country").Label("Country").Lookup("countries"),
+		options.NewLookupOption().Key("countries").Provider(lookups.ProviderFunc(func(ctx context.Context) ([]models.Lookup, error) {
+			calls++
+			return []models.Lookup{{Name: "Germany", Value: "de"}}, nil
+		})),
+	)
+	assert.NoError(t, err)
+
+	middleware := NewFilterMiddleware(fx)
+	request := httptest.NewRequest(goHttp.MethodGet, "/items?filter="+url.QueryEscape("Name Equal Filtex"), nil)
+
+	// Act
+	recorder, expression, called := serve(middleware, request)
+
+	// Assert
+	assert.True(t, called)
+	assert.Equal(t, goHttp.StatusNoContent, recorder.Code)
+	assert.NotNil(t, expression)
+	assert.Equal(t, 0, calls)
+}
+
+func TestHandler_ShouldUseErrorHandler_WhenErrorHandlerIsConfigured(t *testing.T) {
+	// Arrange
+	var status int
+	middleware := NewFilterMiddleware(newTestFiltex(t)).ErrorHandler(func(w goHttp.ResponseWriter, r *goHttp.Request, s int, err error) {
+		status = s
+		w.WriteHeader(goHttp.StatusTeapot)
+	})
+	request := httptest.NewRequest(goHttp.MethodGet, "/items?filter=Unknown", nil)
+
+	// Act
+	recorder, _, called := serve(middleware, request)
+
+	// Assert
+	assert.False(t, called)
+	assert.Equal(t, goHttp.StatusBadRequest, status)
+	assert.Equal(t, goHttp.StatusTeapot, recorder.Code)
+}
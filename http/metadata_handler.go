@@ -0,0 +1,41 @@
+package http
+
+import (
+	"encoding/json"
+	goHttp "net/http"
+
+	"github.com/filtex/filtex-go"
+)
+
+type MetadataHandler struct {
+	filtex *filtex.Filtex
+}
+
+func NewMetadataHandler(fx *filtex.Filtex) *MetadataHandler {
+	return &MetadataHandler{
+		filtex: fx,
+	}
+}
+
+func (h *MetadataHandler) ServeHTTP(w goHttp.ResponseWriter, r *goHttp.Request) {
+	if r.Method != goHttp.MethodGet && r.Method != goHttp.MethodHead {
+		w.Header().Set("Allow", "GET, HEAD")
+		WriteProblem(w, goHttp.StatusMethodNotAllowed, nil)
+		return
+	}
+
+	metadata, err := h.filtex.WithContext(r.Context()).Metadata()
+	if err != nil {
+		WriteProblem(w, goHttp.StatusInternalServerError, err)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(goHttp.StatusOK)
+
+	if r.Method == goHttp.MethodHead {
+		return
+	}
+
+	_ = json.NewEncoder(w).Encode(metadata)
+}
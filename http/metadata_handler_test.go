@@ -0,0 +1,44 @@
+package http
+
+import (
+	"encoding/json"
+	goHttp "net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/filtex/filtex-go/models"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestServeHTTP_ShouldWriteMetadata_WhenMethodIsGet(t *testing.T) {
+	// Arrange
+	handler := NewMetadataHandler(newTestFiltex(t))
+	request := httptest.NewRequest(goHttp.MethodGet, "/filters/metadata", nil)
+	recorder := httptest.NewRecorder()
+
+	// Act
+	handler.ServeHTTP(recorder, request)
+
+	// Assert
+	var metadata models.Metadata
+	assert.Equal(t, goHttp.StatusOK, recorder.Code)
+	assert.Equal(t, "application/json", recorder.Header().Get("Content-Type"))
+	assert.NoError(t, json.Unmarshal(recorder.Body.Bytes(), &metadata))
+	assert.Len(t, metadata.Fields, 2)
+	assert.Equal(t, "name", metadata.Fields[0].Name)
+}
+
+func TestServeHTTP_ShouldWriteProblem_WhenMethodIsNotAllowed(t *testing.T) {
+	// Arrange
+	handler := NewMetadataHandler(newTestFiltex(t))
+	request := httptest.NewRequest(goHttp.MethodPost, "/filters/metadata", nil)
+	recorder := httptest.NewRecorder()
+
+	// Act
+	handler.ServeHTTP(recorder, request)
+
+	// Assert
+	assert.Equal(t, goHttp.StatusMethodNotAllowed, recorder.Code)
+	assert.Equal(t, "GET, HEAD", recorder.Header().Get("Allow"))
+	assert.Equal(t, ProblemContentType, recorder.Header().Get("Content-Type"))
+}
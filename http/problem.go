@@ -0,0 +1,51 @@
+package http
+
+import (
+	"encoding/json"
+	goErrors "errors"
+	goHttp "net/http"
+
+	"github.com/filtex/filtex-go/errors"
+)
+
+const ProblemContentType = "application/problem+json"
+
+type Problem struct {
+	Type     string `json:"type"`
+	Title    string `json:"title"`
+	Status   int    `json:"status"`
+	Detail   string `json:"detail,omitempty"`
+	Field    string `json:"field,omitempty"`
+	Operator string `json:"operator,omitempty"`
+}
+
+func NewProblem(status int, err error) *Problem {
+	problem := &Problem{
+		Type:   "about:blank",
+		Title:  goHttp.StatusText(status),
+		Status: status,
+	}
+
+	if err == nil || status >= goHttp.StatusInternalServerError {
+		return problem
+	}
+
+	problem.Detail = err.Error()
+
+	var queryError *errors.QueryError
+	if goErrors.As(err, &queryError) {
+		problem.Detail = queryError.Err.Error()
+		problem.Field = queryError.Field
+		problem.Operator = queryError.Operator
+	}
+
+	return problem
+}
+
+func WriteProblem(w goHttp.ResponseWriter, status int, err error) {
+	w.Header().Set("Content-Type", ProblemContentType)
+	w.Header().Set("X-Content-Type-Options", "nosniff")
+	w.WriteHeader(status)
+
+	_ = json.NewEncoder(w).Encode(NewProblem(status, err))
+}
@@ -0,0 +1,66 @@
+package http
+
+import (
+	goErrors "errors"
+	goHttp "net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/filtex/filtex-go/errors"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewProblem_ShouldReturnProblem_WhenErrorIsNil(t *testing.T) {
+	// Act
+	problem := NewProblem(goHttp.StatusBadRequest, nil)
+
+	// Assert
+	assert.Equal(t, &Problem{
+		Type:   "about:blank",
+		Title:  "Bad Request",
+		Status: goHttp.StatusBadRequest,
+	}, problem)
+}
+
+func TestNewProblem_ShouldReturnFieldAndOperator_WhenErrorIsQueryError(t *testing.T) {
+	// Arrange
+	err := errors.NewUnsupportedOperatorError("name", "greater-than")
+
+	// Act
+	problem := NewProblem(goHttp.StatusBadRequest, err)
+
+	// Assert
+	assert.Equal(t, &Problem{
+		Type:     "about:blank",
+		Title:    "Bad Request",
+		Status:   goHttp.StatusBadRequest,
+		Detail:   "unsupported operator",
+		Field:    "name",
+		Operator: "greater-than",
+	}, problem)
+}
+
+func TestNewProblem_ShouldHideDetail_WhenStatusIsServerError(t *testing.T) {
+	// Arrange
+	err := goErrors.New("connection refused")
+
+	// Act
+	problem := NewProblem(goHttp.StatusInternalServerError, err)
+
+	// Assert
+	assert.Empty(t, problem.Detail)
+	assert.Equal(t, "Internal Server Error", problem.Title)
+}
+
+func TestWriteProblem_ShouldWriteProblemJson(t *testing.T) {
+	// Arrange
+	recorder := httptest.NewRecorder()
+
+	// Act
+	WriteProblem(recorder, goHttp.StatusBadRequest, errors.NewInvalidTokenError())
+
+	// Assert
+	assert.Equal(t, goHttp.StatusBadRequest, recorder.Code)
+	assert.Equal(t, ProblemContentType, recorder.Header().Get("Content-Type"))
+	assert.JSONEq(t, `{"type":"about:blank","title":"Bad Request","status":400,"detail":"invalid token"}`, recorder.Body.String())
+}
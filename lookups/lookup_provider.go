@@ -0,0 +1,130 @@
+package lookups
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/filtex/filtex-go/models"
+)
+
+type ProviderFunc func(ctx context.Context) ([]models.Lookup, error)
+
+func (f ProviderFunc) Lookups(ctx context.Context) ([]models.Lookup, error) {
+	return f(ctx)
+}
+
+type CachedProvider struct {
+	provider  models.LookupProvider
+	ttl       time.Duration
+	now       func() time.Time
+	mutex     sync.RWMutex
+	values    []models.Lookup
+	expiresAt time.Time
+	refresh   *lookupRefresh
+}
+
+type lookupRefresh struct {
+	done chan struct{}
+	err  error
+}
+
+type detachedContext struct {
+	parent context.Context
+}
+
+func (c detachedContext) Deadline() (time.Time, bool) {
+	return time.Time{}, false
+}
+
+func (c detachedContext) Done() <-chan struct{} {
+	return nil
+}
+
+func (c detachedContext) Err() error {
+	return nil
+}
+
+func (c detachedContext) Value(key interface{}) interface{} {
+	return c.parent.Value(key)
+}
+
+func NewCachedProvider(provider models.LookupProvider, ttl time.Duration) *CachedProvider {
+	return &CachedProvider{
+		provider: provider,
+		ttl:      ttl,
+		now:      time.Now,
+	}
+}
+
+func (p *CachedProvider) Lookups(ctx context.Context) ([]models.Lookup, error) {
+	p.mutex.RLock()
+	values, expiresAt := p.values, p.expiresAt
+	p.mutex.RUnlock()
+
+	if values != nil && (p.ttl <= 0 || p.now().Before(expiresAt)) {
+		return values, nil
+	}
+
+	if err := p.Refresh(ctx); err != nil {
+		if values != nil {
+			return values, nil
+		}
+		return nil, err
+	}
+
+	p.mutex.RLock()
+	defer p.mutex.RUnlock()
+
+	return p.values, nil
+}
+
+func (p *CachedProvider) Refresh(ctx context.Context) error {
+	p.mutex.Lock()
+
+	refresh := p.refresh
+	if refresh == nil {
+		refresh = &lookupRefresh{done: make(chan struct{})}
+		p.refresh = refresh
+
+		go p.load(detachedContext{parent: ctx}, refresh)
+	}
+
+	p.mutex.Unlock()
+
+	select {
+	case <-refresh.done:
+		return refresh.err
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+func (p *CachedProvider) load(ctx context.Context, refresh *lookupRefresh) {
+	values, err := p.provider.Lookups(ctx)
+
+	p.mutex.Lock()
+
+	if err == nil {
+		if values == nil {
+			values = make([]models.Lookup, 0)
+		}
+
+		p.values = values
+		p.expiresAt = p.now().Add(p.ttl)
+	}
+
+	p.refresh = nil
+	p.mutex.Unlock()
+
+	refresh.err = err
+	close(refresh.done)
+}
+
+func (p *CachedProvider) Invalidate() {
+	p.mutex.Lock()
+	defer p.mutex.Unlock()
+
+	p.values = nil
+	p.expiresAt = time.Time{}
+}
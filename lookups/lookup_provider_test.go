@@ -0,0 +1,284 @@
+package lookups
+
+import (
+	"context"
+	goErrors "errors"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/filtex/filtex-go/models"
+	"github.com/stretchr/testify/assert"
+)
+
+type testProvider struct {
+	calls  int
+	values []models.Lookup
+	err    error
+}
+
+func (p *testProvider) Lookups(ctx context.Context) ([]models.Lookup, error) {
+	p.calls++
+
+	if p.err != nil {
+		return nil, p.err
+	}
+
+	return p.values, nil
+}
+
+type blockingTestProvider struct {
+	calls   int32
+	started chan struct{}
+	release chan struct{}
+}
+
+func (p *blockingTestProvider) Lookups(ctx context.Context) ([]models.Lookup, error) {
+	if atomic.AddInt32(&p.calls, 1) == 1 {
+		close(p.started)
+	}
+
+	<-p.release
+
+	return []models.Lookup{{Name: "Enabled", Value: true}}, nil
+}
+
+func newTestCachedProvider(provider models.LookupProvider, ttl time.Duration, now *time.Time) *CachedProvider {
+	cached := NewCachedProvider(provider, ttl)
+	cached.now = func() time.Time {
+		return *now
+	}
+	return cached
+}
+
+func TestProviderFunc_Lookups_ShouldCallFunction(t *testing.T) {
+	// Arrange
+	provider := ProviderFunc(func(ctx context.Context) ([]models.Lookup, error) {
+		return []models.Lookup{{Name: "Enabled", Value: true}}, nil
+	})
+
+	// Act
+	result, err := provider.Lookups(context.Background())
+
+	// Assert
+	assert.NoError(t, err)
+	assert.Equal(t, []models.Lookup{{Name: "Enabled", Value: true}}, result)
+}
+
+func TestCachedProvider_Lookups_ShouldReturnCachedValues_WhenTtlIsNotExpired(t *testing.T) {
+	// Arrange
+	now := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	provider := &testProvider{values: []models.Lookup{{Name: "Enabled", Value: true}}}
+	cached := newTestCachedProvider(provider, time.Minute, &now)
+
+	// Act
+	first, firstErr := cached.Lookups(context.Background())
+	now = now.Add(30 * time.Second)
+	second, secondErr := cached.Lookups(context.Background())
+
+	// Assert
+	assert.NoError(t, firstErr)
+	assert.NoError(t, secondErr)
+	assert.Equal(t, first, second)
+	assert.Equal(t, 1, provider.calls)
+}
+
+func TestCachedProvider_Lookups_ShouldReloadValues_WhenTtlIsExpired(t *testing.T) {
+	// Arrange
+	now := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	provider := &testProvider{values: []models.Lookup{{Name: "Enabled", Value: true}}}
+	cached := newTestCachedProvider(provider, time.Minute, &now)
+	_, _ = cached.Lookups(context.Background())
+	provider.values = []models.Lookup{{Name: "Disabled", Value: false}}
+	now = now.Add(time.Minute)
+
+	// Act
+	result, err := cached.Lookups(context.Background())
+
+	// Assert
+	assert.NoError(t, err)
+	assert.Equal(t, []models.Lookup{{Name: "Disabled", Value: false}}, result)
+	assert.Equal(t, 2, provider.calls)
+}
+
+func TestCachedProvider_Lookups_ShouldReturnStaleValues_WhenReloadFails(t *testing.T) {
+	// Arrange
+	now := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	provider := &testProvider{values: []models.Lookup{{Name: "Enabled", Value: true}}}
+	cached := newTestCachedProvider(provider, time.Minute, &now)
+	_, _ = cached.Lookups(context.Background())
+	provider.err = goErrors.New("unavailable")
+	now = now.Add(time.Hour)
+
+	// Act
+	result, err := cached.Lookups(context.Background())
+
+	// Assert
+	assert.NoError(t, err)
+	assert.Equal(t, []models.Lookup{{Name: "Enabled", Value: true}}, result)
+}
+
+func TestCachedProvider_Lookups_ShouldReturnError_WhenFirstLoadFails(t *testing.T) {
+	// Arrange
+	provider := &testProvider{err: goErrors.New("unavailable")}
+	cached := NewCachedProvider(provider, time.Minute)
+
+	// Act
+	result, err := cached.Lookups(context.Background())
+
+	// Assert
+	assert.Nil(t, result)
+	assert.Error(t, err)
+}
+
+func TestCachedProvider_Lookups_ShouldNeverExpire_WhenTtlIsZero(t *testing.T) {
+	// Arrange
+	now := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	provider := &testProvider{values: []models.Lookup{{Name: "Enabled", Value: true}}}
+	cached := newTestCachedProvider(provider, 0, &now)
+	_, _ = cached.Lookups(context.Background())
+	now = now.Add(24 * time.Hour)
+
+	// Act
+	_, err := cached.Lookups(context.Background())
+
+	// Assert
+	assert.NoError(t, err)
+	assert.Equal(t, 1, provider.calls)
+}
+
+func TestCachedProvider_Refresh_ShouldReplaceValues(t *testing.T) {
+	// Arrange
+	now := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	provider := &testProvider{values: []models.Lookup{{Name: "Enabled", Value: true}}}
+	cached := newTestCachedProvider(provider, time.Hour, &now)
+	_, _ = cached.Lookups(context.Background())
+	provider.values = []models.Lookup{{Name: "Disabled", Value: false}}
+
+	// Act
+	err := cached.Refresh(context.Background())
+	result, _ := cached.Lookups(context.Background())
+
+	// Assert
+	assert.NoError(t, err)
+	assert.Equal(t, []models.Lookup{{Name: "Disabled", Value: false}}, result)
+}
+
+func TestCachedProvider_Invalidate_ShouldReloadValuesOnNextCall(t *testing.T) {
+	// Arrange
+	now := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	provider := &testProvider{values: []models.Lookup{{Name: "Enabled", Value: true}}}
+	cached := newTestCachedProvider(provider, time.Hour, &now)
+	_, _ = cached.Lookups(context.Background())
+
+	// Act
+	cached.Invalidate()
+	_, err := cached.Lookups(context.Background())
+
+	// Assert
+	assert.NoError(t, err)
+	assert.Equal(t, 2, provider.calls)
+}
+
+func TestCachedProvider_Lookups_ShouldLoadOnce_WhenCalledConcurrently(t *testing.T) {
+	// Arrange
+	provider := &blockingTestProvider{started: make(chan struct{}), release: make(chan struct{})}
+	cached := NewCachedProvider(provider, time.Minute)
+	results := make([][]models.Lookup, 10)
+	wg := sync.WaitGroup{}
+
+	// Act
+	for i := range results {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			results[i], _ = cached.Lookups(context.Background())
+		}(i)
+
+		if i == 0 {
+			<-provider.started
+		}
+	}
+	time.Sleep(10 * time.Millisecond)
+	close(provider.release)
+	wg.Wait()
+
+	// Assert
+	assert.Equal(t, int32(1), atomic.LoadInt32(&provider.calls))
+	for _, v := range results {
+		assert.Equal(t, []models.Lookup{{Name: "Enabled", Value: true}}, v)
+	}
+}
+
+func TestCachedProvider_Refresh_ShouldReturnContextError_WhenWaitingIsCancelled(t *testing.T) {
+	// Arrange
+	provider := &blockingTestProvider{started: make(chan struct{}), release: make(chan struct{})}
+	cached := NewCachedProvider(provider, time.Minute)
+	go func() {
+		_ = cached.Refresh(context.Background())
+	}()
+	<-provider.started
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	// Act
+	err := cached.Refresh(ctx)
+	close(provider.release)
+
+	// Assert
+	assert.ErrorIs(t, err, context.Canceled)
+	assert.Equal(t, int32(1), atomic.LoadInt32(&provider.calls))
+}
+
+func TestCachedProvider_Refresh_ShouldFinishLoading_WhenFirstCallerIsCancelled(t *testing.T) {
+	// Arrange
+	var loadErr error
+	started := make(chan struct{})
+	release := make(chan struct{})
+	cached := NewCachedProvider(ProviderFunc(func(ctx context.Context) ([]models.Lookup, error) {
+		close(started)
+		<-release
+		loadErr = ctx.Err()
+		return []models.Lookup{{Name: "Enabled", Value: true}}, nil
+	}), time.Minute)
+	ctx, cancel := context.WithCancel(context.Background())
+	firstErr := make(chan error)
+	go func() {
+		firstErr <- cached.Refresh(ctx)
+	}()
+	<-started
+	secondValues := make(chan []models.Lookup)
+	go func() {
+		values, _ := cached.Lookups(context.Background())
+		secondValues <- values
+	}()
+
+	// Act
+	cancel()
+	err := <-firstErr
+	close(release)
+	values := <-secondValues
+
+	// Assert
+	assert.ErrorIs(t, err, context.Canceled)
+	assert.NoError(t, loadErr)
+	assert.Equal(t, []models.Lookup{{Name: "Enabled", Value: true}}, values)
+}
+
+func TestCachedProvider_Refresh_ShouldPassContextValues_WhenLoading(t *testing.T) {
+	// Arrange
+	type key struct{}
+	var value interface{}
+	cached := NewCachedProvider(ProviderFunc(func(ctx context.Context) ([]models.Lookup, error) {
+		value = ctx.Value(key{})
+		return []models.Lookup{}, nil
+	}), time.Minute)
+
+	// Act
+	err := cached.Refresh(context.WithValue(context.Background(), key{}, "tenant"))
+
+	// Assert
+	assert.NoError(t, err)
+	assert.Equal(t, "tenant", value)
+}
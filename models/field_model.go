@@ -6,4 +6,7 @@ type Field struct {
 	Label     string   `json:"label"`
 	Operators []string `json:"operators"`
 	Values    []Lookup `json:"values"`
+	Collation string   `json:"collation,omitempty"`
+
+	Provider LookupProvider `json:"-"`
 }
@@ -0,0 +1,6 @@
+package models
+
+type FreeText struct {
+	Fields   []string `json:"fields"`
+	Operator string   `json:"operator"`
+}
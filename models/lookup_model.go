@@ -1,6 +1,14 @@
 package models
 
+import (
+	"context"
+)
+
 type Lookup struct {
 	Name  string      `json:"name"`
 	Value interface{} `json:"value"`
 }
+
+type LookupProvider interface {
+	Lookups(ctx context.Context) ([]Lookup, error)
+}
@@ -1,13 +1,103 @@
 package models
 
 import (
+	"context"
 	"strings"
+	"time"
 
 	"github.com/filtex/filtex-go/constants"
+	"github.com/filtex/filtex-go/errors"
 )
 
 type Metadata struct {
-	Fields []Field `json:"fields"`
+	Version  string    `json:"version,omitempty"`
+	Fields   []Field   `json:"fields"`
+	FreeText *FreeText `json:"freeText,omitempty"`
+	TimeZone string    `json:"timeZone,omitempty"`
+
+	Location *time.Location `json:"-"`
+}
+
+func (m *Metadata) Clone() *Metadata {
+	metadata := *m
+
+	if m.Fields != nil {
+		metadata.Fields = make([]Field, len(m.Fields))
+
+		for i, v := range m.Fields {
+			if v.Operators != nil {
+				v.Operators = append([]string{}, v.Operators...)
+			}
+
+			if v.Values != nil {
+				v.Values = append([]Lookup{}, v.Values...)
+			}
+
+			metadata.Fields[i] = v
+		}
+	}
+
+	if m.FreeText != nil {
+		freeText := *m.FreeText
+
+		if m.FreeText.Fields != nil {
+			freeText.Fields = append([]string{}, m.FreeText.Fields...)
+		}
+
+		metadata.FreeText = &freeText
+	}
+
+	return &metadata
+}
+
+func (m *Metadata) WithLocation(location *time.Location) *Metadata {
+	metadata := *m
+	metadata.Location = location
+	metadata.TimeZone = ""
+
+	if location != nil {
+		metadata.TimeZone = location.String()
+	}
+
+	return &metadata
+}
+
+func (m *Metadata) WithLookups(ctx context.Context) (*Metadata, error) {
+	return m.withLookups(ctx, func(field Field) bool {
+		return true
+	})
+}
+
+func (m *Metadata) WithFieldLookups(ctx context.Context, fields []string) (*Metadata, error) {
+	used := make(map[string]bool)
+	for _, v := range fields {
+		used[strings.ToLower(v)] = true
+	}
+
+	return m.withLookups(ctx, func(field Field) bool {
+		return (field.Name != "" && used[strings.ToLower(field.Name)]) ||
+			(field.Label != "" && used[strings.ToLower(field.Label)])
+	})
+}
+
+func (m *Metadata) withLookups(ctx context.Context, used func(field Field) bool) (*Metadata, error) {
+	metadata := *m
+	metadata.Fields = make([]Field, len(m.Fields))
+
+	for i, v := range m.Fields {
+		if v.Provider != nil && used(v) {
+			values, err := v.Provider.Lookups(ctx)
+			if err != nil {
+				return nil, errors.NewLookupError(v.Name, err)
+			}
+
+			v.Values = values
+		}
+
+		metadata.Fields[i] = v
+	}
+
+	return &metadata, nil
 }
 
 func (m *Metadata) GetFieldType(str string) constants.FieldType {
@@ -30,6 +120,16 @@ func (m *Metadata) GetFieldName(str string) string {
 	return str
 }
 
+func (m *Metadata) GetFieldCollation(str string) constants.Collation {
+	for _, v := range m.Fields {
+		if strings.ToLower(v.Label) == strings.ToLower(str) || strings.ToLower(v.Name) == strings.ToLower(str) {
+			return constants.ParseCollation(v.Collation)
+		}
+	}
+
+	return constants.CollationDefault
+}
+
 func (m *Metadata) GetFieldValues(str string) []Lookup {
 	for _, v := range m.Fields {
 		if strings.ToLower(v.Label) == strings.ToLower(str) || strings.ToLower(v.Name) == strings.ToLower(str) {
@@ -39,3 +139,28 @@ func (m *Metadata) GetFieldValues(str string) []Lookup {
 
 	return nil
 }
+
+func (m *Metadata) GetField(str string) *Field {
+	for i, v := range m.Fields {
+		if strings.ToLower(v.Label) == strings.ToLower(str) || strings.ToLower(v.Name) == strings.ToLower(str) {
+			return &m.Fields[i]
+		}
+	}
+
+	return nil
+}
+
+func (m *Metadata) GetSearchFields() []string {
+	result := make([]string, 0)
+
+	for _, v := range m.Fields {
+		for _, o := range v.Operators {
+			if constants.OperatorSearch.Equals(o) {
+				result = append(result, v.Name)
+				break
+			}
+		}
+	}
+
+	return result
+}
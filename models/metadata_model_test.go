@@ -0,0 +1,73 @@
+package models
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestMetadata_Clone_ShouldNotShareFields_WhenOriginalIsChanged(t *testing.T) {
+	// Arrange
+	metadata := &Metadata{
+		Version: "1",
+		Fields: []Field{
+			{
+				Name:      "status",
+				Type:      "string",
+				Label:     "Status",
+				Operators: []string{"equal"},
+				Values:    []Lookup{{Name: "Enabled", Value: "enabled"}},
+			},
+		},
+		FreeText: &FreeText{Fields: []string{"status"}, Operator: "contain"},
+	}
+
+	// Act
+	clone := metadata.Clone()
+	metadata.Fields[0].Name = "state"
+	metadata.Fields[0].Operators[0] = "notequal"
+	metadata.Fields[0].Values[0].Value = "disabled"
+	metadata.FreeText.Fields[0] = "state"
+
+	// Assert
+	assert.Equal(t, "1", clone.Version)
+	assert.Equal(t, "status", clone.Fields[0].Name)
+	assert.Equal(t, []string{"equal"}, clone.Fields[0].Operators)
+	assert.Equal(t, []Lookup{{Name: "Enabled", Value: "enabled"}}, clone.Fields[0].Values)
+	assert.Equal(t, []string{"status"}, clone.FreeText.Fields)
+}
+
+type testLookupProvider struct {
+	calls int
+}
+
+func (p *testLookupProvider) Lookups(ctx context.Context) ([]Lookup, error) {
+	p.calls++
+	return []Lookup{{Name: "Enabled", Value: true}}, nil
+}
+
+func TestMetadata_WithFieldLookups_ShouldResolveOnlyUsedFields(t *testing.T) {
+	// Arrange
+	status := &testLookupProvider{}
+	country := &testLookupProvider{}
+	category := &testLookupProvider{}
+	metadata := &Metadata{
+		Fields: []Field{
+			{Name: "status", Label: "Status", Provider: status},
+			{Name: "countryCode", Label: "Country", Provider: country},
+			{Name: "category", Label: "Category", Provider: category},
+		},
+	}
+
+	// Act
+	result, err := metadata.WithFieldLookups(context.Background(), []string{"STATUS", "category"})
+
+	// Assert
+	assert.NoError(t, err)
+	assert.Equal(t, 1, status.calls)
+	assert.Equal(t, 0, country.calls)
+	assert.Equal(t, 1, category.calls)
+	assert.Equal(t, []Lookup{{Name: "Enabled", Value: true}}, result.Fields[0].Values)
+	assert.Nil(t, result.Fields[1].Values)
+}
@@ -7,12 +7,14 @@ import (
 )
 
 type FieldOption struct {
-	name       string
-	label      string
-	lookup     string
-	fieldType  constants.FieldType
-	isArray    bool
-	isNullable bool
+	name         string
+	label        string
+	lookup       string
+	fieldType    constants.FieldType
+	isArray      bool
+	isNullable   bool
+	isSearchable bool
+	collation    constants.Collation
 }
 
 func NewFieldOption() *FieldOption {
@@ -29,6 +31,16 @@ func (f *FieldOption) Number() *FieldOption {
 	return f
 }
 
+func (f *FieldOption) Integer() *FieldOption {
+	f.fieldType = constants.FieldTypeInteger
+	return f
+}
+
+func (f *FieldOption) Decimal() *FieldOption {
+	f.fieldType = constants.FieldTypeDecimal
+	return f
+}
+
 func (f *FieldOption) Boolean() *FieldOption {
 	f.fieldType = constants.FieldTypeBoolean
 	return f
@@ -44,11 +56,36 @@ func (f *FieldOption) Time() *FieldOption {
 	return f
 }
 
+func (f *FieldOption) Duration() *FieldOption {
+	f.fieldType = constants.FieldTypeDuration
+	return f
+}
+
 func (f *FieldOption) DateTime() *FieldOption {
 	f.fieldType = constants.FieldTypeDateTime
 	return f
 }
 
+func (f *FieldOption) UUID() *FieldOption {
+	f.fieldType = constants.FieldTypeUUID
+	return f
+}
+
+func (f *FieldOption) Enum() *FieldOption {
+	f.fieldType = constants.FieldTypeEnum
+	return f
+}
+
+func (f *FieldOption) IP() *FieldOption {
+	f.fieldType = constants.FieldTypeIP
+	return f
+}
+
+func (f *FieldOption) GeoPoint() *FieldOption {
+	f.fieldType = constants.FieldTypeGeoPoint
+	return f
+}
+
 func (f *FieldOption) Array() *FieldOption {
 	f.isArray = true
 	return f
@@ -59,6 +96,26 @@ func (f *FieldOption) Nullable() *FieldOption {
 	return f
 }
 
+func (f *FieldOption) Searchable() *FieldOption {
+	f.isSearchable = true
+	return f
+}
+
+func (f *FieldOption) CaseSensitive() *FieldOption {
+	f.collation = constants.CollationCaseSensitive
+	return f
+}
+
+func (f *FieldOption) CaseInsensitive() *FieldOption {
+	f.collation = constants.CollationCaseInsensitive
+	return f
+}
+
+func (f *FieldOption) AccentInsensitive() *FieldOption {
+	f.collation = constants.CollationAccentInsensitive
+	return f
+}
+
 func (f *FieldOption) Name(name string) *FieldOption {
 	f.name = name
 	return f
@@ -75,6 +132,10 @@ func (f *FieldOption) Lookup(lookup string) *FieldOption {
 }
 
 func (f *FieldOption) Build(lookups map[string][]models.Lookup) (*models.Field, error) {
+	return f.BuildWithProviders(lookups, nil)
+}
+
+func (f *FieldOption) BuildWithProviders(lookups map[string][]models.Lookup, providers map[string]models.LookupProvider) (*models.Field, error) {
 	if f.fieldType == "" {
 		return nil, errors.NewInvalidFieldTypeError()
 	}
@@ -87,22 +148,14 @@ func (f *FieldOption) Build(lookups map[string][]models.Lookup) (*models.Field,
 		return nil, errors.NewInvalidFieldLabelError()
 	}
 
+	if f.fieldType == constants.FieldTypeGeoPoint && f.isArray {
+		return nil, errors.NewInvalidFieldTypeError()
+	}
+
 	fieldType := f.fieldType
 
 	if f.isArray {
-		if fieldType == constants.FieldTypeString {
-			fieldType = constants.FieldTypeStringArray
-		} else if fieldType == constants.FieldTypeNumber {
-			fieldType = constants.FieldTypeNumberArray
-		} else if fieldType == constants.FieldTypeBoolean {
-			fieldType = constants.FieldTypeBooleanArray
-		} else if fieldType == constants.FieldTypeDate {
-			fieldType = constants.FieldTypeDateArray
-		} else if fieldType == constants.FieldTypeTime {
-			fieldType = constants.FieldTypeTimeArray
-		} else if fieldType == constants.FieldTypeDateTime {
-			fieldType = constants.FieldTypeDateTimeArray
-		}
+		fieldType = fieldType.ToArray()
 	}
 
 	fieldValues := make([]models.Lookup, 0)
@@ -113,21 +166,34 @@ func (f *FieldOption) Build(lookups map[string][]models.Lookup) (*models.Field,
 		}
 	}
 
+	provider := providers[f.lookup]
+	hasLookup := len(fieldValues) > 0 || provider != nil
+
+	if f.fieldType == constants.FieldTypeEnum && !hasLookup {
+		return nil, errors.NewInvalidFieldEnumError()
+	}
+
 	operators := make([]string, 0)
 
-	if !f.isArray {
+	if !f.isArray && fieldType != constants.FieldTypeGeoPoint {
 		operators = append(operators, constants.OperatorEqual.String())
 		operators = append(operators, constants.OperatorNotEqual.String())
 	}
 
 	if (fieldType == constants.FieldTypeNumber ||
 		fieldType == constants.FieldTypeNumberArray ||
+		fieldType == constants.FieldTypeInteger ||
+		fieldType == constants.FieldTypeIntegerArray ||
+		fieldType == constants.FieldTypeDecimal ||
+		fieldType == constants.FieldTypeDecimalArray ||
 		fieldType == constants.FieldTypeDate ||
 		fieldType == constants.FieldTypeDateArray ||
 		fieldType == constants.FieldTypeTime ||
 		fieldType == constants.FieldTypeTimeArray ||
+		fieldType == constants.FieldTypeDuration ||
+		fieldType == constants.FieldTypeDurationArray ||
 		fieldType == constants.FieldTypeDateTime ||
-		fieldType == constants.FieldTypeDateTimeArray) && len(fieldValues) == 0 {
+		fieldType == constants.FieldTypeDateTimeArray) && !hasLookup {
 		operators = append(operators, constants.OperatorGreaterThan.String())
 		operators = append(operators, constants.OperatorGreaterThanOrEqual.String())
 		operators = append(operators, constants.OperatorLessThan.String())
@@ -139,10 +205,15 @@ func (f *FieldOption) Build(lookups map[string][]models.Lookup) (*models.Field,
 		operators = append(operators, constants.OperatorNotBlank.String())
 	}
 
+	if f.isNullable {
+		operators = append(operators, constants.OperatorIsNull.String())
+		operators = append(operators, constants.OperatorIsNotNull.String())
+	}
+
 	if f.isArray {
 		operators = append(operators, constants.OperatorContain.String())
 		operators = append(operators, constants.OperatorNotContain.String())
-	} else if fieldType == constants.FieldTypeString && len(fieldValues) == 0 {
+	} else if fieldType == constants.FieldTypeString && !hasLookup {
 		operators = append(operators, constants.OperatorContain.String())
 		operators = append(operators, constants.OperatorNotContain.String())
 		operators = append(operators, constants.OperatorStartWith.String())
@@ -151,16 +222,37 @@ func (f *FieldOption) Build(lookups map[string][]models.Lookup) (*models.Field,
 		operators = append(operators, constants.OperatorNotEndWith.String())
 	}
 
-	if !f.isArray {
+	if !f.isArray && fieldType != constants.FieldTypeGeoPoint {
 		operators = append(operators, constants.OperatorIn.String())
 		operators = append(operators, constants.OperatorNotIn.String())
 	}
 
+	if fieldType == constants.FieldTypeIP {
+		operators = append(operators, constants.OperatorInSubnet.String())
+		operators = append(operators, constants.OperatorNotInSubnet.String())
+	}
+
+	if fieldType == constants.FieldTypeGeoPoint {
+		operators = append(operators, constants.OperatorWithin.String())
+	}
+
+	if f.isSearchable && (fieldType == constants.FieldTypeString || fieldType == constants.FieldTypeStringArray) {
+		operators = append(operators, constants.OperatorSearch.String())
+	}
+
+	collation := constants.CollationDefault
+
+	if fieldType == constants.FieldTypeString || fieldType == constants.FieldTypeStringArray {
+		collation = f.collation
+	}
+
 	return &models.Field{
 		Name:      f.name,
 		Type:      fieldType.String(),
 		Label:     f.label,
 		Operators: operators,
 		Values:    fieldValues,
+		Collation: collation.String(),
+		Provider:  provider,
 	}, nil
 }
@@ -118,6 +118,54 @@ func TestFieldOption_Nullable_ShouldSetIsNullableAsTrueAndReturnItself(t *testin
 	assert.True(t, result.isNullable)
 }
 
+func TestFieldOption_Searchable_ShouldSetIsSearchableAsTrueAndReturnItself(t *testing.T) {
+	// Arrange
+	opt := NewFieldOption()
+
+	// Act
+	result := opt.Searchable()
+
+	// Assert
+	assert.NotNil(t, result)
+	assert.True(t, result.isSearchable)
+}
+
+func TestFieldOption_CaseSensitive_ShouldSetCollationAndReturnItself(t *testing.T) {
+	// Arrange
+	opt := NewFieldOption()
+
+	// Act
+	result := opt.CaseSensitive()
+
+	// Assert
+	assert.NotNil(t, result)
+	assert.Equal(t, constants.CollationCaseSensitive, result.collation)
+}
+
+func TestFieldOption_CaseInsensitive_ShouldSetCollationAndReturnItself(t *testing.T) {
+	// Arrange
+	opt := NewFieldOption()
+
+	// Act
+	result := opt.CaseInsensitive()
+
+	// Assert
+	assert.NotNil(t, result)
+	assert.Equal(t, constants.CollationCaseInsensitive, result.collation)
+}
+
+func TestFieldOption_AccentInsensitive_ShouldSetCollationAndReturnItself(t *testing.T) {
+	// Arrange
+	opt := NewFieldOption()
+
+	// Act
+	result := opt.AccentInsensitive()
+
+	// Assert
+	assert.NotNil(t, result)
+	assert.Equal(t, constants.CollationAccentInsensitive, result.collation)
+}
+
 func TestFieldOption_Name_ShouldSetNameAndReturnItself(t *testing.T) {
 	// Arrange
 	opt := NewFieldOption()
@@ -400,6 +448,42 @@ func TestFieldOption_Build_ShouldAddBlankOperators_WhenNullableIsDefined(t *test
 	assert.Contains(t, result.Operators, constants.OperatorNotBlank.String())
 }
 
+func TestFieldOption_Build_ShouldAddNullOperators_WhenNullableIsDefined(t *testing.T) {
+	// Arrange
+	opt := NewFieldOption().
+		Number().
+		Nullable().
+		Name("Some Name").
+		Label("Some Label")
+
+	// Act
+	result, err := opt.Build(make(map[string][]models.Lookup))
+
+	// Assert
+	assert.NotNil(t, result)
+	assert.NoError(t, err)
+	assert.Contains(t, result.Operators, constants.OperatorIsNull.String())
+	assert.Contains(t, result.Operators, constants.OperatorIsNotNull.String())
+}
+
+func TestFieldOption_Build_ShouldNotAddNullOperators_WhenOnlyArrayIsDefined(t *testing.T) {
+	// Arrange
+	opt := NewFieldOption().
+		String().
+		Array().
+		Name("Some Name").
+		Label("Some Label")
+
+	// Act
+	result, err := opt.Build(make(map[string][]models.Lookup))
+
+	// Assert
+	assert.NotNil(t, result)
+	assert.NoError(t, err)
+	assert.NotContains(t, result.Operators, constants.OperatorIsNull.String())
+	assert.NotContains(t, result.Operators, constants.OperatorIsNotNull.String())
+}
+
 func TestFieldOption_Build_ShouldAddContainOperators_WhenArrayIsDefined(t *testing.T) {
 	// Arrange
 	opt := NewFieldOption().
@@ -439,6 +523,40 @@ func TestFieldOption_Build_ShouldAddContainOperators_WhenTypeIsString(t *testing
 	assert.Contains(t, result.Operators, constants.OperatorNotEndWith.String())
 }
 
+func TestFieldOption_Build_ShouldAddSearchOperator_WhenTypeIsStringAndSearchableIsDefined(t *testing.T) {
+	// Arrange
+	opt := NewFieldOption().
+		String().
+		Searchable().
+		Name("Some Name").
+		Label("Some Label")
+
+	// Act
+	result, err := opt.Build(make(map[string][]models.Lookup))
+
+	// Assert
+	assert.NotNil(t, result)
+	assert.NoError(t, err)
+	assert.Contains(t, result.Operators, constants.OperatorSearch.String())
+}
+
+func TestFieldOption_Build_ShouldNotAddSearchOperator_WhenTypeIsNotString(t *testing.T) {
+	// Arrange
+	opt := NewFieldOption().
+		Number().
+		Searchable().
+		Name("Some Name").
+		Label("Some Label")
+
+	// Act
+	result, err := opt.Build(make(map[string][]models.Lookup))
+
+	// Assert
+	assert.NotNil(t, result)
+	assert.NoError(t, err)
+	assert.NotContains(t, result.Operators, constants.OperatorSearch.String())
+}
+
 func TestFieldOption_Build_ShouldAddCompareOperators_WhenTypeIsNumber(t *testing.T) {
 	// Arrange
 	opt := NewFieldOption().
@@ -594,3 +712,371 @@ func TestFieldOption_Build_ShouldAddCompareOperators_WhenTypeIsDateTimeAndArrayI
 	assert.Contains(t, result.Operators, constants.OperatorLessThan.String())
 	assert.Contains(t, result.Operators, constants.OperatorLessThanOrEqual.String())
 }
+
+func TestFieldOption_Build_ShouldSetCollation_WhenTypeIsString(t *testing.T) {
+	// Arrange
+	samples := map[*FieldOption]string{
+		NewFieldOption().String().CaseSensitive():             constants.CollationCaseSensitive.String(),
+		NewFieldOption().String().Array().AccentInsensitive(): constants.CollationAccentInsensitive.String(),
+		NewFieldOption().String():                             constants.CollationDefault.String(),
+		NewFieldOption().Number().CaseSensitive():             constants.CollationDefault.String(),
+	}
+
+	for k, v := range samples {
+		// Act
+		result, err := k.Name("Some Name").Label("Some Label").Build(make(map[string][]models.Lookup))
+
+		// Assert
+		assert.NoError(t, err)
+		assert.Equal(t, v, result.Collation)
+	}
+}
+
+func TestFieldOption_Integer_ShouldSetFieldTypeAsIntegerAndReturnItself(t *testing.T) {
+	// Arrange
+	opt := NewFieldOption()
+
+	// Act
+	result := opt.Integer()
+
+	// Assert
+	assert.NotNil(t, result)
+	assert.Equal(t, constants.FieldTypeInteger, result.fieldType)
+}
+
+func TestFieldOption_Build_ShouldSetFieldTypeAsIntegerArray_WhenTypeIsIntegerAndArrayIsDefined(t *testing.T) {
+	// Arrange
+	opt := NewFieldOption().
+		Integer().
+		Array().
+		Name("Some Name").
+		Label("Some Label")
+
+	// Act
+	result, err := opt.Build(make(map[string][]models.Lookup))
+
+	// Assert
+	assert.NotNil(t, result)
+	assert.NoError(t, err)
+	assert.Equal(t, constants.FieldTypeIntegerArray.String(), result.Type)
+}
+
+func TestFieldOption_Build_ShouldAddCompareOperators_WhenTypeIsInteger(t *testing.T) {
+	// Arrange
+	opt := NewFieldOption().
+		Integer().
+		Name("Some Name").
+		Label("Some Label")
+
+	// Act
+	result, err := opt.Build(make(map[string][]models.Lookup))
+
+	// Assert
+	assert.NotNil(t, result)
+	assert.NoError(t, err)
+	assert.Contains(t, result.Operators, constants.OperatorGreaterThan.String())
+	assert.Contains(t, result.Operators, constants.OperatorGreaterThanOrEqual.String())
+	assert.Contains(t, result.Operators, constants.OperatorLessThan.String())
+	assert.Contains(t, result.Operators, constants.OperatorLessThanOrEqual.String())
+}
+
+func TestFieldOption_Decimal_ShouldSetFieldTypeAsDecimalAndReturnItself(t *testing.T) {
+	// Arrange
+	opt := NewFieldOption()
+
+	// Act
+	result := opt.Decimal()
+
+	// Assert
+	assert.NotNil(t, result)
+	assert.Equal(t, constants.FieldTypeDecimal, result.fieldType)
+}
+
+func TestFieldOption_Build_ShouldSetFieldTypeAsDecimalArray_WhenTypeIsDecimalAndArrayIsDefined(t *testing.T) {
+	// Arrange
+	opt := NewFieldOption().
+		Decimal().
+		Array().
+		Name("Some Name").
+		Label("Some Label")
+
+	// Act
+	result, err := opt.Build(make(map[string][]models.Lookup))
+
+	// Assert
+	assert.NotNil(t, result)
+	assert.NoError(t, err)
+	assert.Equal(t, constants.FieldTypeDecimalArray.String(), result.Type)
+}
+
+func TestFieldOption_Build_ShouldAddCompareOperators_WhenTypeIsDecimal(t *testing.T) {
+	// Arrange
+	opt := NewFieldOption().
+		Decimal().
+		Name("Some Name").
+		Label("Some Label")
+
+	// Act
+	result, err := opt.Build(make(map[string][]models.Lookup))
+
+	// Assert
+	assert.NotNil(t, result)
+	assert.NoError(t, err)
+	assert.Contains(t, result.Operators, constants.OperatorGreaterThan.String())
+	assert.Contains(t, result.Operators, constants.OperatorGreaterThanOrEqual.String())
+	assert.Contains(t, result.Operators, constants.OperatorLessThan.String())
+	assert.Contains(t, result.Operators, constants.OperatorLessThanOrEqual.String())
+}
+
+func TestFieldOption_UUID_ShouldSetFieldTypeAsUUIDAndReturnItself(t *testing.T) {
+	// Arrange
+	opt := NewFieldOption()
+
+	// Act
+	result := opt.UUID()
+
+	// Assert
+	assert.NotNil(t, result)
+	assert.Equal(t, constants.FieldTypeUUID, result.fieldType)
+}
+
+func TestFieldOption_Build_ShouldNotAddStringOperators_WhenTypeIsUUID(t *testing.T) {
+	// Arrange
+	opt := NewFieldOption().
+		UUID().
+		Name("Some Name").
+		Label("Some Label")
+
+	// Act
+	result, err := opt.Build(make(map[string][]models.Lookup))
+
+	// Assert
+	assert.NotNil(t, result)
+	assert.NoError(t, err)
+	assert.Equal(t, constants.FieldTypeUUID.String(), result.Type)
+	assert.Equal(t, []string{
+		constants.OperatorEqual.String(),
+		constants.OperatorNotEqual.String(),
+		constants.OperatorIn.String(),
+		constants.OperatorNotIn.String(),
+	}, result.Operators)
+}
+
+func TestFieldOption_Enum_ShouldSetFieldTypeAsEnumAndReturnItself(t *testing.T) {
+	// Arrange
+	opt := NewFieldOption()
+
+	// Act
+	result := opt.Enum()
+
+	// Assert
+	assert.NotNil(t, result)
+	assert.Equal(t, constants.FieldTypeEnum, result.fieldType)
+}
+
+func TestFieldOption_Build_ShouldReturnError_WhenTypeIsEnumAndValuesAreNotDefined(t *testing.T) {
+	// Arrange
+	opt := NewFieldOption().
+		Enum().
+		Name("Some Name").
+		Label("Some Label").
+		Lookup("some_key")
+
+	// Act
+	result, err := opt.Build(make(map[string][]models.Lookup))
+
+	// Assert
+	assert.Nil(t, result)
+	assert.Error(t, err)
+}
+
+func TestFieldOption_Build_ShouldSetFieldTypeAsEnumArray_WhenTypeIsEnumAndArrayIsDefined(t *testing.T) {
+	// Arrange
+	opt := NewFieldOption().
+		Enum().
+		Array().
+		Name("Some Name").
+		Label("Some Label").
+		Lookup("some_key")
+
+	// Act
+	result, err := opt.Build(map[string][]models.Lookup{
+		"some_key": {
+			{
+				Name:  "Active",
+				Value: "active",
+			},
+		},
+	})
+
+	// Assert
+	assert.NotNil(t, result)
+	assert.NoError(t, err)
+	assert.Equal(t, constants.FieldTypeEnumArray.String(), result.Type)
+	assert.Len(t, result.Values, 1)
+}
+
+func TestFieldOption_IP_ShouldSetFieldTypeAsIPAndReturnItself(t *testing.T) {
+	// Arrange
+	opt := NewFieldOption()
+
+	// Act
+	result := opt.IP()
+
+	// Assert
+	assert.NotNil(t, result)
+	assert.Equal(t, constants.FieldTypeIP, result.fieldType)
+}
+
+func TestFieldOption_Build_ShouldAddSubnetOperators_WhenTypeIsIP(t *testing.T) {
+	// Arrange
+	opt := NewFieldOption().
+		IP().
+		Name("Some Name").
+		Label("Some Label")
+
+	// Act
+	result, err := opt.Build(make(map[string][]models.Lookup))
+
+	// Assert
+	assert.NotNil(t, result)
+	assert.NoError(t, err)
+	assert.Contains(t, result.Operators, constants.OperatorInSubnet.String())
+	assert.Contains(t, result.Operators, constants.OperatorNotInSubnet.String())
+	assert.NotContains(t, result.Operators, constants.OperatorContain.String())
+}
+
+func TestFieldOption_Build_ShouldNotAddSubnetOperators_WhenTypeIsIPAndArrayIsDefined(t *testing.T) {
+	// Arrange
+	opt := NewFieldOption().
+		IP().
+		Array().
+		Name("Some Name").
+		Label("Some Label")
+
+	// Act
+	result, err := opt.Build(make(map[string][]models.Lookup))
+
+	// Assert
+	assert.NotNil(t, result)
+	assert.NoError(t, err)
+	assert.Equal(t, constants.FieldTypeIPArray.String(), result.Type)
+	assert.NotContains(t, result.Operators, constants.OperatorInSubnet.String())
+}
+
+func TestFieldOption_Duration_ShouldSetFieldTypeAsDurationAndReturnItself(t *testing.T) {
+	// Arrange
+	opt := NewFieldOption()
+
+	// Act
+	result := opt.Duration()
+
+	// Assert
+	assert.NotNil(t, result)
+	assert.Equal(t, constants.FieldTypeDuration, result.fieldType)
+}
+
+func TestFieldOption_Build_ShouldAddCompareOperators_WhenTypeIsDuration(t *testing.T) {
+	// Arrange
+	opt := NewFieldOption().
+		Duration().
+		Name("Some Name").
+		Label("Some Label")
+
+	// Act
+	result, err := opt.Build(make(map[string][]models.Lookup))
+
+	// Assert
+	assert.NotNil(t, result)
+	assert.NoError(t, err)
+	assert.Equal(t, constants.FieldTypeDuration.String(), result.Type)
+	assert.Contains(t, result.Operators, constants.OperatorGreaterThan.String())
+	assert.Contains(t, result.Operators, constants.OperatorGreaterThanOrEqual.String())
+	assert.Contains(t, result.Operators, constants.OperatorLessThan.String())
+	assert.Contains(t, result.Operators, constants.OperatorLessThanOrEqual.String())
+}
+
+func TestFieldOption_GeoPoint_ShouldSetFieldTypeAsGeoPointAndReturnItself(t *testing.T) {
+	// Arrange
+	opt := NewFieldOption()
+
+	// Act
+	result := opt.GeoPoint()
+
+	// Assert
+	assert.NotNil(t, result)
+	assert.Equal(t, constants.FieldTypeGeoPoint, result.fieldType)
+}
+
+func TestFieldOption_Build_ShouldReturnError_WhenTypeIsGeoPointAndArrayIsDefined(t *testing.T) {
+	// Arrange
+	opt := NewFieldOption().
+		GeoPoint().
+		Array().
+		Name("Some Name").
+		Label("Some Label")
+
+	// Act
+	result, err := opt.Build(make(map[string][]models.Lookup))
+
+	// Assert
+	assert.Nil(t, result)
+	assert.Error(t, err)
+}
+
+func TestFieldOption_Build_ShouldOnlyAddWithinOperator_WhenTypeIsGeoPoint(t *testing.T) {
+	// Arrange
+	opt := NewFieldOption().
+		GeoPoint().
+		Name("Some Name").
+		Label("Some Label")
+
+	// Act
+	result, err := opt.Build(make(map[string][]models.Lookup))
+
+	// Assert
+	assert.NotNil(t, result)
+	assert.NoError(t, err)
+	assert.Equal(t, constants.FieldTypeGeoPoint.String(), result.Type)
+	assert.Equal(t, []string{constants.OperatorWithin.String()}, result.Operators)
+}
+
+func TestFieldOption_BuildWithProviders_ShouldSetProviderAndTreatFieldAsLookup_WhenProviderIsDefined(t *testing.T) {
+	// Arrange
+	provider := &testLookupProvider{}
+	opt := NewFieldOption().
+		String().
+		Name("Some Name").
+		Label("Some Label").
+		Lookup("some_key")
+
+	// Act
+	result, err := opt.BuildWithProviders(
+		map[string][]models.Lookup{"some_key": {}},
+		map[string]models.LookupProvider{"some_key": provider})
+
+	// Assert
+	assert.NotNil(t, result)
+	assert.NoError(t, err)
+	assert.Equal(t, provider, result.Provider)
+	assert.Empty(t, result.Values)
+	assert.NotContains(t, result.Operators, constants.OperatorContain.String())
+}
+
+func TestFieldOption_BuildWithProviders_ShouldNotReturnError_WhenTypeIsEnumAndProviderIsDefined(t *testing.T) {
+	// Arrange
+	opt := NewFieldOption().
+		Enum().
+		Name("Some Name").
+		Label("Some Label").
+		Lookup("some_key")
+
+	// Act
+	result, err := opt.BuildWithProviders(
+		make(map[string][]models.Lookup),
+		map[string]models.LookupProvider{"some_key": &testLookupProvider{}})
+
+	// Assert
+	assert.NotNil(t, result)
+	assert.NoError(t, err)
+}
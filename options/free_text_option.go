@@ -0,0 +1,71 @@
+package options
+
+import (
+	"strings"
+
+	"github.com/filtex/filtex-go/constants"
+	"github.com/filtex/filtex-go/errors"
+	"github.com/filtex/filtex-go/models"
+)
+
+type FreeTextOption struct {
+	fields   []string
+	operator constants.Operator
+}
+
+func NewFreeTextOption() *FreeTextOption {
+	return &FreeTextOption{
+		fields:   make([]string, 0),
+		operator: constants.OperatorContain,
+	}
+}
+
+func (f *FreeTextOption) Fields(fields ...string) *FreeTextOption {
+	f.fields = fields
+	return f
+}
+
+func (f *FreeTextOption) Contain() *FreeTextOption {
+	f.operator = constants.OperatorContain
+	return f
+}
+
+func (f *FreeTextOption) Search() *FreeTextOption {
+	f.operator = constants.OperatorSearch
+	return f
+}
+
+func (f *FreeTextOption) Build(fields []models.Field) (*models.FreeText, error) {
+	if len(f.fields) == 0 {
+		return nil, errors.NewInvalidFreeTextFieldsError()
+	}
+
+	if f.operator != constants.OperatorContain && f.operator != constants.OperatorSearch {
+		return nil, errors.NewInvalidFreeTextOperatorError()
+	}
+
+	names := make([]string, 0)
+
+	for _, name := range f.fields {
+		var field *models.Field
+
+		for i, v := range fields {
+			if strings.ToLower(v.Name) == strings.ToLower(name) || strings.ToLower(v.Label) == strings.ToLower(name) {
+				field = &fields[i]
+				break
+			}
+		}
+
+		if field == nil ||
+			(field.Type != constants.FieldTypeString.String() && field.Type != constants.FieldTypeStringArray.String()) {
+			return nil, errors.NewInvalidFreeTextFieldsError()
+		}
+
+		names = append(names, field.Name)
+	}
+
+	return &models.FreeText{
+		Fields:   names,
+		Operator: f.operator.String(),
+	}, nil
+}
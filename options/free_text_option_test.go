@@ -0,0 +1,80 @@
+package options
+
+import (
+	"testing"
+
+	"github.com/filtex/filtex-go/constants"
+	"github.com/filtex/filtex-go/models"
+	"github.com/stretchr/testify/assert"
+)
+
+func newFreeTextTestFields() []models.Field {
+	return []models.Field{
+		{
+			Name:  "name",
+			Type:  constants.FieldTypeString.String(),
+			Label: "Name",
+		},
+		{
+			Name:  "version",
+			Type:  constants.FieldTypeNumber.String(),
+			Label: "Version",
+		},
+	}
+}
+
+func TestNewFreeTextOption_ShouldReturnFreeTextOptionWithContainOperator(t *testing.T) {
+	// Act
+	opt := NewFreeTextOption()
+
+	// Assert
+	assert.NotNil(t, opt)
+	assert.Empty(t, opt.fields)
+	assert.Equal(t, constants.OperatorContain, opt.operator)
+}
+
+func TestFreeTextOption_Search_ShouldSetOperatorAsSearchAndReturnItself(t *testing.T) {
+	// Act
+	result := NewFreeTextOption().Search()
+
+	// Assert
+	assert.Equal(t, constants.OperatorSearch, result.operator)
+}
+
+func TestFreeTextOption_Build_ShouldReturnError_WhenFieldsAreNotDefined(t *testing.T) {
+	// Act
+	result, err := NewFreeTextOption().Build(newFreeTextTestFields())
+
+	// Assert
+	assert.Nil(t, result)
+	assert.Error(t, err)
+}
+
+func TestFreeTextOption_Build_ShouldReturnError_WhenFieldIsNotString(t *testing.T) {
+	// Arrange
+	samples := [][]string{
+		{"Unknown"},
+		{"Name", "Version"},
+	}
+
+	for _, v := range samples {
+		// Act
+		result, err := NewFreeTextOption().Fields(v...).Build(newFreeTextTestFields())
+
+		// Assert
+		assert.Nil(t, result)
+		assert.Error(t, err)
+	}
+}
+
+func TestFreeTextOption_Build_ShouldReturnFreeText_WhenDefinitionsAreValid(t *testing.T) {
+	// Act
+	result, err := NewFreeTextOption().Fields("Name").Search().Build(newFreeTextTestFields())
+
+	// Assert
+	assert.NoError(t, err)
+	assert.Equal(t, &models.FreeText{
+		Fields:   []string{"name"},
+		Operator: constants.OperatorSearch.String(),
+	}, result)
+}
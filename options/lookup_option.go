@@ -6,8 +6,9 @@ import (
 )
 
 type LookupOption struct {
-	key    string
-	values []models.Lookup
+	key      string
+	values   []models.Lookup
+	provider models.LookupProvider
 }
 
 func NewLookupOption() *LookupOption {
@@ -26,16 +27,41 @@ func (l *LookupOption) Values(values []models.Lookup) *LookupOption {
 	return l
 }
 
+func (l *LookupOption) Provider(provider models.LookupProvider) *LookupOption {
+	l.provider = provider
+	return l
+}
+
 func (l *LookupOption) Build() (map[string][]models.Lookup, error) {
 	if l.key == "" {
 		return nil, errors.NewInvalidLookupKeyError()
 	}
 
-	if l.values == nil || len(l.values) == 0 {
+	if l.provider == nil && (l.values == nil || len(l.values) == 0) {
 		return nil, errors.NewInvalidLookupValuesError()
 	}
 
+	values := l.values
+
+	if values == nil {
+		values = make([]models.Lookup, 0)
+	}
+
 	return map[string][]models.Lookup{
-		l.key: l.values,
+		l.key: values,
+	}, nil
+}
+
+func (l *LookupOption) BuildProvider() (map[string]models.LookupProvider, error) {
+	if l.key == "" {
+		return nil, errors.NewInvalidLookupKeyError()
+	}
+
+	if l.provider == nil {
+		return map[string]models.LookupProvider{}, nil
+	}
+
+	return map[string]models.LookupProvider{
+		l.key: l.provider,
 	}, nil
 }
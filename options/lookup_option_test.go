@@ -1,6 +1,7 @@
 package options
 
 import (
+	"context"
 	"testing"
 
 	"github.com/filtex/filtex-go/models"
@@ -117,3 +118,80 @@ func TestLookupOption_Build_ShouldReturnLookupMap_WhenKeyAndValuesAreDefined(t *
 	assert.NotNil(t, result["some_key"])
 	assert.Len(t, result["some_key"], 2)
 }
+
+type testLookupProvider struct {
+	values []models.Lookup
+}
+
+func (p *testLookupProvider) Lookups(ctx context.Context) ([]models.Lookup, error) {
+	return p.values, nil
+}
+
+func TestLookupOption_Provider_ShouldSetProviderAndReturnItself(t *testing.T) {
+	// Arrange
+	opt := NewLookupOption()
+	provider := &testLookupProvider{}
+
+	// Act
+	result := opt.Provider(provider)
+
+	// Assert
+	assert.NotNil(t, result)
+	assert.Equal(t, provider, result.provider)
+}
+
+func TestLookupOption_Build_ShouldReturnEmptyLookupMap_WhenProviderIsDefined(t *testing.T) {
+	// Arrange
+	opt := NewLookupOption().
+		Key("some_key").
+		Provider(&testLookupProvider{})
+
+	// Act
+	result, err := opt.Build()
+
+	// Assert
+	assert.NoError(t, err)
+	assert.Equal(t, map[string][]models.Lookup{"some_key": {}}, result)
+}
+
+func TestLookupOption_BuildProvider_ShouldReturnError_WhenKeyIsNotDefined(t *testing.T) {
+	// Arrange
+	opt := NewLookupOption().
+		Provider(&testLookupProvider{})
+
+	// Act
+	result, err := opt.BuildProvider()
+
+	// Assert
+	assert.Nil(t, result)
+	assert.Error(t, err)
+}
+
+func TestLookupOption_BuildProvider_ShouldReturnEmptyMap_WhenProviderIsNotDefined(t *testing.T) {
+	// Arrange
+	opt := NewLookupOption().
+		Key("some_key").
+		Values([]models.Lookup{{Name: "Enabled", Value: true}})
+
+	// Act
+	result, err := opt.BuildProvider()
+
+	// Assert
+	assert.NoError(t, err)
+	assert.Empty(t, result)
+}
+
+func TestLookupOption_BuildProvider_ShouldReturnProviderMap_WhenProviderIsDefined(t *testing.T) {
+	// Arrange
+	provider := &testLookupProvider{}
+	opt := NewLookupOption().
+		Key("some_key").
+		Provider(provider)
+
+	// Act
+	result, err := opt.BuildProvider()
+
+	// Assert
+	assert.NoError(t, err)
+	assert.Equal(t, map[string]models.LookupProvider{"some_key": provider}, result)
+}
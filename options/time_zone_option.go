@@ -0,0 +1,45 @@
+package options
+
+import (
+	"time"
+
+	"github.com/filtex/filtex-go/errors"
+)
+
+type TimeZoneOption struct {
+	name     string
+	location *time.Location
+}
+
+func NewTimeZoneOption() *TimeZoneOption {
+	return &TimeZoneOption{}
+}
+
+func (t *TimeZoneOption) Name(name string) *TimeZoneOption {
+	t.name = name
+	t.location = nil
+	return t
+}
+
+func (t *TimeZoneOption) Location(location *time.Location) *TimeZoneOption {
+	t.name = ""
+	t.location = location
+	return t
+}
+
+func (t *TimeZoneOption) Build() (*time.Location, error) {
+	if t.location != nil {
+		return t.location, nil
+	}
+
+	if t.name == "" {
+		return nil, errors.NewInvalidTimeZoneError()
+	}
+
+	location, err := time.LoadLocation(t.name)
+	if err != nil {
+		return nil, errors.NewInvalidTimeZoneError()
+	}
+
+	return location, nil
+}
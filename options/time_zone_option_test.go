@@ -0,0 +1,80 @@
+package options
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewTimeZoneOption_ShouldReturnEmptyTimeZoneOption(t *testing.T) {
+	// Act
+	opt := NewTimeZoneOption()
+
+	// Assert
+	assert.NotNil(t, opt)
+	assert.Empty(t, opt.name)
+	assert.Nil(t, opt.location)
+}
+
+func TestTimeZoneOption_Name_ShouldSetNameAndReturnItself(t *testing.T) {
+	// Act
+	result := NewTimeZoneOption().Location(time.UTC).Name("UTC")
+
+	// Assert
+	assert.NotNil(t, result)
+	assert.Equal(t, "UTC", result.name)
+	assert.Nil(t, result.location)
+}
+
+func TestTimeZoneOption_Location_ShouldSetLocationAndReturnItself(t *testing.T) {
+	// Arrange
+	location := time.FixedZone("UTC+3", 3*60*60)
+
+	// Act
+	result := NewTimeZoneOption().Name("UTC").Location(location)
+
+	// Assert
+	assert.NotNil(t, result)
+	assert.Empty(t, result.name)
+	assert.Equal(t, location, result.location)
+}
+
+func TestTimeZoneOption_Build_ShouldReturnError_WhenTimeZoneIsNotSet(t *testing.T) {
+	// Act
+	result, err := NewTimeZoneOption().Build()
+
+	// Assert
+	assert.Nil(t, result)
+	assert.Error(t, err)
+}
+
+func TestTimeZoneOption_Build_ShouldReturnError_WhenNameIsUnknown(t *testing.T) {
+	// Act
+	result, err := NewTimeZoneOption().Name("Unknown/Zone").Build()
+
+	// Assert
+	assert.Nil(t, result)
+	assert.Error(t, err)
+}
+
+func TestTimeZoneOption_Build_ShouldReturnLocation_WhenNameIsValid(t *testing.T) {
+	// Act
+	result, err := NewTimeZoneOption().Name("UTC").Build()
+
+	// Assert
+	assert.NoError(t, err)
+	assert.Equal(t, time.UTC, result)
+}
+
+func TestTimeZoneOption_Build_ShouldReturnLocation_WhenLocationIsSet(t *testing.T) {
+	// Arrange
+	location := time.FixedZone("UTC+3", 3*60*60)
+
+	// Act
+	result, err := NewTimeZoneOption().Location(location).Build()
+
+	// Assert
+	assert.NoError(t, err)
+	assert.Equal(t, location, result)
+}
@@ -0,0 +1,28 @@
+package options
+
+import (
+	"strings"
+
+	"github.com/filtex/filtex-go/errors"
+)
+
+type VersionOption struct {
+	version string
+}
+
+func NewVersionOption() *VersionOption {
+	return &VersionOption{}
+}
+
+func (v *VersionOption) Version(version string) *VersionOption {
+	v.version = version
+	return v
+}
+
+func (v *VersionOption) Build() (string, error) {
+	if strings.TrimSpace(v.version) == "" {
+		return "", errors.NewInvalidVersionError()
+	}
+
+	return v.version, nil
+}
@@ -0,0 +1,48 @@
+package options
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewVersionOption_ShouldReturnEmptyVersionOption(t *testing.T) {
+	// Act
+	opt := NewVersionOption()
+
+	// Assert
+	assert.NotNil(t, opt)
+	assert.Empty(t, opt.version)
+}
+
+func TestVersionOption_Version_ShouldSetVersionAndReturnItself(t *testing.T) {
+	// Act
+	result := NewVersionOption().Version("2024-06")
+
+	// Assert
+	assert.NotNil(t, result)
+	assert.Equal(t, "2024-06", result.version)
+}
+
+func TestVersionOption_Build_ShouldReturnError_WhenVersionIsNotDefined(t *testing.T) {
+	// Arrange
+	samples := []string{"", "  "}
+
+	for _, v := range samples {
+		// Act
+		result, err := NewVersionOption().Version(v).Build()
+
+		// Assert
+		assert.Empty(t, result)
+		assert.Error(t, err)
+	}
+}
+
+func TestVersionOption_Build_ShouldReturnVersion_WhenVersionIsDefined(t *testing.T) {
+	// Act
+	result, err := NewVersionOption().Version("3").Build()
+
+	// Assert
+	assert.NoError(t, err)
+	assert.Equal(t, "3", result)
+}
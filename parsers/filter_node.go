@@ -0,0 +1,87 @@
+package parsers
+
+import (
+	"github.com/filtex/filtex-go/constants"
+	"github.com/filtex/filtex-go/errors"
+	"github.com/filtex/filtex-go/queries"
+)
+
+type filterNode struct {
+	logic     constants.Logic
+	nodes     []*filterNode
+	field     string
+	operator  constants.Operator
+	value     interface{}
+	collation constants.Collation
+}
+
+var (
+	filterNegations = map[constants.Operator]constants.Operator{
+		constants.OperatorEqual:              constants.OperatorNotEqual,
+		constants.OperatorNotEqual:           constants.OperatorEqual,
+		constants.OperatorGreaterThan:        constants.OperatorLessThanOrEqual,
+		constants.OperatorGreaterThanOrEqual: constants.OperatorLessThan,
+		constants.OperatorLessThan:           constants.OperatorGreaterThanOrEqual,
+		constants.OperatorLessThanOrEqual:    constants.OperatorGreaterThan,
+		constants.OperatorContain:            constants.OperatorNotContain,
+		constants.OperatorNotContain:         constants.OperatorContain,
+		constants.OperatorStartWith:          constants.OperatorNotStartWith,
+		constants.OperatorNotStartWith:       constants.OperatorStartWith,
+		constants.OperatorEndWith:            constants.OperatorNotEndWith,
+		constants.OperatorNotEndWith:         constants.OperatorEndWith,
+		constants.OperatorIn:                 constants.OperatorNotIn,
+		constants.OperatorNotIn:              constants.OperatorIn,
+		constants.OperatorBlank:              constants.OperatorNotBlank,
+		constants.OperatorNotBlank:           constants.OperatorBlank,
+		constants.OperatorIsNull:             constants.OperatorIsNotNull,
+		constants.OperatorIsNotNull:          constants.OperatorIsNull,
+		constants.OperatorInSubnet:           constants.OperatorNotInSubnet,
+		constants.OperatorNotInSubnet:        constants.OperatorInSubnet,
+	}
+)
+
+func negateFilterNode(node *filterNode) (*filterNode, error) {
+	if node.logic != constants.LogicUnknown {
+		logic := constants.LogicAnd
+		if node.logic == constants.LogicAnd {
+			logic = constants.LogicOr
+		}
+
+		nodes := make([]*filterNode, 0)
+
+		for _, v := range node.nodes {
+			negated, err := negateFilterNode(v)
+			if err != nil {
+				return nil, err
+			}
+			nodes = append(nodes, negated)
+		}
+
+		return &filterNode{logic: logic, nodes: nodes}, nil
+	}
+
+	operator, ok := filterNegations[node.operator]
+	if !ok {
+		return nil, errors.NewOperatorCouldNotBeParsedError()
+	}
+
+	return &filterNode{field: node.field, operator: operator, value: node.value, collation: node.collation}, nil
+}
+
+func buildFilterNode(builder *queries.QueryBuilder, node *filterNode) *queries.Query {
+	if node.logic == constants.LogicUnknown {
+		return builder.Where(node.field).Collation(node.collation).Operator(node.operator, node.value)
+	}
+
+	list := make([]*queries.Query, 0)
+
+	for _, v := range node.nodes {
+		list = append(list, buildFilterNode(builder, v))
+	}
+
+	if node.logic == constants.LogicAnd {
+		return builder.And(list...)
+	}
+
+	return builder.Or(list...)
+}
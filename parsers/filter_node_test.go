@@ -0,0 +1,76 @@
+package parsers
+
+import (
+	"testing"
+
+	"github.com/filtex/filtex-go/constants"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNegateFilterNode_ShouldFlipOperator_WhenNodeIsComparison(t *testing.T) {
+	for operator, negation := range filterNegations {
+		// Arrange
+		node := &filterNode{field: "Value", operator: operator, value: 1}
+
+		// Act
+		result, err := negateFilterNode(node)
+
+		// Assert
+		assert.NoError(t, err)
+		assert.Equal(t, &filterNode{field: "Value", operator: negation, value: 1}, result)
+	}
+}
+
+func TestNegateFilterNode_ShouldApplyDeMorgan_WhenNodeIsLogic(t *testing.T) {
+	// Arrange
+	node := &filterNode{
+		logic: constants.LogicAnd,
+		nodes: []*filterNode{
+			{field: "A", operator: constants.OperatorGreaterThan, value: 1},
+			{
+				logic: constants.LogicOr,
+				nodes: []*filterNode{
+					{field: "B", operator: constants.OperatorIsNull},
+					{field: "C", operator: constants.OperatorIn, value: []interface{}{1}},
+				},
+			},
+		},
+	}
+
+	// Act
+	result, err := negateFilterNode(node)
+
+	// Assert
+	assert.NoError(t, err)
+	assert.Equal(t, &filterNode{
+		logic: constants.LogicOr,
+		nodes: []*filterNode{
+			{field: "A", operator: constants.OperatorLessThanOrEqual, value: 1},
+			{
+				logic: constants.LogicAnd,
+				nodes: []*filterNode{
+					{field: "B", operator: constants.OperatorIsNotNull},
+					{field: "C", operator: constants.OperatorNotIn, value: []interface{}{1}},
+				},
+			},
+		},
+	}, result)
+}
+
+func TestNegateFilterNode_ShouldReturnError_WhenOperatorCannotBeNegated(t *testing.T) {
+	// Arrange
+	samples := []*filterNode{
+		{field: "A", operator: constants.OperatorWithin, value: "5km of (0, 0)"},
+		{field: "A", operator: constants.OperatorSearch, value: "text"},
+		{logic: constants.LogicOr, nodes: []*filterNode{{field: "A", operator: constants.OperatorUnknown}}},
+	}
+
+	for _, v := range samples {
+		// Act
+		result, err := negateFilterNode(v)
+
+		// Assert
+		assert.Nil(t, result)
+		assert.Error(t, err)
+	}
+}
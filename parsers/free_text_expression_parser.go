@@ -0,0 +1,36 @@
+package parsers
+
+import (
+	"github.com/filtex/filtex-go/constants"
+	"github.com/filtex/filtex-go/errors"
+	"github.com/filtex/filtex-go/expressions"
+	"github.com/filtex/filtex-go/models"
+	"github.com/filtex/filtex-go/utils"
+)
+
+func newFreeTextExpression(metadata *models.Metadata, value interface{}) (expressions.Expression, error) {
+	if metadata.FreeText == nil || len(metadata.FreeText.Fields) == 0 {
+		return nil, errors.NewCouldNotBeParsedError()
+	}
+
+	text, err := utils.String(value)
+	if err != nil || text == "" {
+		return nil, errors.NewCouldNotBeParsedError()
+	}
+
+	if constants.OperatorSearch.Equals(metadata.FreeText.Operator) {
+		return expressions.NewSearchExpression(metadata.FreeText.Fields, text), nil
+	}
+
+	expressionList := make([]expressions.Expression, 0)
+
+	for _, v := range metadata.FreeText.Fields {
+		expressionList = append(expressionList, newOperatorExpression(metadata, v, constants.OperatorContain, text))
+	}
+
+	if len(expressionList) == 1 {
+		return expressionList[0], nil
+	}
+
+	return expressions.NewLogicExpression(constants.LogicOr, expressionList), nil
+}
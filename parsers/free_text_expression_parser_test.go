@@ -0,0 +1,213 @@
+package parsers
+
+import (
+	"testing"
+
+	"github.com/filtex/filtex-go/constants"
+	"github.com/filtex/filtex-go/expressions"
+	"github.com/filtex/filtex-go/models"
+	"github.com/filtex/filtex-go/tokenizers"
+	"github.com/filtex/filtex-go/validators"
+	"github.com/stretchr/testify/assert"
+)
+
+func newFreeTextTestMetadata(operator constants.Operator) *models.Metadata {
+	return &models.Metadata{
+		Fields: []models.Field{
+			{
+				Name:  "name",
+				Type:  constants.FieldTypeString.String(),
+				Label: "Name",
+				Operators: []string{
+					constants.OperatorEqual.String(),
+					constants.OperatorContain.String(),
+				},
+			},
+			{
+				Name:  "description",
+				Type:  constants.FieldTypeString.String(),
+				Label: "Description",
+				Operators: []string{
+					constants.OperatorContain.String(),
+				},
+			},
+			{
+				Name:  "age",
+				Type:  constants.FieldTypeNumber.String(),
+				Label: "Age",
+				Operators: []string{
+					constants.OperatorGreaterThan.String(),
+					constants.OperatorIn.String(),
+				},
+			},
+		},
+		FreeText: &models.FreeText{
+			Fields:   []string{"name", "description"},
+			Operator: operator.String(),
+		},
+	}
+}
+
+func TestTextQueryParser_ShouldReturnError_WhenFreeTextIsNotEnabled(t *testing.T) {
+	// Arrange
+	metadata := newFreeTextTestMetadata(constants.OperatorContain)
+	metadata.FreeText = nil
+	validator := validators.NewTextQueryValidator(metadata, tokenizers.NewTextQueryTokenizer(metadata))
+
+	// Act
+	err := validator.Validate("acme")
+
+	// Assert
+	assert.Error(t, err)
+}
+
+func TestTextQueryParser_ShouldReturnContainExpressions_WhenBareWordIsGiven(t *testing.T) {
+	// Arrange
+	metadata := newFreeTextTestMetadata(constants.OperatorContain)
+	tokenizer := tokenizers.NewTextQueryTokenizer(metadata)
+	parser := NewTextQueryParser(metadata, tokenizer)
+	validator := validators.NewTextQueryValidator(metadata, tokenizer)
+
+	// Act
+	validateErr := validator.Validate("acme")
+	expression, err := parser.Parse("acme")
+
+	// Assert
+	assert.NoError(t, validateErr)
+	assert.NoError(t, err)
+	assert.Equal(t, expressions.NewLogicExpression(constants.LogicOr, []expressions.Expression{
+		expressions.NewOperatorExpression(constants.FieldTypeString, "name", constants.OperatorContain, "acme"),
+		expressions.NewOperatorExpression(constants.FieldTypeString, "description", constants.OperatorContain, "acme"),
+	}), expression)
+}
+
+func TestTextQueryParser_ShouldCombineWordsWithAnd_WhenMultipleBareWordsAreGiven(t *testing.T) {
+	// Arrange
+	metadata := newFreeTextTestMetadata(constants.OperatorSearch)
+	parser := NewTextQueryParser(metadata, tokenizers.NewTextQueryTokenizer(metadata))
+
+	// Act
+	expression, err := parser.Parse(`"red shoes" sale`)
+
+	// Assert
+	assert.NoError(t, err)
+	assert.Equal(t, expressions.NewLogicExpression(constants.LogicAnd, []expressions.Expression{
+		expressions.NewSearchExpression([]string{"name", "description"}, "red shoes"),
+		expressions.NewSearchExpression([]string{"name", "description"}, "sale"),
+	}), expression)
+}
+
+func TestTextQueryParser_ShouldCombineWithStructuredConditions_WhenFreeTextIsMixed(t *testing.T) {
+	// Arrange
+	metadata := newFreeTextTestMetadata(constants.OperatorSearch)
+	tokenizer := tokenizers.NewTextQueryTokenizer(metadata)
+	parser := NewTextQueryParser(metadata, tokenizer)
+	validator := validators.NewTextQueryValidator(metadata, tokenizer)
+
+	// Act
+	validateErr := validator.Validate(`Name Equal Filtex Or (acme And 2024)`)
+	expression, err := parser.Parse(`Name Equal Filtex Or (acme And 2024)`)
+
+	// Assert
+	assert.NoError(t, validateErr)
+	assert.NoError(t, err)
+	assert.Equal(t, expressions.NewLogicExpression(constants.LogicOr, []expressions.Expression{
+		expressions.NewOperatorExpression(constants.FieldTypeString, "name", constants.OperatorEqual, "Filtex"),
+		expressions.NewLogicExpression(constants.LogicAnd, []expressions.Expression{
+			expressions.NewSearchExpression([]string{"name", "description"}, "acme"),
+			expressions.NewSearchExpression([]string{"name", "description"}, "2024"),
+		}),
+	}), expression)
+}
+
+func TestTextQueryParser_ShouldCombineWithStructuredConditions_WhenFreeTextComesFirst(t *testing.T) {
+	// Arrange
+	metadata := newFreeTextTestMetadata(constants.OperatorSearch)
+	tokenizer := tokenizers.NewTextQueryTokenizer(metadata)
+	parser := NewTextQueryParser(metadata, tokenizer)
+	validator := validators.NewTextQueryValidator(metadata, tokenizer)
+
+	queryMap := map[string]expressions.Expression{
+		`acme Age > 5`: expressions.NewLogicExpression(constants.LogicAnd, []expressions.Expression{
+			expressions.NewSearchExpression([]string{"name", "description"}, "acme"),
+			expressions.NewOperatorExpression(constants.FieldTypeNumber, "age", constants.OperatorGreaterThan, float64(5)),
+		}),
+		`Age > 5 acme`: expressions.NewLogicExpression(constants.LogicAnd, []expressions.Expression{
+			expressions.NewOperatorExpression(constants.FieldTypeNumber, "age", constants.OperatorGreaterThan, float64(5)),
+			expressions.NewSearchExpression([]string{"name", "description"}, "acme"),
+		}),
+		`"red shoes" Age In 1, 2`: expressions.NewLogicExpression(constants.LogicAnd, []expressions.Expression{
+			expressions.NewSearchExpression([]string{"name", "description"}, "red shoes"),
+			expressions.NewOperatorExpression(constants.FieldTypeNumber, "age", constants.OperatorIn, []interface{}{float64(1), float64(2)}),
+		}),
+	}
+
+	for query, expected := range queryMap {
+		// Act
+		validateErr := validator.Validate(query)
+		expression, err := parser.Parse(query)
+
+		// Assert
+		assert.NoError(t, validateErr, query)
+		assert.NoError(t, err, query)
+		assert.Equal(t, expected, expression, query)
+	}
+}
+
+func TestTextQueryParser_ShouldTreatLabelAsFreeText_WhenItIsNotFollowedByOperator(t *testing.T) {
+	// Arrange
+	metadata := newFreeTextTestMetadata(constants.OperatorSearch)
+	tokenizer := tokenizers.NewTextQueryTokenizer(metadata)
+	parser := NewTextQueryParser(metadata, tokenizer)
+	validator := validators.NewTextQueryValidator(metadata, tokenizer)
+
+	queryMap := map[string]expressions.Expression{
+		`Name`: expressions.NewSearchExpression([]string{"name", "description"}, "Name"),
+		`Description acme`: expressions.NewLogicExpression(constants.LogicAnd, []expressions.Expression{
+			expressions.NewSearchExpression([]string{"name", "description"}, "Description"),
+			expressions.NewSearchExpression([]string{"name", "description"}, "acme"),
+		}),
+		`acme Age`: expressions.NewLogicExpression(constants.LogicAnd, []expressions.Expression{
+			expressions.NewSearchExpression([]string{"name", "description"}, "acme"),
+			expressions.NewSearchExpression([]string{"name", "description"}, "Age"),
+		}),
+	}
+
+	for query, expected := range queryMap {
+		// Act
+		validateErr := validator.Validate(query)
+		expression, err := parser.Parse(query)
+
+		// Assert
+		assert.NoError(t, validateErr, query)
+		assert.NoError(t, err, query)
+		assert.Equal(t, expected, expression, query)
+	}
+}
+
+func TestTextQueryParser_ShouldReturnError_WhenLabelIsGivenAloneAndFreeTextIsNotEnabled(t *testing.T) {
+	// Arrange
+	metadata := newFreeTextTestMetadata(constants.OperatorSearch)
+	metadata.FreeText = nil
+	tokenizer := tokenizers.NewTextQueryTokenizer(metadata)
+
+	// Act
+	validateErr := validators.NewTextQueryValidator(metadata, tokenizer).Validate("Name")
+	_, err := NewTextQueryParser(metadata, tokenizer).Parse("Name")
+
+	// Assert
+	assert.Error(t, validateErr)
+	assert.Error(t, err)
+}
+
+func TestJsonQueryParser_ShouldNotAcceptBareWords_WhenFreeTextIsEnabled(t *testing.T) {
+	// Arrange
+	metadata := newFreeTextTestMetadata(constants.OperatorContain)
+	validator := validators.NewJsonQueryValidator(metadata, tokenizers.NewJsonQueryTokenizer(metadata))
+
+	// Act
+	err := validator.Validate(`["acme", "Equal", "x"]`)
+
+	// Assert
+	assert.Error(t, err)
+}
@@ -69,11 +69,21 @@ func (p *JsonQueryParser) parseInternal(data []interface{}) (expressions.Express
 			return nil, errors.NewOperatorCouldNotBeParsedError()
 		}
 
-		return expressions.NewOperatorExpression(
-			p.metadata.GetFieldType(fieldToken.Value.(string)),
-			p.metadata.GetFieldName(fieldToken.Value.(string)),
-			operator,
-			value), nil
+		if valueToken, ok := data[2].(models.Token); ok && valueToken.Type.IsNullTokenType() {
+			nullOperator, err := parseNullOperator(operator)
+			if err != nil {
+				return nil, err
+			}
+
+			operator = nullOperator
+			value = ""
+		}
+
+		if operator == constants.OperatorSearch {
+			return newSearchExpression(p.metadata, fieldToken.Value.(string), value)
+		}
+
+		return newOperatorExpression(p.metadata, fieldToken.Value.(string), operator, value), nil
 	}
 
 	if len(data) == 2 {
@@ -82,6 +92,15 @@ func (p *JsonQueryParser) parseInternal(data []interface{}) (expressions.Express
 			return nil, errors.NewCouldNotBeParsedError()
 		}
 
+		if logicToken.Type == constants.TokenTypeSearch {
+			valueToken, ok := data[1].(models.Token)
+			if !ok {
+				return nil, errors.NewCouldNotBeParsedError()
+			}
+
+			return newSearchExpression(p.metadata, "", valueToken.Value)
+		}
+
 		expressionList := make([]expressions.Expression, 0)
 
 		logic := constants.ParseLogic(logicToken.Value.(string))
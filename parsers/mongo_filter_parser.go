@@ -0,0 +1,526 @@
+package parsers
+
+import (
+	"context"
+	"reflect"
+	"regexp"
+	"sort"
+	"strings"
+
+	"github.com/filtex/filtex-go/constants"
+	"github.com/filtex/filtex-go/errors"
+	"github.com/filtex/filtex-go/expressions"
+	"github.com/filtex/filtex-go/models"
+	"github.com/filtex/filtex-go/queries"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+type MongoFilterParser struct {
+	metadata *models.Metadata
+	ctx      context.Context
+}
+
+var (
+	mongoComparers = map[string]constants.Operator{
+		"$eq":  constants.OperatorEqual,
+		"$ne":  constants.OperatorNotEqual,
+		"$gt":  constants.OperatorGreaterThan,
+		"$gte": constants.OperatorGreaterThanOrEqual,
+		"$lt":  constants.OperatorLessThan,
+		"$lte": constants.OperatorLessThanOrEqual,
+		"$in":  constants.OperatorIn,
+		"$nin": constants.OperatorNotIn,
+	}
+	mongoNotContainPattern = regexp.MustCompile(`^\^\(\(\?!(.*)\)\.\)\*\$$`)
+	mongoMetaChars         = `\.^$*+?()[]{}|`
+)
+
+func NewMongoFilterParser(metadata *models.Metadata) *MongoFilterParser {
+	return &MongoFilterParser{
+		metadata: metadata,
+		ctx:      context.Background(),
+	}
+}
+
+func (p *MongoFilterParser) Context(ctx context.Context) *MongoFilterParser {
+	p.ctx = ctx
+	return p
+}
+
+func (p *MongoFilterParser) Parse(filter interface{}) (expressions.Expression, error) {
+	node, err := p.parseDocument(filter)
+	if err != nil {
+		return nil, err
+	}
+
+	return buildFilterNode(queries.NewQueryBuilder(p.metadata).Context(p.ctx), node).Build()
+}
+
+func (p *MongoFilterParser) parseDocument(document interface{}) (*filterNode, error) {
+	elements, ok := mongoElements(document)
+	if !ok || len(elements) == 0 {
+		return nil, errors.NewCouldNotBeParsedError()
+	}
+
+	nodes := make([]*filterNode, 0)
+
+	for _, e := range elements {
+		var node *filterNode
+		var err error
+
+		switch e.Key {
+		case "$and":
+			node, err = p.parseLogic(constants.LogicAnd, e.Value)
+		case "$or":
+			node, err = p.parseLogic(constants.LogicOr, e.Value)
+		case "$nor":
+			node, err = p.parseLogic(constants.LogicOr, e.Value)
+			if err == nil {
+				node, err = p.negate(node)
+			}
+		default:
+			if strings.HasPrefix(e.Key, "$") {
+				return nil, errors.NewUnsupportedConstructError(e.Key)
+			}
+			node, err = p.parseField(e.Key, e.Value)
+		}
+
+		if err != nil {
+			return nil, err
+		}
+
+		nodes = append(nodes, node)
+	}
+
+	if len(nodes) == 1 {
+		return nodes[0], nil
+	}
+
+	return &filterNode{logic: constants.LogicAnd, nodes: nodes}, nil
+}
+
+func (p *MongoFilterParser) parseLogic(logic constants.Logic, value interface{}) (*filterNode, error) {
+	items, ok := mongoArray(value)
+	if !ok || len(items) == 0 {
+		return nil, errors.NewCouldNotBeParsedError()
+	}
+
+	nodes := make([]*filterNode, 0)
+
+	for _, v := range items {
+		node, err := p.parseDocument(v)
+		if err != nil {
+			return nil, err
+		}
+		nodes = append(nodes, node)
+	}
+
+	if len(nodes) == 1 {
+		return nodes[0], nil
+	}
+
+	return &filterNode{logic: logic, nodes: nodes}, nil
+}
+
+func (p *MongoFilterParser) parseField(field string, value interface{}) (*filterNode, error) {
+	if regex, ok := value.(primitive.Regex); ok {
+		return p.parseRegex(field, regex.Pattern, regex.Options)
+	}
+
+	elements, ok := mongoElements(value)
+	if !ok || len(elements) == 0 || !strings.HasPrefix(elements[0].Key, "$") {
+		return p.parseComparison(field, "$eq", value)
+	}
+
+	nodes := make([]*filterNode, 0)
+	options := ""
+
+	for _, e := range elements {
+		if e.Key == "$options" {
+			options, ok = e.Value.(string)
+			if !ok {
+				return nil, errors.NewCouldNotBeParsedError()
+			}
+		}
+	}
+
+	for _, e := range elements {
+		var node *filterNode
+		var err error
+
+		switch e.Key {
+		case "$options":
+			continue
+		case "$regex":
+			node, err = p.parseRegexValue(field, e.Value, options)
+		case "$not":
+			node, err = p.parseField(field, e.Value)
+			if err == nil {
+				node, err = p.negate(node)
+			}
+		case "$exists":
+			exists, ok := e.Value.(bool)
+			if !ok {
+				return nil, errors.NewCouldNotBeParsedError()
+			}
+
+			if exists {
+				node = &filterNode{field: field, operator: constants.OperatorIsNotNull}
+			} else {
+				node = &filterNode{field: field, operator: constants.OperatorIsNull}
+			}
+		default:
+			if !strings.HasPrefix(e.Key, "$") {
+				return nil, errors.NewCouldNotBeParsedError()
+			}
+			node, err = p.parseComparison(field, e.Key, e.Value)
+		}
+
+		if err != nil {
+			return nil, err
+		}
+
+		nodes = append(nodes, node)
+	}
+
+	if len(nodes) == 0 {
+		return nil, errors.NewCouldNotBeParsedError()
+	}
+
+	if len(nodes) == 1 {
+		return nodes[0], nil
+	}
+
+	return &filterNode{logic: constants.LogicAnd, nodes: nodes}, nil
+}
+
+func (p *MongoFilterParser) parseComparison(field string, key string, value interface{}) (*filterNode, error) {
+	operator, ok := mongoComparers[key]
+	if !ok {
+		return nil, errors.NewUnsupportedConstructError(key)
+	}
+
+	if operator != constants.OperatorIn && operator != constants.OperatorNotIn {
+		if _, ok := mongoElements(value); ok {
+			return nil, errors.NewUnsupportedConstructError(key + " document")
+		}
+
+		if _, ok := value.(primitive.Regex); ok {
+			return nil, errors.NewUnsupportedConstructError(key + " regex")
+		}
+
+		if value == nil && operator != constants.OperatorEqual && operator != constants.OperatorNotEqual {
+			return nil, errors.NewUnsupportedConstructError(key + " null")
+		}
+
+		if p.isArray(field) && value != nil {
+			if operator == constants.OperatorEqual {
+				return &filterNode{field: field, operator: constants.OperatorContain, value: mongoValue(value)}, nil
+			}
+
+			if operator == constants.OperatorNotEqual {
+				return &filterNode{field: field, operator: constants.OperatorNotContain, value: mongoValue(value)}, nil
+			}
+		}
+
+		return &filterNode{field: field, operator: operator, value: mongoValue(value)}, nil
+	}
+
+	items, ok := mongoArray(value)
+	if !ok {
+		return nil, errors.NewCouldNotBeParsedError()
+	}
+
+	if isMongoBlankArray(items) {
+		if operator == constants.OperatorIn {
+			return &filterNode{field: field, operator: constants.OperatorBlank}, nil
+		}
+		return &filterNode{field: field, operator: constants.OperatorNotBlank}, nil
+	}
+
+	values := make([]interface{}, 0)
+
+	for _, v := range items {
+		if _, ok := v.(primitive.Regex); ok {
+			return nil, errors.NewUnsupportedConstructError(key + " regex")
+		}
+
+		if v == nil {
+			return nil, errors.NewUnsupportedConstructError(key + " null")
+		}
+
+		values = append(values, mongoValue(v))
+	}
+
+	if p.isArray(field) {
+		return newArrayFilterNode(field, operator, values), nil
+	}
+
+	return &filterNode{field: field, operator: operator, value: values}, nil
+}
+
+func (p *MongoFilterParser) negate(node *filterNode) (*filterNode, error) {
+	negated, err := negateFilterNode(node)
+	if err != nil {
+		return nil, err
+	}
+
+	return p.withNulls(negated), nil
+}
+
+func (p *MongoFilterParser) withNulls(node *filterNode) *filterNode {
+	if node.logic != constants.LogicUnknown {
+		for i, v := range node.nodes {
+			node.nodes[i] = p.withNulls(v)
+		}
+
+		return node
+	}
+
+	switch node.operator {
+	case constants.OperatorGreaterThan,
+		constants.OperatorGreaterThanOrEqual,
+		constants.OperatorLessThan,
+		constants.OperatorLessThanOrEqual:
+		if p.isNullable(node.field) {
+			return &filterNode{
+				logic: constants.LogicOr,
+				nodes: []*filterNode{
+					node,
+					{field: node.field, operator: constants.OperatorIsNull},
+				},
+			}
+		}
+	}
+
+	return node
+}
+
+func (p *MongoFilterParser) isArray(field string) bool {
+	return p.metadata != nil && p.metadata.GetFieldType(field).IsArray()
+}
+
+func (p *MongoFilterParser) isNullable(field string) bool {
+	if p.metadata == nil {
+		return false
+	}
+
+	item := p.metadata.GetField(field)
+	if item == nil {
+		return false
+	}
+
+	for _, v := range item.Operators {
+		if constants.OperatorIsNull.Equals(v) {
+			return true
+		}
+	}
+
+	return false
+}
+
+func newArrayFilterNode(field string, operator constants.Operator, values []interface{}) *filterNode {
+	itemOperator := constants.OperatorContain
+	logic := constants.LogicOr
+
+	if operator == constants.OperatorNotIn {
+		itemOperator = constants.OperatorNotContain
+		logic = constants.LogicAnd
+	}
+
+	nodes := make([]*filterNode, 0)
+
+	for _, v := range values {
+		nodes = append(nodes, &filterNode{field: field, operator: itemOperator, value: v})
+	}
+
+	if len(nodes) == 1 {
+		return nodes[0]
+	}
+
+	return &filterNode{logic: logic, nodes: nodes}
+}
+
+func (p *MongoFilterParser) parseRegexValue(field string, value interface{}, options string) (*filterNode, error) {
+	switch v := value.(type) {
+	case string:
+		return p.parseRegex(field, v, options)
+	case primitive.Regex:
+		if options == "" {
+			options = v.Options
+		}
+		return p.parseRegex(field, v.Pattern, options)
+	}
+
+	return nil, errors.NewCouldNotBeParsedError()
+}
+
+func (p *MongoFilterParser) parseRegex(field string, pattern string, options string) (*filterNode, error) {
+	if strings.Trim(options, "i") != "" {
+		return nil, errors.NewUnsupportedConstructError("$options " + options)
+	}
+
+	if match := mongoNotContainPattern.FindStringSubmatch(pattern); match != nil {
+		value, ok := unescapeMongoPattern(match[1])
+		if !ok || value == "" {
+			return nil, errors.NewUnsupportedConstructError("$regex " + pattern)
+		}
+
+		return &filterNode{field: field, operator: constants.OperatorNotContain, value: value, collation: mongoRegexCollation(options)}, nil
+	}
+
+	body := pattern
+	hasPrefix := strings.HasPrefix(body, "^")
+
+	if hasPrefix {
+		body = body[1:]
+	}
+
+	hasSuffix := strings.HasSuffix(body, "$") && !strings.HasSuffix(body, `\$`)
+
+	if hasSuffix {
+		body = body[:len(body)-1]
+	}
+
+	value, ok := unescapeMongoPattern(body)
+	if !ok || value == "" {
+		return nil, errors.NewUnsupportedConstructError("$regex " + pattern)
+	}
+
+	operator := constants.OperatorContain
+
+	if hasPrefix && hasSuffix {
+		operator = constants.OperatorEqual
+	} else if hasPrefix {
+		operator = constants.OperatorStartWith
+	} else if hasSuffix {
+		operator = constants.OperatorEndWith
+	}
+
+	return &filterNode{field: field, operator: operator, value: value, collation: mongoRegexCollation(options)}, nil
+}
+
+func mongoRegexCollation(options string) constants.Collation {
+	if strings.Contains(options, "i") {
+		return constants.CollationCaseInsensitive
+	}
+
+	return constants.CollationCaseSensitive
+}
+
+func unescapeMongoPattern(pattern string) (string, bool) {
+	var sb strings.Builder
+
+	runes := []rune(pattern)
+
+	for i := 0; i < len(runes); i++ {
+		if runes[i] == '\\' {
+			if i+1 >= len(runes) || isMongoEscapeClass(runes[i+1]) {
+				return "", false
+			}
+			sb.WriteRune(runes[i+1])
+			i++
+			continue
+		}
+
+		if strings.ContainsRune(mongoMetaChars, runes[i]) {
+			return "", false
+		}
+
+		sb.WriteRune(runes[i])
+	}
+
+	return sb.String(), true
+}
+
+func isMongoEscapeClass(r rune) bool {
+	return (r >= 'a' && r <= 'z') || (r >= 'A' && r <= 'Z') || (r >= '0' && r <= '9')
+}
+
+func isMongoBlankArray(items []interface{}) bool {
+	if len(items) != 2 {
+		return false
+	}
+
+	hasNull := false
+	hasEmpty := false
+
+	for _, v := range items {
+		if v == nil {
+			hasNull = true
+		} else if s, ok := v.(string); ok && s == "" {
+			hasEmpty = true
+		}
+	}
+
+	return hasNull && hasEmpty
+}
+
+func mongoElements(document interface{}) ([]bson.E, bool) {
+	switch v := document.(type) {
+	case bson.D:
+		return v, true
+	case bson.M:
+		return sortedMongoElements(v), true
+	case map[string]interface{}:
+		return sortedMongoElements(v), true
+	}
+
+	return nil, false
+}
+
+func sortedMongoElements(document map[string]interface{}) []bson.E {
+	keys := make([]string, 0)
+
+	for k := range document {
+		keys = append(keys, k)
+	}
+
+	sort.Strings(keys)
+
+	result := make([]bson.E, 0)
+
+	for _, k := range keys {
+		result = append(result, bson.E{Key: k, Value: document[k]})
+	}
+
+	return result
+}
+
+func mongoArray(value interface{}) ([]interface{}, bool) {
+	switch v := value.(type) {
+	case bson.A:
+		return v, true
+	case []interface{}:
+		return v, true
+	case nil, []byte, string, bson.D:
+		return nil, false
+	}
+
+	rv := reflect.ValueOf(value)
+	if rv.Kind() != reflect.Slice && rv.Kind() != reflect.Array {
+		return nil, false
+	}
+
+	result := make([]interface{}, 0)
+
+	for i := 0; i < rv.Len(); i++ {
+		result = append(result, rv.Index(i).Interface())
+	}
+
+	return result, true
+}
+
+func mongoValue(value interface{}) interface{} {
+	switch v := value.(type) {
+	case primitive.DateTime:
+		return v.Time().UTC()
+	case primitive.Decimal128:
+		return v.String()
+	case primitive.ObjectID:
+		return v.Hex()
+	}
+
+	return value
+}
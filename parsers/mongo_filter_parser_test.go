@@ -0,0 +1,256 @@
+package parsers
+
+import (
+	goErrors "errors"
+	"testing"
+	"time"
+
+	"github.com/filtex/filtex-go/builders/memory"
+	"github.com/filtex/filtex-go/constants"
+	"github.com/filtex/filtex-go/errors"
+	"github.com/filtex/filtex-go/expressions"
+	"github.com/stretchr/testify/assert"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+func TestMongoFilterParser_Parse_ShouldReturnOperatorExpression_WhenFilterHasSingleCondition(t *testing.T) {
+	// Arrange
+	createdAt := time.Date(2024, 1, 2, 3, 4, 5, 0, time.UTC)
+	samples := []struct {
+		filter   interface{}
+		expected expressions.Expression
+	}{
+		{bson.M{"status": "active"}, expressions.NewOperatorExpression(constants.FieldTypeString, "status", constants.OperatorEqual, "active")},
+		{bson.M{"Status": bson.M{"$eq": "Passive"}}, expressions.NewOperatorExpression(constants.FieldTypeString, "status", constants.OperatorEqual, "passive")},
+		{bson.M{"status": bson.M{"$ne": "active"}}, expressions.NewOperatorExpression(constants.FieldTypeString, "status", constants.OperatorNotEqual, "active")},
+		{bson.M{"age": bson.M{"$gt": int32(18)}}, expressions.NewOperatorExpression(constants.FieldTypeNumber, "age", constants.OperatorGreaterThan, 18.0)},
+		{bson.M{"age": bson.M{"$gte": 18.5}}, expressions.NewOperatorExpression(constants.FieldTypeNumber, "age", constants.OperatorGreaterThanOrEqual, 18.5)},
+		{bson.M{"age": bson.M{"$lt": int64(65)}}, expressions.NewOperatorExpression(constants.FieldTypeNumber, "age", constants.OperatorLessThan, 65.0)},
+		{bson.M{"age": bson.M{"$lte": 65}}, expressions.NewOperatorExpression(constants.FieldTypeNumber, "age", constants.OperatorLessThanOrEqual, 65.0)},
+		{bson.M{"status": bson.M{"$in": bson.A{"active", "Passive"}}}, expressions.NewOperatorExpression(constants.FieldTypeString, "status", constants.OperatorIn, []interface{}{"active", "passive"})},
+		{bson.M{"status": bson.M{"$nin": []string{"active"}}}, expressions.NewOperatorExpression(constants.FieldTypeString, "status", constants.OperatorNotIn, []interface{}{"active"})},
+		{bson.M{"name": nil}, expressions.NewOperatorExpression(constants.FieldTypeString, "name", constants.OperatorIsNull, "")},
+		{bson.M{"name": bson.M{"$ne": nil}}, expressions.NewOperatorExpression(constants.FieldTypeString, "name", constants.OperatorIsNotNull, "")},
+		{bson.M{"name": bson.M{"$exists": false}}, expressions.NewOperatorExpression(constants.FieldTypeString, "name", constants.OperatorIsNull, "")},
+		{bson.M{"name": bson.M{"$in": bson.A{nil, ""}}}, expressions.NewOperatorExpression(constants.FieldTypeString, "name", constants.OperatorBlank, "")},
+		{bson.M{"name": bson.M{"$nin": bson.A{"", nil}}}, expressions.NewOperatorExpression(constants.FieldTypeString, "name", constants.OperatorNotBlank, "")},
+		{bson.M{"name": bson.M{"$regex": "fil"}}, expressions.NewCollatedOperatorExpression(constants.FieldTypeString, "name", constants.OperatorContain, "fil", constants.CollationCaseSensitive)},
+		{bson.M{"name": bson.M{"$regex": "^fil", "$options": "i"}}, expressions.NewCollatedOperatorExpression(constants.FieldTypeString, "name", constants.OperatorStartWith, "fil", constants.CollationCaseInsensitive)},
+		{bson.M{"name": bson.M{"$regex": "^ab", "$options": ""}}, expressions.NewCollatedOperatorExpression(constants.FieldTypeString, "name", constants.OperatorStartWith, "ab", constants.CollationCaseSensitive)},
+		{bson.M{"name": primitive.Regex{Pattern: `tex\.io$`, Options: "i"}}, expressions.NewCollatedOperatorExpression(constants.FieldTypeString, "name", constants.OperatorEndWith, "tex.io", constants.CollationCaseInsensitive)},
+		{bson.M{"name": bson.M{"$regex": primitive.Regex{Pattern: "^filtex$"}}}, expressions.NewCollatedOperatorExpression(constants.FieldTypeString, "name", constants.OperatorEqual, "filtex", constants.CollationCaseSensitive)},
+		{bson.M{"name": primitive.Regex{Pattern: "^((?!fil).)*$", Options: "i"}}, expressions.NewCollatedOperatorExpression(constants.FieldTypeString, "name", constants.OperatorNotContain, "fil", constants.CollationCaseInsensitive)},
+		{bson.M{"name": bson.M{"$not": primitive.Regex{Pattern: "^fil"}}}, expressions.NewCollatedOperatorExpression(constants.FieldTypeString, "name", constants.OperatorNotStartWith, "fil", constants.CollationCaseSensitive)},
+		{bson.M{"age": bson.M{"$not": bson.M{"$gt": 18}}}, expressions.NewOperatorExpression(constants.FieldTypeNumber, "age", constants.OperatorLessThanOrEqual, 18.0)},
+		{bson.M{"tags": "go"}, expressions.NewOperatorExpression(constants.FieldTypeStringArray, "tags", constants.OperatorContain, "go")},
+		{bson.M{"tags": bson.M{"$ne": "go"}}, expressions.NewOperatorExpression(constants.FieldTypeStringArray, "tags", constants.OperatorNotContain, "go")},
+		{bson.M{"tags": bson.M{"$in": bson.A{"go"}}}, expressions.NewOperatorExpression(constants.FieldTypeStringArray, "tags", constants.OperatorContain, "go")},
+		{bson.M{"tags": bson.M{"$in": bson.A{nil, ""}}}, expressions.NewOperatorExpression(constants.FieldTypeStringArray, "tags", constants.OperatorBlank, "")},
+		{bson.M{"createdAt": bson.M{"$gte": primitive.NewDateTimeFromTime(createdAt)}}, expressions.NewOperatorExpression(constants.FieldTypeDateTime, "createdAt", constants.OperatorGreaterThanOrEqual, &createdAt)},
+		{map[string]interface{}{"enabled": true}, expressions.NewOperatorExpression(constants.FieldTypeBoolean, "enabled", constants.OperatorEqual, true)},
+	}
+
+	for _, v := range samples {
+		// Act
+		result, err := NewMongoFilterParser(newImportTestMetadata()).Parse(v.filter)
+
+		// Assert
+		assert.NoError(t, err, v.filter)
+		assert.Equal(t, v.expected, result, v.filter)
+	}
+}
+
+func TestMongoFilterParser_Parse_ShouldReturnLogicExpression_WhenFilterHasLogic(t *testing.T) {
+	// Arrange
+	samples := []struct {
+		filter   interface{}
+		expected expressions.Expression
+	}{
+		{
+			bson.D{
+				{Key: "status", Value: "active"},
+				{Key: "$or", Value: bson.A{
+					bson.M{"age": bson.M{"$gt": 18}},
+					bson.D{{Key: "name", Value: bson.M{"$regex": "^fil", "$options": "i"}}},
+				}},
+			},
+			expressions.NewLogicExpression(constants.LogicAnd, []expressions.Expression{
+				expressions.NewOperatorExpression(constants.FieldTypeString, "status", constants.OperatorEqual, "active"),
+				expressions.NewLogicExpression(constants.LogicOr, []expressions.Expression{
+					expressions.NewOperatorExpression(constants.FieldTypeNumber, "age", constants.OperatorGreaterThan, 18.0),
+					expressions.NewCollatedOperatorExpression(constants.FieldTypeString, "name", constants.OperatorStartWith, "fil", constants.CollationCaseInsensitive),
+				}),
+			}),
+		},
+		{
+			bson.M{"age": bson.M{"$gte": 18, "$lt": 65}},
+			expressions.NewLogicExpression(constants.LogicAnd, []expressions.Expression{
+				expressions.NewOperatorExpression(constants.FieldTypeNumber, "age", constants.OperatorGreaterThanOrEqual, 18.0),
+				expressions.NewOperatorExpression(constants.FieldTypeNumber, "age", constants.OperatorLessThan, 65.0),
+			}),
+		},
+		{
+			bson.M{"$and": []bson.M{{"age": bson.M{"$gte": 18}}, {"enabled": true}}},
+			expressions.NewLogicExpression(constants.LogicAnd, []expressions.Expression{
+				expressions.NewOperatorExpression(constants.FieldTypeNumber, "age", constants.OperatorGreaterThanOrEqual, 18.0),
+				expressions.NewOperatorExpression(constants.FieldTypeBoolean, "enabled", constants.OperatorEqual, true),
+			}),
+		},
+		{
+			bson.M{"tags": bson.M{"$in": bson.A{"go", "rust"}}},
+			expressions.NewLogicExpression(constants.LogicOr, []expressions.Expression{
+				expressions.NewOperatorExpression(constants.FieldTypeStringArray, "tags", constants.OperatorContain, "go"),
+				expressions.NewOperatorExpression(constants.FieldTypeStringArray, "tags", constants.OperatorContain, "rust"),
+			}),
+		},
+		{
+			bson.M{"tags": bson.M{"$nin": bson.A{"go", "rust"}}},
+			expressions.NewLogicExpression(constants.LogicAnd, []expressions.Expression{
+				expressions.NewOperatorExpression(constants.FieldTypeStringArray, "tags", constants.OperatorNotContain, "go"),
+				expressions.NewOperatorExpression(constants.FieldTypeStringArray, "tags", constants.OperatorNotContain, "rust"),
+			}),
+		},
+		{
+			bson.M{"score": bson.M{"$not": bson.M{"$gt": 5}}},
+			expressions.NewLogicExpression(constants.LogicOr, []expressions.Expression{
+				expressions.NewOperatorExpression(constants.FieldTypeNumber, "score", constants.OperatorLessThanOrEqual, 5.0),
+				expressions.NewOperatorExpression(constants.FieldTypeNumber, "score", constants.OperatorIsNull, ""),
+			}),
+		},
+		{
+			bson.M{"$nor": bson.A{bson.M{"score": bson.M{"$lte": 5}}, bson.M{"name": nil}}},
+			expressions.NewLogicExpression(constants.LogicAnd, []expressions.Expression{
+				expressions.NewLogicExpression(constants.LogicOr, []expressions.Expression{
+					expressions.NewOperatorExpression(constants.FieldTypeNumber, "score", constants.OperatorGreaterThan, 5.0),
+					expressions.NewOperatorExpression(constants.FieldTypeNumber, "score", constants.OperatorIsNull, ""),
+				}),
+				expressions.NewOperatorExpression(constants.FieldTypeString, "name", constants.OperatorIsNotNull, ""),
+			}),
+		},
+		{
+			bson.M{"$nor": bson.A{bson.M{"age": bson.M{"$gte": 18}}, bson.M{"name": nil}}},
+			expressions.NewLogicExpression(constants.LogicAnd, []expressions.Expression{
+				expressions.NewOperatorExpression(constants.FieldTypeNumber, "age", constants.OperatorLessThan, 18.0),
+				expressions.NewOperatorExpression(constants.FieldTypeString, "name", constants.OperatorIsNotNull, ""),
+			}),
+		},
+	}
+
+	for _, v := range samples {
+		// Act
+		result, err := NewMongoFilterParser(newImportTestMetadata()).Parse(v.filter)
+
+		// Assert
+		assert.NoError(t, err, v.filter)
+		assert.Equal(t, v.expected, result, v.filter)
+	}
+}
+
+func TestMongoFilterParser_Parse_ShouldMatchNullValues_WhenComparisonIsNegated(t *testing.T) {
+	// Arrange
+	samples := []struct {
+		filter   interface{}
+		expected expressions.Expression
+	}{
+		{bson.M{"score": bson.M{"$ne": 5}}, expressions.NewOperatorExpression(constants.FieldTypeNumber, "score", constants.OperatorNotEqual, 5.0)},
+		{bson.M{"score": bson.M{"$nin": bson.A{5}}}, expressions.NewOperatorExpression(constants.FieldTypeNumber, "score", constants.OperatorNotIn, []interface{}{5.0})},
+		{bson.M{"score": bson.M{"$not": bson.M{"$eq": 5}}}, expressions.NewOperatorExpression(constants.FieldTypeNumber, "score", constants.OperatorNotEqual, 5.0)},
+	}
+
+	for _, v := range samples {
+		// Act
+		result, err := NewMongoFilterParser(newImportTestMetadata()).Parse(v.filter)
+		expression, buildErr := memory.NewMemoryFilterBuilder().Build(result)
+
+		// Assert
+		assert.NoError(t, err, v.filter)
+		assert.NoError(t, buildErr, v.filter)
+		assert.Equal(t, v.expected, result, v.filter)
+		assert.True(t, expression.Fn(map[string]interface{}{"score": nil}), v.filter)
+		assert.True(t, expression.Fn(map[string]interface{}{}), v.filter)
+		assert.False(t, expression.Fn(map[string]interface{}{"score": 5.0}), v.filter)
+	}
+}
+
+func TestMongoFilterParser_Parse_ShouldReturnError_WhenFilterIsNotValid(t *testing.T) {
+	// Arrange
+	samples := []interface{}{
+		nil,
+		"status",
+		bson.M{},
+		bson.M{"$and": bson.A{}},
+		bson.M{"$or": bson.M{"age": 1}},
+		bson.M{"$and": bson.A{"age"}},
+		bson.M{"status": bson.M{"$in": "active"}},
+		bson.M{"name": bson.M{"$exists": 1}},
+		bson.M{"name": bson.M{"$regex": 1}},
+		bson.M{"name": bson.M{"$regex": "fil", "$options": 1}},
+		bson.D{{Key: "age", Value: bson.D{{Key: "$gt", Value: 1}, {Key: "other", Value: 2}}}},
+	}
+
+	for _, filter := range samples {
+		// Act
+		result, err := NewMongoFilterParser(newImportTestMetadata()).Parse(filter)
+
+		// Assert
+		assert.Nil(t, result, filter)
+		assert.Error(t, err, filter)
+	}
+}
+
+func TestMongoFilterParser_Parse_ShouldReturnImportError_WhenConstructIsNotSupported(t *testing.T) {
+	// Arrange
+	samples := []struct {
+		filter    interface{}
+		construct string
+	}{
+		{bson.M{"$where": "this.age > 18"}, "$where"},
+		{bson.M{"$text": bson.M{"$search": "fil"}}, "$text"},
+		{bson.M{"tags": bson.M{"$elemMatch": bson.M{"$eq": "a"}}}, "$elemMatch"},
+		{bson.M{"age": bson.M{"$gt": bson.M{"value": 1}}}, "$gt document"},
+		{bson.M{"age": bson.M{"$gt": nil}}, "$gt null"},
+		{bson.M{"status": bson.M{"$in": bson.A{"active", nil}}}, "$in null"},
+		{bson.M{"status": bson.M{"$in": bson.A{primitive.Regex{Pattern: "^a"}}}}, "$in regex"},
+		{bson.M{"name": bson.M{"$regex": "fil", "$options": "m"}}, "$options m"},
+		{bson.M{"name": bson.M{"$regex": "f.l"}}, "$regex f.l"},
+		{bson.M{"name": bson.M{"$regex": `\d+`}}, `$regex \d+`},
+		{bson.M{"name": bson.M{"$regex": "^$"}}, "$regex ^$"},
+	}
+
+	for _, v := range samples {
+		// Act
+		result, err := NewMongoFilterParser(newImportTestMetadata()).Parse(v.filter)
+
+		// Assert
+		var importError *errors.ImportError
+		assert.Nil(t, result, v.filter)
+		assert.True(t, goErrors.As(err, &importError), v.filter)
+		assert.Equal(t, v.construct, importError.Construct, v.filter)
+	}
+}
+
+func TestMongoFilterParser_Parse_ShouldReturnQueryError_WhenFieldOrValueIsNotValid(t *testing.T) {
+	// Arrange
+	samples := []struct {
+		filter interface{}
+		field  string
+	}{
+		{bson.M{"unknown": 1}, "unknown"},
+		{bson.M{"age": bson.M{"$gt": "abc"}}, "age"},
+		{bson.M{"status": "deleted"}, "status"},
+		{bson.M{"age": bson.M{"$regex": "1"}}, "age"},
+		{bson.M{"enabled": bson.M{"$not": bson.M{"$eq": true}}}, "enabled"},
+	}
+
+	for _, v := range samples {
+		// Act
+		result, err := NewMongoFilterParser(newImportTestMetadata()).Parse(v.filter)
+
+		// Assert
+		var queryError *errors.QueryError
+		assert.Nil(t, result, v.filter)
+		assert.True(t, goErrors.As(err, &queryError), v.filter)
+		assert.Equal(t, v.field, queryError.Field, v.filter)
+	}
+}
@@ -0,0 +1,17 @@
+package parsers
+
+import (
+	"github.com/filtex/filtex-go/constants"
+	"github.com/filtex/filtex-go/errors"
+)
+
+func parseNullOperator(operator constants.Operator) (constants.Operator, error) {
+	switch operator {
+	case constants.OperatorEqual:
+		return constants.OperatorIsNull, nil
+	case constants.OperatorNotEqual:
+		return constants.OperatorIsNotNull, nil
+	}
+
+	return constants.OperatorUnknown, errors.NewOperatorCouldNotBeParsedError()
+}
@@ -0,0 +1,137 @@
+package parsers
+
+import (
+	"testing"
+
+	"github.com/filtex/filtex-go/constants"
+	"github.com/filtex/filtex-go/expressions"
+	"github.com/filtex/filtex-go/models"
+	"github.com/filtex/filtex-go/tokenizers"
+	"github.com/filtex/filtex-go/validators"
+	"github.com/stretchr/testify/assert"
+)
+
+func newNullTestMetadata() *models.Metadata {
+	return &models.Metadata{
+		Fields: []models.Field{
+			{
+				Name:  "age",
+				Type:  constants.FieldTypeNumber.String(),
+				Label: "Age",
+				Operators: []string{
+					constants.OperatorEqual.String(),
+					constants.OperatorNotEqual.String(),
+					constants.OperatorBlank.String(),
+					constants.OperatorNotBlank.String(),
+					constants.OperatorIsNull.String(),
+					constants.OperatorIsNotNull.String(),
+				},
+			},
+			{
+				Name:  "name",
+				Type:  constants.FieldTypeString.String(),
+				Label: "Name",
+				Operators: []string{
+					constants.OperatorEqual.String(),
+				},
+			},
+		},
+	}
+}
+
+func TestParseNullOperator_ShouldReturnError_WhenOperatorIsNotEquality(t *testing.T) {
+	// Arrange
+	// Act
+	operator, err := parseNullOperator(constants.OperatorGreaterThan)
+
+	// Assert
+	assert.Equal(t, constants.OperatorUnknown, operator)
+	assert.Error(t, err)
+}
+
+func TestTextQueryParser_ShouldReturnNullExpressions_WhenQueryHasNull(t *testing.T) {
+	// Arrange
+	metadata := newNullTestMetadata()
+	tokenizer := tokenizers.NewTextQueryTokenizer(metadata)
+	parser := NewTextQueryParser(metadata, tokenizer)
+	validator := validators.NewTextQueryValidator(metadata, tokenizer)
+	samples := map[string]expressions.Expression{
+		"Age = null":      expressions.NewOperatorExpression(constants.FieldTypeNumber, "age", constants.OperatorIsNull, ""),
+		"Age != null":     expressions.NewOperatorExpression(constants.FieldTypeNumber, "age", constants.OperatorIsNotNull, ""),
+		"Age is null":     expressions.NewOperatorExpression(constants.FieldTypeNumber, "age", constants.OperatorIsNull, ""),
+		"Age is not null": expressions.NewOperatorExpression(constants.FieldTypeNumber, "age", constants.OperatorIsNotNull, ""),
+		"Name = null":     expressions.NewOperatorExpression(constants.FieldTypeString, "name", constants.OperatorEqual, "null"),
+		"Name = \"null\"": expressions.NewOperatorExpression(constants.FieldTypeString, "name", constants.OperatorEqual, "null"),
+		"Age is null and Age blank": expressions.NewLogicExpression(constants.LogicAnd, []expressions.Expression{
+			expressions.NewOperatorExpression(constants.FieldTypeNumber, "age", constants.OperatorIsNull, ""),
+			expressions.NewOperatorExpression(constants.FieldTypeNumber, "age", constants.OperatorBlank, ""),
+		}),
+	}
+
+	for k, v := range samples {
+		// Act
+		err := validator.Validate(k)
+		expression, parseErr := parser.Parse(k)
+
+		// Assert
+		assert.NoError(t, err, k)
+		assert.NoError(t, parseErr, k)
+		assert.Equal(t, v, expression, k)
+	}
+}
+
+func TestTextQueryParser_ShouldReturnError_WhenNullIsUsedWithOtherOperator(t *testing.T) {
+	// Arrange
+	metadata := newNullTestMetadata()
+	validator := validators.NewTextQueryValidator(metadata, tokenizers.NewTextQueryTokenizer(metadata))
+	samples := []string{
+		"Age is null 5",
+		"Name is null",
+	}
+
+	for _, v := range samples {
+		// Act
+		err := validator.Validate(v)
+
+		// Assert
+		assert.Error(t, err, v)
+	}
+}
+
+func TestJsonQueryParser_ShouldReturnNullExpressions_WhenQueryHasNull(t *testing.T) {
+	// Arrange
+	metadata := newNullTestMetadata()
+	tokenizer := tokenizers.NewJsonQueryTokenizer(metadata)
+	parser := NewJsonQueryParser(metadata, tokenizer)
+	validator := validators.NewJsonQueryValidator(metadata, tokenizer)
+	samples := map[string]expressions.Expression{
+		`["Age", "Equal", null]`:       expressions.NewOperatorExpression(constants.FieldTypeNumber, "age", constants.OperatorIsNull, ""),
+		`["Age", "Not Equal", null]`:   expressions.NewOperatorExpression(constants.FieldTypeNumber, "age", constants.OperatorIsNotNull, ""),
+		`["Age", "Is Null", ""]`:       expressions.NewOperatorExpression(constants.FieldTypeNumber, "age", constants.OperatorIsNull, ""),
+		`["Age", "Is Not Null", null]`: expressions.NewOperatorExpression(constants.FieldTypeNumber, "age", constants.OperatorIsNotNull, ""),
+		`["Name", "Equal", "null"]`:    expressions.NewOperatorExpression(constants.FieldTypeString, "name", constants.OperatorEqual, "null"),
+	}
+
+	for k, v := range samples {
+		// Act
+		err := validator.Validate(k)
+		expression, parseErr := parser.Parse(k)
+
+		// Assert
+		assert.NoError(t, err, k)
+		assert.NoError(t, parseErr, k)
+		assert.Equal(t, v, expression, k)
+	}
+}
+
+func TestJsonQueryValidator_ShouldReturnError_WhenNullIsNotAllowed(t *testing.T) {
+	// Arrange
+	metadata := newNullTestMetadata()
+	validator := validators.NewJsonQueryValidator(metadata, tokenizers.NewJsonQueryTokenizer(metadata))
+
+	// Act
+	err := validator.Validate(`["Name", "Equal", null]`)
+
+	// Assert
+	assert.Error(t, err)
+}
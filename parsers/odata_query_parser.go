@@ -0,0 +1,302 @@
+package parsers
+
+import (
+	"context"
+	"strings"
+	"unicode"
+
+	"github.com/filtex/filtex-go/constants"
+	"github.com/filtex/filtex-go/errors"
+	"github.com/filtex/filtex-go/expressions"
+	"github.com/filtex/filtex-go/models"
+	"github.com/filtex/filtex-go/queries"
+)
+
+type ODataQueryParser struct {
+	metadata *models.Metadata
+	ctx      context.Context
+}
+
+type odataToken struct {
+	value    string
+	isString bool
+}
+
+var (
+	odataComparers = map[string]constants.Operator{
+		"eq": constants.OperatorEqual,
+		"ne": constants.OperatorNotEqual,
+		"gt": constants.OperatorGreaterThan,
+		"ge": constants.OperatorGreaterThanOrEqual,
+		"lt": constants.OperatorLessThan,
+		"le": constants.OperatorLessThanOrEqual,
+	}
+	odataFunctions = map[string]constants.Operator{
+		"contains":   constants.OperatorContain,
+		"startswith": constants.OperatorStartWith,
+		"endswith":   constants.OperatorEndWith,
+	}
+)
+
+func NewODataQueryParser(metadata *models.Metadata) *ODataQueryParser {
+	return &ODataQueryParser{
+		metadata: metadata,
+		ctx:      context.Background(),
+	}
+}
+
+func (p *ODataQueryParser) Context(ctx context.Context) *ODataQueryParser {
+	p.ctx = ctx
+	return p
+}
+
+func (p *ODataQueryParser) Parse(query string) (expressions.Expression, error) {
+	tokens, err := p.tokenize(query)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(tokens) == 0 {
+		return nil, errors.NewCouldNotBeParsedError()
+	}
+
+	node, err := p.parseOr(&tokens)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(tokens) > 0 {
+		return nil, errors.NewCouldNotBeParsedError()
+	}
+
+	return buildFilterNode(queries.NewQueryBuilder(p.metadata).Context(p.ctx), node).Build()
+}
+
+func (p *ODataQueryParser) tokenize(query string) ([]odataToken, error) {
+	tokens := make([]odataToken, 0)
+	runes := []rune(query)
+
+	for i := 0; i < len(runes); {
+		r := runes[i]
+
+		switch {
+		case unicode.IsSpace(r):
+			i++
+		case r == '(' || r == ')' || r == ',':
+			tokens = append(tokens, odataToken{value: string(r)})
+			i++
+		case r == '\'':
+			var sb strings.Builder
+			closed := false
+			i++
+
+			for i < len(runes) {
+				if runes[i] == '\'' {
+					if i+1 < len(runes) && runes[i+1] == '\'' {
+						sb.WriteRune('\'')
+						i += 2
+						continue
+					}
+					closed = true
+					i++
+					break
+				}
+				sb.WriteRune(runes[i])
+				i++
+			}
+
+			if !closed {
+				return nil, errors.NewCouldNotBeParsedError()
+			}
+
+			tokens = append(tokens, odataToken{value: sb.String(), isString: true})
+		default:
+			start := i
+
+			for i < len(runes) && !unicode.IsSpace(runes[i]) && !strings.ContainsRune("(),'", runes[i]) {
+				i++
+			}
+
+			tokens = append(tokens, odataToken{value: string(runes[start:i])})
+		}
+	}
+
+	return tokens, nil
+}
+
+func (p *ODataQueryParser) parseOr(tokens *[]odataToken) (*filterNode, error) {
+	return p.parseLogic(tokens, constants.LogicOr, "or", p.parseAnd)
+}
+
+func (p *ODataQueryParser) parseAnd(tokens *[]odataToken) (*filterNode, error) {
+	return p.parseLogic(tokens, constants.LogicAnd, "and", p.parseUnary)
+}
+
+func (p *ODataQueryParser) parseLogic(tokens *[]odataToken, logic constants.Logic, keyword string, next func(*[]odataToken) (*filterNode, error)) (*filterNode, error) {
+	node, err := next(tokens)
+	if err != nil {
+		return nil, err
+	}
+
+	nodes := []*filterNode{node}
+
+	for p.acceptKeyword(tokens, keyword) {
+		node, err = next(tokens)
+		if err != nil {
+			return nil, err
+		}
+		nodes = append(nodes, node)
+	}
+
+	if len(nodes) == 1 {
+		return nodes[0], nil
+	}
+
+	return &filterNode{logic: logic, nodes: nodes}, nil
+}
+
+func (p *ODataQueryParser) parseUnary(tokens *[]odataToken) (*filterNode, error) {
+	if p.acceptKeyword(tokens, "not") {
+		node, err := p.parseUnary(tokens)
+		if err != nil {
+			return nil, err
+		}
+
+		return negateFilterNode(node)
+	}
+
+	if p.accept(tokens, "(") {
+		node, err := p.parseOr(tokens)
+		if err != nil {
+			return nil, err
+		}
+
+		if !p.accept(tokens, ")") {
+			return nil, errors.NewCouldNotBeParsedError()
+		}
+
+		return node, nil
+	}
+
+	if len(*tokens) > 1 && !(*tokens)[0].isString && (*tokens)[1].value == "(" {
+		if operator, ok := odataFunctions[strings.ToLower((*tokens)[0].value)]; ok {
+			return p.parseFunction(tokens, operator)
+		}
+	}
+
+	return p.parseComparison(tokens)
+}
+
+func (p *ODataQueryParser) parseFunction(tokens *[]odataToken, operator constants.Operator) (*filterNode, error) {
+	*tokens = (*tokens)[2:]
+
+	field, ok := p.next(tokens)
+	if !ok || field.isString || !p.accept(tokens, ",") {
+		return nil, errors.NewCouldNotBeParsedError()
+	}
+
+	value, ok := p.next(tokens)
+	if !ok || !p.accept(tokens, ")") {
+		return nil, errors.NewCouldNotBeParsedError()
+	}
+
+	return &filterNode{field: field.value, operator: operator, value: p.parseValue(value)}, nil
+}
+
+func (p *ODataQueryParser) parseComparison(tokens *[]odataToken) (*filterNode, error) {
+	field, ok := p.next(tokens)
+	if !ok || field.isString {
+		return nil, errors.NewCouldNotBeParsedError()
+	}
+
+	if p.acceptKeyword(tokens, "in") {
+		if !p.accept(tokens, "(") {
+			return nil, errors.NewCouldNotBeParsedError()
+		}
+
+		values := make([]interface{}, 0)
+
+		for {
+			value, ok := p.next(tokens)
+			if !ok {
+				return nil, errors.NewCouldNotBeParsedError()
+			}
+			values = append(values, p.parseValue(value))
+
+			if p.accept(tokens, ")") {
+				break
+			}
+
+			if !p.accept(tokens, ",") {
+				return nil, errors.NewCouldNotBeParsedError()
+			}
+		}
+
+		return &filterNode{field: field.value, operator: constants.OperatorIn, value: values}, nil
+	}
+
+	operatorToken, ok := p.next(tokens)
+	if !ok || operatorToken.isString {
+		return nil, errors.NewCouldNotBeParsedError()
+	}
+
+	operator, ok := odataComparers[strings.ToLower(operatorToken.value)]
+	if !ok {
+		return nil, errors.NewOperatorCouldNotBeParsedError()
+	}
+
+	value, ok := p.next(tokens)
+	if !ok || (!value.isString && (value.value == "(" || value.value == ")" || value.value == ",")) {
+		return nil, errors.NewCouldNotBeParsedError()
+	}
+
+	return &filterNode{field: field.value, operator: operator, value: p.parseValue(value)}, nil
+}
+
+func (p *ODataQueryParser) parseValue(token odataToken) interface{} {
+	if token.isString {
+		return token.value
+	}
+
+	switch strings.ToLower(token.value) {
+	case "null":
+		return nil
+	case "true":
+		return true
+	case "false":
+		return false
+	}
+
+	return token.value
+}
+
+func (p *ODataQueryParser) next(tokens *[]odataToken) (odataToken, bool) {
+	if len(*tokens) == 0 {
+		return odataToken{}, false
+	}
+
+	token := (*tokens)[0]
+	*tokens = (*tokens)[1:]
+
+	return token, true
+}
+
+func (p *ODataQueryParser) accept(tokens *[]odataToken, value string) bool {
+	if len(*tokens) == 0 || (*tokens)[0].isString || (*tokens)[0].value != value {
+		return false
+	}
+
+	*tokens = (*tokens)[1:]
+
+	return true
+}
+
+func (p *ODataQueryParser) acceptKeyword(tokens *[]odataToken, keyword string) bool {
+	if len(*tokens) == 0 || (*tokens)[0].isString || strings.ToLower((*tokens)[0].value) != keyword {
+		return false
+	}
+
+	*tokens = (*tokens)[1:]
+
+	return true
+}
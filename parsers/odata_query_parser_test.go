@@ -0,0 +1,199 @@
+package parsers
+
+import (
+	goErrors "errors"
+	"testing"
+	"time"
+
+	"github.com/filtex/filtex-go/constants"
+	"github.com/filtex/filtex-go/errors"
+	"github.com/filtex/filtex-go/expressions"
+	"github.com/filtex/filtex-go/models"
+	"github.com/stretchr/testify/assert"
+)
+
+func newODataTestMetadata() *models.Metadata {
+	return &models.Metadata{
+		Fields: []models.Field{
+			{
+				Name:  "status",
+				Type:  constants.FieldTypeString.String(),
+				Label: "Status",
+				Operators: []string{
+					constants.OperatorEqual.String(),
+					constants.OperatorNotEqual.String(),
+					constants.OperatorIn.String(),
+					constants.OperatorNotIn.String(),
+				},
+				Values: []models.Lookup{
+					{Name: "Active", Value: "active"},
+					{Name: "Passive", Value: "passive"},
+				},
+			},
+			{
+				Name:  "name",
+				Type:  constants.FieldTypeString.String(),
+				Label: "Name",
+				Operators: []string{
+					constants.OperatorEqual.String(),
+					constants.OperatorContain.String(),
+					constants.OperatorNotContain.String(),
+					constants.OperatorStartWith.String(),
+					constants.OperatorEndWith.String(),
+					constants.OperatorIsNull.String(),
+					constants.OperatorIsNotNull.String(),
+				},
+			},
+			{
+				Name:  "age",
+				Type:  constants.FieldTypeNumber.String(),
+				Label: "Age",
+				Operators: []string{
+					constants.OperatorEqual.String(),
+					constants.OperatorGreaterThan.String(),
+					constants.OperatorGreaterThanOrEqual.String(),
+					constants.OperatorLessThan.String(),
+					constants.OperatorLessThanOrEqual.String(),
+				},
+			},
+			{
+				Name:  "enabled",
+				Type:  constants.FieldTypeBoolean.String(),
+				Label: "Enabled",
+				Operators: []string{
+					constants.OperatorEqual.String(),
+				},
+			},
+			{
+				Name:  "createdAt",
+				Type:  constants.FieldTypeDate.String(),
+				Label: "Created At",
+				Operators: []string{
+					constants.OperatorGreaterThanOrEqual.String(),
+				},
+			},
+		},
+	}
+}
+
+func TestODataQueryParser_Parse_ShouldReturnOperatorExpression_WhenQueryIsComparison(t *testing.T) {
+	// Arrange
+	date := time.Date(2024, 1, 2, 0, 0, 0, 0, time.UTC)
+	sampleMap := map[string]expressions.Expression{
+		"Status eq 'active'":            expressions.NewOperatorExpression(constants.FieldTypeString, "status", constants.OperatorEqual, "active"),
+		"status ne 'Passive'":           expressions.NewOperatorExpression(constants.FieldTypeString, "status", constants.OperatorNotEqual, "passive"),
+		"Age gt 18":                     expressions.NewOperatorExpression(constants.FieldTypeNumber, "age", constants.OperatorGreaterThan, 18.0),
+		"Age GE -1.5":                   expressions.NewOperatorExpression(constants.FieldTypeNumber, "age", constants.OperatorGreaterThanOrEqual, -1.5),
+		"Age lt 65":                     expressions.NewOperatorExpression(constants.FieldTypeNumber, "age", constants.OperatorLessThan, 65.0),
+		"Age le 65":                     expressions.NewOperatorExpression(constants.FieldTypeNumber, "age", constants.OperatorLessThanOrEqual, 65.0),
+		"Enabled eq true":               expressions.NewOperatorExpression(constants.FieldTypeBoolean, "enabled", constants.OperatorEqual, true),
+		"Name eq 'O''Brien'":            expressions.NewOperatorExpression(constants.FieldTypeString, "name", constants.OperatorEqual, "O'Brien"),
+		"Name eq null":                  expressions.NewOperatorExpression(constants.FieldTypeString, "name", constants.OperatorIsNull, ""),
+		"createdAt ge 2024-01-02":       expressions.NewOperatorExpression(constants.FieldTypeDate, "createdAt", constants.OperatorGreaterThanOrEqual, &date),
+		"contains(Name, 'fil')":         expressions.NewOperatorExpression(constants.FieldTypeString, "name", constants.OperatorContain, "fil"),
+		"startswith(Name,'fil')":        expressions.NewOperatorExpression(constants.FieldTypeString, "name", constants.OperatorStartWith, "fil"),
+		"endswith(Name,'tex')":          expressions.NewOperatorExpression(constants.FieldTypeString, "name", constants.OperatorEndWith, "tex"),
+		"Status in ('active', Passive)": expressions.NewOperatorExpression(constants.FieldTypeString, "status", constants.OperatorIn, []interface{}{"active", "passive"}),
+		"((Age gt 18))":                 expressions.NewOperatorExpression(constants.FieldTypeNumber, "age", constants.OperatorGreaterThan, 18.0),
+	}
+
+	for query, expected := range sampleMap {
+		// Act
+		result, err := NewODataQueryParser(newODataTestMetadata()).Parse(query)
+
+		// Assert
+		assert.NoError(t, err, query)
+		assert.Equal(t, expected, result, query)
+	}
+}
+
+func TestODataQueryParser_Parse_ShouldReturnLogicExpression_WhenQueryHasLogic(t *testing.T) {
+	// Arrange
+	query := "Status eq 'active' and (Age gt 18 or contains(Name,'fil')) and Enabled eq true"
+
+	// Act
+	result, err := NewODataQueryParser(newODataTestMetadata()).Parse(query)
+
+	// Assert
+	assert.NoError(t, err)
+	assert.Equal(t, expressions.NewLogicExpression(constants.LogicAnd, []expressions.Expression{
+		expressions.NewOperatorExpression(constants.FieldTypeString, "status", constants.OperatorEqual, "active"),
+		expressions.NewLogicExpression(constants.LogicOr, []expressions.Expression{
+			expressions.NewOperatorExpression(constants.FieldTypeNumber, "age", constants.OperatorGreaterThan, 18.0),
+			expressions.NewOperatorExpression(constants.FieldTypeString, "name", constants.OperatorContain, "fil"),
+		}),
+		expressions.NewOperatorExpression(constants.FieldTypeBoolean, "enabled", constants.OperatorEqual, true),
+	}), result)
+}
+
+func TestODataQueryParser_Parse_ShouldNegateExpression_WhenQueryHasNot(t *testing.T) {
+	// Arrange
+	sampleMap := map[string]expressions.Expression{
+		"not contains(Name,'fil')": expressions.NewOperatorExpression(constants.FieldTypeString, "name", constants.OperatorNotContain, "fil"),
+		"not (Name eq null)":       expressions.NewOperatorExpression(constants.FieldTypeString, "name", constants.OperatorIsNotNull, ""),
+		"not Status in ('active')": expressions.NewOperatorExpression(constants.FieldTypeString, "status", constants.OperatorNotIn, []interface{}{"active"}),
+		"not not Age gt 18":        expressions.NewOperatorExpression(constants.FieldTypeNumber, "age", constants.OperatorGreaterThan, 18.0),
+		"not (Age ge 18 and Status eq 'active')": expressions.NewLogicExpression(constants.LogicOr, []expressions.Expression{
+			expressions.NewOperatorExpression(constants.FieldTypeNumber, "age", constants.OperatorLessThan, 18.0),
+			expressions.NewOperatorExpression(constants.FieldTypeString, "status", constants.OperatorNotEqual, "active"),
+		}),
+	}
+
+	for query, expected := range sampleMap {
+		// Act
+		result, err := NewODataQueryParser(newODataTestMetadata()).Parse(query)
+
+		// Assert
+		assert.NoError(t, err, query)
+		assert.Equal(t, expected, result, query)
+	}
+}
+
+func TestODataQueryParser_Parse_ShouldReturnError_WhenQueryIsNotValid(t *testing.T) {
+	// Arrange
+	samples := []string{
+		"",
+		"Age",
+		"Age gt",
+		"Age between 18",
+		"Age gt 18 and",
+		"(Age gt 18",
+		"Age gt 18)",
+		"Name eq 'unterminated",
+		"contains(Name 'fil')",
+		"Status in 'active'",
+		"Status in ('active'",
+		"not (Enabled eq true)",
+	}
+
+	for _, query := range samples {
+		// Act
+		result, err := NewODataQueryParser(newODataTestMetadata()).Parse(query)
+
+		// Assert
+		assert.Nil(t, result, query)
+		assert.Error(t, err, query)
+	}
+}
+
+func TestODataQueryParser_Parse_ShouldReturnQueryError_WhenFieldOrValueIsNotValid(t *testing.T) {
+	// Arrange
+	sampleMap := map[string]string{
+		"Unknown eq 1":            "Unknown",
+		"Age gt 'abc'":            "age",
+		"Status eq 'deleted'":     "status",
+		"contains(Age, '1')":      "age",
+		"createdAt lt 2024-01-02": "createdAt",
+	}
+
+	for query, field := range sampleMap {
+		// Act
+		result, err := NewODataQueryParser(newODataTestMetadata()).Parse(query)
+
+		// Assert
+		var queryError *errors.QueryError
+		assert.Nil(t, result, query)
+		assert.True(t, goErrors.As(err, &queryError), query)
+		assert.Equal(t, field, queryError.Field, query)
+	}
+}
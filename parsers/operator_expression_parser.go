@@ -0,0 +1,16 @@
+package parsers
+
+import (
+	"github.com/filtex/filtex-go/constants"
+	"github.com/filtex/filtex-go/expressions"
+	"github.com/filtex/filtex-go/models"
+)
+
+func newOperatorExpression(metadata *models.Metadata, field string, operator constants.Operator, value interface{}) expressions.Expression {
+	return expressions.NewCollatedOperatorExpression(
+		metadata.GetFieldType(field),
+		metadata.GetFieldName(field),
+		operator,
+		value,
+		metadata.GetFieldCollation(field))
+}
@@ -0,0 +1,162 @@
+package parsers
+
+import (
+	"github.com/filtex/filtex-go/constants"
+	"github.com/filtex/filtex-go/errors"
+	"github.com/filtex/filtex-go/expressions"
+	"github.com/filtex/filtex-go/models"
+	"github.com/filtex/filtex-go/tokenizers"
+)
+
+type RsqlQueryParser struct {
+	metadata       *models.Metadata
+	queryTokenizer tokenizers.RsqlQueryTokenizer
+}
+
+func NewRsqlQueryParser(metadata *models.Metadata, queryTokenizer tokenizers.RsqlQueryTokenizer) QueryParser {
+	return &RsqlQueryParser{
+		metadata:       metadata,
+		queryTokenizer: queryTokenizer,
+	}
+}
+
+func (p *RsqlQueryParser) Parse(query string) (expressions.Expression, error) {
+	tokens, err := p.queryTokenizer.Tokenize(query)
+	if err != nil {
+		return nil, err
+	}
+
+	if tokens == nil || len(*tokens) == 0 {
+		return nil, errors.NewCouldNotBeParsedError()
+	}
+
+	for _, v := range *tokens {
+		if v.Type == constants.TokenTypeNone {
+			return nil, errors.NewCouldNotBeParsedError()
+		}
+	}
+
+	queue := *tokens
+
+	expression, err := p.parseLogic(&queue, constants.LogicOr)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(queue) > 0 {
+		return nil, errors.NewCouldNotBeParsedError()
+	}
+
+	return expression, nil
+}
+
+func (p *RsqlQueryParser) parseLogic(queue *[]models.Token, logic constants.Logic) (expressions.Expression, error) {
+	next := func() (expressions.Expression, error) {
+		if logic == constants.LogicOr {
+			return p.parseLogic(queue, constants.LogicAnd)
+		}
+		return p.parseComparison(queue)
+	}
+
+	expression, err := next()
+	if err != nil {
+		return nil, err
+	}
+
+	list := []expressions.Expression{expression}
+
+	for len(*queue) > 0 && (*queue)[0].Type == logic.ToTokenType() {
+		*queue = (*queue)[1:]
+
+		expression, err = next()
+		if err != nil {
+			return nil, err
+		}
+
+		if logicExpression, ok := expression.(*expressions.LogicExpression); ok && logicExpression.Logic == logic {
+			list = append(list, logicExpression.Expressions...)
+		} else {
+			list = append(list, expression)
+		}
+	}
+
+	if len(list) == 1 {
+		return list[0], nil
+	}
+
+	return expressions.NewLogicExpression(logic, list), nil
+}
+
+func (p *RsqlQueryParser) parseComparison(queue *[]models.Token) (expressions.Expression, error) {
+	if len(*queue) == 0 {
+		return nil, errors.NewCouldNotBeParsedError()
+	}
+
+	token := (*queue)[0]
+	*queue = (*queue)[1:]
+
+	if token.Type.IsOpenGroupTokenType() {
+		expression, err := p.parseLogic(queue, constants.LogicOr)
+		if err != nil {
+			return nil, err
+		}
+
+		if len(*queue) == 0 || !(*queue)[0].Type.IsCloseGroupTokenType() {
+			return nil, errors.NewCouldNotBeParsedError()
+		}
+
+		*queue = (*queue)[1:]
+
+		return expression, nil
+	}
+
+	if !token.Type.IsFieldTokenType() || len(*queue) < 2 {
+		return nil, errors.NewCouldNotBeParsedError()
+	}
+
+	operatorToken := (*queue)[0]
+	*queue = (*queue)[1:]
+
+	operator := operatorToken.Type.ToOperator()
+	if operator == constants.OperatorUnknown {
+		return nil, errors.NewOperatorCouldNotBeParsedError()
+	}
+
+	values := make([]interface{}, 0)
+	isNull := false
+
+	for len(*queue) > 0 && (*queue)[0].Type.IsValueTokenType() {
+		isNull = isNull || (*queue)[0].Type.IsNullTokenType()
+		values = append(values, (*queue)[0].Value)
+		*queue = (*queue)[1:]
+
+		if len(*queue) == 0 || !(*queue)[0].Type.IsSeparatorTokenType() {
+			break
+		}
+
+		*queue = (*queue)[1:]
+	}
+
+	if len(values) == 0 {
+		return nil, errors.NewCouldNotBeParsedError()
+	}
+
+	if isNull {
+		nullOperator, err := parseNullOperator(operator)
+		if err != nil {
+			return nil, err
+		}
+
+		return newOperatorExpression(p.metadata, token.Value.(string), nullOperator, ""), nil
+	}
+
+	if operatorToken.Type.IsMultiAllowedTokenType() {
+		return newOperatorExpression(p.metadata, token.Value.(string), operator, values), nil
+	}
+
+	if len(values) != 1 {
+		return nil, errors.NewCouldNotBeParsedError()
+	}
+
+	return newOperatorExpression(p.metadata, token.Value.(string), operator, values[0]), nil
+}
@@ -0,0 +1,208 @@
+package parsers
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/filtex/filtex-go/constants"
+	"github.com/filtex/filtex-go/expressions"
+	"github.com/filtex/filtex-go/models"
+	"github.com/filtex/filtex-go/tokenizers"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func newRsqlTestMetadata() *models.Metadata {
+	return &models.Metadata{
+		Fields: []models.Field{
+			{
+				Name:  "status",
+				Type:  constants.FieldTypeString.String(),
+				Label: "Status",
+				Operators: []string{
+					constants.OperatorEqual.String(),
+					constants.OperatorNotEqual.String(),
+					constants.OperatorIn.String(),
+					constants.OperatorNotIn.String(),
+				},
+				Values: []models.Lookup{
+					{Name: "Active", Value: "active"},
+					{Name: "Passive", Value: "passive"},
+				},
+			},
+			{
+				Name:  "name",
+				Type:  constants.FieldTypeString.String(),
+				Label: "Name",
+				Operators: []string{
+					constants.OperatorEqual.String(),
+					constants.OperatorContain.String(),
+					constants.OperatorNotContain.String(),
+					constants.OperatorStartWith.String(),
+					constants.OperatorEndWith.String(),
+					constants.OperatorIsNull.String(),
+					constants.OperatorIsNotNull.String(),
+				},
+			},
+			{
+				Name:  "age",
+				Type:  constants.FieldTypeNumber.String(),
+				Label: "Age",
+				Operators: []string{
+					constants.OperatorEqual.String(),
+					constants.OperatorGreaterThan.String(),
+					constants.OperatorGreaterThanOrEqual.String(),
+					constants.OperatorLessThan.String(),
+					constants.OperatorLessThanOrEqual.String(),
+				},
+			},
+		},
+	}
+}
+
+func TestRsqlQueryParser_Parse_ShouldReturnError_WhenQueryTokenizerReturnedError(t *testing.T) {
+	// Arrange
+	rsqlQueryTokenizerMock := tokenizers.NewRsqlQueryTokenizerMock()
+	rsqlQueryTokenizerMock.
+		On("Tokenize", mock.Anything).
+		Return(nil, errors.New("some error"))
+
+	rsqlQueryParser := NewRsqlQueryParser(newRsqlTestMetadata(), rsqlQueryTokenizerMock)
+
+	// Act
+	expression, err := rsqlQueryParser.Parse("some_text")
+
+	// Assert
+	assert.Nil(t, expression)
+	assert.Error(t, err)
+}
+
+func TestRsqlQueryParser_Parse_ShouldReturnError_WhenQueryTokenizerReturnedEmptyResult(t *testing.T) {
+	// Arrange
+	rsqlQueryTokenizerMock := tokenizers.NewRsqlQueryTokenizerMock()
+	rsqlQueryTokenizerMock.
+		On("Tokenize", mock.Anything).
+		Return(&[]models.Token{}, nil)
+
+	rsqlQueryParser := NewRsqlQueryParser(newRsqlTestMetadata(), rsqlQueryTokenizerMock)
+
+	// Act
+	expression, err := rsqlQueryParser.Parse("some_text")
+
+	// Assert
+	assert.Nil(t, expression)
+	assert.Error(t, err)
+}
+
+func TestRsqlQueryParser_Parse_ShouldReturnError_WhenQueryTokenizerReturnedNoneToken(t *testing.T) {
+	// Arrange
+	rsqlQueryTokenizerMock := tokenizers.NewRsqlQueryTokenizerMock()
+	rsqlQueryTokenizerMock.
+		On("Tokenize", mock.Anything).
+		Return(&[]models.Token{
+			{Type: constants.TokenTypeField, Value: "age"},
+			{Type: constants.TokenTypeNone, Value: "=foo="},
+			{Type: constants.TokenTypeValue, Value: 1.0},
+		}, nil)
+
+	rsqlQueryParser := NewRsqlQueryParser(newRsqlTestMetadata(), rsqlQueryTokenizerMock)
+
+	// Act
+	expression, err := rsqlQueryParser.Parse("some_text")
+
+	// Assert
+	assert.Nil(t, expression)
+	assert.Error(t, err)
+}
+
+func TestRsqlQueryParser_Parse_ShouldReturnOperatorExpression_WhenQueryIsComparison(t *testing.T) {
+	// Arrange
+	sampleMap := map[string]expressions.Expression{
+		`status==Active`:             expressions.NewOperatorExpression(constants.FieldTypeString, "status", constants.OperatorEqual, "active"),
+		`status!="passive"`:          expressions.NewOperatorExpression(constants.FieldTypeString, "status", constants.OperatorNotEqual, "passive"),
+		`age=gt=18`:                  expressions.NewOperatorExpression(constants.FieldTypeNumber, "age", constants.OperatorGreaterThan, 18.0),
+		`age>=18`:                    expressions.NewOperatorExpression(constants.FieldTypeNumber, "age", constants.OperatorGreaterThanOrEqual, 18.0),
+		`age=lt=65`:                  expressions.NewOperatorExpression(constants.FieldTypeNumber, "age", constants.OperatorLessThan, 65.0),
+		`age<=65`:                    expressions.NewOperatorExpression(constants.FieldTypeNumber, "age", constants.OperatorLessThanOrEqual, 65.0),
+		`name==*fil*`:                expressions.NewOperatorExpression(constants.FieldTypeString, "name", constants.OperatorContain, "fil"),
+		`name!=*fil*`:                expressions.NewOperatorExpression(constants.FieldTypeString, "name", constants.OperatorNotContain, "fil"),
+		`name=="fil*"`:               expressions.NewOperatorExpression(constants.FieldTypeString, "name", constants.OperatorStartWith, "fil"),
+		`name==*tex`:                 expressions.NewOperatorExpression(constants.FieldTypeString, "name", constants.OperatorEndWith, "tex"),
+		`name=="a\*b"`:               expressions.NewOperatorExpression(constants.FieldTypeString, "name", constants.OperatorEqual, "a*b"),
+		`name==null`:                 expressions.NewOperatorExpression(constants.FieldTypeString, "name", constants.OperatorIsNull, ""),
+		`name!=null`:                 expressions.NewOperatorExpression(constants.FieldTypeString, "name", constants.OperatorIsNotNull, ""),
+		`status=in=(active,Passive)`: expressions.NewOperatorExpression(constants.FieldTypeString, "status", constants.OperatorIn, []interface{}{"active", "passive"}),
+		`status=out=('active')`:      expressions.NewOperatorExpression(constants.FieldTypeString, "status", constants.OperatorNotIn, []interface{}{"active"}),
+		`((age=gt=18))`:              expressions.NewOperatorExpression(constants.FieldTypeNumber, "age", constants.OperatorGreaterThan, 18.0),
+	}
+
+	for query, expected := range sampleMap {
+		// Act
+		result, err := NewRsqlQueryParser(newRsqlTestMetadata(), tokenizers.NewRsqlQueryTokenizer(newRsqlTestMetadata())).Parse(query)
+
+		// Assert
+		assert.NoError(t, err, query)
+		assert.Equal(t, expected, result, query)
+	}
+}
+
+func TestRsqlQueryParser_Parse_ShouldReturnLogicExpression_WhenQueryHasLogic(t *testing.T) {
+	// Arrange
+	sampleMap := map[string]expressions.Expression{
+		`status==active;age=gt=18,name=="A*"`: expressions.NewLogicExpression(constants.LogicOr, []expressions.Expression{
+			expressions.NewLogicExpression(constants.LogicAnd, []expressions.Expression{
+				expressions.NewOperatorExpression(constants.FieldTypeString, "status", constants.OperatorEqual, "active"),
+				expressions.NewOperatorExpression(constants.FieldTypeNumber, "age", constants.OperatorGreaterThan, 18.0),
+			}),
+			expressions.NewOperatorExpression(constants.FieldTypeString, "name", constants.OperatorStartWith, "A"),
+		}),
+		`status==active and (age>18 or age<10) and name==x`: expressions.NewLogicExpression(constants.LogicAnd, []expressions.Expression{
+			expressions.NewOperatorExpression(constants.FieldTypeString, "status", constants.OperatorEqual, "active"),
+			expressions.NewLogicExpression(constants.LogicOr, []expressions.Expression{
+				expressions.NewOperatorExpression(constants.FieldTypeNumber, "age", constants.OperatorGreaterThan, 18.0),
+				expressions.NewOperatorExpression(constants.FieldTypeNumber, "age", constants.OperatorLessThan, 10.0),
+			}),
+			expressions.NewOperatorExpression(constants.FieldTypeString, "name", constants.OperatorEqual, "x"),
+		}),
+	}
+
+	for query, expected := range sampleMap {
+		// Act
+		result, err := NewRsqlQueryParser(newRsqlTestMetadata(), tokenizers.NewRsqlQueryTokenizer(newRsqlTestMetadata())).Parse(query)
+
+		// Assert
+		assert.NoError(t, err, query)
+		assert.Equal(t, expected, result, query)
+	}
+}
+
+func TestRsqlQueryParser_Parse_ShouldReturnError_WhenQueryIsNotValid(t *testing.T) {
+	// Arrange
+	samples := []string{
+		``,
+		`age`,
+		`age=gt=`,
+		`age=foo=18`,
+		`age=gt=abc`,
+		`age=gt=18;`,
+		`(age=gt=18`,
+		`age=gt=18)`,
+		`age=in=(1,2)`,
+		`status=in=active`,
+		`status=in=(active`,
+		`status==(active,passive)`,
+		`status==deleted`,
+		`name=="open`,
+		`name==a*b`,
+		`unknown==1`,
+	}
+
+	for _, query := range samples {
+		// Act
+		result, err := NewRsqlQueryParser(newRsqlTestMetadata(), tokenizers.NewRsqlQueryTokenizer(newRsqlTestMetadata())).Parse(query)
+
+		// Assert
+		assert.Nil(t, result, query)
+		assert.Error(t, err, query)
+	}
+}
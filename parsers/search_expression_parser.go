@@ -0,0 +1,27 @@
+package parsers
+
+import (
+	"github.com/filtex/filtex-go/errors"
+	"github.com/filtex/filtex-go/expressions"
+	"github.com/filtex/filtex-go/models"
+	"github.com/filtex/filtex-go/utils"
+)
+
+func newSearchExpression(metadata *models.Metadata, field string, value interface{}) (expressions.Expression, error) {
+	text, err := utils.String(value)
+	if err != nil || text == "" {
+		return nil, errors.NewCouldNotBeParsedError()
+	}
+
+	fields := metadata.GetSearchFields()
+
+	if field != "" {
+		fields = []string{metadata.GetFieldName(field)}
+	}
+
+	if len(fields) == 0 {
+		return nil, errors.NewCouldNotBeParsedError()
+	}
+
+	return expressions.NewSearchExpression(fields, text), nil
+}
@@ -0,0 +1,101 @@
+package parsers
+
+import (
+	"testing"
+
+	"github.com/filtex/filtex-go/constants"
+	"github.com/filtex/filtex-go/expressions"
+	"github.com/filtex/filtex-go/models"
+	"github.com/filtex/filtex-go/tokenizers"
+	"github.com/stretchr/testify/assert"
+)
+
+func newSearchTestMetadata() *models.Metadata {
+	return &models.Metadata{
+		Fields: []models.Field{
+			{
+				Name:  "name",
+				Type:  constants.FieldTypeString.String(),
+				Label: "Name",
+				Operators: []string{
+					constants.OperatorEqual.String(),
+					constants.OperatorSearch.String(),
+				},
+			},
+			{
+				Name:  "description",
+				Type:  constants.FieldTypeString.String(),
+				Label: "Description",
+				Operators: []string{
+					constants.OperatorSearch.String(),
+				},
+			},
+			{
+				Name:  "code",
+				Type:  constants.FieldTypeString.String(),
+				Label: "Code",
+				Operators: []string{
+					constants.OperatorEqual.String(),
+				},
+			},
+		},
+	}
+}
+
+func TestTextQueryParser_ShouldReturnSearchExpression_WhenSearchHasNoField(t *testing.T) {
+	// Arrange
+	metadata := newSearchTestMetadata()
+	parser := NewTextQueryParser(metadata, tokenizers.NewTextQueryTokenizer(metadata))
+
+	// Act
+	expression, err := parser.Parse(`Code = A1 And Search "red shoes"`)
+
+	// Assert
+	assert.NoError(t, err)
+	assert.Equal(t, expressions.NewLogicExpression(constants.LogicAnd, []expressions.Expression{
+		expressions.NewOperatorExpression(constants.FieldTypeString, "code", constants.OperatorEqual, "A1"),
+		expressions.NewSearchExpression([]string{"name", "description"}, "red shoes"),
+	}), expression)
+}
+
+func TestTextQueryParser_ShouldReturnSearchExpression_WhenSearchHasField(t *testing.T) {
+	// Arrange
+	metadata := newSearchTestMetadata()
+	parser := NewTextQueryParser(metadata, tokenizers.NewTextQueryTokenizer(metadata))
+
+	// Act
+	expression, err := parser.Parse(`Description Search "red shoes"`)
+
+	// Assert
+	assert.NoError(t, err)
+	assert.Equal(t, expressions.NewSearchExpression([]string{"description"}, "red shoes"), expression)
+}
+
+func TestJsonQueryParser_ShouldReturnSearchExpression_WhenSearchHasNoField(t *testing.T) {
+	// Arrange
+	metadata := newSearchTestMetadata()
+	parser := NewJsonQueryParser(metadata, tokenizers.NewJsonQueryTokenizer(metadata))
+
+	// Act
+	expression, err := parser.Parse(`["And", [["Code", "Equal", "A1"], ["Search", "red shoes"]]]`)
+
+	// Assert
+	assert.NoError(t, err)
+	assert.Equal(t, expressions.NewLogicExpression(constants.LogicAnd, []expressions.Expression{
+		expressions.NewOperatorExpression(constants.FieldTypeString, "code", constants.OperatorEqual, "A1"),
+		expressions.NewSearchExpression([]string{"name", "description"}, "red shoes"),
+	}), expression)
+}
+
+func TestJsonQueryParser_ShouldReturnSearchExpression_WhenSearchHasField(t *testing.T) {
+	// Arrange
+	metadata := newSearchTestMetadata()
+	parser := NewJsonQueryParser(metadata, tokenizers.NewJsonQueryTokenizer(metadata))
+
+	// Act
+	expression, err := parser.Parse(`["Name", "Search", "red shoes"]`)
+
+	// Assert
+	assert.NoError(t, err)
+	assert.Equal(t, expressions.NewSearchExpression([]string{"name"}, "red shoes"), expression)
+}
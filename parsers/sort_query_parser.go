@@ -0,0 +1,78 @@
+package parsers
+
+import (
+	"strings"
+
+	"github.com/filtex/filtex-go/constants"
+	"github.com/filtex/filtex-go/errors"
+	"github.com/filtex/filtex-go/expressions"
+	"github.com/filtex/filtex-go/models"
+)
+
+type SortQueryParser struct {
+	metadata *models.Metadata
+}
+
+func NewSortQueryParser(metadata *models.Metadata) *SortQueryParser {
+	return &SortQueryParser{
+		metadata: metadata,
+	}
+}
+
+func (p *SortQueryParser) Parse(query string) ([]*expressions.SortExpression, error) {
+	result := make([]*expressions.SortExpression, 0)
+
+	if strings.TrimSpace(query) == "" {
+		return result, nil
+	}
+
+	fields := make(map[string]bool)
+
+	for _, item := range strings.Split(query, ",") {
+		sortExpression, err := p.parseItem(item)
+		if err != nil {
+			return nil, err
+		}
+
+		if fields[sortExpression.Field] {
+			return nil, errors.NewSortCouldNotBeParsedError()
+		}
+
+		fields[sortExpression.Field] = true
+		result = append(result, sortExpression)
+	}
+
+	return result, nil
+}
+
+func (p *SortQueryParser) parseItem(item string) (*expressions.SortExpression, error) {
+	words := strings.Fields(item)
+	if len(words) == 0 {
+		return nil, errors.NewSortCouldNotBeParsedError()
+	}
+
+	nulls := constants.SortNullsDefault
+
+	if len(words) > 2 {
+		nulls = constants.ParseSortNulls(strings.Join(words[len(words)-2:], " "))
+		if nulls != constants.SortNullsDefault {
+			words = words[:len(words)-2]
+		}
+	}
+
+	direction := constants.SortDirectionAsc
+
+	if len(words) > 1 {
+		if d := constants.ParseSortDirection(words[len(words)-1]); d != constants.SortDirectionUnknown {
+			direction = d
+			words = words[:len(words)-1]
+		}
+	}
+
+	field := p.metadata.GetField(strings.Join(words, " "))
+	if field == nil || constants.FieldType(field.Type).IsArray() {
+		return nil, errors.NewInvalidFieldError()
+	}
+
+	return expressions.NewCollatedSortExpression(constants.FieldType(field.Type), field.Name, direction, nulls, constants.ParseCollation(field.Collation)), nil
+}
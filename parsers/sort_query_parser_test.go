@@ -0,0 +1,95 @@
+package parsers
+
+import (
+	"testing"
+
+	"github.com/filtex/filtex-go/constants"
+	"github.com/filtex/filtex-go/expressions"
+	"github.com/filtex/filtex-go/models"
+	"github.com/stretchr/testify/assert"
+)
+
+func newSortTestMetadata() *models.Metadata {
+	return &models.Metadata{
+		Fields: []models.Field{
+			{
+				Name:  "name",
+				Type:  constants.FieldTypeString.String(),
+				Label: "Name",
+			},
+			{
+				Name:  "createdAt",
+				Type:  constants.FieldTypeDateTime.String(),
+				Label: "Created At",
+			},
+			{
+				Name:  "tags",
+				Type:  constants.FieldTypeStringArray.String(),
+				Label: "Tags",
+			},
+		},
+	}
+}
+
+func TestSortQueryParser_ShouldReturnEmptyList_WhenQueryIsEmpty(t *testing.T) {
+	// Arrange
+	parser := NewSortQueryParser(newSortTestMetadata())
+
+	// Act
+	result, err := parser.Parse("  ")
+
+	// Assert
+	assert.NoError(t, err)
+	assert.Empty(t, result)
+}
+
+func TestSortQueryParser_ShouldReturnError_WhenQueryIsNotValid(t *testing.T) {
+	// Arrange
+	parser := NewSortQueryParser(newSortTestMetadata())
+	samples := []string{
+		"Unknown asc",
+		"Name upward",
+		"Name asc,",
+		"Name asc, Name desc",
+		"Tags asc",
+		"asc",
+	}
+
+	for _, v := range samples {
+		// Act
+		result, err := parser.Parse(v)
+
+		// Assert
+		assert.Nil(t, result)
+		assert.Error(t, err)
+	}
+}
+
+func TestSortQueryParser_ShouldReturnSortExpressions_WhenQueryIsValid(t *testing.T) {
+	// Arrange
+	parser := NewSortQueryParser(newSortTestMetadata())
+
+	// Act
+	result, err := parser.Parse("Name, created at DESC nulls last")
+
+	// Assert
+	assert.NoError(t, err)
+	assert.Equal(t, []*expressions.SortExpression{
+		expressions.NewSortExpression(constants.FieldTypeString, "name", constants.SortDirectionAsc, constants.SortNullsDefault),
+		expressions.NewSortExpression(constants.FieldTypeDateTime, "createdAt", constants.SortDirectionDesc, constants.SortNullsLast),
+	}, result)
+}
+
+func TestSortQueryParser_ShouldUseFieldName_WhenLabelIsGiven(t *testing.T) {
+	// Arrange
+	parser := NewSortQueryParser(newSortTestMetadata())
+
+	// Act
+	result, err := parser.Parse("name asc nulls first")
+
+	// Assert
+	assert.NoError(t, err)
+	assert.Len(t, result, 1)
+	assert.Equal(t, "name", result[0].Field)
+	assert.Equal(t, constants.SortNullsFirst, result[0].Nulls)
+}
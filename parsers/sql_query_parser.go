@@ -0,0 +1,463 @@
+package parsers
+
+import (
+	"context"
+	"regexp"
+	"strings"
+	"unicode"
+
+	"github.com/filtex/filtex-go/constants"
+	"github.com/filtex/filtex-go/errors"
+	"github.com/filtex/filtex-go/expressions"
+	"github.com/filtex/filtex-go/models"
+	"github.com/filtex/filtex-go/queries"
+)
+
+type SqlQueryParser struct {
+	metadata *models.Metadata
+	ctx      context.Context
+}
+
+type sqlTokenKind int
+
+const (
+	sqlTokenWord sqlTokenKind = iota
+	sqlTokenIdentifier
+	sqlTokenString
+	sqlTokenNumber
+	sqlTokenSymbol
+)
+
+type sqlToken struct {
+	kind  sqlTokenKind
+	value string
+}
+
+var (
+	sqlComparers = map[string]constants.Operator{
+		"=":  constants.OperatorEqual,
+		"!=": constants.OperatorNotEqual,
+		"<>": constants.OperatorNotEqual,
+		">":  constants.OperatorGreaterThan,
+		">=": constants.OperatorGreaterThanOrEqual,
+		"<":  constants.OperatorLessThan,
+		"<=": constants.OperatorLessThanOrEqual,
+	}
+	sqlKeywords = map[string]bool{
+		"and":     true,
+		"or":      true,
+		"not":     true,
+		"is":      true,
+		"null":    true,
+		"in":      true,
+		"like":    true,
+		"ilike":   true,
+		"between": true,
+		"true":    true,
+		"false":   true,
+		"where":   true,
+	}
+	sqlNumberPattern = regexp.MustCompile(`^-?(\d+(\.\d*)?|\.\d+)([eE][-+]?\d+)?$`)
+)
+
+func NewSqlQueryParser(metadata *models.Metadata) *SqlQueryParser {
+	return &SqlQueryParser{
+		metadata: metadata,
+		ctx:      context.Background(),
+	}
+}
+
+func (p *SqlQueryParser) Context(ctx context.Context) *SqlQueryParser {
+	p.ctx = ctx
+	return p
+}
+
+func (p *SqlQueryParser) Parse(query string) (expressions.Expression, error) {
+	tokens, err := p.tokenize(query)
+	if err != nil {
+		return nil, err
+	}
+
+	p.acceptKeyword(&tokens, "where")
+
+	if len(tokens) == 0 {
+		return nil, errors.NewCouldNotBeParsedError()
+	}
+
+	node, err := p.parseOr(&tokens)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(tokens) > 0 {
+		return nil, errors.NewCouldNotBeParsedError()
+	}
+
+	return buildFilterNode(queries.NewQueryBuilder(p.metadata).Context(p.ctx), node).Build()
+}
+
+func (p *SqlQueryParser) tokenize(query string) ([]sqlToken, error) {
+	tokens := make([]sqlToken, 0)
+	runes := []rune(query)
+
+	for i := 0; i < len(runes); {
+		r := runes[i]
+
+		switch {
+		case unicode.IsSpace(r):
+			i++
+		case r == '(' || r == ')' || r == ',':
+			tokens = append(tokens, sqlToken{kind: sqlTokenSymbol, value: string(r)})
+			i++
+		case r == '<' || r == '>' || r == '=' || r == '!':
+			symbol := string(r)
+
+			if i+1 < len(runes) {
+				if _, ok := sqlComparers[string(runes[i:i+2])]; ok {
+					symbol = string(runes[i : i+2])
+				}
+			}
+
+			if _, ok := sqlComparers[symbol]; !ok {
+				return nil, errors.NewUnsupportedConstructError(symbol)
+			}
+
+			tokens = append(tokens, sqlToken{kind: sqlTokenSymbol, value: symbol})
+			i += len([]rune(symbol))
+		case r == '\'' || r == '"' || r == '`' || r == '[':
+			closing := r
+			kind := sqlTokenIdentifier
+
+			if r == '\'' {
+				kind = sqlTokenString
+			} else if r == '[' {
+				closing = ']'
+			}
+
+			var sb strings.Builder
+			closed := false
+			i++
+
+			for i < len(runes) {
+				if runes[i] == closing {
+					if i+1 < len(runes) && runes[i+1] == closing && closing != ']' {
+						sb.WriteRune(closing)
+						i += 2
+						continue
+					}
+					closed = true
+					i++
+					break
+				}
+				sb.WriteRune(runes[i])
+				i++
+			}
+
+			if !closed {
+				return nil, errors.NewCouldNotBeParsedError()
+			}
+
+			tokens = append(tokens, sqlToken{kind: kind, value: sb.String()})
+		case unicode.IsDigit(r) || ((r == '-' || r == '.') && i+1 < len(runes) && (unicode.IsDigit(runes[i+1]) || runes[i+1] == '.')):
+			start := i
+			i++
+
+			for i < len(runes) && (unicode.IsDigit(runes[i]) || runes[i] == '.' || runes[i] == 'e' || runes[i] == 'E' ||
+				((runes[i] == '-' || runes[i] == '+') && (runes[i-1] == 'e' || runes[i-1] == 'E'))) {
+				i++
+			}
+
+			number := string(runes[start:i])
+			if !sqlNumberPattern.MatchString(number) {
+				return nil, errors.NewUnsupportedConstructError(number)
+			}
+
+			tokens = append(tokens, sqlToken{kind: sqlTokenNumber, value: number})
+		case unicode.IsLetter(r) || r == '_':
+			start := i
+
+			for i < len(runes) && (unicode.IsLetter(runes[i]) || unicode.IsDigit(runes[i]) || runes[i] == '_' || runes[i] == '.') {
+				i++
+			}
+
+			tokens = append(tokens, sqlToken{kind: sqlTokenWord, value: string(runes[start:i])})
+		default:
+			return nil, errors.NewUnsupportedConstructError(string(r))
+		}
+	}
+
+	return tokens, nil
+}
+
+func (p *SqlQueryParser) parseOr(tokens *[]sqlToken) (*filterNode, error) {
+	return p.parseLogic(tokens, constants.LogicOr, "or", p.parseAnd)
+}
+
+func (p *SqlQueryParser) parseAnd(tokens *[]sqlToken) (*filterNode, error) {
+	return p.parseLogic(tokens, constants.LogicAnd, "and", p.parseUnary)
+}
+
+func (p *SqlQueryParser) parseLogic(tokens *[]sqlToken, logic constants.Logic, keyword string, next func(*[]sqlToken) (*filterNode, error)) (*filterNode, error) {
+	node, err := next(tokens)
+	if err != nil {
+		return nil, err
+	}
+
+	nodes := []*filterNode{node}
+
+	for p.acceptKeyword(tokens, keyword) {
+		node, err = next(tokens)
+		if err != nil {
+			return nil, err
+		}
+		nodes = append(nodes, node)
+	}
+
+	if len(nodes) == 1 {
+		return nodes[0], nil
+	}
+
+	return &filterNode{logic: logic, nodes: nodes}, nil
+}
+
+func (p *SqlQueryParser) parseUnary(tokens *[]sqlToken) (*filterNode, error) {
+	if p.acceptKeyword(tokens, "not") {
+		node, err := p.parseUnary(tokens)
+		if err != nil {
+			return nil, err
+		}
+
+		return negateFilterNode(node)
+	}
+
+	if p.acceptSymbol(tokens, "(") {
+		node, err := p.parseOr(tokens)
+		if err != nil {
+			return nil, err
+		}
+
+		if !p.acceptSymbol(tokens, ")") {
+			return nil, errors.NewCouldNotBeParsedError()
+		}
+
+		return node, nil
+	}
+
+	return p.parsePredicate(tokens)
+}
+
+func (p *SqlQueryParser) parsePredicate(tokens *[]sqlToken) (*filterNode, error) {
+	field, ok := p.next(tokens)
+	if !ok || field.kind == sqlTokenSymbol || (field.kind == sqlTokenWord && sqlKeywords[strings.ToLower(field.value)]) {
+		return nil, errors.NewCouldNotBeParsedError()
+	}
+
+	if field.kind != sqlTokenWord && field.kind != sqlTokenIdentifier {
+		return nil, errors.NewUnsupportedConstructError(field.value)
+	}
+
+	if len(*tokens) > 0 && (*tokens)[0].kind == sqlTokenSymbol && (*tokens)[0].value == "(" {
+		return nil, errors.NewUnsupportedConstructError(field.value + "()")
+	}
+
+	negated := p.acceptKeyword(tokens, "not")
+
+	node, err := p.parseCondition(tokens, field.value, negated)
+	if err != nil {
+		return nil, err
+	}
+
+	if negated {
+		return negateFilterNode(node)
+	}
+
+	return node, nil
+}
+
+func (p *SqlQueryParser) parseCondition(tokens *[]sqlToken, field string, negated bool) (*filterNode, error) {
+	switch {
+	case !negated && p.acceptKeyword(tokens, "is"):
+		operator := constants.OperatorIsNull
+		if p.acceptKeyword(tokens, "not") {
+			operator = constants.OperatorIsNotNull
+		}
+
+		if !p.acceptKeyword(tokens, "null") {
+			return nil, errors.NewCouldNotBeParsedError()
+		}
+
+		return &filterNode{field: field, operator: operator}, nil
+	case p.acceptKeyword(tokens, "in"):
+		if !p.acceptSymbol(tokens, "(") {
+			return nil, errors.NewCouldNotBeParsedError()
+		}
+
+		values := make([]interface{}, 0)
+
+		for {
+			value, err := p.parseValue(tokens)
+			if err != nil {
+				return nil, err
+			}
+			values = append(values, value)
+
+			if p.acceptSymbol(tokens, ")") {
+				break
+			}
+
+			if !p.acceptSymbol(tokens, ",") {
+				return nil, errors.NewCouldNotBeParsedError()
+			}
+		}
+
+		return &filterNode{field: field, operator: constants.OperatorIn, value: values}, nil
+	case p.acceptKeyword(tokens, "like"):
+		return p.parseLike(tokens, field, constants.CollationCaseSensitive)
+	case p.acceptKeyword(tokens, "ilike"):
+		return p.parseLike(tokens, field, constants.CollationCaseInsensitive)
+	case p.acceptKeyword(tokens, "between"):
+		from, err := p.parseValue(tokens)
+		if err != nil {
+			return nil, err
+		}
+
+		if !p.acceptKeyword(tokens, "and") {
+			return nil, errors.NewCouldNotBeParsedError()
+		}
+
+		to, err := p.parseValue(tokens)
+		if err != nil {
+			return nil, err
+		}
+
+		return &filterNode{
+			logic: constants.LogicAnd,
+			nodes: []*filterNode{
+				{field: field, operator: constants.OperatorGreaterThanOrEqual, value: from},
+				{field: field, operator: constants.OperatorLessThanOrEqual, value: to},
+			},
+		}, nil
+	case negated:
+		return nil, errors.NewCouldNotBeParsedError()
+	}
+
+	comparer, ok := p.next(tokens)
+	if !ok || comparer.kind != sqlTokenSymbol {
+		return nil, errors.NewCouldNotBeParsedError()
+	}
+
+	operator, ok := sqlComparers[comparer.value]
+	if !ok {
+		return nil, errors.NewOperatorCouldNotBeParsedError()
+	}
+
+	if p.acceptKeyword(tokens, "null") {
+		return nil, errors.NewUnsupportedConstructError(comparer.value + " NULL")
+	}
+
+	value, err := p.parseValue(tokens)
+	if err != nil {
+		return nil, err
+	}
+
+	return &filterNode{field: field, operator: operator, value: value}, nil
+}
+
+func (p *SqlQueryParser) parseValue(tokens *[]sqlToken) (interface{}, error) {
+	token, ok := p.next(tokens)
+	if !ok || token.kind == sqlTokenSymbol {
+		return nil, errors.NewCouldNotBeParsedError()
+	}
+
+	switch token.kind {
+	case sqlTokenString, sqlTokenNumber:
+		return token.value, nil
+	case sqlTokenWord:
+		switch strings.ToLower(token.value) {
+		case "true":
+			return true, nil
+		case "false":
+			return false, nil
+		}
+	}
+
+	return nil, errors.NewUnsupportedConstructError(token.value)
+}
+
+func (p *SqlQueryParser) parseLike(tokens *[]sqlToken, field string, collation constants.Collation) (*filterNode, error) {
+	token, ok := p.next(tokens)
+	if !ok || token.kind != sqlTokenString {
+		return nil, errors.NewCouldNotBeParsedError()
+	}
+
+	var sb strings.Builder
+
+	pattern := token.value
+	runes := []rune(pattern)
+	hasPrefix := false
+	hasSuffix := false
+
+	for i := 0; i < len(runes); i++ {
+		switch {
+		case runes[i] == '\\' && i+1 < len(runes):
+			sb.WriteRune(runes[i+1])
+			i++
+		case runes[i] == '%' && i == 0:
+			hasPrefix = true
+		case runes[i] == '%' && i == len(runes)-1:
+			hasSuffix = true
+		case runes[i] == '%' || runes[i] == '_':
+			return nil, errors.NewUnsupportedConstructError("LIKE '" + pattern + "'")
+		default:
+			sb.WriteRune(runes[i])
+		}
+	}
+
+	value := sb.String()
+	if value == "" {
+		return nil, errors.NewUnsupportedConstructError("LIKE '" + pattern + "'")
+	}
+
+	operator := constants.OperatorEqual
+
+	if hasPrefix && hasSuffix {
+		operator = constants.OperatorContain
+	} else if hasSuffix {
+		operator = constants.OperatorStartWith
+	} else if hasPrefix {
+		operator = constants.OperatorEndWith
+	}
+
+	return &filterNode{field: field, operator: operator, value: value, collation: collation}, nil
+}
+
+func (p *SqlQueryParser) next(tokens *[]sqlToken) (sqlToken, bool) {
+	if len(*tokens) == 0 {
+		return sqlToken{}, false
+	}
+
+	token := (*tokens)[0]
+	*tokens = (*tokens)[1:]
+
+	return token, true
+}
+
+func (p *SqlQueryParser) acceptSymbol(tokens *[]sqlToken, value string) bool {
+	if len(*tokens) == 0 || (*tokens)[0].kind != sqlTokenSymbol || (*tokens)[0].value != value {
+		return false
+	}
+
+	*tokens = (*tokens)[1:]
+
+	return true
+}
+
+func (p *SqlQueryParser) acceptKeyword(tokens *[]sqlToken, keyword string) bool {
+	if len(*tokens) == 0 || (*tokens)[0].kind != sqlTokenWord || strings.ToLower((*tokens)[0].value) != keyword {
+		return false
+	}
+
+	*tokens = (*tokens)[1:]
+
+	return true
+}
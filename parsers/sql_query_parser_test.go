@@ -0,0 +1,265 @@
+package parsers
+
+import (
+	goErrors "errors"
+	"testing"
+	"time"
+
+	"github.com/filtex/filtex-go/constants"
+	"github.com/filtex/filtex-go/errors"
+	"github.com/filtex/filtex-go/expressions"
+	"github.com/filtex/filtex-go/models"
+	"github.com/stretchr/testify/assert"
+)
+
+func newImportTestMetadata() *models.Metadata {
+	return &models.Metadata{
+		Fields: []models.Field{
+			{
+				Name:  "status",
+				Type:  constants.FieldTypeString.String(),
+				Label: "Status",
+				Operators: []string{
+					constants.OperatorEqual.String(),
+					constants.OperatorNotEqual.String(),
+					constants.OperatorIn.String(),
+					constants.OperatorNotIn.String(),
+				},
+				Values: []models.Lookup{
+					{Name: "Active", Value: "active"},
+					{Name: "Passive", Value: "passive"},
+				},
+			},
+			{
+				Name:  "name",
+				Type:  constants.FieldTypeString.String(),
+				Label: "Name",
+				Operators: []string{
+					constants.OperatorEqual.String(),
+					constants.OperatorNotEqual.String(),
+					constants.OperatorContain.String(),
+					constants.OperatorNotContain.String(),
+					constants.OperatorStartWith.String(),
+					constants.OperatorNotStartWith.String(),
+					constants.OperatorEndWith.String(),
+					constants.OperatorBlank.String(),
+					constants.OperatorNotBlank.String(),
+					constants.OperatorIsNull.String(),
+					constants.OperatorIsNotNull.String(),
+				},
+			},
+			{
+				Name:  "age",
+				Type:  constants.FieldTypeNumber.String(),
+				Label: "Age",
+				Operators: []string{
+					constants.OperatorEqual.String(),
+					constants.OperatorGreaterThan.String(),
+					constants.OperatorGreaterThanOrEqual.String(),
+					constants.OperatorLessThan.String(),
+					constants.OperatorLessThanOrEqual.String(),
+				},
+			},
+			{
+				Name:  "enabled",
+				Type:  constants.FieldTypeBoolean.String(),
+				Label: "Enabled",
+				Operators: []string{
+					constants.OperatorEqual.String(),
+				},
+			},
+			{
+				Name:  "createdAt",
+				Type:  constants.FieldTypeDateTime.String(),
+				Label: "Created At",
+				Operators: []string{
+					constants.OperatorGreaterThanOrEqual.String(),
+				},
+			},
+			{
+				Name:  "score",
+				Type:  constants.FieldTypeNumber.String(),
+				Label: "Score",
+				Operators: []string{
+					constants.OperatorEqual.String(),
+					constants.OperatorNotEqual.String(),
+					constants.OperatorNotIn.String(),
+					constants.OperatorGreaterThan.String(),
+					constants.OperatorLessThanOrEqual.String(),
+					constants.OperatorIsNull.String(),
+					constants.OperatorIsNotNull.String(),
+				},
+			},
+			{
+				Name:  "tags",
+				Type:  constants.FieldTypeStringArray.String(),
+				Label: "Tags",
+				Operators: []string{
+					constants.OperatorContain.String(),
+					constants.OperatorNotContain.String(),
+					constants.OperatorBlank.String(),
+					constants.OperatorNotBlank.String(),
+				},
+			},
+		},
+	}
+}
+
+func TestSqlQueryParser_Parse_ShouldReturnOperatorExpression_WhenQueryIsPredicate(t *testing.T) {
+	// Arrange
+	createdAt := time.Date(2024, 1, 2, 3, 4, 5, 0, time.UTC)
+	sampleMap := map[string]expressions.Expression{
+		"status = 'active'":                     expressions.NewOperatorExpression(constants.FieldTypeString, "status", constants.OperatorEqual, "active"),
+		"WHERE status <> 'Passive'":             expressions.NewOperatorExpression(constants.FieldTypeString, "status", constants.OperatorNotEqual, "passive"),
+		"status != 'active'":                    expressions.NewOperatorExpression(constants.FieldTypeString, "status", constants.OperatorNotEqual, "active"),
+		"age>18":                                expressions.NewOperatorExpression(constants.FieldTypeNumber, "age", constants.OperatorGreaterThan, 18.0),
+		"age >= -1.5":                           expressions.NewOperatorExpression(constants.FieldTypeNumber, "age", constants.OperatorGreaterThanOrEqual, -1.5),
+		"\"age\" < 1e2":                         expressions.NewOperatorExpression(constants.FieldTypeNumber, "age", constants.OperatorLessThan, 100.0),
+		"`Age` <= 65":                           expressions.NewOperatorExpression(constants.FieldTypeNumber, "age", constants.OperatorLessThanOrEqual, 65.0),
+		"enabled = TRUE":                        expressions.NewOperatorExpression(constants.FieldTypeBoolean, "enabled", constants.OperatorEqual, true),
+		"name = 'O''Brien'":                     expressions.NewOperatorExpression(constants.FieldTypeString, "name", constants.OperatorEqual, "O'Brien"),
+		"name IS NULL":                          expressions.NewOperatorExpression(constants.FieldTypeString, "name", constants.OperatorIsNull, ""),
+		"name is not null":                      expressions.NewOperatorExpression(constants.FieldTypeString, "name", constants.OperatorIsNotNull, ""),
+		"name LIKE '%fil%'":                     expressions.NewCollatedOperatorExpression(constants.FieldTypeString, "name", constants.OperatorContain, "fil", constants.CollationCaseSensitive),
+		"name ILIKE 'fil%'":                     expressions.NewCollatedOperatorExpression(constants.FieldTypeString, "name", constants.OperatorStartWith, "fil", constants.CollationCaseInsensitive),
+		"name LIKE '%tex'":                      expressions.NewCollatedOperatorExpression(constants.FieldTypeString, "name", constants.OperatorEndWith, "tex", constants.CollationCaseSensitive),
+		"name LIKE 'filtex'":                    expressions.NewCollatedOperatorExpression(constants.FieldTypeString, "name", constants.OperatorEqual, "filtex", constants.CollationCaseSensitive),
+		"name LIKE '100\\%%'":                   expressions.NewCollatedOperatorExpression(constants.FieldTypeString, "name", constants.OperatorStartWith, "100%", constants.CollationCaseSensitive),
+		"name LIKE 'a\\%'":                      expressions.NewCollatedOperatorExpression(constants.FieldTypeString, "name", constants.OperatorEqual, "a%", constants.CollationCaseSensitive),
+		"name ILIKE 'a\\_b%'":                   expressions.NewCollatedOperatorExpression(constants.FieldTypeString, "name", constants.OperatorStartWith, "a_b", constants.CollationCaseInsensitive),
+		"name NOT LIKE '%fil%'":                 expressions.NewCollatedOperatorExpression(constants.FieldTypeString, "name", constants.OperatorNotContain, "fil", constants.CollationCaseSensitive),
+		"status IN ('active', 'Passive')":       expressions.NewOperatorExpression(constants.FieldTypeString, "status", constants.OperatorIn, []interface{}{"active", "passive"}),
+		"status NOT IN ('active')":              expressions.NewOperatorExpression(constants.FieldTypeString, "status", constants.OperatorNotIn, []interface{}{"active"}),
+		"[Created At] >= '2024-01-02 03:04:05'": expressions.NewOperatorExpression(constants.FieldTypeDateTime, "createdAt", constants.OperatorGreaterThanOrEqual, &createdAt),
+		"NOT (status = 'active')":               expressions.NewOperatorExpression(constants.FieldTypeString, "status", constants.OperatorNotEqual, "active"),
+		"((age > 18))":                          expressions.NewOperatorExpression(constants.FieldTypeNumber, "age", constants.OperatorGreaterThan, 18.0),
+	}
+
+	for query, expected := range sampleMap {
+		// Act
+		result, err := NewSqlQueryParser(newImportTestMetadata()).Parse(query)
+
+		// Assert
+		assert.NoError(t, err, query)
+		assert.Equal(t, expected, result, query)
+	}
+}
+
+func TestSqlQueryParser_Parse_ShouldReturnLogicExpression_WhenQueryHasLogic(t *testing.T) {
+	// Arrange
+	sampleMap := map[string]expressions.Expression{
+		"status = 'active' AND (age > 18 OR name LIKE 'fil%') AND enabled = true": expressions.NewLogicExpression(constants.LogicAnd, []expressions.Expression{
+			expressions.NewOperatorExpression(constants.FieldTypeString, "status", constants.OperatorEqual, "active"),
+			expressions.NewLogicExpression(constants.LogicOr, []expressions.Expression{
+				expressions.NewOperatorExpression(constants.FieldTypeNumber, "age", constants.OperatorGreaterThan, 18.0),
+				expressions.NewCollatedOperatorExpression(constants.FieldTypeString, "name", constants.OperatorStartWith, "fil", constants.CollationCaseSensitive),
+			}),
+			expressions.NewOperatorExpression(constants.FieldTypeBoolean, "enabled", constants.OperatorEqual, true),
+		}),
+		"age BETWEEN 18 AND 65": expressions.NewLogicExpression(constants.LogicAnd, []expressions.Expression{
+			expressions.NewOperatorExpression(constants.FieldTypeNumber, "age", constants.OperatorGreaterThanOrEqual, 18.0),
+			expressions.NewOperatorExpression(constants.FieldTypeNumber, "age", constants.OperatorLessThanOrEqual, 65.0),
+		}),
+		"age NOT BETWEEN 18 AND 65": expressions.NewLogicExpression(constants.LogicOr, []expressions.Expression{
+			expressions.NewOperatorExpression(constants.FieldTypeNumber, "age", constants.OperatorLessThan, 18.0),
+			expressions.NewOperatorExpression(constants.FieldTypeNumber, "age", constants.OperatorGreaterThan, 65.0),
+		}),
+		"NOT (age >= 18 AND name IS NULL)": expressions.NewLogicExpression(constants.LogicOr, []expressions.Expression{
+			expressions.NewOperatorExpression(constants.FieldTypeNumber, "age", constants.OperatorLessThan, 18.0),
+			expressions.NewOperatorExpression(constants.FieldTypeString, "name", constants.OperatorIsNotNull, ""),
+		}),
+	}
+
+	for query, expected := range sampleMap {
+		// Act
+		result, err := NewSqlQueryParser(newImportTestMetadata()).Parse(query)
+
+		// Assert
+		assert.NoError(t, err, query)
+		assert.Equal(t, expected, result, query)
+	}
+}
+
+func TestSqlQueryParser_Parse_ShouldReturnError_WhenQueryIsNotValid(t *testing.T) {
+	// Arrange
+	samples := []string{
+		"",
+		"WHERE",
+		"age",
+		"age >",
+		"age > 18 AND",
+		"(age > 18",
+		"age > 18)",
+		"name = 'unterminated",
+		"name IS 'x'",
+		"name NOT = 'x'",
+		"status IN 'active'",
+		"status IN ('active'",
+		"age BETWEEN 18",
+		"AND = 1",
+	}
+
+	for _, query := range samples {
+		// Act
+		result, err := NewSqlQueryParser(newImportTestMetadata()).Parse(query)
+
+		// Assert
+		assert.Nil(t, result, query)
+		assert.Error(t, err, query)
+	}
+}
+
+func TestSqlQueryParser_Parse_ShouldReturnImportError_WhenConstructIsNotSupported(t *testing.T) {
+	// Arrange
+	sampleMap := map[string]string{
+		"age = $1":           "$",
+		"age = ?":            "?",
+		"age::int = 1":       ":",
+		"age + 1 > 2":        "+",
+		"lower(name) = 'x'":  "lower()",
+		"18 < age":           "18",
+		"'x' = name":         "x",
+		"age = other_age":    "other_age",
+		"name = NULL":        "= NULL",
+		"name LIKE 'a%b'":    "LIKE 'a%b'",
+		"name LIKE 'a_c'":    "LIKE 'a_c'",
+		"name LIKE '%'":      "LIKE '%'",
+		"status = 'active';": ";",
+		"age = 1.2.3":        "1.2.3",
+	}
+
+	for query, construct := range sampleMap {
+		// Act
+		result, err := NewSqlQueryParser(newImportTestMetadata()).Parse(query)
+
+		// Assert
+		var importError *errors.ImportError
+		assert.Nil(t, result, query)
+		assert.True(t, goErrors.As(err, &importError), query)
+		assert.Equal(t, construct, importError.Construct, query)
+	}
+}
+
+func TestSqlQueryParser_Parse_ShouldReturnQueryError_WhenFieldOrValueIsNotValid(t *testing.T) {
+	// Arrange
+	sampleMap := map[string]string{
+		"unknown = 1":          "unknown",
+		"p.status = 'active'":  "p.status",
+		"age > 'abc'":          "age",
+		"status = 'deleted'":   "status",
+		"age LIKE '%1%'":       "age",
+		"enabled != true":      "enabled",
+		"NOT (enabled = true)": "enabled",
+	}
+
+	for query, field := range sampleMap {
+		// Act
+		result, err := NewSqlQueryParser(newImportTestMetadata()).Parse(query)
+
+		// Assert
+		var queryError *errors.QueryError
+		assert.Nil(t, result, query)
+		assert.True(t, goErrors.As(err, &queryError), query)
+		assert.Equal(t, field, queryError.Field, query)
+	}
+}
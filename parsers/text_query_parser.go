@@ -42,8 +42,28 @@ func (p *TextQueryParser) parseTokens(queue *[]models.Token, result []interface{
 		}
 
 		if token.Type.IsFieldTokenType() {
-			result = append(result, token)
+			if len(result) > 0 {
+				condition := p.parseTokens(queue, []interface{}{token}, true)
+				result = []interface{}{
+					models.Token{
+						Type:  constants.TokenTypeAnd,
+						Value: string(constants.LogicAnd),
+					},
+					[]interface{}{
+						result,
+						condition,
+					},
+				}
+			} else {
+				result = append(result, token)
+			}
 		} else if token.Type.IsComparerTokenType() {
+			if token.Type == constants.TokenTypeSearch && len(result) == 0 {
+				result = append(result, models.Token{
+					Type:  constants.TokenTypeField,
+					Value: "",
+				})
+			}
 			result = append(result, token)
 		} else if token.Type.IsNotComparerTokenType() {
 			result = append(result, token)
@@ -52,6 +72,25 @@ func (p *TextQueryParser) parseTokens(queue *[]models.Token, result []interface{
 				Value: "",
 			})
 
+			if isValueExpected {
+				return result
+			}
+		} else if token.Type.IsFreeTextTokenType() {
+			if len(result) > 0 {
+				result = []interface{}{
+					models.Token{
+						Type:  constants.TokenTypeAnd,
+						Value: string(constants.LogicAnd),
+					},
+					[]interface{}{
+						result,
+						[]interface{}{token},
+					},
+				}
+			} else {
+				result = append(result, token)
+			}
+
 			if isValueExpected {
 				return result
 			}
@@ -64,7 +103,7 @@ func (p *TextQueryParser) parseTokens(queue *[]models.Token, result []interface{
 				result = append(result, token)
 			}
 
-			if isValueExpected {
+			if isValueExpected && !p.isSeparatorNext(queue) {
 				return result
 			}
 		} else if token.Type.IsLogicTokenType() {
@@ -101,7 +140,23 @@ func (p *TextQueryParser) parseTokens(queue *[]models.Token, result []interface{
 	return result
 }
 
+func (p *TextQueryParser) isSeparatorNext(queue *[]models.Token) bool {
+	for _, v := range *queue {
+		if v.Type != constants.TokenTypeSpace {
+			return v.Type.IsSeparatorTokenType()
+		}
+	}
+
+	return false
+}
+
 func (p *TextQueryParser) parseExpression(data []interface{}) (expressions.Expression, error) {
+	if len(data) == 1 {
+		if token, ok := data[0].(models.Token); ok && token.Type.IsFreeTextTokenType() {
+			return newFreeTextExpression(p.metadata, token.Value)
+		}
+	}
+
 	if len(data) == 3 {
 		fieldToken := data[0].(models.Token)
 		operatorToken := data[1].(models.Token)
@@ -123,11 +178,21 @@ func (p *TextQueryParser) parseExpression(data []interface{}) (expressions.Expre
 			return nil, errors.NewOperatorCouldNotBeParsedError()
 		}
 
-		return expressions.NewOperatorExpression(
-			p.metadata.GetFieldType(fieldToken.Value.(string)),
-			p.metadata.GetFieldName(fieldToken.Value.(string)),
-			operator,
-			value), nil
+		if valueToken, ok := data[2].(models.Token); ok && valueToken.Type.IsNullTokenType() {
+			nullOperator, err := parseNullOperator(operator)
+			if err != nil {
+				return nil, err
+			}
+
+			operator = nullOperator
+			value = ""
+		}
+
+		if operator == constants.OperatorSearch {
+			return newSearchExpression(p.metadata, fieldToken.Value.(string), value)
+		}
+
+		return newOperatorExpression(p.metadata, fieldToken.Value.(string), operator, value), nil
 	}
 
 	if len(data) == 2 {
@@ -384,6 +384,41 @@ func TestTextQueryParser_ShouldReturnLogicExpression_WhenQueryHasLogic(t *testin
 	}
 }
 
+func TestTextQueryParser_ShouldReturnLogicExpression_WhenLastConditionHasMultipleValues(t *testing.T) {
+	// Arrange
+	metadata := models.Metadata{
+		Fields: []models.Field{
+			{
+				Name:  "Value1",
+				Type:  constants.FieldTypeString.String(),
+				Label: "Value1",
+				Operators: []string{
+					constants.OperatorEqual.String(),
+				},
+			},
+			{
+				Name:  "Value2",
+				Type:  constants.FieldTypeNumber.String(),
+				Label: "Value2",
+				Operators: []string{
+					constants.OperatorIn.String(),
+				},
+			},
+		},
+	}
+	textQueryParser := NewTextQueryParser(&metadata, tokenizers.NewTextQueryTokenizer(&metadata))
+
+	// Act
+	expression, err := textQueryParser.Parse("Value1 Equal Test1 And Value2 In 1, 2")
+
+	// Assert
+	assert.NoError(t, err)
+	assert.Equal(t, expressions.NewLogicExpression(constants.LogicAnd, []expressions.Expression{
+		expressions.NewOperatorExpression(constants.FieldTypeString, "Value1", constants.OperatorEqual, "Test1"),
+		expressions.NewOperatorExpression(constants.FieldTypeNumber, "Value2", constants.OperatorIn, []interface{}{float64(1), float64(2)}),
+	}), expression)
+}
+
 func TestTextQueryParser_ShouldReturnOperatorExpression_WhenQueryDoesNotHaveLogic(t *testing.T) {
 	// Arrange
 	queryMap := map[string][]models.Token{
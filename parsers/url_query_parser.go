@@ -0,0 +1,186 @@
+package parsers
+
+import (
+	"context"
+	"net/url"
+	"sort"
+	"strings"
+
+	"github.com/filtex/filtex-go/constants"
+	"github.com/filtex/filtex-go/errors"
+	"github.com/filtex/filtex-go/expressions"
+	"github.com/filtex/filtex-go/models"
+	"github.com/filtex/filtex-go/queries"
+	"github.com/filtex/filtex-go/utils"
+)
+
+type UrlQueryParser struct {
+	metadata *models.Metadata
+	ctx      context.Context
+	style    constants.UrlStyle
+	ignored  map[string]bool
+}
+
+var (
+	urlNegations = map[constants.Operator]constants.Operator{
+		constants.OperatorBlank:     constants.OperatorNotBlank,
+		constants.OperatorNotBlank:  constants.OperatorBlank,
+		constants.OperatorIsNull:    constants.OperatorIsNotNull,
+		constants.OperatorIsNotNull: constants.OperatorIsNull,
+	}
+)
+
+func NewUrlQueryParser(metadata *models.Metadata) *UrlQueryParser {
+	return &UrlQueryParser{
+		metadata: metadata,
+		ctx:      context.Background(),
+		style:    constants.UrlStyleBracket,
+		ignored:  make(map[string]bool),
+	}
+}
+
+func (p *UrlQueryParser) Context(ctx context.Context) *UrlQueryParser {
+	p.ctx = ctx
+	return p
+}
+
+func (p *UrlQueryParser) Style(style constants.UrlStyle) *UrlQueryParser {
+	p.style = style
+	return p
+}
+
+func (p *UrlQueryParser) Ignore(keys ...string) *UrlQueryParser {
+	for _, v := range keys {
+		p.ignored[strings.ToLower(v)] = true
+	}
+	return p
+}
+
+func (p *UrlQueryParser) Parse(values url.Values) (expressions.Expression, error) {
+	if p.style != constants.UrlStyleBracket && p.style != constants.UrlStyleColon {
+		return nil, errors.NewCouldNotBeParsedError()
+	}
+
+	keys := make([]string, 0)
+
+	for k := range values {
+		if !p.ignored[strings.ToLower(k)] {
+			keys = append(keys, k)
+		}
+	}
+
+	sort.Strings(keys)
+
+	builder := queries.NewQueryBuilder(p.metadata).Context(p.ctx)
+	list := make([]*queries.Query, 0)
+
+	for _, k := range keys {
+		field, code := p.style.SplitKey(k)
+
+		if p.ignored[strings.ToLower(field)] {
+			continue
+		}
+
+		operator := constants.OperatorEqual
+		if code != "" {
+			operator = constants.ParseUrlOperator(code)
+		}
+
+		if operator == constants.OperatorUnknown {
+			return nil, errors.NewOperatorCouldNotBeParsedError()
+		}
+
+		items, err := p.parseValues(builder, field, operator, values[k])
+		if err != nil {
+			return nil, err
+		}
+
+		list = append(list, items...)
+	}
+
+	if len(list) == 0 {
+		return nil, errors.NewCouldNotBeParsedError()
+	}
+
+	if len(list) == 1 {
+		return list[0].Build()
+	}
+
+	return builder.And(list...).Build()
+}
+
+func (p *UrlQueryParser) parseValues(builder *queries.QueryBuilder, field string, operator constants.Operator, values []string) ([]*queries.Query, error) {
+	if operator == constants.OperatorIn || operator == constants.OperatorNotIn {
+		items := make([]interface{}, 0)
+
+		for _, v := range values {
+			for _, item := range strings.Split(v, ",") {
+				if item = strings.TrimSpace(item); item != "" {
+					items = append(items, item)
+				}
+			}
+		}
+
+		if p.metadata != nil && p.metadata.GetFieldType(field).IsArray() && len(items) > 0 {
+			return p.parseArrayValues(builder, field, operator, items), nil
+		}
+
+		return []*queries.Query{builder.Where(field).Operator(operator, items)}, nil
+	}
+
+	if negation, ok := urlNegations[operator]; ok {
+		list := make([]*queries.Query, 0)
+
+		for _, v := range values {
+			flag := true
+
+			if strings.TrimSpace(v) != "" {
+				b, err := utils.Boolean(v)
+				if err != nil {
+					return nil, errors.NewCouldNotBeParsedError()
+				}
+				flag = b
+			}
+
+			if flag {
+				list = append(list, builder.Where(field).Operator(operator, ""))
+			} else {
+				list = append(list, builder.Where(field).Operator(negation, ""))
+			}
+		}
+
+		return list, nil
+	}
+
+	list := make([]*queries.Query, 0)
+
+	for _, v := range values {
+		list = append(list, builder.Where(field).Operator(operator, v))
+	}
+
+	return list, nil
+}
+
+func (p *UrlQueryParser) parseArrayValues(builder *queries.QueryBuilder, field string, operator constants.Operator, items []interface{}) []*queries.Query {
+	if operator == constants.OperatorNotIn {
+		list := make([]*queries.Query, 0)
+
+		for _, v := range items {
+			list = append(list, builder.Where(field).NotContain(v))
+		}
+
+		return list
+	}
+
+	if len(items) == 1 {
+		return []*queries.Query{builder.Where(field).Contain(items[0])}
+	}
+
+	list := make([]*queries.Query, 0)
+
+	for _, v := range items {
+		list = append(list, builder.Where(field).Contain(v))
+	}
+
+	return []*queries.Query{builder.Or(list...)}
+}
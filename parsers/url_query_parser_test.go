@@ -0,0 +1,174 @@
+package parsers
+
+import (
+	goErrors "errors"
+	"net/url"
+	"testing"
+	"time"
+
+	"github.com/filtex/filtex-go/constants"
+	"github.com/filtex/filtex-go/errors"
+	"github.com/filtex/filtex-go/expressions"
+	"github.com/filtex/filtex-go/models"
+	"github.com/filtex/filtex-go/options"
+	"github.com/stretchr/testify/assert"
+)
+
+func newUrlTestMetadata() *models.Metadata {
+	lookups := map[string][]models.Lookup{
+		"status": {
+			{Name: "Active", Value: "active"},
+			{Name: "Passive", Value: "passive"},
+		},
+	}
+	fieldOptions := []*options.FieldOption{
+		options.NewFieldOption().String().Name("status").Label("Status").Lookup("status"),
+		options.NewFieldOption().String().Name("name").Label("Name").Nullable(),
+		options.NewFieldOption().Number().Name("age").Label("Age"),
+		options.NewFieldOption().String().Array().Name("tags").Label("Tags"),
+		options.NewFieldOption().Date().Name("createdAt").Label("Created At"),
+	}
+	metadata := &models.Metadata{
+		Fields: make([]models.Field, 0),
+	}
+
+	for _, v := range fieldOptions {
+		field, _ := v.Build(lookups)
+		metadata.Fields = append(metadata.Fields, *field)
+	}
+
+	return metadata
+}
+
+func TestUrlQueryParser_Parse_ShouldReturnOperatorExpression_WhenQueryHasSingleCondition(t *testing.T) {
+	// Arrange
+	date := time.Date(2024, 1, 2, 0, 0, 0, 0, time.UTC)
+	sampleMap := map[string]expressions.Expression{
+		"status=Active":      expressions.NewOperatorExpression(constants.FieldTypeString, "status", constants.OperatorEqual, "active"),
+		"Status[eq]=passive": expressions.NewOperatorExpression(constants.FieldTypeString, "status", constants.OperatorEqual, "passive"),
+		"status[ne]=active":  expressions.NewOperatorExpression(constants.FieldTypeString, "status", constants.OperatorNotEqual, "active"),
+		"age[gte]=18":        expressions.NewOperatorExpression(constants.FieldTypeNumber, "age", constants.OperatorGreaterThanOrEqual, 18.0),
+		"age[LT]=65.5":       expressions.NewOperatorExpression(constants.FieldTypeNumber, "age", constants.OperatorLessThan, 65.5),
+		"name[contains]=fil": expressions.NewOperatorExpression(constants.FieldTypeString, "name", constants.OperatorContain, "fil"),
+		"name[null]":         expressions.NewOperatorExpression(constants.FieldTypeString, "name", constants.OperatorIsNull, ""),
+		"name[null]=false":   expressions.NewOperatorExpression(constants.FieldTypeString, "name", constants.OperatorIsNotNull, ""),
+		"name[nnull]=true":   expressions.NewOperatorExpression(constants.FieldTypeString, "name", constants.OperatorIsNotNull, ""),
+		"tags[in]=a":         expressions.NewOperatorExpression(constants.FieldTypeStringArray, "tags", constants.OperatorContain, "a"),
+		"tags[in]=a,b": expressions.NewLogicExpression(constants.LogicOr, []expressions.Expression{
+			expressions.NewOperatorExpression(constants.FieldTypeStringArray, "tags", constants.OperatorContain, "a"),
+			expressions.NewOperatorExpression(constants.FieldTypeStringArray, "tags", constants.OperatorContain, "b"),
+		}),
+		"tags[in]=a&tags[in]=b,c": expressions.NewLogicExpression(constants.LogicOr, []expressions.Expression{
+			expressions.NewOperatorExpression(constants.FieldTypeStringArray, "tags", constants.OperatorContain, "a"),
+			expressions.NewOperatorExpression(constants.FieldTypeStringArray, "tags", constants.OperatorContain, "b"),
+			expressions.NewOperatorExpression(constants.FieldTypeStringArray, "tags", constants.OperatorContain, "c"),
+		}),
+		"tags[nin]=a":                  expressions.NewOperatorExpression(constants.FieldTypeStringArray, "tags", constants.OperatorNotContain, "a"),
+		"status[nin]=Active":           expressions.NewOperatorExpression(constants.FieldTypeString, "status", constants.OperatorNotIn, []interface{}{"active"}),
+		"createdAt[gte]=2024-01-02":    expressions.NewOperatorExpression(constants.FieldTypeDate, "createdAt", constants.OperatorGreaterThanOrEqual, &date),
+		"Created%20At[gte]=2024-01-02": expressions.NewOperatorExpression(constants.FieldTypeDate, "createdAt", constants.OperatorGreaterThanOrEqual, &date),
+	}
+
+	for query, expected := range sampleMap {
+		values, _ := url.ParseQuery(query)
+
+		// Act
+		result, err := NewUrlQueryParser(newUrlTestMetadata()).Parse(values)
+
+		// Assert
+		assert.NoError(t, err, query)
+		assert.Equal(t, expected, result, query)
+	}
+}
+
+func TestUrlQueryParser_Parse_ShouldReturnAndExpression_WhenQueryHasMultipleConditions(t *testing.T) {
+	// Arrange
+	values, _ := url.ParseQuery("status=active&age[gte]=18&tags[in]=a,b&page=2&sort=name")
+
+	// Act
+	result, err := NewUrlQueryParser(newUrlTestMetadata()).Ignore("page", "Sort").Parse(values)
+
+	// Assert
+	assert.NoError(t, err)
+	assert.Equal(t, expressions.NewLogicExpression(constants.LogicAnd, []expressions.Expression{
+		expressions.NewOperatorExpression(constants.FieldTypeNumber, "age", constants.OperatorGreaterThanOrEqual, 18.0),
+		expressions.NewOperatorExpression(constants.FieldTypeString, "status", constants.OperatorEqual, "active"),
+		expressions.NewLogicExpression(constants.LogicOr, []expressions.Expression{
+			expressions.NewOperatorExpression(constants.FieldTypeStringArray, "tags", constants.OperatorContain, "a"),
+			expressions.NewOperatorExpression(constants.FieldTypeStringArray, "tags", constants.OperatorContain, "b"),
+		}),
+	}), result)
+}
+
+func TestUrlQueryParser_Parse_ShouldUseColonStyle_WhenStyleIsColon(t *testing.T) {
+	// Arrange
+	values, _ := url.ParseQuery("age:gte=18&age:lt=65")
+
+	// Act
+	result, err := NewUrlQueryParser(newUrlTestMetadata()).Style(constants.UrlStyleColon).Parse(values)
+
+	// Assert
+	assert.NoError(t, err)
+	assert.Equal(t, expressions.NewLogicExpression(constants.LogicAnd, []expressions.Expression{
+		expressions.NewOperatorExpression(constants.FieldTypeNumber, "age", constants.OperatorGreaterThanOrEqual, 18.0),
+		expressions.NewOperatorExpression(constants.FieldTypeNumber, "age", constants.OperatorLessThan, 65.0),
+	}), result)
+}
+
+func TestUrlQueryParser_Parse_ShouldReturnError_WhenQueryIsNotValid(t *testing.T) {
+	// Arrange
+	samples := []string{
+		"",
+		"page=1",
+		"age[between]=1",
+		"age:gte=18",
+		"name[null]=maybe",
+		"tags[in]=",
+	}
+
+	for _, query := range samples {
+		values, _ := url.ParseQuery(query)
+
+		// Act
+		result, err := NewUrlQueryParser(newUrlTestMetadata()).Ignore("page").Parse(values)
+
+		// Assert
+		assert.Nil(t, result, query)
+		assert.Error(t, err, query)
+	}
+}
+
+func TestUrlQueryParser_Parse_ShouldReturnError_WhenStyleIsNotValid(t *testing.T) {
+	// Arrange
+	values, _ := url.ParseQuery("age=18")
+
+	// Act
+	result, err := NewUrlQueryParser(newUrlTestMetadata()).Style(constants.UrlStyleUnknown).Parse(values)
+
+	// Assert
+	assert.Nil(t, result)
+	assert.Error(t, err)
+}
+
+func TestUrlQueryParser_Parse_ShouldReturnQueryError_WhenFieldOrValueIsNotValid(t *testing.T) {
+	// Arrange
+	sampleMap := map[string]string{
+		"unknown=1":       "unknown",
+		"age[gte]=abc":    "age",
+		"status=deleted":  "status",
+		"age[contains]=1": "age",
+	}
+
+	for query, field := range sampleMap {
+		values, _ := url.ParseQuery(query)
+
+		// Act
+		result, err := NewUrlQueryParser(newUrlTestMetadata()).Parse(values)
+
+		// Assert
+		var queryError *errors.QueryError
+		assert.Nil(t, result, query)
+		assert.True(t, goErrors.As(err, &queryError), query)
+		assert.Equal(t, field, queryError.Field, query)
+	}
+}
@@ -0,0 +1,197 @@
+package queries
+
+import (
+	"strings"
+
+	"github.com/filtex/filtex-go/constants"
+	"github.com/filtex/filtex-go/errors"
+	"github.com/filtex/filtex-go/expressions"
+	"github.com/filtex/filtex-go/models"
+)
+
+type FieldQuery struct {
+	builder   *QueryBuilder
+	name      string
+	field     *models.Field
+	collation constants.Collation
+	err       error
+}
+
+func (f *FieldQuery) Collation(collation constants.Collation) *FieldQuery {
+	f.collation = collation
+	return f
+}
+
+func (f *FieldQuery) Eq(value interface{}) *Query {
+	return f.Operator(constants.OperatorEqual, value)
+}
+
+func (f *FieldQuery) Ne(value interface{}) *Query {
+	return f.Operator(constants.OperatorNotEqual, value)
+}
+
+func (f *FieldQuery) Gt(value interface{}) *Query {
+	return f.Operator(constants.OperatorGreaterThan, value)
+}
+
+func (f *FieldQuery) Gte(value interface{}) *Query {
+	return f.Operator(constants.OperatorGreaterThanOrEqual, value)
+}
+
+func (f *FieldQuery) Lt(value interface{}) *Query {
+	return f.Operator(constants.OperatorLessThan, value)
+}
+
+func (f *FieldQuery) Lte(value interface{}) *Query {
+	return f.Operator(constants.OperatorLessThanOrEqual, value)
+}
+
+func (f *FieldQuery) Contain(value interface{}) *Query {
+	return f.Operator(constants.OperatorContain, value)
+}
+
+func (f *FieldQuery) NotContain(value interface{}) *Query {
+	return f.Operator(constants.OperatorNotContain, value)
+}
+
+func (f *FieldQuery) StartWith(value interface{}) *Query {
+	return f.Operator(constants.OperatorStartWith, value)
+}
+
+func (f *FieldQuery) NotStartWith(value interface{}) *Query {
+	return f.Operator(constants.OperatorNotStartWith, value)
+}
+
+func (f *FieldQuery) EndWith(value interface{}) *Query {
+	return f.Operator(constants.OperatorEndWith, value)
+}
+
+func (f *FieldQuery) NotEndWith(value interface{}) *Query {
+	return f.Operator(constants.OperatorNotEndWith, value)
+}
+
+func (f *FieldQuery) Blank() *Query {
+	return f.Operator(constants.OperatorBlank, nil)
+}
+
+func (f *FieldQuery) NotBlank() *Query {
+	return f.Operator(constants.OperatorNotBlank, nil)
+}
+
+func (f *FieldQuery) IsNull() *Query {
+	return f.Operator(constants.OperatorIsNull, nil)
+}
+
+func (f *FieldQuery) IsNotNull() *Query {
+	return f.Operator(constants.OperatorIsNotNull, nil)
+}
+
+func (f *FieldQuery) In(values ...interface{}) *Query {
+	return f.Operator(constants.OperatorIn, values)
+}
+
+func (f *FieldQuery) NotIn(values ...interface{}) *Query {
+	return f.Operator(constants.OperatorNotIn, values)
+}
+
+func (f *FieldQuery) InSubnet(value interface{}) *Query {
+	return f.Operator(constants.OperatorInSubnet, value)
+}
+
+func (f *FieldQuery) NotInSubnet(value interface{}) *Query {
+	return f.Operator(constants.OperatorNotInSubnet, value)
+}
+
+func (f *FieldQuery) Within(value interface{}) *Query {
+	return f.Operator(constants.OperatorWithin, value)
+}
+
+func (f *FieldQuery) Search(value string) *Query {
+	return f.Operator(constants.OperatorSearch, value)
+}
+
+func (f *FieldQuery) Operator(operator constants.Operator, value interface{}) *Query {
+	if f.err != nil {
+		return &Query{err: f.err}
+	}
+
+	if f.field == nil {
+		return &Query{err: errors.NewUnknownQueryFieldError(f.name)}
+	}
+
+	if value == nil && operator == constants.OperatorEqual {
+		operator = constants.OperatorIsNull
+	} else if value == nil && operator == constants.OperatorNotEqual {
+		operator = constants.OperatorIsNotNull
+	}
+
+	if !f.isOperatorAllowed(operator) {
+		return &Query{err: errors.NewUnsupportedOperatorError(f.field.Name, operator.String())}
+	}
+
+	var castedValue interface{}
+
+	if operator == constants.OperatorBlank ||
+		operator == constants.OperatorNotBlank ||
+		operator == constants.OperatorIsNull ||
+		operator == constants.OperatorIsNotNull {
+		castedValue = ""
+	} else if operator == constants.OperatorIn || operator == constants.OperatorNotIn {
+		values, ok := value.([]interface{})
+		if !ok || len(values) == 0 {
+			return &Query{err: errors.NewInvalidQueryValueError(f.field.Name, operator.String())}
+		}
+
+		items := make([]interface{}, 0)
+
+		for _, v := range values {
+			item, ok := f.builder.castValue(f.field, v)
+			if !ok {
+				return &Query{err: errors.NewInvalidQueryValueError(f.field.Name, operator.String())}
+			}
+			items = append(items, item)
+		}
+
+		castedValue = items
+	} else {
+		item, ok := f.builder.castValue(f.field, value)
+		if !ok {
+			return &Query{err: errors.NewInvalidQueryValueError(f.field.Name, operator.String())}
+		}
+
+		castedValue = item
+	}
+
+	if operator == constants.OperatorSearch {
+		text, ok := castedValue.(string)
+		if !ok || text == "" {
+			return &Query{err: errors.NewInvalidQueryValueError(f.field.Name, operator.String())}
+		}
+
+		return &Query{expression: expressions.NewSearchExpression([]string{f.field.Name}, text)}
+	}
+
+	collation := constants.ParseCollation(f.field.Collation)
+	if f.collation != constants.CollationDefault {
+		collation = f.collation
+	}
+
+	return &Query{
+		expression: expressions.NewCollatedOperatorExpression(
+			constants.FieldType(f.field.Type),
+			f.field.Name,
+			operator,
+			castedValue,
+			collation),
+	}
+}
+
+func (f *FieldQuery) isOperatorAllowed(operator constants.Operator) bool {
+	for _, v := range f.field.Operators {
+		if strings.ToLower(v) == strings.ToLower(operator.String()) {
+			return true
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,70 @@
+package queries
+
+import (
+	"github.com/filtex/filtex-go/constants"
+	"github.com/filtex/filtex-go/errors"
+	"github.com/filtex/filtex-go/expressions"
+)
+
+type Query struct {
+	expression expressions.Expression
+	err        error
+}
+
+func newLogicQuery(logic constants.Logic, queries []*Query) *Query {
+	list := make([]expressions.Expression, 0)
+
+	for _, v := range queries {
+		if v == nil {
+			continue
+		}
+
+		if v.err != nil {
+			return &Query{err: v.err}
+		}
+
+		if v.expression == nil {
+			continue
+		}
+
+		if logicExpression, ok := v.expression.(*expressions.LogicExpression); ok && logicExpression.Logic == logic {
+			list = append(list, logicExpression.Expressions...)
+		} else {
+			list = append(list, v.expression)
+		}
+	}
+
+	if len(list) == 0 {
+		return &Query{err: errors.NewEmptyQueryExpressionError()}
+	}
+
+	if len(list) == 1 {
+		return &Query{expression: list[0]}
+	}
+
+	return &Query{expression: expressions.NewLogicExpression(logic, list)}
+}
+
+func (q *Query) And(queries ...*Query) *Query {
+	return newLogicQuery(constants.LogicAnd, append([]*Query{q}, queries...))
+}
+
+func (q *Query) Or(queries ...*Query) *Query {
+	return newLogicQuery(constants.LogicOr, append([]*Query{q}, queries...))
+}
+
+func (q *Query) Err() error {
+	return q.err
+}
+
+func (q *Query) Build() (expressions.Expression, error) {
+	if q.err != nil {
+		return nil, q.err
+	}
+
+	if q.expression == nil {
+		return nil, errors.NewEmptyQueryExpressionError()
+	}
+
+	return q.expression, nil
+}
@@ -0,0 +1,151 @@
+package queries
+
+import (
+	"context"
+	"strings"
+
+	"github.com/filtex/filtex-go/constants"
+	"github.com/filtex/filtex-go/errors"
+	"github.com/filtex/filtex-go/expressions"
+	"github.com/filtex/filtex-go/models"
+	"github.com/filtex/filtex-go/utils"
+)
+
+type QueryBuilder struct {
+	metadata *models.Metadata
+	ctx      context.Context
+}
+
+func NewQueryBuilder(metadata *models.Metadata) *QueryBuilder {
+	return &QueryBuilder{
+		metadata: metadata,
+		ctx:      context.Background(),
+	}
+}
+
+func (b *QueryBuilder) Context(ctx context.Context) *QueryBuilder {
+	b.ctx = ctx
+	return b
+}
+
+func (b *QueryBuilder) Where(field string) *FieldQuery {
+	found := b.metadata.GetField(field)
+
+	if found != nil && found.Provider != nil {
+		values, err := found.Provider.Lookups(b.ctx)
+		if err != nil {
+			return &FieldQuery{
+				builder: b,
+				name:    field,
+				err:     err,
+			}
+		}
+
+		copied := *found
+		copied.Values = values
+		found = &copied
+	}
+
+	return &FieldQuery{
+		builder: b,
+		name:    field,
+		field:   found,
+	}
+}
+
+func (b *QueryBuilder) Search(value string) *Query {
+	fields := b.metadata.GetSearchFields()
+	if len(fields) == 0 {
+		return &Query{err: errors.NewUnsupportedOperatorError("", constants.OperatorSearch.String())}
+	}
+
+	if value == "" {
+		return &Query{err: errors.NewInvalidQueryValueError("", constants.OperatorSearch.String())}
+	}
+
+	return &Query{expression: expressions.NewSearchExpression(fields, value)}
+}
+
+func (b *QueryBuilder) And(queries ...*Query) *Query {
+	return newLogicQuery(constants.LogicAnd, queries)
+}
+
+func (b *QueryBuilder) Or(queries ...*Query) *Query {
+	return newLogicQuery(constants.LogicOr, queries)
+}
+
+func (b *QueryBuilder) castValue(field *models.Field, value interface{}) (interface{}, bool) {
+	if value == nil {
+		return nil, false
+	}
+
+	if len(field.Values) > 0 {
+		str, err := utils.String(value)
+		if err != nil {
+			return nil, false
+		}
+
+		found := false
+
+		for _, v := range field.Values {
+			vVal, _ := utils.String(v.Value)
+
+			if strings.ToLower(v.Name) == strings.ToLower(str) || strings.ToLower(vVal) == strings.ToLower(str) {
+				value = v.Value
+				found = true
+				break
+			}
+		}
+
+		if !found {
+			return nil, false
+		}
+	}
+
+	switch constants.FieldType(field.Type) {
+	case constants.FieldTypeString, constants.FieldTypeStringArray:
+		s, err := utils.String(value)
+		return s, err == nil
+	case constants.FieldTypeNumber, constants.FieldTypeNumberArray:
+		n, err := utils.Number(value)
+		return n, err == nil
+	case constants.FieldTypeInteger, constants.FieldTypeIntegerArray:
+		i, err := utils.Integer(value)
+		return i, err == nil
+	case constants.FieldTypeDecimal, constants.FieldTypeDecimalArray:
+		d, err := utils.Decimal(value)
+		return d, err == nil
+	case constants.FieldTypeBoolean, constants.FieldTypeBooleanArray:
+		b, err := utils.Boolean(value)
+		return b, err == nil
+	case constants.FieldTypeDate, constants.FieldTypeDateArray:
+		d, err := utils.DateIn(value, b.metadata.Location)
+		return d, err == nil && d != nil
+	case constants.FieldTypeTime, constants.FieldTypeTimeArray:
+		t, err := utils.Time(value)
+		return t, err == nil
+	case constants.FieldTypeDuration, constants.FieldTypeDurationArray:
+		d, err := utils.Duration(value)
+		return d, err == nil
+	case constants.FieldTypeDateTime, constants.FieldTypeDateTimeArray:
+		if r, err := utils.TimeRangeIn(value, b.metadata.Location); err == nil {
+			return r, true
+		}
+
+		dt, err := utils.DateTimeIn(value, b.metadata.Location)
+		return dt, err == nil && dt != nil
+	case constants.FieldTypeUUID, constants.FieldTypeUUIDArray:
+		u, err := utils.UUID(value)
+		return u, err == nil
+	case constants.FieldTypeEnum, constants.FieldTypeEnumArray:
+		return value, len(field.Values) > 0
+	case constants.FieldTypeIP, constants.FieldTypeIPArray:
+		ip, err := utils.IP(value)
+		return ip, err == nil
+	case constants.FieldTypeGeoPoint:
+		a, err := utils.GeoArea(value)
+		return a, err == nil
+	}
+
+	return nil, false
+}
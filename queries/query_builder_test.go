@@ -0,0 +1,434 @@
+package queries
+
+import (
+	"context"
+	goErrors "errors"
+	"testing"
+	"time"
+
+	"github.com/filtex/filtex-go/constants"
+	"github.com/filtex/filtex-go/errors"
+	"github.com/filtex/filtex-go/expressions"
+	"github.com/filtex/filtex-go/lookups"
+	"github.com/filtex/filtex-go/models"
+	"github.com/filtex/filtex-go/utils"
+	"github.com/stretchr/testify/assert"
+)
+
+func newTestMetadata() *models.Metadata {
+	return &models.Metadata{
+		Fields: []models.Field{
+			{
+				Name:  "name",
+				Type:  constants.FieldTypeString.String(),
+				Label: "Name",
+				Operators: []string{
+					constants.OperatorEqual.String(),
+					constants.OperatorNotEqual.String(),
+					constants.OperatorContain.String(),
+					constants.OperatorIn.String(),
+				},
+			},
+			{
+				Name:  "age",
+				Type:  constants.FieldTypeNumber.String(),
+				Label: "Age",
+				Operators: []string{
+					constants.OperatorEqual.String(),
+					constants.OperatorNotEqual.String(),
+					constants.OperatorGreaterThan.String(),
+					constants.OperatorIsNull.String(),
+					constants.OperatorIsNotNull.String(),
+				},
+			},
+			{
+				Name:  "createdAt",
+				Type:  constants.FieldTypeDate.String(),
+				Label: "Created At",
+				Operators: []string{
+					constants.OperatorGreaterThanOrEqual.String(),
+				},
+			},
+			{
+				Name:  "status",
+				Type:  constants.FieldTypeBoolean.String(),
+				Label: "Status",
+				Operators: []string{
+					constants.OperatorEqual.String(),
+				},
+				Values: []models.Lookup{
+					{Name: "Enabled", Value: true},
+					{Name: "Disabled", Value: false},
+				},
+			},
+			{
+				Name:  "id",
+				Type:  constants.FieldTypeUUID.String(),
+				Label: "Id",
+				Operators: []string{
+					constants.OperatorEqual.String(),
+				},
+			},
+			{
+				Name:  "address",
+				Type:  constants.FieldTypeIP.String(),
+				Label: "Address",
+				Operators: []string{
+					constants.OperatorEqual.String(),
+					constants.OperatorInSubnet.String(),
+				},
+			},
+			{
+				Name:  "location",
+				Type:  constants.FieldTypeGeoPoint.String(),
+				Label: "Location",
+				Operators: []string{
+					constants.OperatorWithin.String(),
+				},
+			},
+		},
+	}
+}
+
+func TestQueryBuilder_Where_ShouldReturnError_WhenFieldIsNotDefined(t *testing.T) {
+	// Arrange
+	builder := NewQueryBuilder(newTestMetadata())
+
+	// Act
+	expression, err := builder.Where("Unknown").Eq("Filtex").Build()
+
+	// Assert
+	var queryError *errors.QueryError
+	assert.Nil(t, expression)
+	assert.Error(t, err)
+	assert.True(t, goErrors.As(err, &queryError))
+	assert.Equal(t, "Unknown", queryError.Field)
+}
+
+func TestQueryBuilder_Where_ShouldReturnError_WhenOperatorIsNotAllowed(t *testing.T) {
+	// Arrange
+	builder := NewQueryBuilder(newTestMetadata())
+
+	// Act
+	expression, err := builder.Where("Age").Lt(10).Build()
+
+	// Assert
+	var queryError *errors.QueryError
+	assert.Nil(t, expression)
+	assert.True(t, goErrors.As(err, &queryError))
+	assert.Equal(t, "age", queryError.Field)
+	assert.Equal(t, constants.OperatorLessThan.String(), queryError.Operator)
+}
+
+func TestQueryBuilder_Where_ShouldReturnError_WhenValueIsNotValid(t *testing.T) {
+	// Arrange
+	builder := NewQueryBuilder(newTestMetadata())
+	samples := []*Query{
+		builder.Where("Age").Gt("many"),
+		builder.Where("Created At").Gte("yesterday"),
+		builder.Where("Status").Eq("Unknown"),
+		builder.Where("Name").In(),
+		builder.Where("Name").Eq(nil),
+	}
+
+	for _, v := range samples {
+		// Act
+		expression, err := v.Build()
+
+		// Assert
+		var queryError *errors.QueryError
+		assert.Nil(t, expression)
+		assert.True(t, goErrors.As(err, &queryError))
+	}
+}
+
+func TestQueryBuilder_Where_ShouldReturnOperatorExpression_WhenQueryIsValid(t *testing.T) {
+	// Arrange
+	builder := NewQueryBuilder(newTestMetadata())
+
+	// Act
+	expression, err := builder.Where("Age").Gt("18").Build()
+
+	// Assert
+	assert.NoError(t, err)
+	assert.Equal(t, expressions.NewOperatorExpression(constants.FieldTypeNumber, "age", constants.OperatorGreaterThan, float64(18)), expression)
+}
+
+func TestQueryBuilder_Where_ShouldUseCollation_WhenCollationIsSet(t *testing.T) {
+	// Arrange
+	builder := NewQueryBuilder(newTestMetadata())
+
+	// Act
+	expression, err := builder.Where("Name").Collation(constants.CollationCaseSensitive).Contain("Fil").Build()
+
+	// Assert
+	assert.NoError(t, err)
+	assert.Equal(t, expressions.NewCollatedOperatorExpression(constants.FieldTypeString, "name", constants.OperatorContain, "Fil", constants.CollationCaseSensitive), expression)
+}
+
+func TestQueryBuilder_Where_ShouldReturnNullOperatorExpression_WhenValueIsNil(t *testing.T) {
+	// Arrange
+	builder := NewQueryBuilder(newTestMetadata())
+	samples := map[*Query]expressions.Expression{
+		builder.Where("Age").Eq(nil):     expressions.NewOperatorExpression(constants.FieldTypeNumber, "age", constants.OperatorIsNull, ""),
+		builder.Where("Age").Ne(nil):     expressions.NewOperatorExpression(constants.FieldTypeNumber, "age", constants.OperatorIsNotNull, ""),
+		builder.Where("Age").IsNull():    expressions.NewOperatorExpression(constants.FieldTypeNumber, "age", constants.OperatorIsNull, ""),
+		builder.Where("Age").IsNotNull(): expressions.NewOperatorExpression(constants.FieldTypeNumber, "age", constants.OperatorIsNotNull, ""),
+	}
+
+	for k, v := range samples {
+		// Act
+		expression, err := k.Build()
+
+		// Assert
+		assert.NoError(t, err)
+		assert.Equal(t, v, expression)
+	}
+}
+
+func TestQueryBuilder_Where_ShouldCastValue_WhenFieldIsDate(t *testing.T) {
+	// Arrange
+	builder := NewQueryBuilder(newTestMetadata())
+
+	// Act
+	expression, err := builder.Where("createdAt").Gte("2024-01-05").Build()
+
+	// Assert
+	assert.NoError(t, err)
+	operatorExpression := expression.(*expressions.OperatorExpression)
+	assert.Equal(t, time.Date(2024, 1, 5, 0, 0, 0, 0, time.UTC), *operatorExpression.Value.(*time.Time))
+}
+
+func TestQueryBuilder_Where_ShouldResolveLookupValue_WhenFieldHasLookups(t *testing.T) {
+	// Arrange
+	builder := NewQueryBuilder(newTestMetadata())
+
+	// Act
+	expression, err := builder.Where("Status").Eq("Enabled").Build()
+
+	// Assert
+	assert.NoError(t, err)
+	assert.Equal(t, expressions.NewOperatorExpression(constants.FieldTypeBoolean, "status", constants.OperatorEqual, true), expression)
+}
+
+func TestQueryBuilder_Where_ShouldResolveProviderLookups_WhenFieldIsUsed(t *testing.T) {
+	// Arrange
+	calls := 0
+	metadata := newTestMetadata()
+	metadata.Fields[3].Values = nil
+	metadata.Fields[3].Provider = lookups.ProviderFunc(func(ctx context.Context) ([]models.Lookup, error) {
+		calls++
+		return []models.Lookup{{Name: "Enabled", Value: true}}, nil
+	})
+	builder := NewQueryBuilder(metadata)
+
+	// Act
+	_, nameErr := builder.Where("Name").Eq("filtex").Build()
+	expression, err := builder.Where("Status").Eq("Enabled").Build()
+
+	// Assert
+	assert.NoError(t, nameErr)
+	assert.NoError(t, err)
+	assert.Equal(t, expressions.NewOperatorExpression(constants.FieldTypeBoolean, "status", constants.OperatorEqual, true), expression)
+	assert.Equal(t, 1, calls)
+	assert.Nil(t, metadata.Fields[3].Values)
+}
+
+func TestQueryBuilder_Where_ShouldReturnError_WhenProviderFails(t *testing.T) {
+	// Arrange
+	metadata := newTestMetadata()
+	metadata.Fields[3].Provider = lookups.ProviderFunc(func(ctx context.Context) ([]models.Lookup, error) {
+		return nil, goErrors.New("unavailable")
+	})
+
+	// Act
+	expression, err := NewQueryBuilder(metadata).Where("Status").Eq("Enabled").Build()
+
+	// Assert
+	assert.Nil(t, expression)
+	assert.EqualError(t, err, "unavailable")
+}
+
+func TestQueryBuilder_Where_ShouldReturnArrayValue_WhenOperatorIsIn(t *testing.T) {
+	// Arrange
+	builder := NewQueryBuilder(newTestMetadata())
+
+	// Act
+	expression, err := builder.Where("Name").In("Filtex", "Go").Build()
+
+	// Assert
+	assert.NoError(t, err)
+	assert.Equal(t, expressions.NewOperatorExpression(constants.FieldTypeString, "name", constants.OperatorIn, []interface{}{"Filtex", "Go"}), expression)
+}
+
+func TestQueryBuilder_Where_ShouldNormalizeValue_WhenFieldIsUUID(t *testing.T) {
+	// Arrange
+	builder := NewQueryBuilder(newTestMetadata())
+
+	// Act
+	expression, err := builder.Where("Id").Eq("3F2504E0-4F89-11D3-9A0C-0305E82C3301").Build()
+
+	// Assert
+	assert.NoError(t, err)
+	assert.Equal(t, expressions.NewOperatorExpression(constants.FieldTypeUUID, "id", constants.OperatorEqual, "3f2504e0-4f89-11d3-9a0c-0305e82c3301"), expression)
+}
+
+func TestQueryBuilder_Where_ShouldReturnError_WhenFieldIsIPAndValueIsNotValid(t *testing.T) {
+	// Arrange
+	builder := NewQueryBuilder(newTestMetadata())
+
+	// Act
+	expression, err := builder.Where("Address").InSubnet("10.0.0.0/33").Build()
+
+	// Assert
+	assert.Nil(t, expression)
+	assert.Error(t, err)
+}
+
+func TestQueryBuilder_Where_ShouldReturnSubnetExpression_WhenFieldIsIP(t *testing.T) {
+	// Arrange
+	builder := NewQueryBuilder(newTestMetadata())
+
+	// Act
+	expression, err := builder.Where("Address").InSubnet("10.0.0.0/8").Build()
+
+	// Assert
+	assert.NoError(t, err)
+	assert.Equal(t, expressions.NewOperatorExpression(constants.FieldTypeIP, "address", constants.OperatorInSubnet, "10.0.0.0/8"), expression)
+}
+
+func TestQueryBuilder_Where_ShouldReturnError_WhenGeoAreaIsNotValid(t *testing.T) {
+	// Arrange
+	builder := NewQueryBuilder(newTestMetadata())
+
+	// Act
+	expression, err := builder.Where("Location").Within("5km of (91, 0)").Build()
+
+	// Assert
+	assert.Nil(t, expression)
+	assert.Error(t, err)
+}
+
+func TestQueryBuilder_Where_ShouldReturnWithinExpression_WhenFieldIsGeoPoint(t *testing.T) {
+	// Arrange
+	builder := NewQueryBuilder(newTestMetadata())
+	circle := utils.GeoCircle{Center: utils.GeoPoint{Lat: 40.7128, Lng: -74.006}, Radius: 5000}
+
+	// Act
+	textExpression, textErr := builder.Where("Location").Within("5km of (40.7128, -74.006)").Build()
+	circleExpression, circleErr := builder.Where("Location").Within(circle).Build()
+
+	// Assert
+	assert.NoError(t, textErr)
+	assert.NoError(t, circleErr)
+	assert.Equal(t, expressions.NewOperatorExpression(constants.FieldTypeGeoPoint, "location", constants.OperatorWithin, &circle), textExpression)
+	assert.Equal(t, textExpression, circleExpression)
+}
+
+func TestQuery_And_ShouldReturnLogicExpression_WhenQueriesAreValid(t *testing.T) {
+	// Arrange
+	builder := NewQueryBuilder(newTestMetadata())
+
+	// Act
+	expression, err := builder.Where("Name").Contain("Filtex").
+		And(builder.Where("Age").Gt(18)).
+		And(builder.Where("Status").Eq(true)).
+		Build()
+
+	// Assert
+	assert.NoError(t, err)
+	assert.Equal(t, expressions.NewLogicExpression(constants.LogicAnd, []expressions.Expression{
+		expressions.NewOperatorExpression(constants.FieldTypeString, "name", constants.OperatorContain, "Filtex"),
+		expressions.NewOperatorExpression(constants.FieldTypeNumber, "age", constants.OperatorGreaterThan, float64(18)),
+		expressions.NewOperatorExpression(constants.FieldTypeBoolean, "status", constants.OperatorEqual, true),
+	}), expression)
+}
+
+func TestQuery_Or_ShouldReturnNestedLogicExpression_WhenLogicsAreMixed(t *testing.T) {
+	// Arrange
+	builder := NewQueryBuilder(newTestMetadata())
+
+	// Act
+	expression, err := builder.Where("Name").Eq("Filtex").
+		Or(builder.And(builder.Where("Age").Gt(18), builder.Where("Status").Eq("Disabled"))).
+		Build()
+
+	// Assert
+	assert.NoError(t, err)
+	assert.Equal(t, expressions.NewLogicExpression(constants.LogicOr, []expressions.Expression{
+		expressions.NewOperatorExpression(constants.FieldTypeString, "name", constants.OperatorEqual, "Filtex"),
+		expressions.NewLogicExpression(constants.LogicAnd, []expressions.Expression{
+			expressions.NewOperatorExpression(constants.FieldTypeNumber, "age", constants.OperatorGreaterThan, float64(18)),
+			expressions.NewOperatorExpression(constants.FieldTypeBoolean, "status", constants.OperatorEqual, false),
+		}),
+	}), expression)
+}
+
+func TestQuery_And_ShouldReturnError_WhenAnyQueryIsNotValid(t *testing.T) {
+	// Arrange
+	builder := NewQueryBuilder(newTestMetadata())
+
+	// Act
+	expression, err := builder.Where("Name").Eq("Filtex").
+		And(builder.Where("Unknown").Eq(1)).
+		Build()
+
+	// Assert
+	assert.Nil(t, expression)
+	assert.Error(t, err)
+}
+
+func TestQueryBuilder_And_ShouldReturnError_WhenQueriesAreEmpty(t *testing.T) {
+	// Arrange
+	builder := NewQueryBuilder(newTestMetadata())
+
+	// Act
+	expression, err := builder.And().Build()
+
+	// Assert
+	assert.Nil(t, expression)
+	assert.Error(t, err)
+}
+
+func TestQueryBuilder_Search_ShouldReturnError_WhenThereIsNoSearchableField(t *testing.T) {
+	// Arrange
+	builder := NewQueryBuilder(newTestMetadata())
+
+	// Act
+	expression, err := builder.Search("red shoes").Build()
+
+	// Assert
+	assert.Nil(t, expression)
+	assert.Error(t, err)
+}
+
+func TestQueryBuilder_Search_ShouldReturnSearchExpression_WhenThereAreSearchableFields(t *testing.T) {
+	// Arrange
+	metadata := newTestMetadata()
+	metadata.Fields[0].Operators = append(metadata.Fields[0].Operators, constants.OperatorSearch.String())
+	builder := NewQueryBuilder(metadata)
+
+	// Act
+	expression, err := builder.Search("red shoes").And(builder.Where("Name").Search("shoes")).Build()
+
+	// Assert
+	assert.NoError(t, err)
+	assert.Equal(t, expressions.NewLogicExpression(constants.LogicAnd, []expressions.Expression{
+		expressions.NewSearchExpression([]string{"name"}, "red shoes"),
+		expressions.NewSearchExpression([]string{"name"}, "shoes"),
+	}), expression)
+}
+
+func TestQueryBuilder_Where_ShouldCastValueInLocation_WhenMetadataHasLocation(t *testing.T) {
+	// Arrange
+	location := time.FixedZone("UTC+3", 3*60*60)
+	builder := NewQueryBuilder(newTestMetadata().WithLocation(location))
+
+	// Act
+	expression, err := builder.Where("createdAt").Gte("2024-01-05").Build()
+
+	// Assert
+	assert.NoError(t, err)
+	operatorExpression := expression.(*expressions.OperatorExpression)
+	assert.Equal(t, time.Date(2024, 1, 5, 0, 0, 0, 0, location), *operatorExpression.Value.(*time.Time))
+}
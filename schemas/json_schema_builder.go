@@ -0,0 +1,57 @@
+package schemas
+
+import (
+	"github.com/filtex/filtex-go/models"
+)
+
+const jsonSchemaDialect = "https://json-schema.org/draft/2020-12/schema"
+
+type JsonSchemaBuilder struct {
+	id    string
+	title string
+}
+
+func NewJsonSchemaBuilder() *JsonSchemaBuilder {
+	return &JsonSchemaBuilder{
+		title: "Filtex JSON Query",
+	}
+}
+
+func (b *JsonSchemaBuilder) Id(id string) *JsonSchemaBuilder {
+	b.id = id
+	return b
+}
+
+func (b *JsonSchemaBuilder) Title(title string) *JsonSchemaBuilder {
+	b.title = title
+	return b
+}
+
+func (b *JsonSchemaBuilder) Build(metadata *models.Metadata) (map[string]interface{}, error) {
+	definitions, err := newQueryDefinitions(metadata, func(definition string) string {
+		return "#/$defs/" + definition
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	schema := map[string]interface{}{
+		"$schema": jsonSchemaDialect,
+		"$ref":    "#/$defs/" + definitionQuery,
+		"$defs":   definitions,
+	}
+
+	if b.id != "" {
+		schema["$id"] = b.id
+	}
+
+	if b.title != "" {
+		schema["title"] = b.title
+	}
+
+	if metadata.Version != "" {
+		schema["description"] = "Metadata version " + metadata.Version
+	}
+
+	return schema, nil
+}
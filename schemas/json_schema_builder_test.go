@@ -0,0 +1,468 @@
+package schemas
+
+import (
+	"encoding/json"
+	"regexp"
+	"strings"
+	"testing"
+
+	"github.com/filtex/filtex-go/constants"
+	"github.com/filtex/filtex-go/models"
+	"github.com/filtex/filtex-go/tokenizers"
+	"github.com/filtex/filtex-go/validators"
+	"github.com/stretchr/testify/assert"
+)
+
+func newTestMetadata(fields ...models.Field) *models.Metadata {
+	return &models.Metadata{
+		Fields: fields,
+	}
+}
+
+func newTestField(fieldType constants.FieldType, name string, label string, operators ...constants.Operator) models.Field {
+	names := make([]string, 0)
+
+	for _, v := range operators {
+		names = append(names, v.String())
+	}
+
+	return models.Field{
+		Name:      name,
+		Type:      fieldType.String(),
+		Label:     label,
+		Operators: names,
+	}
+}
+
+func findConditions(t *testing.T, schema map[string]interface{}) []interface{} {
+	definitions := schema["$defs"].(map[string]interface{})
+	condition, ok := definitions[definitionCondition].(map[string]interface{})
+	assert.True(t, ok)
+
+	return condition["anyOf"].([]interface{})
+}
+
+func findPrefixItems(condition interface{}) []interface{} {
+	return condition.(map[string]interface{})["prefixItems"].([]interface{})
+}
+
+func matchSchema(root map[string]interface{}, schema interface{}, value interface{}) bool {
+	if b, ok := schema.(bool); ok {
+		return b
+	}
+
+	m := schema.(map[string]interface{})
+
+	if ref, ok := m["$ref"].(string); ok {
+		definitions := root["$defs"].(map[string]interface{})
+		if !matchSchema(root, definitions[strings.TrimPrefix(ref, "#/$defs/")], value) {
+			return false
+		}
+	}
+
+	if anyOf, ok := m["anyOf"].([]interface{}); ok {
+		matched := false
+		for _, v := range anyOf {
+			matched = matched || matchSchema(root, v, value)
+		}
+		if !matched {
+			return false
+		}
+	}
+
+	if types, ok := m["type"]; ok {
+		if !matchSchemaType(types, value) {
+			return false
+		}
+	}
+
+	if enum, ok := m["enum"].([]interface{}); ok {
+		matched := false
+		for _, v := range enum {
+			expected, _ := json.Marshal(v)
+			actual, _ := json.Marshal(value)
+			matched = matched || string(expected) == string(actual)
+		}
+		if !matched {
+			return false
+		}
+	}
+
+	if str, ok := value.(string); ok {
+		if pattern, ok := m["pattern"].(string); ok && !regexp.MustCompile(pattern).MatchString(str) {
+			return false
+		}
+		if minLength, ok := m["minLength"].(int); ok && len(str) < minLength {
+			return false
+		}
+	}
+
+	if items, ok := value.([]interface{}); ok {
+		if minItems, ok := m["minItems"].(int); ok && len(items) < minItems {
+			return false
+		}
+		if maxItems, ok := m["maxItems"].(int); ok && len(items) > maxItems {
+			return false
+		}
+		prefixItems, _ := m["prefixItems"].([]interface{})
+		for i, v := range items {
+			if i < len(prefixItems) {
+				if !matchSchema(root, prefixItems[i], v) {
+					return false
+				}
+			} else if itemSchema, ok := m["items"]; ok && !matchSchema(root, itemSchema, v) {
+				return false
+			}
+		}
+	}
+
+	return true
+}
+
+func matchSchemaType(types interface{}, value interface{}) bool {
+	if t, ok := types.(string); ok {
+		types = []interface{}{t}
+	}
+
+	for _, v := range types.([]interface{}) {
+		matched := false
+
+		switch v {
+		case "string":
+			_, matched = value.(string)
+		case "boolean":
+			_, matched = value.(bool)
+		case "array":
+			_, matched = value.([]interface{})
+		case "number":
+			_, matched = value.(float64)
+		case "integer":
+			f, ok := value.(float64)
+			matched = ok && f == float64(int64(f))
+		case "null":
+			matched = value == nil
+		}
+
+		if matched {
+			return true
+		}
+	}
+
+	return false
+}
+
+func TestJsonSchemaBuild_ShouldReturnError_WhenMetadataIsNil(t *testing.T) {
+	// Arrange
+	builder := NewJsonSchemaBuilder()
+
+	// Act
+	schema, err := builder.Build(nil)
+
+	// Assert
+	assert.Nil(t, schema)
+	assert.Error(t, err)
+}
+
+func TestJsonSchemaBuild_ShouldReturnError_WhenFieldTypeIsNotValid(t *testing.T) {
+	// Arrange
+	builder := NewJsonSchemaBuilder()
+	metadata := newTestMetadata(models.Field{Name: "value", Type: "unknown", Label: "Value", Operators: []string{"equal"}})
+
+	// Act
+	schema, err := builder.Build(metadata)
+
+	// Assert
+	assert.Nil(t, schema)
+	assert.Error(t, err)
+}
+
+func TestJsonSchemaBuild_ShouldReturnError_WhenOperatorIsNotValid(t *testing.T) {
+	// Arrange
+	builder := NewJsonSchemaBuilder()
+	metadata := newTestMetadata(models.Field{Name: "value", Type: "string", Label: "Value", Operators: []string{"equals"}})
+
+	// Act
+	schema, err := builder.Build(metadata)
+
+	// Assert
+	assert.Nil(t, schema)
+	assert.Error(t, err)
+}
+
+func TestJsonSchemaBuild_ShouldReturnSchema_WhenMetadataIsValid(t *testing.T) {
+	// Arrange
+	builder := NewJsonSchemaBuilder()
+	metadata := newTestMetadata(newTestField(constants.FieldTypeNumber, "price", "Price", constants.OperatorEqual))
+
+	// Act
+	schema, err := builder.Build(metadata)
+
+	// Assert
+	assert.NoError(t, err)
+	assert.Equal(t, jsonSchemaDialect, schema["$schema"])
+	assert.Equal(t, "#/$defs/Query", schema["$ref"])
+	assert.Equal(t, "Filtex JSON Query", schema["title"])
+	assert.NotContains(t, schema, "$id")
+	assert.NotContains(t, schema, "description")
+
+	definitions := schema["$defs"].(map[string]interface{})
+	assert.Contains(t, definitions, definitionLogic)
+	assert.Contains(t, definitions, definitionCondition)
+	assert.NotContains(t, definitions, definitionSearch)
+	assert.Equal(t, map[string]interface{}{
+		"anyOf": []interface{}{
+			map[string]interface{}{"$ref": "#/$defs/Logic"},
+			map[string]interface{}{"$ref": "#/$defs/Condition"},
+		},
+	}, definitions[definitionQuery])
+}
+
+func TestJsonSchemaBuild_ShouldSetIdTitleAndDescription_WhenTheyAreSet(t *testing.T) {
+	// Arrange
+	builder := NewJsonSchemaBuilder().Id("https://example.com/query.json").Title("Projects Query")
+	metadata := newTestMetadata(newTestField(constants.FieldTypeString, "name", "Name", constants.OperatorEqual))
+	metadata.Version = "3"
+
+	// Act
+	schema, err := builder.Build(metadata)
+
+	// Assert
+	assert.NoError(t, err)
+	assert.Equal(t, "https://example.com/query.json", schema["$id"])
+	assert.Equal(t, "Projects Query", schema["title"])
+	assert.Equal(t, "Metadata version 3", schema["description"])
+}
+
+func TestJsonSchemaBuild_ShouldRejectConditions_WhenThereIsNoField(t *testing.T) {
+	// Arrange
+	builder := NewJsonSchemaBuilder()
+
+	// Act
+	schema, err := builder.Build(newTestMetadata())
+
+	// Assert
+	assert.NoError(t, err)
+	assert.Equal(t, false, schema["$defs"].(map[string]interface{})[definitionCondition])
+}
+
+func TestJsonSchemaBuild_ShouldAcceptNamesLabelsAndSymbols_WhenFieldIsComparable(t *testing.T) {
+	// Arrange
+	builder := NewJsonSchemaBuilder()
+	metadata := newTestMetadata(newTestField(constants.FieldTypeNumber, "price", "Unit Price",
+		constants.OperatorEqual, constants.OperatorNotEqual, constants.OperatorGreaterThan))
+
+	// Act
+	schema, err := builder.Build(metadata)
+
+	// Assert
+	assert.NoError(t, err)
+
+	conditions := findConditions(t, schema)
+	assert.Len(t, conditions, 1)
+	assert.Equal(t, "Unit Price", conditions[0].(map[string]interface{})["title"])
+
+	items := findPrefixItems(conditions[0])
+	assert.Equal(t, map[string]interface{}{
+		"type":    "string",
+		"pattern": "^([Pp][Rr][Ii][Cc][Ee]|[Uu][Nn][Ii][Tt] [Pp][Rr][Ii][Cc][Ee])$",
+	}, items[0])
+	assert.Equal(t, map[string]interface{}{
+		"type":    "string",
+		"pattern": "^([Ee][Qq][Uu][Aa][Ll]|=|[Nn][Oo][Tt] [Ee][Qq][Uu][Aa][Ll]|!=|[Gg][Rr][Ee][Aa][Tt][Ee][Rr] [Tt][Hh][Aa][Nn]|>)$",
+	}, items[1])
+	assert.Equal(t, []interface{}{"number", "string"}, items[2].(map[string]interface{})["type"])
+}
+
+func TestJsonSchemaBuild_ShouldAllowNull_WhenFieldIsNullable(t *testing.T) {
+	// Arrange
+	builder := NewJsonSchemaBuilder()
+	metadata := newTestMetadata(newTestField(constants.FieldTypeString, "name", "Name",
+		constants.OperatorEqual, constants.OperatorIsNull, constants.OperatorIsNotNull))
+
+	// Act
+	schema, err := builder.Build(metadata)
+
+	// Assert
+	assert.NoError(t, err)
+
+	conditions := findConditions(t, schema)
+	assert.Len(t, conditions, 2)
+	assert.Equal(t, map[string]interface{}{
+		"anyOf": []interface{}{
+			map[string]interface{}{"type": []interface{}{"string", "number", "boolean"}},
+			map[string]interface{}{"type": "null"},
+		},
+	}, findPrefixItems(conditions[0])[2])
+	assert.Equal(t, map[string]interface{}{
+		"type":    "string",
+		"pattern": "^([Ii][Ss] [Nn][Uu][Ll][Ll]|[Ii][Ss] [Nn][Oo][Tt] [Nn][Uu][Ll][Ll])$",
+	}, findPrefixItems(conditions[1])[1])
+	assert.Equal(t, map[string]interface{}{"enum": []interface{}{"", nil}}, findPrefixItems(conditions[1])[2])
+}
+
+func TestJsonSchemaBuild_ShouldAcceptValueOrArray_WhenOperatorIsIn(t *testing.T) {
+	// Arrange
+	builder := NewJsonSchemaBuilder()
+	metadata := newTestMetadata(newTestField(constants.FieldTypeInteger, "count", "Count", constants.OperatorIn, constants.OperatorNotIn))
+
+	// Act
+	schema, err := builder.Build(metadata)
+
+	// Assert
+	assert.NoError(t, err)
+
+	items := findPrefixItems(findConditions(t, schema)[0])
+	integer := map[string]interface{}{"type": []interface{}{"integer", "string"}, "pattern": "^-?[0-9]+$"}
+	assert.Equal(t, map[string]interface{}{"type": "string", "pattern": "^([Ii][Nn]|[Nn][Oo][Tt] [Ii][Nn])$"}, items[1])
+	assert.Equal(t, map[string]interface{}{
+		"anyOf": []interface{}{
+			integer,
+			map[string]interface{}{
+				"type":     "array",
+				"items":    integer,
+				"minItems": 1,
+			},
+		},
+	}, items[2])
+}
+
+func TestJsonSchemaBuild_ShouldEnumerateLookups_WhenFieldHasValues(t *testing.T) {
+	// Arrange
+	builder := NewJsonSchemaBuilder()
+	field := newTestField(constants.FieldTypeInteger, "status", "Status", constants.OperatorEqual)
+	field.Values = []models.Lookup{{Name: "Enabled", Value: 1}, {Name: "Disabled", Value: 0}}
+
+	// Act
+	schema, err := builder.Build(newTestMetadata(field))
+
+	// Assert
+	assert.NoError(t, err)
+	assert.Equal(t, map[string]interface{}{
+		"anyOf": []interface{}{
+			map[string]interface{}{"enum": []interface{}{"Enabled", "Disabled", 1, 0}},
+			map[string]interface{}{"type": "string", "pattern": "^([Ee][Nn][Aa][Bb][Ll][Ee][Dd]|[Dd][Ii][Ss][Aa][Bb][Ll][Ee][Dd]|1|0)$"},
+		},
+	}, findPrefixItems(findConditions(t, schema)[0])[2])
+}
+
+func TestJsonSchemaBuild_ShouldUseValueSchemas_WhenFieldTypesAreDifferent(t *testing.T) {
+	// Arrange
+	builder := NewJsonSchemaBuilder()
+	samples := map[constants.FieldType]interface{}{
+		constants.FieldTypeString:   []interface{}{"string", "number", "boolean"},
+		constants.FieldTypeNumber:   []interface{}{"number", "string"},
+		constants.FieldTypeInteger:  []interface{}{"integer", "string"},
+		constants.FieldTypeBoolean:  []interface{}{"boolean", "string"},
+		constants.FieldTypeDate:     "string",
+		constants.FieldTypeTime:     "string",
+		constants.FieldTypeDateTime: "string",
+		constants.FieldTypeDuration: "string",
+		constants.FieldTypeUUID:     "string",
+	}
+
+	for k, v := range samples {
+		metadata := newTestMetadata(newTestField(k, "value", "Value", constants.OperatorEqual))
+
+		// Act
+		schema, err := builder.Build(metadata)
+
+		// Assert
+		assert.NoError(t, err)
+		assert.Equal(t, v, findPrefixItems(findConditions(t, schema)[0])[2].(map[string]interface{})["type"])
+	}
+}
+
+func TestJsonSchemaBuild_ShouldAddSearch_WhenFieldIsSearchable(t *testing.T) {
+	// Arrange
+	builder := NewJsonSchemaBuilder()
+	metadata := newTestMetadata(newTestField(constants.FieldTypeString, "name", "Name", constants.OperatorEqual, constants.OperatorSearch))
+
+	// Act
+	schema, err := builder.Build(metadata)
+
+	// Assert
+	assert.NoError(t, err)
+
+	definitions := schema["$defs"].(map[string]interface{})
+	assert.Equal(t, []interface{}{
+		map[string]interface{}{"type": "string", "pattern": "^([Ss][Ee][Aa][Rr][Cc][Hh])$"},
+		map[string]interface{}{"type": "string", "minLength": 1},
+	}, findPrefixItems(definitions[definitionSearch]))
+	assert.Contains(t, definitions[definitionQuery].(map[string]interface{})["anyOf"], map[string]interface{}{"$ref": "#/$defs/Search"})
+	assert.Len(t, findConditions(t, schema), 2)
+}
+
+func TestJsonSchemaBuild_ShouldAgreeWithJsonQueryValidator_WhenQueriesAreChecked(t *testing.T) {
+	// Arrange
+	status := newTestField(constants.FieldTypeBoolean, "status", "Status", constants.OperatorEqual)
+	status.Values = []models.Lookup{{Name: "Enabled", Value: true}, {Name: "Disabled", Value: false}}
+	metadata := newTestMetadata(
+		newTestField(constants.FieldTypeString, "name", "Name", constants.OperatorEqual, constants.OperatorContain),
+		newTestField(constants.FieldTypeNumber, "age", "Age", constants.OperatorGreaterThan, constants.OperatorIn),
+		newTestField(constants.FieldTypeInteger, "count", "Count", constants.OperatorEqual),
+		newTestField(constants.FieldTypeBoolean, "enabled", "Enabled", constants.OperatorEqual),
+		newTestField(constants.FieldTypeDate, "day", "Day", constants.OperatorEqual),
+		newTestField(constants.FieldTypeDateTime, "created", "Created At", constants.OperatorEqual, constants.OperatorGreaterThan),
+		newTestField(constants.FieldTypeUUID, "id", "Id", constants.OperatorEqual),
+		status,
+	)
+	schema, err := NewJsonSchemaBuilder().Build(metadata)
+	assert.NoError(t, err)
+	validator := validators.NewJsonQueryValidator(metadata, tokenizers.NewJsonQueryTokenizer(metadata))
+	samples := map[string]bool{
+		`["name", "Equal", "x"]`:                              true,
+		`["NAME", "Equal", "x"]`:                              true,
+		`["Name", "eQuAl", "x"]`:                              true,
+		`["name", "~", "x"]`:                                  true,
+		`["name", "Equals", "x"]`:                             false,
+		`["name", "Equal", 5]`:                                true,
+		`["name", "Equal", 5.5]`:                              true,
+		`["name", "Equal", true]`:                             true,
+		`["name", "Equal", {"a": 1}]`:                         false,
+		`["title", "Equal", "x"]`:                             false,
+		`["AND", [["name", "=", "x"]]]`:                       true,
+		`["or", [["name", "=", "x"], ["age", ">", 5]]]`:       true,
+		`["Xor", [["name", "=", "x"]]]`:                       false,
+		`["age", ">", 5]`:                                     true,
+		`["age", ">", "5"]`:                                   true,
+		`["age", ">", "-5.5"]`:                                true,
+		`["age", ">", "five"]`:                                false,
+		`["age", "in", ["5", 6]]`:                             true,
+		`["count", "=", "5"]`:                                 true,
+		`["count", "=", 5.5]`:                                 false,
+		`["count", "=", "5.5"]`:                               false,
+		`["enabled", "=", true]`:                              true,
+		`["enabled", "=", "TRUE"]`:                            true,
+		`["enabled", "=", "yes"]`:                             false,
+		`["day", "=", "2024-01-02"]`:                          true,
+		`["day", "=", "2024-99x"]`:                            false,
+		`["created", "=", "2024-01"]`:                         true,
+		`["created", "=", "2024-01-02"]`:                      true,
+		`["created", "=", "2024-01-02T10"]`:                   true,
+		`["Created At", ">", "2024-01-02T10:00:00Z"]`:         true,
+		`["created", ">", "2024-01-02T10:00:00.5+02:00"]`:     true,
+		`["created", ">", "2024-01-02 10:00"]`:                true,
+		`["created", ">", "2024"]`:                            false,
+		`["created", ">", "2024-99x"]`:                        false,
+		`["created", ">", "last 7 days"]`:                     false,
+		`["id", "=", "6F9619FF-8B86-D011-B42D-00C04FC964FF"]`: true,
+		`["id", "=", "6F9619FF"]`:                             false,
+		`["status", "=", "Enabled"]`:                          true,
+		`["status", "=", "enabled"]`:                          true,
+		`["status", "=", true]`:                               true,
+		`["status", "=", "Unknown"]`:                          false,
+	}
+
+	for k, v := range samples {
+		var value interface{}
+		assert.NoError(t, json.Unmarshal([]byte(k), &value))
+
+		// Act
+		matched := matchSchema(schema, schema, value)
+		validErr := validator.Validate(k)
+
+		// Assert
+		assert.Equal(t, v, matched, k)
+		assert.Equal(t, v, validErr == nil, k)
+	}
+}
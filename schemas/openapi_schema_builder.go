@@ -0,0 +1,105 @@
+package schemas
+
+import (
+	"github.com/filtex/filtex-go/errors"
+	"github.com/filtex/filtex-go/models"
+)
+
+type OpenApiSchemaBuilder struct {
+	name string
+}
+
+func NewOpenApiSchemaBuilder() *OpenApiSchemaBuilder {
+	return &OpenApiSchemaBuilder{
+		name: "FiltexQuery",
+	}
+}
+
+func (b *OpenApiSchemaBuilder) Name(name string) *OpenApiSchemaBuilder {
+	b.name = name
+	return b
+}
+
+func (b *OpenApiSchemaBuilder) Build(metadata *models.Metadata) (map[string]interface{}, error) {
+	if b.name == "" {
+		return nil, errors.NewCouldNotBeBuiltError()
+	}
+
+	definitions, err := newQueryDefinitions(metadata, func(definition string) string {
+		return "#/components/schemas/" + b.schemaName(definition)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	schemas := map[string]interface{}{
+		b.schemaName("Metadata"): newMetadataSchema(),
+	}
+
+	for k, v := range definitions {
+		schemas[b.schemaName(k)] = v
+	}
+
+	return map[string]interface{}{
+		"components": map[string]interface{}{
+			"schemas": schemas,
+		},
+	}, nil
+}
+
+func (b *OpenApiSchemaBuilder) schemaName(definition string) string {
+	if definition == definitionQuery {
+		return b.name
+	}
+
+	return b.name + definition
+}
+
+func newMetadataSchema() map[string]interface{} {
+	return map[string]interface{}{
+		"type":     "object",
+		"required": []interface{}{"fields"},
+		"properties": map[string]interface{}{
+			"version":  map[string]interface{}{"type": "string"},
+			"timeZone": map[string]interface{}{"type": "string"},
+			"fields": map[string]interface{}{
+				"type": "array",
+				"items": map[string]interface{}{
+					"type":     "object",
+					"required": []interface{}{"name", "type", "label", "operators"},
+					"properties": map[string]interface{}{
+						"name":      map[string]interface{}{"type": "string"},
+						"type":      map[string]interface{}{"type": "string"},
+						"label":     map[string]interface{}{"type": "string"},
+						"collation": map[string]interface{}{"type": "string"},
+						"operators": map[string]interface{}{
+							"type":  "array",
+							"items": map[string]interface{}{"type": "string"},
+						},
+						"values": map[string]interface{}{
+							"type": []interface{}{"array", "null"},
+							"items": map[string]interface{}{
+								"type":     "object",
+								"required": []interface{}{"name", "value"},
+								"properties": map[string]interface{}{
+									"name":  map[string]interface{}{"type": "string"},
+									"value": map[string]interface{}{},
+								},
+							},
+						},
+					},
+				},
+			},
+			"freeText": map[string]interface{}{
+				"type": "object",
+				"properties": map[string]interface{}{
+					"fields": map[string]interface{}{
+						"type":  "array",
+						"items": map[string]interface{}{"type": "string"},
+					},
+					"operator": map[string]interface{}{"type": "string"},
+				},
+			},
+		},
+	}
+}
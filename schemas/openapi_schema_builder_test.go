@@ -0,0 +1,79 @@
+package schemas
+
+import (
+	"testing"
+
+	"github.com/filtex/filtex-go/constants"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestOpenApiSchemaBuild_ShouldReturnError_WhenNameIsEmpty(t *testing.T) {
+	// Arrange
+	builder := NewOpenApiSchemaBuilder().Name("")
+	metadata := newTestMetadata(newTestField(constants.FieldTypeString, "name", "Name", constants.OperatorEqual))
+
+	// Act
+	schema, err := builder.Build(metadata)
+
+	// Assert
+	assert.Nil(t, schema)
+	assert.Error(t, err)
+}
+
+func TestOpenApiSchemaBuild_ShouldReturnError_WhenMetadataIsNil(t *testing.T) {
+	// Arrange
+	builder := NewOpenApiSchemaBuilder()
+
+	// Act
+	schema, err := builder.Build(nil)
+
+	// Assert
+	assert.Nil(t, schema)
+	assert.Error(t, err)
+}
+
+func TestOpenApiSchemaBuild_ShouldReturnComponents_WhenMetadataIsValid(t *testing.T) {
+	// Arrange
+	builder := NewOpenApiSchemaBuilder()
+	metadata := newTestMetadata(newTestField(constants.FieldTypeString, "name", "Name", constants.OperatorEqual, constants.OperatorSearch))
+
+	// Act
+	schema, err := builder.Build(metadata)
+
+	// Assert
+	assert.NoError(t, err)
+
+	schemas := schema["components"].(map[string]interface{})["schemas"].(map[string]interface{})
+	assert.Len(t, schemas, 5)
+	assert.Contains(t, schemas, "FiltexQuery")
+	assert.Contains(t, schemas, "FiltexQueryLogic")
+	assert.Contains(t, schemas, "FiltexQueryCondition")
+	assert.Contains(t, schemas, "FiltexQuerySearch")
+	assert.Contains(t, schemas, "FiltexQueryMetadata")
+	assert.Equal(t, map[string]interface{}{
+		"anyOf": []interface{}{
+			map[string]interface{}{"$ref": "#/components/schemas/FiltexQueryLogic"},
+			map[string]interface{}{"$ref": "#/components/schemas/FiltexQueryCondition"},
+			map[string]interface{}{"$ref": "#/components/schemas/FiltexQuerySearch"},
+		},
+	}, schemas["FiltexQuery"])
+}
+
+func TestOpenApiSchemaBuild_ShouldUseName_WhenNameIsSet(t *testing.T) {
+	// Arrange
+	builder := NewOpenApiSchemaBuilder().Name("ProjectFilter")
+	metadata := newTestMetadata(newTestField(constants.FieldTypeString, "name", "Name", constants.OperatorEqual))
+
+	// Act
+	schema, err := builder.Build(metadata)
+
+	// Assert
+	assert.NoError(t, err)
+
+	schemas := schema["components"].(map[string]interface{})["schemas"].(map[string]interface{})
+	assert.Contains(t, schemas, "ProjectFilter")
+	assert.Contains(t, schemas, "ProjectFilterMetadata")
+	assert.NotContains(t, schemas, "ProjectFilterSearch")
+	assert.Equal(t, map[string]interface{}{"$ref": "#/components/schemas/ProjectFilter"},
+		findPrefixItems(schemas["ProjectFilterLogic"])[1].(map[string]interface{})["items"])
+}
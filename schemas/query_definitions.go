@@ -0,0 +1,278 @@
+package schemas
+
+import (
+	"fmt"
+	"reflect"
+	"regexp"
+	"strings"
+	"unicode"
+
+	"github.com/filtex/filtex-go/constants"
+	"github.com/filtex/filtex-go/errors"
+	"github.com/filtex/filtex-go/models"
+)
+
+const (
+	definitionQuery     = "Query"
+	definitionLogic     = "Logic"
+	definitionSearch    = "Search"
+	definitionCondition = "Condition"
+)
+
+const (
+	datePattern     = `^[0-9]{4}-[0-9]{2}-[0-9]{2}([T ][0-9]{2}:[0-9]{2}(:[0-9]{2}(\.[0-9]+)?)?|T[0-9]{2}:[0-9]{2}:[0-9]{2}(\.[0-9]+)?(Z|[+-][0-9]{2}:[0-9]{2}))?$`
+	dateTimePattern = `^[0-9]{4}-[0-9]{2}(-[0-9]{2}([T ][0-9]{2}(:[0-9]{2}(:[0-9]{2}(\.[0-9]+)?)?)?|T[0-9]{2}:[0-9]{2}:[0-9]{2}(\.[0-9]+)?(Z|[+-][0-9]{2}:[0-9]{2}))?)?$`
+)
+
+var operatorSymbols = map[constants.Operator]string{
+	constants.OperatorEqual:              "=",
+	constants.OperatorNotEqual:           "!=",
+	constants.OperatorGreaterThan:        ">",
+	constants.OperatorGreaterThanOrEqual: ">=",
+	constants.OperatorLessThan:           "<",
+	constants.OperatorLessThanOrEqual:    "<=",
+	constants.OperatorBlank:              "[]",
+	constants.OperatorNotBlank:           "![]",
+	constants.OperatorContain:            "~",
+	constants.OperatorNotContain:         "!~",
+	constants.OperatorStartWith:          "~*",
+	constants.OperatorNotStartWith:       "!~*",
+	constants.OperatorEndWith:            "*~",
+	constants.OperatorNotEndWith:         "!*~",
+}
+
+func newQueryDefinitions(metadata *models.Metadata, ref func(definition string) string) (map[string]interface{}, error) {
+	if metadata == nil {
+		return nil, errors.NewInvalidMetadataError()
+	}
+
+	conditions := make([]interface{}, 0)
+
+	for _, v := range metadata.Fields {
+		items, err := newConditions(v)
+		if err != nil {
+			return nil, err
+		}
+
+		conditions = append(conditions, items...)
+	}
+
+	queries := []interface{}{
+		map[string]interface{}{"$ref": ref(definitionLogic)},
+		map[string]interface{}{"$ref": ref(definitionCondition)},
+	}
+
+	definitions := map[string]interface{}{
+		definitionLogic: newTuple(
+			newNamePattern(string(constants.LogicAnd), string(constants.LogicOr)),
+			map[string]interface{}{
+				"type":     "array",
+				"items":    map[string]interface{}{"$ref": ref(definitionQuery)},
+				"minItems": 1,
+			},
+		),
+		definitionCondition: false,
+	}
+
+	if len(conditions) > 0 {
+		definitions[definitionCondition] = map[string]interface{}{"anyOf": conditions}
+	}
+
+	if len(metadata.GetSearchFields()) > 0 {
+		definitions[definitionSearch] = newTuple(
+			newNamePattern(operatorSpellings(constants.OperatorSearch)...),
+			map[string]interface{}{"type": "string", "minLength": 1},
+		)
+		queries = append(queries, map[string]interface{}{"$ref": ref(definitionSearch)})
+	}
+
+	definitions[definitionQuery] = map[string]interface{}{"anyOf": queries}
+
+	return definitions, nil
+}
+
+func newConditions(field models.Field) ([]interface{}, error) {
+	value, err := newValueSchema(field)
+	if err != nil {
+		return nil, err
+	}
+
+	nullable := false
+
+	for _, v := range field.Operators {
+		if constants.OperatorIsNull.Equals(v) {
+			nullable = true
+		}
+	}
+
+	equalities := make([]string, 0)
+	comparers := make([]string, 0)
+	multiples := make([]string, 0)
+	presences := make([]string, 0)
+	searches := make([]string, 0)
+
+	for _, v := range field.Operators {
+		operator := constants.ParseOperator(v)
+		spellings := operatorSpellings(operator)
+
+		switch operator {
+		case constants.OperatorUnknown:
+			return nil, errors.NewInvalidMetadataError()
+		case constants.OperatorEqual, constants.OperatorNotEqual:
+			if nullable {
+				equalities = append(equalities, spellings...)
+			} else {
+				comparers = append(comparers, spellings...)
+			}
+		case constants.OperatorIn, constants.OperatorNotIn:
+			multiples = append(multiples, spellings...)
+		case constants.OperatorBlank, constants.OperatorNotBlank, constants.OperatorIsNull, constants.OperatorIsNotNull:
+			presences = append(presences, spellings...)
+		case constants.OperatorSearch:
+			searches = append(searches, spellings...)
+		default:
+			comparers = append(comparers, spellings...)
+		}
+	}
+
+	names := newNamePattern(field.Name, field.Label)
+	conditions := make([]interface{}, 0)
+
+	add := func(operators []string, value interface{}) {
+		if len(operators) == 0 {
+			return
+		}
+
+		condition := newTuple(
+			names,
+			newNamePattern(operators...),
+			value,
+		)
+		condition["title"] = field.Label
+
+		conditions = append(conditions, condition)
+	}
+
+	add(equalities, map[string]interface{}{"anyOf": []interface{}{value, map[string]interface{}{"type": "null"}}})
+	add(comparers, value)
+	add(multiples, map[string]interface{}{"anyOf": []interface{}{value, map[string]interface{}{"type": "array", "items": value, "minItems": 1}}})
+	add(presences, map[string]interface{}{"enum": []interface{}{"", nil}})
+	add(searches, map[string]interface{}{"type": "string", "minLength": 1})
+
+	return conditions, nil
+}
+
+func newValueSchema(field models.Field) (map[string]interface{}, error) {
+	if len(field.Values) > 0 {
+		values := make([]interface{}, 0)
+		names := make([]string, 0)
+
+		for _, v := range field.Values {
+			values = appendUnique(values, v.Name)
+			names = append(names, v.Name)
+		}
+
+		for _, v := range field.Values {
+			values = appendUnique(values, v.Value)
+			names = append(names, fmt.Sprint(v.Value))
+		}
+
+		return map[string]interface{}{
+			"anyOf": []interface{}{
+				map[string]interface{}{"enum": values},
+				newNamePattern(names...),
+			},
+		}, nil
+	}
+
+	switch constants.FieldType(strings.TrimSuffix(field.Type, "-array")) {
+	case constants.FieldTypeString:
+		return map[string]interface{}{"type": []interface{}{"string", "number", "boolean"}}, nil
+	case constants.FieldTypeEnum, constants.FieldTypeIP, constants.FieldTypeGeoPoint:
+		return map[string]interface{}{"type": "string"}, nil
+	case constants.FieldTypeNumber:
+		return map[string]interface{}{"type": []interface{}{"number", "string"}, "pattern": `^-?[0-9]+(\.[0-9]+)?([eE][+-]?[0-9]+)?$`}, nil
+	case constants.FieldTypeInteger:
+		return map[string]interface{}{"type": []interface{}{"integer", "string"}, "pattern": `^-?[0-9]+$`}, nil
+	case constants.FieldTypeDecimal:
+		return map[string]interface{}{"type": []interface{}{"string", "number"}, "pattern": `^-?[0-9]+(\.[0-9]+)?$`}, nil
+	case constants.FieldTypeBoolean:
+		return map[string]interface{}{"type": []interface{}{"boolean", "string"}, "pattern": `^(1|0|t|f|T|F|true|false|TRUE|FALSE|True|False)$`}, nil
+	case constants.FieldTypeDate:
+		return map[string]interface{}{"type": "string", "pattern": datePattern}, nil
+	case constants.FieldTypeTime:
+		return map[string]interface{}{"type": "string", "pattern": `^[0-9]{2}:[0-9]{2}(:[0-9]{2})?$`}, nil
+	case constants.FieldTypeDuration:
+		return map[string]interface{}{"type": "string", "pattern": `^-?[0-9]+(\.[0-9]+)?(d|h|ms|us|ns|m|s)( ?[0-9]+(\.[0-9]+)?(d|h|ms|us|ns|m|s))*$`}, nil
+	case constants.FieldTypeDateTime:
+		return map[string]interface{}{"type": "string", "pattern": dateTimePattern}, nil
+	case constants.FieldTypeUUID:
+		return map[string]interface{}{"type": "string", "format": "uuid", "pattern": `^[0-9a-fA-F]{8}-[0-9a-fA-F]{4}-[0-9a-fA-F]{4}-[0-9a-fA-F]{4}-[0-9a-fA-F]{12}$`}, nil
+	}
+
+	return nil, errors.NewInvalidFieldTypeError()
+}
+
+func newNamePattern(names ...string) map[string]interface{} {
+	alternatives := make([]string, 0)
+	seen := make(map[string]bool)
+
+	for _, v := range names {
+		if v == "" || seen[strings.ToLower(v)] {
+			continue
+		}
+
+		seen[strings.ToLower(v)] = true
+		alternatives = append(alternatives, caseInsensitivePattern(v))
+	}
+
+	return map[string]interface{}{
+		"type":    "string",
+		"pattern": "^(" + strings.Join(alternatives, "|") + ")$",
+	}
+}
+
+func caseInsensitivePattern(str string) string {
+	var builder strings.Builder
+
+	for _, r := range str {
+		upper, lower := unicode.ToUpper(r), unicode.ToLower(r)
+
+		if upper != lower {
+			builder.WriteString("[" + string(upper) + string(lower) + "]")
+		} else {
+			builder.WriteString(regexp.QuoteMeta(string(r)))
+		}
+	}
+
+	return builder.String()
+}
+
+func newTuple(items ...interface{}) map[string]interface{} {
+	return map[string]interface{}{
+		"type":        "array",
+		"prefixItems": items,
+		"minItems":    len(items),
+		"maxItems":    len(items),
+	}
+}
+
+func operatorSpellings(operator constants.Operator) []string {
+	spellings := []string{operator.Label()}
+
+	if symbol, ok := operatorSymbols[operator]; ok {
+		spellings = append(spellings, symbol)
+	}
+
+	return spellings
+}
+
+func appendUnique(values []interface{}, value interface{}) []interface{} {
+	for _, v := range values {
+		if reflect.DeepEqual(v, value) {
+			return values
+		}
+	}
+
+	return append(values, value)
+}
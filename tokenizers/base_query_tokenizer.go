@@ -11,8 +11,9 @@ import (
 )
 
 type BaseQueryTokenizer struct {
-	metadata      *models.Metadata
-	tokenPatterns []tokenPattern
+	metadata          *models.Metadata
+	tokenPatterns     []tokenPattern
+	isFreeTextAllowed bool
 }
 
 type tokenPattern struct {
@@ -63,6 +64,9 @@ func NewBaseQueryTokenizer(metadata *models.Metadata) *BaseQueryTokenizer {
 		{`(?i)^!\[\]`, constants.TokenTypeNotBlank},
 		{`(?i)^not blank\b`, constants.TokenTypeNotBlank},
 
+		{`(?i)^is null\b`, constants.TokenTypeIsNull},
+		{`(?i)^is not null\b`, constants.TokenTypeIsNotNull},
+
 		{`(?i)^~\*`, constants.TokenTypeStartWith},
 		{`(?i)^start with\b`, constants.TokenTypeStartWith},
 		{`(?i)^!~\*`, constants.TokenTypeNotStartWith},
@@ -78,8 +82,14 @@ func NewBaseQueryTokenizer(metadata *models.Metadata) *BaseQueryTokenizer {
 		{`(?i)^!~`, constants.TokenTypeNotContain},
 		{`(?i)^not contain\b`, constants.TokenTypeNotContain},
 
+		{`(?i)^in subnet\b`, constants.TokenTypeInSubnet},
+		{`(?i)^not in subnet\b`, constants.TokenTypeNotInSubnet},
 		{`(?i)^in\b`, constants.TokenTypeIn},
 		{`(?i)^not in\b`, constants.TokenTypeNotIn},
+
+		{`(?i)^within\b`, constants.TokenTypeWithin},
+
+		{`(?i)^search\b`, constants.TokenTypeSearch},
 	}
 
 	for _, field := range metadata.Fields {
@@ -96,12 +106,20 @@ func NewBaseQueryTokenizer(metadata *models.Metadata) *BaseQueryTokenizer {
 	tokenizer.tokenPatterns = append(tokenizer.tokenPatterns,
 		tokenPattern{`(?i)^"[^"]*"`, constants.TokenTypeStringValue},
 		tokenPattern{`(?i)^\'[^\']*\'`, constants.TokenTypeStringValue},
-		tokenPattern{`(?i)^\d\d\d\d-\d\d-\d\d \d\d:\d\d(:\d\d)?`, constants.TokenTypeDateTimeValue},
+		tokenPattern{`(?i)^[0-9a-f]{8}-[0-9a-f]{4}-[0-9a-f]{4}-[0-9a-f]{4}-[0-9a-f]{12}\b`, constants.TokenTypeLiteral},
+		tokenPattern{`(?i)^\d+(\.\d+)?\s*(km|mi|m)\s+of\s*\(\s*-?\d+(\.\d+)?\s*,\s*-?\d+(\.\d+)?\s*\)`, constants.TokenTypeGeoValue},
+		tokenPattern{`(?i)^box\s*\(\s*-?\d+(\.\d+)?(\s*,\s*-?\d+(\.\d+)?){3}\s*\)`, constants.TokenTypeGeoValue},
+		tokenPattern{`(?i)^\d\d\d\d-\d\d-\d\d \d\d(:\d\d(:\d\d)?)?\b`, constants.TokenTypeDateTimeValue},
+		tokenPattern{`(?i)^\d\d\d\d-\d\d-\d\dT\d\d(:\d\d(:\d\d)?)?\b`, constants.TokenTypeDateTimeValue},
 		tokenPattern{`(?i)^\d\d\d\d-\d\d-\d\d`, constants.TokenTypeDateValue},
+		tokenPattern{`(?i)^\d\d\d\d-\d\d\b`, constants.TokenTypeDateValue},
 		tokenPattern{`(?i)^\d\d:\d\d(:\d\d)?`, constants.TokenTypeTimeValue},
-		tokenPattern{`(?i)^(\d+h)?( ?\d+m)?( ?\d+s)?`, constants.TokenTypeTimeValue},
-		tokenPattern{`(?i)^[0-9]+([.][0-9]+)?`, constants.TokenTypeNumberValue},
+		tokenPattern{`(?i)^-?\d+(\.\d+)?(d|h|ms|us|ns|m|s)( ?\d+(\.\d+)?(d|h|ms|us|ns|m|s))*\b`, constants.TokenTypeDurationValue},
+		tokenPattern{`(?i)^\d{1,3}(\.\d{1,3}){3}(/\d{1,2})?`, constants.TokenTypeLiteral},
+		tokenPattern{`(?i)^[0-9a-f]{0,4}(:[0-9a-f]{0,4}){2,7}(\.\d{1,3}){0,3}(/\d{1,3})?`, constants.TokenTypeLiteral},
+		tokenPattern{`(?i)^-?[0-9]+([.][0-9]+)?(e[+-]?[0-9]+)?`, constants.TokenTypeNumberValue},
 		tokenPattern{`(?i)^(true|false)`, constants.TokenTypeBooleanValue},
+		tokenPattern{`(?i)^null\b`, constants.TokenTypeNullValue},
 		tokenPattern{`(?i)^[a-zA-Z0-9-_]+`, constants.TokenTypeLiteral})
 
 	return &tokenizer
@@ -122,6 +140,7 @@ func (t *BaseQueryTokenizer) createToken(tokens []models.Token, tokenType consta
 
 	var lastToken models.Token
 	var lastTokenType constants.TokenType
+	var previousTokenType constants.TokenType
 	var lastFieldToken *models.Token
 	var lastOperatorToken *models.Token
 
@@ -144,10 +163,18 @@ func (t *BaseQueryTokenizer) createToken(tokens []models.Token, tokenType consta
 			}
 		}
 
+		previousTokenType = lastTokenType
 		lastToken = v
 		lastTokenType = v.Type
 	}
 
+	if t.isFreeTextToken(allTokens, lastTokenType, tokenType, value) {
+		return &models.Token{
+			Type:  constants.TokenTypeFreeTextValue,
+			Value: strings.Trim(strings.Trim(value, `"`), `'`),
+		}
+	}
+
 	if len(allTokens) == 0 {
 		if tokenType == constants.TokenTypeField || tokenType == constants.TokenTypeLiteral {
 			if t.validateField(value) {
@@ -166,9 +193,20 @@ func (t *BaseQueryTokenizer) createToken(tokens []models.Token, tokenType consta
 				Type:  tokenType,
 				Value: value,
 			}
+		} else if tokenType == constants.TokenTypeSearch {
+			return t.createSearchToken(value)
 		}
+	} else if lastTokenType == constants.TokenTypeSearch && previousTokenType != constants.TokenTypeField {
+		if tokenType == constants.TokenTypeField || tokenType == constants.TokenTypeLiteral || tokenType.IsValueTokenType() {
+			return &models.Token{
+				Type:  constants.TokenTypeStringValue,
+				Value: strings.Trim(strings.Trim(value, `"`), `'`),
+			}
+		}
+	} else if tokenType == constants.TokenTypeSearch && lastTokenType.IsPreFieldTokenType() {
+		return t.createSearchToken(value)
 	} else if tokenType == constants.TokenTypeField {
-		if lastTokenType.IsPreFieldTokenType() {
+		if lastTokenType.IsPreFieldTokenType() || lastTokenType.IsFreeTextTokenType() {
 			if t.validateField(value) {
 				return &models.Token{
 					Type:  constants.TokenTypeField,
@@ -238,7 +276,7 @@ func (t *BaseQueryTokenizer) createToken(tokens []models.Token, tokenType consta
 					Value: value,
 				}
 			}
-		} else if lastTokenType.IsPreFieldTokenType() {
+		} else if lastTokenType.IsPreFieldTokenType() || lastTokenType.IsFreeTextTokenType() {
 			if t.validateField(value) {
 				return &models.Token{
 					Type:  constants.TokenTypeField,
@@ -251,6 +289,15 @@ func (t *BaseQueryTokenizer) createToken(tokens []models.Token, tokenType consta
 				}
 			}
 		}
+	} else if tokenType.IsNullTokenType() {
+		if lastFieldToken != nil && t.isNullAllowed(lastFieldToken.Value, lastTokenType) {
+			return &models.Token{
+				Type:  tokenType,
+				Value: nil,
+			}
+		}
+
+		return t.createToken(tokens, constants.TokenTypeLiteral, value)
 	} else if tokenType.IsValueTokenType() {
 		if lastTokenType.IsComparerTokenType() || lastTokenType.IsSeparatorTokenType() {
 			if lastFieldToken != nil && t.validateValue(lastFieldToken.Value, value) {
@@ -337,6 +384,52 @@ func (t *BaseQueryTokenizer) createToken(tokens []models.Token, tokenType consta
 	}
 }
 
+func (t *BaseQueryTokenizer) isFreeTextEnabled() bool {
+	return t.isFreeTextAllowed && t.metadata.FreeText != nil && len(t.metadata.FreeText.Fields) > 0
+}
+
+func (t *BaseQueryTokenizer) isFreeTextToken(allTokens []models.Token, lastTokenType constants.TokenType, tokenType constants.TokenType, value string) bool {
+	if !t.isFreeTextEnabled() {
+		return false
+	}
+
+	if tokenType != constants.TokenTypeLiteral && !tokenType.IsValueTokenType() {
+		return false
+	}
+
+	if tokenType == constants.TokenTypeLiteral && t.validateField(value) {
+		return false
+	}
+
+	return len(allTokens) == 0 ||
+		lastTokenType.IsPreFieldTokenType() ||
+		lastTokenType.IsValueTokenType() ||
+		lastTokenType.IsCloseGroupTokenType() ||
+		lastTokenType.IsNotComparerTokenType()
+}
+
+func (t *BaseQueryTokenizer) isNullAllowed(field interface{}, lastTokenType constants.TokenType) bool {
+	if lastTokenType != constants.TokenTypeEqual && lastTokenType != constants.TokenTypeNotEqual {
+		return false
+	}
+
+	return t.validateOperator(field, constants.OperatorIsNull.String())
+}
+
+func (t *BaseQueryTokenizer) createSearchToken(value string) *models.Token {
+	if len(t.metadata.GetSearchFields()) == 0 {
+		return &models.Token{
+			Type:  constants.TokenTypeNone,
+			Value: value,
+		}
+	}
+
+	return &models.Token{
+		Type:  constants.TokenTypeSearch,
+		Value: value,
+	}
+}
+
 func (t *BaseQueryTokenizer) findMatch(text string) *tokenMatch {
 	for _, v := range t.tokenPatterns {
 		re := regexp.MustCompile(v.tokenPattern)
@@ -418,6 +511,14 @@ func (t *BaseQueryTokenizer) validateValue(field interface{}, value string) bool
 			return utils.IsNumber(value)
 		case constants.FieldTypeNumberArray.String():
 			return utils.IsNumber(value)
+		case constants.FieldTypeInteger.String():
+			return utils.IsInteger(value)
+		case constants.FieldTypeIntegerArray.String():
+			return utils.IsInteger(value)
+		case constants.FieldTypeDecimal.String():
+			return utils.IsDecimal(value)
+		case constants.FieldTypeDecimalArray.String():
+			return utils.IsDecimal(value)
 		case constants.FieldTypeBoolean.String():
 			return utils.IsBoolean(value)
 		case constants.FieldTypeBooleanArray.String():
@@ -430,10 +531,24 @@ func (t *BaseQueryTokenizer) validateValue(field interface{}, value string) bool
 			return utils.IsTime(value)
 		case constants.FieldTypeTimeArray.String():
 			return utils.IsTime(value)
+		case constants.FieldTypeDuration.String():
+			return utils.IsDuration(value)
+		case constants.FieldTypeDurationArray.String():
+			return utils.IsDuration(value)
 		case constants.FieldTypeDateTime.String():
-			return utils.IsDateTime(value)
+			return utils.IsDateTime(value) || utils.IsTimeRange(value)
 		case constants.FieldTypeDateTimeArray.String():
-			return utils.IsDateTime(value)
+			return utils.IsDateTime(value) || utils.IsTimeRange(value)
+		case constants.FieldTypeUUID.String():
+			return utils.IsUUID(value)
+		case constants.FieldTypeUUIDArray.String():
+			return utils.IsUUID(value)
+		case constants.FieldTypeIP.String():
+			return utils.IsIP(value)
+		case constants.FieldTypeIPArray.String():
+			return utils.IsIP(value)
+		case constants.FieldTypeGeoPoint.String():
+			return utils.IsGeoArea(value)
 		}
 
 		return false
@@ -479,6 +594,18 @@ func (t *BaseQueryTokenizer) castValue(field interface{}, value interface{}) int
 	case constants.FieldTypeNumberArray.String():
 		f, _ := utils.Number(value)
 		return f
+	case constants.FieldTypeInteger.String():
+		i, _ := utils.Integer(value)
+		return i
+	case constants.FieldTypeIntegerArray.String():
+		i, _ := utils.Integer(value)
+		return i
+	case constants.FieldTypeDecimal.String():
+		d, _ := utils.Decimal(value)
+		return d
+	case constants.FieldTypeDecimalArray.String():
+		d, _ := utils.Decimal(value)
+		return d
 	case constants.FieldTypeBoolean.String():
 		b, _ := utils.Boolean(value)
 		return b
@@ -486,10 +613,10 @@ func (t *BaseQueryTokenizer) castValue(field interface{}, value interface{}) int
 		b, _ := utils.Boolean(value)
 		return b
 	case constants.FieldTypeDate.String():
-		t, _ := utils.Date(value)
+		t, _ := utils.DateIn(value, t.metadata.Location)
 		return t
 	case constants.FieldTypeDateArray.String():
-		t, _ := utils.Date(value)
+		t, _ := utils.DateIn(value, t.metadata.Location)
 		return t
 	case constants.FieldTypeTime.String():
 		d, _ := utils.Time(value)
@@ -497,13 +624,59 @@ func (t *BaseQueryTokenizer) castValue(field interface{}, value interface{}) int
 	case constants.FieldTypeTimeArray.String():
 		d, _ := utils.Time(value)
 		return d
+	case constants.FieldTypeDuration.String():
+		d, _ := utils.Duration(value)
+		return d
+	case constants.FieldTypeDurationArray.String():
+		d, _ := utils.Duration(value)
+		return d
 	case constants.FieldTypeDateTime.String():
-		dt, _ := utils.DateTime(value)
+		if r, err := utils.TimeRangeIn(value, t.metadata.Location); err == nil {
+			return r
+		}
+		dt, _ := utils.DateTimeIn(value, t.metadata.Location)
 		return dt
 	case constants.FieldTypeDateTimeArray.String():
-		dt, _ := utils.DateTime(value)
+		if r, err := utils.TimeRangeIn(value, t.metadata.Location); err == nil {
+			return r
+		}
+		dt, _ := utils.DateTimeIn(value, t.metadata.Location)
 		return dt
+	case constants.FieldTypeUUID.String():
+		u, _ := utils.UUID(value)
+		return u
+	case constants.FieldTypeUUIDArray.String():
+		u, _ := utils.UUID(value)
+		return u
+	case constants.FieldTypeEnum.String():
+		return t.castEnumValue(field, value)
+	case constants.FieldTypeEnumArray.String():
+		return t.castEnumValue(field, value)
+	case constants.FieldTypeIP.String():
+		ip, _ := utils.IP(value)
+		return ip
+	case constants.FieldTypeIPArray.String():
+		ip, _ := utils.IP(value)
+		return ip
+	case constants.FieldTypeGeoPoint.String():
+		a, _ := utils.GeoArea(value)
+		return a
 	}
 
 	return value
 }
+
+func (t *BaseQueryTokenizer) castEnumValue(field interface{}, value interface{}) interface{} {
+	s, _ := utils.String(value)
+	s = strings.Trim(strings.Trim(s, `"`), `'`)
+
+	for _, v := range t.metadata.GetFieldValues(field.(string)) {
+		vVal, _ := utils.String(v.Value)
+
+		if strings.ToLower(vVal) == strings.ToLower(s) || strings.ToLower(v.Name) == strings.ToLower(s) {
+			return v.Value
+		}
+	}
+
+	return s
+}
@@ -6685,8 +6685,8 @@ func TestBaseQueryTokenizer_CastValue_ShouldReturnCasted_WhenFieldIsValid(t *tes
 		"BooleanArrayValue":  {"False", false},
 		"DateValue":          {"2020-01-01", &dateValueResult},
 		"DateArrayValue":     {"2020-01-01", &dateValueResult},
-		"TimeValue":          {"1h15m", &timeValueResult},
-		"TimeArrayValue":     {"1h15m", &timeValueResult},
+		"TimeValue":          {"01:15", &timeValueResult},
+		"TimeArrayValue":     {"01:15", &timeValueResult},
 		"DateTimeValue":      {"2020-01-01 11:12:13", &dateTimeValueResult},
 		"DateTimeArrayValue": {"2020-01-01 11:12:13", &dateTimeValueResult},
 	}
@@ -6699,3 +6699,32 @@ func TestBaseQueryTokenizer_CastValue_ShouldReturnCasted_WhenFieldIsValid(t *tes
 		assert.Equal(t, sample[1], result)
 	}
 }
+
+func TestBaseQueryTokenizer_CastValue_ShouldParseDateInLocation_WhenMetadataHasLocation(t *testing.T) {
+	// Arrange
+	location := time.FixedZone("UTC+3", 3*60*60)
+	metadata := (&models.Metadata{
+		Fields: []models.Field{
+			{
+				Name:  "Value",
+				Type:  constants.FieldTypeDate.String(),
+				Label: "Value",
+			},
+			{
+				Name:  "Other",
+				Type:  constants.FieldTypeDateTime.String(),
+				Label: "Other",
+			},
+		},
+	}).WithLocation(location)
+
+	baseQueryTokenizer := NewBaseQueryTokenizer(metadata)
+
+	// Act
+	date := baseQueryTokenizer.castValue("Value", "2024-03-10")
+	dateTime := baseQueryTokenizer.castValue("Other", "2024-03-10 10:30")
+
+	// Assert
+	assert.Equal(t, time.Date(2024, 3, 10, 0, 0, 0, 0, location), *date.(*time.Time))
+	assert.Equal(t, time.Date(2024, 3, 10, 10, 30, 0, 0, location), *dateTime.(*time.Time))
+}
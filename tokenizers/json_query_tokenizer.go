@@ -2,6 +2,7 @@ package tokenizers
 
 import (
 	"encoding/json"
+	"strings"
 
 	"github.com/filtex/filtex-go/constants"
 	"github.com/filtex/filtex-go/errors"
@@ -21,7 +22,11 @@ func NewJsonQueryTokenizer(metadata *models.Metadata) JsonQueryTokenizer {
 
 func (t *jsonQueryTokenizer) Tokenize(query string) ([]interface{}, error) {
 	var data []interface{}
-	err := json.Unmarshal([]byte(query), &data)
+
+	decoder := json.NewDecoder(strings.NewReader(query))
+	decoder.UseNumber()
+
+	err := decoder.Decode(&data)
 	if err != nil {
 		return nil, err
 	}
@@ -57,6 +62,14 @@ func (t *jsonQueryTokenizer) tokenizeInternal(data []interface{}) ([]interface{}
 			}
 		}
 
+		if data[2] == nil {
+			return []interface{}{
+				*fieldToken,
+				*operatorToken,
+				*t.createNullValueToken(*fieldToken, *operatorToken),
+			}, nil
+		}
+
 		if utils.IsArray(data[2]) {
 			valueTokens := make([]models.Token, 0)
 			values, err := utils.Array(data[2])
@@ -70,6 +83,9 @@ func (t *jsonQueryTokenizer) tokenizeInternal(data []interface{}) ([]interface{}
 					return nil, err
 				}
 				valueMatch := t.findMatch(valueString)
+				if valueMatch != nil && valueMatch.tokenType.IsNullTokenType() {
+					valueMatch.tokenType = constants.TokenTypeLiteral
+				}
 
 				var valueToken *models.Token
 
@@ -81,6 +97,8 @@ func (t *jsonQueryTokenizer) tokenizeInternal(data []interface{}) ([]interface{}
 							Type:  constants.TokenTypeStringValue,
 							Value: valueString,
 						}
+					} else {
+						valueToken = t.createToken([]models.Token{*fieldToken, *operatorToken}, constants.TokenTypeLiteral, valueString)
 					}
 				} else if operatorToken.Type.IsNotComparerTokenType() && valueString == "" {
 					valueToken = &models.Token{
@@ -109,6 +127,9 @@ func (t *jsonQueryTokenizer) tokenizeInternal(data []interface{}) ([]interface{}
 				return nil, err
 			}
 			valueMatch := t.findMatch(valueString)
+			if valueMatch != nil && valueMatch.tokenType.IsNullTokenType() {
+				valueMatch.tokenType = constants.TokenTypeLiteral
+			}
 
 			var valueToken *models.Token
 
@@ -120,6 +141,8 @@ func (t *jsonQueryTokenizer) tokenizeInternal(data []interface{}) ([]interface{}
 						Type:  constants.TokenTypeStringValue,
 						Value: valueString,
 					}
+				} else {
+					valueToken = t.createToken([]models.Token{*fieldToken, *operatorToken}, constants.TokenTypeLiteral, valueString)
 				}
 			} else if operatorToken.Type.IsNotComparerTokenType() && valueString == "" {
 				valueToken = &models.Token{
@@ -150,6 +173,10 @@ func (t *jsonQueryTokenizer) tokenizeInternal(data []interface{}) ([]interface{}
 		}
 		logicMatch := t.findMatch(logicString)
 
+		if constants.OperatorSearch.Equals(logicString) && !utils.IsArray(data[1]) {
+			return t.tokenizeSearch(logicString, data[1])
+		}
+
 		logicToken := models.Token{
 			Type:  constants.TokenTypeNone,
 			Value: logicString,
@@ -197,3 +224,45 @@ func (t *jsonQueryTokenizer) tokenizeInternal(data []interface{}) ([]interface{}
 
 	return nil, errors.NewCouldNotBeTokenizedError()
 }
+
+func (t *jsonQueryTokenizer) createNullValueToken(fieldToken models.Token, operatorToken models.Token) *models.Token {
+	if operatorToken.Type.IsNotComparerTokenType() {
+		return &models.Token{
+			Type:  constants.TokenTypeValue,
+			Value: "",
+		}
+	}
+
+	if fieldToken.Type.IsFieldTokenType() && t.isNullAllowed(fieldToken.Value, operatorToken.Type) {
+		return &models.Token{
+			Type:  constants.TokenTypeNullValue,
+			Value: nil,
+		}
+	}
+
+	return &models.Token{
+		Type:  constants.TokenTypeNone,
+		Value: nil,
+	}
+}
+
+func (t *jsonQueryTokenizer) tokenizeSearch(searchString string, value interface{}) ([]interface{}, error) {
+	searchToken := t.createSearchToken(searchString)
+
+	valueToken := models.Token{
+		Type:  constants.TokenTypeNone,
+		Value: value,
+	}
+
+	if valueString, err := utils.String(value); err == nil && valueString != "" {
+		valueToken = models.Token{
+			Type:  constants.TokenTypeStringValue,
+			Value: valueString,
+		}
+	}
+
+	return []interface{}{
+		*searchToken,
+		valueToken,
+	}, nil
+}
@@ -2,6 +2,7 @@ package tokenizers
 
 import (
 	"testing"
+	"time"
 
 	"github.com/filtex/filtex-go/constants"
 	"github.com/filtex/filtex-go/models"
@@ -265,3 +266,103 @@ func TestJsonQueryTokenizer_Tokenize_ShouldReturnTokens_WhenQueryLengthIsTwo(t *
 		assert.Equal(t, tokenExpressions[1], resultExpressions[1])
 	}
 }
+
+func TestJsonQueryTokenizer_Tokenize_ShouldKeepPrecision_WhenFieldTypeIsIntegerOrDecimal(t *testing.T) {
+	// Arrange
+	metadata := models.Metadata{
+		Fields: []models.Field{
+			{
+				Name:  "Id",
+				Type:  constants.FieldTypeInteger.String(),
+				Label: "Id",
+				Operators: []string{
+					constants.OperatorEqual.String(),
+				},
+			},
+			{
+				Name:  "Price",
+				Type:  constants.FieldTypeDecimal.String(),
+				Label: "Price",
+				Operators: []string{
+					constants.OperatorEqual.String(),
+				},
+			},
+		},
+	}
+
+	jsonQueryTokenizer := NewJsonQueryTokenizer(&metadata)
+
+	// Act
+	result, err := jsonQueryTokenizer.Tokenize(`["And", [["Id", "Equal", 9007199254740993], ["Price", "Equal", 19.90]]]`)
+
+	// Assert
+	assert.NoError(t, err)
+	assert.Equal(t, []interface{}{
+		models.Token{Type: constants.TokenTypeAnd, Value: "And"},
+		[]interface{}{
+			[]interface{}{
+				models.Token{Type: constants.TokenTypeField, Value: "Id"},
+				models.Token{Type: constants.TokenTypeEqual, Value: "Equal"},
+				models.Token{Type: constants.TokenTypeNumberValue, Value: int64(9007199254740993)},
+			},
+			[]interface{}{
+				models.Token{Type: constants.TokenTypeField, Value: "Price"},
+				models.Token{Type: constants.TokenTypeEqual, Value: "Equal"},
+				models.Token{Type: constants.TokenTypeNumberValue, Value: "19.90"},
+			},
+		},
+	}, result)
+}
+
+func TestJsonQueryTokenizer_Tokenize_ShouldReturnValueToken_WhenWholeValueIsValid(t *testing.T) {
+	// Arrange
+	metadata := models.Metadata{
+		Fields: []models.Field{
+			{
+				Name:  "CreatedAt",
+				Type:  constants.FieldTypeDateTime.String(),
+				Label: "CreatedAt",
+				Operators: []string{
+					constants.OperatorGreaterThan.String(),
+				},
+			},
+		},
+	}
+
+	jsonQueryTokenizer := NewJsonQueryTokenizer(&metadata)
+	expected := time.Date(2024, 1, 2, 10, 0, 0, 500000000, time.FixedZone("", 2*60*60))
+
+	// Act
+	result, err := jsonQueryTokenizer.Tokenize(`["CreatedAt", ">", "2024-01-02T10:00:00.5+02:00"]`)
+
+	// Assert
+	assert.NoError(t, err)
+	assert.Len(t, result, 3)
+	assert.Equal(t, constants.TokenTypeValue, result[2].(models.Token).Type)
+	assert.True(t, expected.Equal(*result[2].(models.Token).Value.(*time.Time)))
+}
+
+func TestJsonQueryTokenizer_Tokenize_ShouldReturnNoneToken_WhenWholeValueIsNotValid(t *testing.T) {
+	// Arrange
+	metadata := models.Metadata{
+		Fields: []models.Field{
+			{
+				Name:  "CreatedAt",
+				Type:  constants.FieldTypeDateTime.String(),
+				Label: "CreatedAt",
+				Operators: []string{
+					constants.OperatorGreaterThan.String(),
+				},
+			},
+		},
+	}
+
+	jsonQueryTokenizer := NewJsonQueryTokenizer(&metadata)
+
+	// Act
+	result, err := jsonQueryTokenizer.Tokenize(`["CreatedAt", ">", "2024-99x"]`)
+
+	// Assert
+	assert.NoError(t, err)
+	assert.Equal(t, models.Token{Type: constants.TokenTypeNone, Value: "2024-99x"}, result[2])
+}
@@ -0,0 +1,354 @@
+package tokenizers
+
+import (
+	"regexp"
+	"strings"
+	"unicode"
+
+	"github.com/filtex/filtex-go/constants"
+	"github.com/filtex/filtex-go/models"
+	"github.com/filtex/filtex-go/utils"
+)
+
+type rsqlQueryTokenizer struct {
+	*BaseQueryTokenizer
+}
+
+type rsqlValue struct {
+	text         string
+	isQuoted     bool
+	hasPrefix    bool
+	hasSuffix    bool
+	hasInnerStar bool
+}
+
+var (
+	rsqlOperatorPattern = regexp.MustCompile(`^(==|!=|=[a-zA-Z]*=|>=|<=|>|<)`)
+	rsqlKeywordPattern  = regexp.MustCompile(`(?i)^(and|or)(\s|\(|$)`)
+	rsqlReservedChars   = `"'();,=!<>`
+	rsqlOperators       = map[string]constants.Operator{
+		"==":    constants.OperatorEqual,
+		"!=":    constants.OperatorNotEqual,
+		"=gt=":  constants.OperatorGreaterThan,
+		">":     constants.OperatorGreaterThan,
+		"=ge=":  constants.OperatorGreaterThanOrEqual,
+		">=":    constants.OperatorGreaterThanOrEqual,
+		"=lt=":  constants.OperatorLessThan,
+		"<":     constants.OperatorLessThan,
+		"=le=":  constants.OperatorLessThanOrEqual,
+		"<=":    constants.OperatorLessThanOrEqual,
+		"=in=":  constants.OperatorIn,
+		"=out=": constants.OperatorNotIn,
+	}
+)
+
+func NewRsqlQueryTokenizer(metadata *models.Metadata) RsqlQueryTokenizer {
+	return &rsqlQueryTokenizer{
+		BaseQueryTokenizer: NewBaseQueryTokenizer(metadata),
+	}
+}
+
+func (t *rsqlQueryTokenizer) Tokenize(query string) (*[]models.Token, error) {
+	tokens := make([]models.Token, 0)
+	runes := []rune(query)
+
+	for i := 0; i < len(runes); {
+		r := runes[i]
+
+		if unicode.IsSpace(r) {
+			i++
+			continue
+		}
+
+		switch r {
+		case '(':
+			tokens = append(tokens, models.Token{Type: constants.TokenTypeOpenBracket, Value: "("})
+			i++
+			continue
+		case ')':
+			tokens = append(tokens, models.Token{Type: constants.TokenTypeCloseBracket, Value: ")"})
+			i++
+			continue
+		case ';':
+			tokens = append(tokens, models.Token{Type: constants.TokenTypeAnd, Value: ";"})
+			i++
+			continue
+		case ',':
+			tokens = append(tokens, models.Token{Type: constants.TokenTypeOr, Value: ","})
+			i++
+			continue
+		}
+
+		if len(tokens) > 0 && !tokens[len(tokens)-1].Type.IsLogicTokenType() && !tokens[len(tokens)-1].Type.IsOpenGroupTokenType() {
+			if match := rsqlKeywordPattern.FindStringSubmatch(string(runes[i:])); match != nil {
+				tokenType := constants.ParseLogic(match[1]).ToTokenType()
+				tokens = append(tokens, models.Token{Type: tokenType, Value: match[1]})
+				i += len([]rune(match[1]))
+				continue
+			}
+		}
+
+		comparison, next := t.tokenizeComparison(runes, i)
+		tokens = append(tokens, comparison...)
+
+		if next < 0 {
+			break
+		}
+
+		i = next
+	}
+
+	return &tokens, nil
+}
+
+func (t *rsqlQueryTokenizer) tokenizeComparison(runes []rune, start int) ([]models.Token, int) {
+	i := start
+
+	for i < len(runes) && !unicode.IsSpace(runes[i]) && !strings.ContainsRune(rsqlReservedChars, runes[i]) {
+		i++
+	}
+
+	selector := string(runes[start:i])
+
+	match := rsqlOperatorPattern.FindString(string(runes[i:]))
+	if selector == "" || match == "" {
+		return []models.Token{{Type: constants.TokenTypeNone, Value: string(runes[start:])}}, -1
+	}
+
+	i += len([]rune(match))
+
+	values := make([]rsqlValue, 0)
+	isList := i < len(runes) && runes[i] == '('
+
+	if isList {
+		i++
+
+		for {
+			value, next, ok := t.readValue(runes, skipSpaces(runes, i))
+			if !ok {
+				return []models.Token{{Type: constants.TokenTypeNone, Value: string(runes[start:])}}, -1
+			}
+			values = append(values, value)
+			i = skipSpaces(runes, next)
+
+			if i < len(runes) && runes[i] == ',' {
+				i++
+				continue
+			}
+
+			if i < len(runes) && runes[i] == ')' {
+				i++
+				break
+			}
+
+			return []models.Token{{Type: constants.TokenTypeNone, Value: string(runes[start:])}}, -1
+		}
+	} else {
+		value, next, ok := t.readValue(runes, i)
+		if !ok {
+			return []models.Token{{Type: constants.TokenTypeNone, Value: string(runes[start:])}}, -1
+		}
+		values = append(values, value)
+		i = next
+	}
+
+	return t.createComparisonTokens(selector, match, values, isList), i
+}
+
+func skipSpaces(runes []rune, start int) int {
+	for start < len(runes) && unicode.IsSpace(runes[start]) {
+		start++
+	}
+
+	return start
+}
+
+func (t *rsqlQueryTokenizer) readValue(runes []rune, start int) (rsqlValue, int, bool) {
+	var sb strings.Builder
+
+	stars := make([]int, 0)
+	i := start
+	length := 0
+
+	if i < len(runes) && (runes[i] == '"' || runes[i] == '\'') {
+		quote := runes[i]
+		closed := false
+		i++
+
+		for i < len(runes) {
+			if runes[i] == '\\' && i+1 < len(runes) {
+				sb.WriteRune(runes[i+1])
+				length++
+				i += 2
+				continue
+			}
+
+			if runes[i] == quote {
+				closed = true
+				i++
+				break
+			}
+
+			if runes[i] == '*' {
+				stars = append(stars, length)
+			}
+
+			sb.WriteRune(runes[i])
+			length++
+			i++
+		}
+
+		if !closed {
+			return rsqlValue{}, i, false
+		}
+
+		return newRsqlValue(sb.String(), length, stars, true), i, true
+	}
+
+	for i < len(runes) && !unicode.IsSpace(runes[i]) && !strings.ContainsRune(rsqlReservedChars, runes[i]) {
+		if runes[i] == '*' {
+			stars = append(stars, length)
+		}
+
+		sb.WriteRune(runes[i])
+		length++
+		i++
+	}
+
+	if length == 0 {
+		return rsqlValue{}, i, false
+	}
+
+	return newRsqlValue(sb.String(), length, stars, false), i, true
+}
+
+func newRsqlValue(text string, length int, stars []int, isQuoted bool) rsqlValue {
+	value := rsqlValue{
+		text:     text,
+		isQuoted: isQuoted,
+	}
+
+	for _, v := range stars {
+		if v == 0 {
+			value.hasPrefix = true
+		} else if v == length-1 {
+			value.hasSuffix = true
+		} else {
+			value.hasInnerStar = true
+		}
+	}
+
+	return value
+}
+
+func (t *rsqlQueryTokenizer) createComparisonTokens(selector string, operatorText string, values []rsqlValue, isList bool) []models.Token {
+	tokens := make([]models.Token, 0)
+
+	field := t.metadata.GetField(selector)
+	if field == nil || !t.validateField(selector) {
+		tokens = append(tokens, models.Token{Type: constants.TokenTypeNone, Value: selector})
+	} else {
+		tokens = append(tokens, models.Token{Type: constants.TokenTypeField, Value: selector})
+	}
+
+	operator, ok := rsqlOperators[strings.ToLower(operatorText)]
+	if ok && (operator == constants.OperatorEqual || operator == constants.OperatorNotEqual) && !isList {
+		operator, values[0] = t.applyWildcards(operator, values[0])
+	}
+
+	isMulti := operator == constants.OperatorIn || operator == constants.OperatorNotIn
+	isNull := !isList && !values[0].isQuoted && strings.ToLower(values[0].text) == "null" && field != nil &&
+		t.isNullAllowed(selector, operator.ToTokenType())
+
+	if !ok || operator == constants.OperatorUnknown || field == nil || isMulti != isList ||
+		(!isNull && !t.validateOperator(selector, operator.String())) {
+		tokens = append(tokens, models.Token{Type: constants.TokenTypeNone, Value: operatorText})
+	} else {
+		tokens = append(tokens, models.Token{Type: operator.ToTokenType(), Value: operatorText})
+	}
+
+	for i, v := range values {
+		if i > 0 {
+			tokens = append(tokens, models.Token{Type: constants.TokenTypeComma, Value: ","})
+		}
+
+		if isNull {
+			tokens = append(tokens, models.Token{Type: constants.TokenTypeNullValue, Value: nil})
+			continue
+		}
+
+		tokens = append(tokens, t.createValueToken(field, v))
+	}
+
+	return tokens
+}
+
+func (t *rsqlQueryTokenizer) applyWildcards(operator constants.Operator, value rsqlValue) (constants.Operator, rsqlValue) {
+	if !value.hasPrefix && !value.hasSuffix && !value.hasInnerStar {
+		return operator, value
+	}
+
+	if value.hasInnerStar {
+		return constants.OperatorUnknown, value
+	}
+
+	text := value.text
+
+	if value.hasPrefix {
+		text = text[1:]
+	}
+
+	if value.hasSuffix {
+		text = text[:len(text)-1]
+	}
+
+	if text == "" {
+		return constants.OperatorUnknown, value
+	}
+
+	isEqual := operator == constants.OperatorEqual
+	result := rsqlValue{
+		text:     text,
+		isQuoted: value.isQuoted,
+	}
+
+	if value.hasPrefix && value.hasSuffix {
+		if isEqual {
+			return constants.OperatorContain, result
+		}
+		return constants.OperatorNotContain, result
+	}
+
+	if value.hasSuffix {
+		if isEqual {
+			return constants.OperatorStartWith, result
+		}
+		return constants.OperatorNotStartWith, result
+	}
+
+	if isEqual {
+		return constants.OperatorEndWith, result
+	}
+	return constants.OperatorNotEndWith, result
+}
+
+func (t *rsqlQueryTokenizer) createValueToken(field *models.Field, value rsqlValue) models.Token {
+	if field == nil {
+		return models.Token{Type: constants.TokenTypeNone, Value: value.text}
+	}
+
+	lookupValue := value.text
+
+	for _, v := range field.Values {
+		if strings.ToLower(v.Name) == strings.ToLower(value.text) {
+			lookupValue, _ = utils.String(v.Value)
+			break
+		}
+	}
+
+	if !t.validateValue(field.Label, lookupValue) {
+		return models.Token{Type: constants.TokenTypeNone, Value: value.text}
+	}
+
+	return models.Token{Type: constants.TokenTypeValue, Value: t.castValue(field.Label, lookupValue)}
+}
@@ -0,0 +1,7 @@
+package tokenizers
+
+import "github.com/filtex/filtex-go/models"
+
+type RsqlQueryTokenizer interface {
+	Tokenize(query string) (*[]models.Token, error)
+}
@@ -0,0 +1,24 @@
+package tokenizers
+
+import (
+	"github.com/filtex/filtex-go/models"
+	"github.com/stretchr/testify/mock"
+)
+
+type rsqlQueryTokenizerMock struct {
+	mock.Mock
+}
+
+func (t *rsqlQueryTokenizerMock) Tokenize(query string) (*[]models.Token, error) {
+	args := t.Called(query)
+
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+
+	return args.Get(0).(*[]models.Token), args.Error(1)
+}
+
+func NewRsqlQueryTokenizerMock() *rsqlQueryTokenizerMock {
+	return &rsqlQueryTokenizerMock{}
+}
@@ -0,0 +1,193 @@
+package tokenizers
+
+import (
+	"testing"
+
+	"github.com/filtex/filtex-go/constants"
+	"github.com/filtex/filtex-go/models"
+	"github.com/stretchr/testify/assert"
+)
+
+func newRsqlTestMetadata() *models.Metadata {
+	return &models.Metadata{
+		Fields: []models.Field{
+			{
+				Name:  "status",
+				Type:  constants.FieldTypeString.String(),
+				Label: "Status",
+				Operators: []string{
+					constants.OperatorEqual.String(),
+					constants.OperatorNotEqual.String(),
+					constants.OperatorIn.String(),
+					constants.OperatorNotIn.String(),
+				},
+				Values: []models.Lookup{
+					{Name: "Active", Value: "active"},
+					{Name: "Passive", Value: "passive"},
+				},
+			},
+			{
+				Name:  "name",
+				Type:  constants.FieldTypeString.String(),
+				Label: "Name",
+				Operators: []string{
+					constants.OperatorEqual.String(),
+					constants.OperatorNotEqual.String(),
+					constants.OperatorContain.String(),
+					constants.OperatorNotContain.String(),
+					constants.OperatorStartWith.String(),
+					constants.OperatorNotStartWith.String(),
+					constants.OperatorEndWith.String(),
+					constants.OperatorNotEndWith.String(),
+					constants.OperatorIsNull.String(),
+					constants.OperatorIsNotNull.String(),
+				},
+			},
+			{
+				Name:  "age",
+				Type:  constants.FieldTypeNumber.String(),
+				Label: "Age",
+				Operators: []string{
+					constants.OperatorEqual.String(),
+					constants.OperatorGreaterThan.String(),
+					constants.OperatorGreaterThanOrEqual.String(),
+					constants.OperatorLessThan.String(),
+					constants.OperatorLessThanOrEqual.String(),
+				},
+			},
+		},
+	}
+}
+
+func TestRsqlQueryTokenizer_Tokenize_ShouldReturnTokens_WhenQueryIsValid(t *testing.T) {
+	// Arrange
+	tokenizer := NewRsqlQueryTokenizer(newRsqlTestMetadata())
+
+	// Act
+	result, err := tokenizer.Tokenize(`status==Active;(age=gt=18,name=="A*")`)
+
+	// Assert
+	assert.NoError(t, err)
+	assert.Equal(t, []models.Token{
+		{Type: constants.TokenTypeField, Value: "status"},
+		{Type: constants.TokenTypeEqual, Value: "=="},
+		{Type: constants.TokenTypeValue, Value: "active"},
+		{Type: constants.TokenTypeAnd, Value: ";"},
+		{Type: constants.TokenTypeOpenBracket, Value: "("},
+		{Type: constants.TokenTypeField, Value: "age"},
+		{Type: constants.TokenTypeGreaterThan, Value: "=gt="},
+		{Type: constants.TokenTypeValue, Value: 18.0},
+		{Type: constants.TokenTypeOr, Value: ","},
+		{Type: constants.TokenTypeField, Value: "name"},
+		{Type: constants.TokenTypeStartWith, Value: "=="},
+		{Type: constants.TokenTypeValue, Value: "A"},
+		{Type: constants.TokenTypeCloseBracket, Value: ")"},
+	}, *result)
+}
+
+func TestRsqlQueryTokenizer_Tokenize_ShouldMapOperators(t *testing.T) {
+	// Arrange
+	sampleMap := map[string]constants.TokenType{
+		`age==1`:              constants.TokenTypeEqual,
+		`age=gt=1`:            constants.TokenTypeGreaterThan,
+		`age>1`:               constants.TokenTypeGreaterThan,
+		`age=ge=1`:            constants.TokenTypeGreaterThanOrEqual,
+		`age>=1`:              constants.TokenTypeGreaterThanOrEqual,
+		`age=lt=1`:            constants.TokenTypeLessThan,
+		`age<1`:               constants.TokenTypeLessThan,
+		`age=LE=1`:            constants.TokenTypeLessThanOrEqual,
+		`age<=1`:              constants.TokenTypeLessThanOrEqual,
+		`status!=active`:      constants.TokenTypeNotEqual,
+		`status=in=(active)`:  constants.TokenTypeIn,
+		`status=out=(active)`: constants.TokenTypeNotIn,
+		`name==*ex*`:          constants.TokenTypeContain,
+		`name!=*ex*`:          constants.TokenTypeNotContain,
+		`name==fil*`:          constants.TokenTypeStartWith,
+		`name!=fil*`:          constants.TokenTypeNotStartWith,
+		`name=='*tex'`:        constants.TokenTypeEndWith,
+		`name!=*tex`:          constants.TokenTypeNotEndWith,
+	}
+
+	for query, expected := range sampleMap {
+		// Act
+		result, err := NewRsqlQueryTokenizer(newRsqlTestMetadata()).Tokenize(query)
+
+		// Assert
+		assert.NoError(t, err)
+		assert.Equal(t, expected, (*result)[1].Type, query)
+	}
+}
+
+func TestRsqlQueryTokenizer_Tokenize_ShouldReturnListTokens_WhenOperatorIsIn(t *testing.T) {
+	// Act
+	result, err := NewRsqlQueryTokenizer(newRsqlTestMetadata()).Tokenize(`status=in=(Active, "passive")`)
+
+	// Assert
+	assert.NoError(t, err)
+	assert.Equal(t, []models.Token{
+		{Type: constants.TokenTypeField, Value: "status"},
+		{Type: constants.TokenTypeIn, Value: "=in="},
+		{Type: constants.TokenTypeValue, Value: "active"},
+		{Type: constants.TokenTypeComma, Value: ","},
+		{Type: constants.TokenTypeValue, Value: "passive"},
+	}, *result)
+}
+
+func TestRsqlQueryTokenizer_Tokenize_ShouldReturnLogicTokens_WhenKeywordsAreUsed(t *testing.T) {
+	// Act
+	result, err := NewRsqlQueryTokenizer(newRsqlTestMetadata()).Tokenize(`age>1 and name==x OR age<0`)
+
+	// Assert
+	assert.NoError(t, err)
+	assert.Equal(t, models.Token{Type: constants.TokenTypeAnd, Value: "and"}, (*result)[3])
+	assert.Equal(t, models.Token{Type: constants.TokenTypeOr, Value: "OR"}, (*result)[7])
+}
+
+func TestRsqlQueryTokenizer_Tokenize_ShouldUnescapeQuotedValues(t *testing.T) {
+	// Act
+	result, err := NewRsqlQueryTokenizer(newRsqlTestMetadata()).Tokenize(`name=="say \"hi\" \*"`)
+
+	// Assert
+	assert.NoError(t, err)
+	assert.Equal(t, models.Token{Type: constants.TokenTypeEqual, Value: "=="}, (*result)[1])
+	assert.Equal(t, models.Token{Type: constants.TokenTypeValue, Value: `say "hi" *`}, (*result)[2])
+}
+
+func TestRsqlQueryTokenizer_Tokenize_ShouldReturnNullToken_WhenNullIsAllowed(t *testing.T) {
+	// Act
+	nullResult, nullErr := NewRsqlQueryTokenizer(newRsqlTestMetadata()).Tokenize(`name==null`)
+	quotedResult, quotedErr := NewRsqlQueryTokenizer(newRsqlTestMetadata()).Tokenize(`name=="null"`)
+
+	// Assert
+	assert.NoError(t, nullErr)
+	assert.NoError(t, quotedErr)
+	assert.Equal(t, models.Token{Type: constants.TokenTypeNullValue, Value: nil}, (*nullResult)[2])
+	assert.Equal(t, models.Token{Type: constants.TokenTypeValue, Value: "null"}, (*quotedResult)[2])
+}
+
+func TestRsqlQueryTokenizer_Tokenize_ShouldReturnNoneToken_WhenQueryIsNotValid(t *testing.T) {
+	// Arrange
+	sampleMap := map[string]int{
+		`unknown==1`:                 0,
+		`age=foo=1`:                  1,
+		`age=in=1`:                   1,
+		`age==(1,2)`:                 1,
+		`age=gt=abc`:                 2,
+		`name==a*b`:                  1,
+		`name==*`:                    1,
+		`status==deleted`:            2,
+		`status=in=(active,deleted)`: 4,
+		`name=="open`:                0,
+		`==1`:                        0,
+		`age`:                        0,
+	}
+
+	for query, index := range sampleMap {
+		// Act
+		result, err := NewRsqlQueryTokenizer(newRsqlTestMetadata()).Tokenize(query)
+
+		// Assert
+		assert.NoError(t, err)
+		assert.Equal(t, constants.TokenTypeNone, (*result)[index].Type, query)
+	}
+}
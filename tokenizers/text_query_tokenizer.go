@@ -12,8 +12,11 @@ type textQueryTokenizer struct {
 }
 
 func NewTextQueryTokenizer(metadata *models.Metadata) TextQueryTokenizer {
+	baseQueryTokenizer := NewBaseQueryTokenizer(metadata)
+	baseQueryTokenizer.isFreeTextAllowed = true
+
 	return &textQueryTokenizer{
-		BaseQueryTokenizer: NewBaseQueryTokenizer(metadata),
+		BaseQueryTokenizer: baseQueryTokenizer,
 	}
 }
 
@@ -25,6 +28,8 @@ func (t *textQueryTokenizer) Tokenize(text string) (*[]models.Token, error) {
 	for len(remainingText) > 0 {
 		match := t.findMatch(remainingText)
 		if match != nil {
+			t.releaseField(tokens, match.tokenType)
+
 			token := t.createToken(tokens, match.tokenType, match.value)
 			if token != nil {
 				tokens = append(tokens, *token)
@@ -46,6 +51,8 @@ func (t *textQueryTokenizer) Tokenize(text string) (*[]models.Token, error) {
 					break
 				}
 
+				t.releaseField(tokens, constants.TokenTypeNone)
+
 				token := t.createToken(tokens, constants.TokenTypeNone, string(invalidTokenMatch))
 				if token != nil {
 					tokens = append(tokens, *token)
@@ -55,5 +62,28 @@ func (t *textQueryTokenizer) Tokenize(text string) (*[]models.Token, error) {
 		}
 	}
 
+	t.releaseField(tokens, constants.TokenTypeNone)
+
 	return &tokens, nil
 }
+
+func (t *textQueryTokenizer) releaseField(tokens []models.Token, nextTokenType constants.TokenType) {
+	if !t.isFreeTextEnabled() || nextTokenType == constants.TokenTypeSpace || nextTokenType.IsOperatorTokenType() {
+		return
+	}
+
+	for i := len(tokens) - 1; i >= 0; i-- {
+		if tokens[i].Type == constants.TokenTypeSpace {
+			continue
+		}
+
+		if tokens[i].Type.IsFieldTokenType() {
+			tokens[i] = models.Token{
+				Type:  constants.TokenTypeFreeTextValue,
+				Value: tokens[i].Value,
+			}
+		}
+
+		return
+	}
+}
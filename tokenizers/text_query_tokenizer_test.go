@@ -2,9 +2,11 @@ package tokenizers
 
 import (
 	"testing"
+	"time"
 
 	"github.com/filtex/filtex-go/constants"
 	"github.com/filtex/filtex-go/models"
+	"github.com/filtex/filtex-go/utils"
 	"github.com/stretchr/testify/assert"
 )
 
@@ -129,3 +131,478 @@ func TestTextQueryTokenizer_Tokenize_ShouldReturnTokens(t *testing.T) {
 		}
 	}
 }
+
+func TestTextQueryTokenizer_Tokenize_ShouldReturnSearchTokens_WhenSearchHasNoField(t *testing.T) {
+	// Arrange
+	metadata := models.Metadata{
+		Fields: []models.Field{
+			{
+				Name:  "Value",
+				Type:  constants.FieldTypeString.String(),
+				Label: "Value",
+				Operators: []string{
+					constants.OperatorEqual.String(),
+					constants.OperatorSearch.String(),
+				},
+			},
+		},
+	}
+
+	textQueryTokenizer := NewTextQueryTokenizer(&metadata)
+
+	// Act
+	result, err := textQueryTokenizer.Tokenize(`Value Equal Test And Search "red shoes"`)
+
+	// Assert
+	assert.NoError(t, err)
+	assert.Equal(t, []models.Token{
+		{Type: constants.TokenTypeField, Value: "Value"},
+		{Type: constants.TokenTypeSpace, Value: " "},
+		{Type: constants.TokenTypeEqual, Value: "Equal"},
+		{Type: constants.TokenTypeSpace, Value: " "},
+		{Type: constants.TokenTypeValue, Value: "Test"},
+		{Type: constants.TokenTypeSpace, Value: " "},
+		{Type: constants.TokenTypeAnd, Value: "And"},
+		{Type: constants.TokenTypeSpace, Value: " "},
+		{Type: constants.TokenTypeSearch, Value: "Search"},
+		{Type: constants.TokenTypeSpace, Value: " "},
+		{Type: constants.TokenTypeStringValue, Value: "red shoes"},
+	}, *result)
+}
+
+func TestTextQueryTokenizer_Tokenize_ShouldReturnNoneToken_WhenThereIsNoSearchableField(t *testing.T) {
+	// Arrange
+	metadata := models.Metadata{
+		Fields: []models.Field{
+			{
+				Name:  "Value",
+				Type:  constants.FieldTypeString.String(),
+				Label: "Value",
+				Operators: []string{
+					constants.OperatorEqual.String(),
+				},
+			},
+		},
+	}
+
+	textQueryTokenizer := NewTextQueryTokenizer(&metadata)
+
+	// Act
+	result, err := textQueryTokenizer.Tokenize(`search shoes`)
+
+	// Assert
+	assert.NoError(t, err)
+	assert.Equal(t, constants.TokenTypeNone, (*result)[0].Type)
+}
+
+func TestTextQueryTokenizer_Tokenize_ShouldReturnNullTokens_WhenFieldIsNullable(t *testing.T) {
+	// Arrange
+	metadata := models.Metadata{
+		Fields: []models.Field{
+			{
+				Name:  "Value",
+				Type:  constants.FieldTypeNumber.String(),
+				Label: "Value",
+				Operators: []string{
+					constants.OperatorEqual.String(),
+					constants.OperatorNotEqual.String(),
+					constants.OperatorIsNull.String(),
+					constants.OperatorIsNotNull.String(),
+				},
+			},
+		},
+	}
+
+	textQueryTokenizer := NewTextQueryTokenizer(&metadata)
+
+	// Act
+	result, err := textQueryTokenizer.Tokenize(`Value = null or Value is not null`)
+
+	// Assert
+	assert.NoError(t, err)
+	assert.Equal(t, []models.Token{
+		{Type: constants.TokenTypeField, Value: "Value"},
+		{Type: constants.TokenTypeSpace, Value: " "},
+		{Type: constants.TokenTypeEqual, Value: "="},
+		{Type: constants.TokenTypeSpace, Value: " "},
+		{Type: constants.TokenTypeNullValue, Value: nil},
+		{Type: constants.TokenTypeSpace, Value: " "},
+		{Type: constants.TokenTypeOr, Value: "or"},
+		{Type: constants.TokenTypeSpace, Value: " "},
+		{Type: constants.TokenTypeField, Value: "Value"},
+		{Type: constants.TokenTypeSpace, Value: " "},
+		{Type: constants.TokenTypeIsNotNull, Value: "is not null"},
+	}, *result)
+}
+
+func TestTextQueryTokenizer_Tokenize_ShouldReturnLiteralValue_WhenNullIsNotAllowed(t *testing.T) {
+	// Arrange
+	metadata := models.Metadata{
+		Fields: []models.Field{
+			{
+				Name:  "Value",
+				Type:  constants.FieldTypeString.String(),
+				Label: "Value",
+				Operators: []string{
+					constants.OperatorEqual.String(),
+				},
+			},
+		},
+	}
+
+	textQueryTokenizer := NewTextQueryTokenizer(&metadata)
+
+	// Act
+	result, err := textQueryTokenizer.Tokenize(`Value = null`)
+
+	// Assert
+	assert.NoError(t, err)
+	assert.Equal(t, models.Token{Type: constants.TokenTypeValue, Value: "null"}, (*result)[4])
+}
+
+func TestTextQueryTokenizer_Tokenize_ShouldReturnTimeRangeTokens_WhenDateTimeValueIsPartial(t *testing.T) {
+	// Arrange
+	metadata := models.Metadata{
+		Fields: []models.Field{
+			{
+				Name:  "Value",
+				Type:  constants.FieldTypeDateTime.String(),
+				Label: "Value",
+				Operators: []string{
+					constants.OperatorEqual.String(),
+					constants.OperatorIn.String(),
+				},
+			},
+		},
+	}
+
+	textQueryTokenizer := NewTextQueryTokenizer(&metadata)
+
+	// Act
+	result, err := textQueryTokenizer.Tokenize(`Value In 2024-01, 2024-02-05, 2024-02-06T10`)
+
+	// Assert
+	assert.NoError(t, err)
+	assert.Equal(t, []models.Token{
+		{Type: constants.TokenTypeField, Value: "Value"},
+		{Type: constants.TokenTypeSpace, Value: " "},
+		{Type: constants.TokenTypeIn, Value: "In"},
+		{Type: constants.TokenTypeSpace, Value: " "},
+		{Type: constants.TokenTypeDateValue, Value: &utils.TimeRange{
+			Start: time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC),
+			End:   time.Date(2024, 2, 1, 0, 0, 0, 0, time.UTC),
+		}},
+		{Type: constants.TokenTypeComma, Value: ","},
+		{Type: constants.TokenTypeSpace, Value: " "},
+		{Type: constants.TokenTypeDateValue, Value: &utils.TimeRange{
+			Start: time.Date(2024, 2, 5, 0, 0, 0, 0, time.UTC),
+			End:   time.Date(2024, 2, 6, 0, 0, 0, 0, time.UTC),
+		}},
+		{Type: constants.TokenTypeComma, Value: ","},
+		{Type: constants.TokenTypeSpace, Value: " "},
+		{Type: constants.TokenTypeDateTimeValue, Value: &utils.TimeRange{
+			Start: time.Date(2024, 2, 6, 10, 0, 0, 0, time.UTC),
+			End:   time.Date(2024, 2, 6, 11, 0, 0, 0, time.UTC),
+		}},
+	}, *result)
+}
+
+func TestTextQueryTokenizer_Tokenize_ShouldReturnHourRangeToken_WhenDateTimeValueHasSpaceSeparatedHour(t *testing.T) {
+	// Arrange
+	metadata := models.Metadata{
+		Fields: []models.Field{
+			{
+				Name:  "Value",
+				Type:  constants.FieldTypeDateTime.String(),
+				Label: "Value",
+				Operators: []string{
+					constants.OperatorEqual.String(),
+				},
+			},
+		},
+	}
+
+	textQueryTokenizer := NewTextQueryTokenizer(&metadata)
+
+	// Act
+	result, err := textQueryTokenizer.Tokenize(`Value = 2024-01-05 10`)
+
+	// Assert
+	assert.NoError(t, err)
+	assert.Equal(t, []models.Token{
+		{Type: constants.TokenTypeField, Value: "Value"},
+		{Type: constants.TokenTypeSpace, Value: " "},
+		{Type: constants.TokenTypeEqual, Value: "="},
+		{Type: constants.TokenTypeSpace, Value: " "},
+		{Type: constants.TokenTypeDateTimeValue, Value: &utils.TimeRange{
+			Start: time.Date(2024, 1, 5, 10, 0, 0, 0, time.UTC),
+			End:   time.Date(2024, 1, 5, 11, 0, 0, 0, time.UTC),
+		}},
+	}, *result)
+}
+
+func TestTextQueryTokenizer_Tokenize_ShouldReturnNumberTokens_WhenValueIsNegativeOrHasExponent(t *testing.T) {
+	// Arrange
+	metadata := models.Metadata{
+		Fields: []models.Field{
+			{
+				Name:  "Value",
+				Type:  constants.FieldTypeInteger.String(),
+				Label: "Value",
+				Operators: []string{
+					constants.OperatorIn.String(),
+				},
+			},
+		},
+	}
+
+	textQueryTokenizer := NewTextQueryTokenizer(&metadata)
+
+	// Act
+	result, err := textQueryTokenizer.Tokenize(`Value In -5, 1e3`)
+
+	// Assert
+	assert.NoError(t, err)
+	assert.Equal(t, []models.Token{
+		{Type: constants.TokenTypeField, Value: "Value"},
+		{Type: constants.TokenTypeSpace, Value: " "},
+		{Type: constants.TokenTypeIn, Value: "In"},
+		{Type: constants.TokenTypeSpace, Value: " "},
+		{Type: constants.TokenTypeNumberValue, Value: int64(-5)},
+		{Type: constants.TokenTypeComma, Value: ","},
+		{Type: constants.TokenTypeSpace, Value: " "},
+		{Type: constants.TokenTypeNumberValue, Value: int64(1000)},
+	}, *result)
+}
+
+func TestTextQueryTokenizer_Tokenize_ShouldReturnValueTokens_WhenFieldTypesAreUUIDEnumAndIP(t *testing.T) {
+	// Arrange
+	metadata := models.Metadata{
+		Fields: []models.Field{
+			{
+				Name:  "Id",
+				Type:  constants.FieldTypeUUID.String(),
+				Label: "Id",
+				Operators: []string{
+					constants.OperatorEqual.String(),
+				},
+			},
+			{
+				Name:  "Status",
+				Type:  constants.FieldTypeEnum.String(),
+				Label: "Status",
+				Operators: []string{
+					constants.OperatorEqual.String(),
+				},
+				Values: []models.Lookup{
+					{Name: "Active", Value: "active"},
+				},
+			},
+			{
+				Name:  "Address",
+				Type:  constants.FieldTypeIP.String(),
+				Label: "Address",
+				Operators: []string{
+					constants.OperatorInSubnet.String(),
+					constants.OperatorIn.String(),
+				},
+			},
+		},
+	}
+
+	textQueryTokenizer := NewTextQueryTokenizer(&metadata)
+
+	// Act
+	result, err := textQueryTokenizer.Tokenize(`Id = 12345678-9ABC-DEF0-1234-56789ABCDEF0 And Status = Active And Address In Subnet 10.0.0.0/8 And Address In fe80::1, ::ffff:10.0.0.1`)
+
+	// Assert
+	assert.NoError(t, err)
+	assert.Equal(t, []models.Token{
+		{Type: constants.TokenTypeField, Value: "Id"},
+		{Type: constants.TokenTypeSpace, Value: " "},
+		{Type: constants.TokenTypeEqual, Value: "="},
+		{Type: constants.TokenTypeSpace, Value: " "},
+		{Type: constants.TokenTypeValue, Value: "12345678-9abc-def0-1234-56789abcdef0"},
+		{Type: constants.TokenTypeSpace, Value: " "},
+		{Type: constants.TokenTypeAnd, Value: "And"},
+		{Type: constants.TokenTypeSpace, Value: " "},
+		{Type: constants.TokenTypeField, Value: "Status"},
+		{Type: constants.TokenTypeSpace, Value: " "},
+		{Type: constants.TokenTypeEqual, Value: "="},
+		{Type: constants.TokenTypeSpace, Value: " "},
+		{Type: constants.TokenTypeValue, Value: "active"},
+		{Type: constants.TokenTypeSpace, Value: " "},
+		{Type: constants.TokenTypeAnd, Value: "And"},
+		{Type: constants.TokenTypeSpace, Value: " "},
+		{Type: constants.TokenTypeField, Value: "Address"},
+		{Type: constants.TokenTypeSpace, Value: " "},
+		{Type: constants.TokenTypeInSubnet, Value: "In Subnet"},
+		{Type: constants.TokenTypeSpace, Value: " "},
+		{Type: constants.TokenTypeValue, Value: "10.0.0.0/8"},
+		{Type: constants.TokenTypeSpace, Value: " "},
+		{Type: constants.TokenTypeAnd, Value: "And"},
+		{Type: constants.TokenTypeSpace, Value: " "},
+		{Type: constants.TokenTypeField, Value: "Address"},
+		{Type: constants.TokenTypeSpace, Value: " "},
+		{Type: constants.TokenTypeIn, Value: "In"},
+		{Type: constants.TokenTypeSpace, Value: " "},
+		{Type: constants.TokenTypeValue, Value: "fe80::1"},
+		{Type: constants.TokenTypeComma, Value: ","},
+		{Type: constants.TokenTypeSpace, Value: " "},
+		{Type: constants.TokenTypeValue, Value: "10.0.0.1"},
+	}, *result)
+}
+
+func TestTextQueryTokenizer_Tokenize_ShouldReturnNoneToken_WhenEnumValueIsNotInLookups(t *testing.T) {
+	// Arrange
+	metadata := models.Metadata{
+		Fields: []models.Field{
+			{
+				Name:  "Status",
+				Type:  constants.FieldTypeEnum.String(),
+				Label: "Status",
+				Operators: []string{
+					constants.OperatorEqual.String(),
+				},
+				Values: []models.Lookup{
+					{Name: "Active", Value: "active"},
+				},
+			},
+		},
+	}
+
+	textQueryTokenizer := NewTextQueryTokenizer(&metadata)
+
+	// Act
+	result, err := textQueryTokenizer.Tokenize(`Status = Deleted`)
+
+	// Assert
+	assert.NoError(t, err)
+	assert.Equal(t, models.Token{Type: constants.TokenTypeNone, Value: "Deleted"}, (*result)[4])
+}
+
+func TestTextQueryTokenizer_Tokenize_ShouldReturnDurationTokens_WhenFieldTypeIsDuration(t *testing.T) {
+	// Arrange
+	metadata := models.Metadata{
+		Fields: []models.Field{
+			{
+				Name:  "Timeout",
+				Type:  constants.FieldTypeDuration.String(),
+				Label: "Timeout",
+				Operators: []string{
+					constants.OperatorIn.String(),
+				},
+			},
+		},
+	}
+
+	textQueryTokenizer := NewTextQueryTokenizer(&metadata)
+
+	// Act
+	result, err := textQueryTokenizer.Tokenize(`Timeout In 1d 12h, 90m, 30`)
+
+	// Assert
+	assert.NoError(t, err)
+	assert.Equal(t, []models.Token{
+		{Type: constants.TokenTypeField, Value: "Timeout"},
+		{Type: constants.TokenTypeSpace, Value: " "},
+		{Type: constants.TokenTypeIn, Value: "In"},
+		{Type: constants.TokenTypeSpace, Value: " "},
+		{Type: constants.TokenTypeDurationValue, Value: 36 * time.Hour},
+		{Type: constants.TokenTypeComma, Value: ","},
+		{Type: constants.TokenTypeSpace, Value: " "},
+		{Type: constants.TokenTypeDurationValue, Value: 90 * time.Minute},
+		{Type: constants.TokenTypeComma, Value: ","},
+		{Type: constants.TokenTypeSpace, Value: " "},
+		{Type: constants.TokenTypeNumberValue, Value: 30 * time.Second},
+	}, *result)
+}
+
+func TestTextQueryTokenizer_Tokenize_ShouldReturnNoneToken_WhenTimeValueIsDuration(t *testing.T) {
+	// Arrange
+	metadata := models.Metadata{
+		Fields: []models.Field{
+			{
+				Name:  "OpensAt",
+				Type:  constants.FieldTypeTime.String(),
+				Label: "OpensAt",
+				Operators: []string{
+					constants.OperatorEqual.String(),
+				},
+			},
+		},
+	}
+
+	textQueryTokenizer := NewTextQueryTokenizer(&metadata)
+
+	// Act
+	result, err := textQueryTokenizer.Tokenize(`OpensAt = 1h30m`)
+
+	// Assert
+	assert.NoError(t, err)
+	assert.Equal(t, models.Token{Type: constants.TokenTypeNone, Value: "1h30m"}, (*result)[4])
+}
+
+func TestTextQueryTokenizer_Tokenize_ShouldReturnGeoTokens_WhenFieldTypeIsGeoPoint(t *testing.T) {
+	// Arrange
+	metadata := models.Metadata{
+		Fields: []models.Field{
+			{
+				Name:  "Location",
+				Type:  constants.FieldTypeGeoPoint.String(),
+				Label: "Location",
+				Operators: []string{
+					constants.OperatorWithin.String(),
+				},
+			},
+		},
+	}
+
+	textQueryTokenizer := NewTextQueryTokenizer(&metadata)
+
+	// Act
+	result, err := textQueryTokenizer.Tokenize(`Location within 5km of (40.7128, -74.006) or Location within box(40, -75, 41, -73)`)
+
+	// Assert
+	assert.NoError(t, err)
+	assert.Equal(t, []models.Token{
+		{Type: constants.TokenTypeField, Value: "Location"},
+		{Type: constants.TokenTypeSpace, Value: " "},
+		{Type: constants.TokenTypeWithin, Value: "within"},
+		{Type: constants.TokenTypeSpace, Value: " "},
+		{Type: constants.TokenTypeGeoValue, Value: &utils.GeoCircle{Center: utils.GeoPoint{Lat: 40.7128, Lng: -74.006}, Radius: 5000}},
+		{Type: constants.TokenTypeSpace, Value: " "},
+		{Type: constants.TokenTypeOr, Value: "or"},
+		{Type: constants.TokenTypeSpace, Value: " "},
+		{Type: constants.TokenTypeField, Value: "Location"},
+		{Type: constants.TokenTypeSpace, Value: " "},
+		{Type: constants.TokenTypeWithin, Value: "within"},
+		{Type: constants.TokenTypeSpace, Value: " "},
+		{Type: constants.TokenTypeGeoValue, Value: &utils.GeoBox{SouthWest: utils.GeoPoint{Lat: 40, Lng: -75}, NorthEast: utils.GeoPoint{Lat: 41, Lng: -73}}},
+	}, *result)
+}
+
+func TestTextQueryTokenizer_Tokenize_ShouldReturnNoneToken_WhenGeoValueIsOutOfRange(t *testing.T) {
+	// Arrange
+	metadata := models.Metadata{
+		Fields: []models.Field{
+			{
+				Name:  "Location",
+				Type:  constants.FieldTypeGeoPoint.String(),
+				Label: "Location",
+				Operators: []string{
+					constants.OperatorWithin.String(),
+				},
+			},
+		},
+	}
+
+	textQueryTokenizer := NewTextQueryTokenizer(&metadata)
+
+	// Act
+	result, err := textQueryTokenizer.Tokenize(`Location within 5km of (95, 10)`)
+
+	// Assert
+	assert.NoError(t, err)
+	assert.Equal(t, models.Token{Type: constants.TokenTypeNone, Value: "5km of (95, 10)"}, (*result)[4])
+}
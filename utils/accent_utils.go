@@ -0,0 +1,66 @@
+package utils
+
+import (
+	"strings"
+)
+
+var accents = map[rune]string{
+	'a': "àáâãäåāăą",
+	'c': "çćĉċč",
+	'd': "ďđ",
+	'e': "èéêëēĕėęě",
+	'g': "ĝğġģ",
+	'h': "ĥħ",
+	'i': "ìíîïĩīĭįı",
+	'j': "ĵ",
+	'k': "ķ",
+	'l': "ĺļľŀł",
+	'n': "ñńņňŉ",
+	'o': "òóôõöøōŏő",
+	'r': "ŕŗř",
+	's': "śŝşšș",
+	't': "ţťŧț",
+	'u': "ùúûüũūŭůűų",
+	'w': "ŵ",
+	'y': "ýÿŷ",
+	'z': "źżž",
+}
+
+var unaccents = func() map[rune]rune {
+	result := make(map[rune]rune)
+
+	for k, v := range accents {
+		for _, r := range v {
+			result[r] = k
+		}
+	}
+
+	return result
+}()
+
+func RemoveAccents(str string) string {
+	return strings.Map(func(r rune) rune {
+		if base, ok := unaccents[r]; ok {
+			return base
+		}
+
+		return r
+	}, strings.ToLower(str))
+}
+
+func AccentInsensitivePattern(str string) string {
+	var builder strings.Builder
+
+	for _, r := range RemoveAccents(str) {
+		if variants, ok := accents[r]; ok {
+			builder.WriteString("[")
+			builder.WriteRune(r)
+			builder.WriteString(variants)
+			builder.WriteString("]")
+		} else {
+			builder.WriteRune(r)
+		}
+	}
+
+	return builder.String()
+}
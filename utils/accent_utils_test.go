@@ -0,0 +1,34 @@
+package utils
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRemoveAccents_ShouldReturnLowerCaseTextWithoutAccents(t *testing.T) {
+	// Arrange
+	samples := map[string]string{
+		"Crème Brûlée": "creme brulee",
+		"ŞİŞLİ":        "sisli",
+		"Zoë":          "zoe",
+		"plain":        "plain",
+	}
+
+	for k, v := range samples {
+		// Act
+		result := RemoveAccents(k)
+
+		// Assert
+		assert.Equal(t, v, result)
+	}
+}
+
+func TestAccentInsensitivePattern_ShouldReturnCharacterClasses_WhenLetterHasAccents(t *testing.T) {
+	// Arrange
+	// Act
+	result := AccentInsensitivePattern("Café 1")
+
+	// Assert
+	assert.Equal(t, "[cçćĉċč][aàáâãäåāăą]f[eèéêëēĕėęě] 1", result)
+}
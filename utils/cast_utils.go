@@ -1,9 +1,9 @@
 package utils
 
 import (
+	"encoding/json"
 	"reflect"
 	"strconv"
-	"strings"
 	"time"
 
 	"github.com/filtex/filtex-go/errors"
@@ -66,6 +66,8 @@ func String(val interface{}) (string, error) {
 		return strconv.FormatBool(v), nil
 	case string:
 		return v, nil
+	case json.Number:
+		return v.String(), nil
 	case []byte:
 		return string(v), nil
 	case time.Time:
@@ -140,6 +142,8 @@ func Number(val interface{}) (float64, error) {
 		return float64(0), nil
 	case string:
 		return strconv.ParseFloat(val.(string), 64)
+	case json.Number:
+		return v.Float64()
 	case reflect.Value:
 		if v.CanInterface() {
 			return Number(v.Interface())
@@ -200,6 +204,21 @@ func Date(val interface{}) (*time.Time, error) {
 		return nil, err
 	}
 
+	if datetime == nil {
+		return nil, errors.NewCouldNotBeCastedError()
+	}
+
+	date := time.Date(datetime.Year(), datetime.Month(), datetime.Day(), 0, 0, 0, 0, datetime.Location())
+
+	return &date, nil
+}
+
+func DateIn(val interface{}, loc *time.Location) (*time.Time, error) {
+	datetime, err := DateTimeIn(val, loc)
+	if err != nil {
+		return nil, err
+	}
+
 	date := time.Date(datetime.Year(), datetime.Month(), datetime.Day(), 0, 0, 0, 0, datetime.Location())
 
 	return &date, nil
@@ -215,17 +234,18 @@ func Time(val interface{}) (*int, error) {
 		return nil, errors.NewCouldNotBeCastedError()
 	}
 
+	if v, ok := val.(*int); ok {
+		if v == nil {
+			return nil, errors.NewCouldNotBeCastedError()
+		}
+		return Time(*v)
+	}
+
 	str, err := String(val)
 	if err != nil {
 		return nil, err
 	}
 
-	duration, err := time.ParseDuration(strings.ToLower(str))
-	if err == nil {
-		seconds := int(duration.Seconds())
-		return &seconds, nil
-	}
-
 	timeWithSeconds, err := time.Parse("15:04:05", str)
 	if err == nil {
 		zeroTime, _ := time.Parse("15:04:05", "00:00:00")
@@ -241,7 +261,7 @@ func Time(val interface{}) (*int, error) {
 	}
 
 	sec, err := strconv.Atoi(str)
-	if err == nil {
+	if err == nil && sec >= 0 && sec < 24*60*60 {
 		return &sec, nil
 	}
 
@@ -254,10 +274,44 @@ func IsDateTime(val interface{}) bool {
 }
 
 func DateTime(val interface{}) (*time.Time, error) {
+	return parseDateTime(val, time.UTC)
+}
+
+func DateTimeIn(val interface{}, loc *time.Location) (*time.Time, error) {
+	if loc == nil {
+		return DateTime(val)
+	}
+
+	datetime, err := parseDateTime(val, loc)
+	if err != nil {
+		return nil, err
+	}
+
+	if datetime == nil {
+		return nil, nil
+	}
+
+	result := datetime.In(loc)
+
+	return &result, nil
+}
+
+func parseDateTime(val interface{}, loc *time.Location) (*time.Time, error) {
 	if val == nil {
 		return nil, nil
 	}
 
+	switch v := val.(type) {
+	case time.Time:
+		return &v, nil
+	case *time.Time:
+		if v == nil {
+			return nil, nil
+		}
+		result := *v
+		return &result, nil
+	}
+
 	var formats = []string{
 		"2006-01-02 15:04:05 Z0700 MST",
 		"2006-01-02 15:04:05 Z07:00 MST",
@@ -296,7 +350,7 @@ func DateTime(val interface{}) (*time.Time, error) {
 	}
 
 	for _, format := range formats {
-		t, err := time.Parse(format, s)
+		t, err := time.ParseInLocation(format, s, loc)
 		if err == nil {
 			return &t, nil
 		}
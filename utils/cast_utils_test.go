@@ -442,6 +442,8 @@ func TestIsTime_ShouldReturnFalse_WhenInputTypeIsNotSupported(t *testing.T) {
 		struct{}{},
 		"TEST",
 		time.Now(),
+		"1H",
+		"1h30m",
 	}
 
 	for _, input := range sampleMap {
@@ -458,9 +460,7 @@ func TestIsTime_ShouldReturnTrue_WhenInputTypeIsSupported(t *testing.T) {
 	sampleMap := []interface{}{
 		60,
 		"10:12:11",
-		"1H",
-		"1H30M",
-		"1h30m",
+		"10:12",
 	}
 
 	for _, input := range sampleMap {
@@ -478,6 +478,9 @@ func TestTime_ShouldReturnError_WhenInputTypeIsNotSupported(t *testing.T) {
 		struct{}{},
 		"TEST",
 		time.Now(),
+		"1h30m",
+		-1,
+		24 * 60 * 60,
 	}
 
 	for _, input := range sampleMap {
@@ -492,10 +495,11 @@ func TestTime_ShouldReturnError_WhenInputTypeIsNotSupported(t *testing.T) {
 
 func TestTime_ShouldReturnValueAsTime_WhenInputTypeIsSupported(t *testing.T) {
 	// Arrange
+	seconds := 1*60*60 + 30*60
 	sampleMap := map[interface{}]int{
 		10:         10,
-		"1h30m":    1*60*60 + 30*60,
 		"01:30:00": 1*60*60 + 30*60,
+		&seconds:   1*60*60 + 30*60,
 		"01:30":    1*60*60 + 30*60,
 	}
 
@@ -577,7 +581,7 @@ func TestDateTime_ShouldReturnValueAsDateTime_WhenInputTypeIsSupported(t *testin
 	// Arrange
 	sampleMap := map[interface{}]time.Time{
 		time.Date(2020, 01, 01, 0, 0, 0, 0, time.UTC):      time.Date(2020, 01, 01, 0, 0, 0, 0, time.UTC),
-		time.Date(2020, 01, 01, 10, 12, 32, 800, time.UTC): time.Date(2020, 01, 01, 10, 12, 32, 0, time.UTC),
+		time.Date(2020, 01, 01, 10, 12, 32, 800, time.UTC): time.Date(2020, 01, 01, 10, 12, 32, 800, time.UTC),
 		"2020-01-01":              time.Date(2020, 01, 01, 0, 0, 0, 0, time.UTC),
 		"2020-01-01 10:12:14":     time.Date(2020, 01, 01, 10, 12, 14, 0, time.UTC),
 		"2020-01-01 10:12:14.899": time.Date(2020, 01, 01, 10, 12, 14, 899000000, time.UTC),
@@ -594,3 +598,61 @@ func TestDateTime_ShouldReturnValueAsDateTime_WhenInputTypeIsSupported(t *testin
 		assert.Equal(t, output, *result)
 	}
 }
+
+func TestDateIn_ShouldReturnValueAsDateInLocation_WhenInputTypeIsSupported(t *testing.T) {
+	// Arrange
+	loc := time.FixedZone("UTC+3", 3*60*60)
+	sampleMap := map[interface{}]time.Time{
+		time.Date(2020, 01, 01, 22, 0, 0, 0, time.UTC): time.Date(2020, 01, 02, 0, 0, 0, 0, loc),
+		"2020-01-01":                time.Date(2020, 01, 01, 0, 0, 0, 0, loc),
+		"2020-01-01 23:12:14":       time.Date(2020, 01, 01, 0, 0, 0, 0, loc),
+		"2020-01-01T22:00:00Z":      time.Date(2020, 01, 02, 0, 0, 0, 0, loc),
+		"2020-01-01T22:00:00+03:00": time.Date(2020, 01, 01, 0, 0, 0, 0, loc),
+	}
+
+	for input, output := range sampleMap {
+		// Act
+		result, err := DateIn(input, loc)
+
+		// Assert
+		assert.NotNil(t, result)
+		assert.NoError(t, err)
+		assert.Equal(t, output.UnixNano(), result.UnixNano())
+		assert.Equal(t, loc, result.Location())
+	}
+}
+
+func TestDateTimeIn_ShouldKeepParsedLocation_WhenLocationIsNil(t *testing.T) {
+	// Act
+	result, err := DateTimeIn("2020-01-01T10:12:14+03:00", nil)
+
+	// Assert
+	assert.NoError(t, err)
+	assert.NotNil(t, result)
+
+	_, offset := result.Zone()
+	assert.Equal(t, 3*60*60, offset)
+}
+
+func TestDateTimeIn_ShouldReturnValueAsDateTimeInLocation_WhenInputTypeIsSupported(t *testing.T) {
+	// Arrange
+	loc := time.FixedZone("UTC+3", 3*60*60)
+	sampleMap := map[interface{}]time.Time{
+		time.Date(2020, 01, 01, 10, 12, 32, 800, time.UTC): time.Date(2020, 01, 01, 13, 12, 32, 800, loc),
+		"2020-01-01":                time.Date(2020, 01, 01, 0, 0, 0, 0, loc),
+		"2020-01-01 10:12:14":       time.Date(2020, 01, 01, 10, 12, 14, 0, loc),
+		"2020-01-01T10:12:14Z":      time.Date(2020, 01, 01, 13, 12, 14, 0, loc),
+		"2020-01-01T10:12:14+01:00": time.Date(2020, 01, 01, 12, 12, 14, 0, loc),
+	}
+
+	for input, output := range sampleMap {
+		// Act
+		result, err := DateTimeIn(input, loc)
+
+		// Assert
+		assert.NotNil(t, result)
+		assert.NoError(t, err)
+		assert.Equal(t, output.UnixNano(), result.UnixNano())
+		assert.Equal(t, loc, result.Location())
+	}
+}
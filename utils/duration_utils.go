@@ -0,0 +1,86 @@
+package utils
+
+import (
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/filtex/filtex-go/errors"
+)
+
+var durationPattern = regexp.MustCompile(`^(\d+(?:\.\d+)?)(d|h|ms|us|µs|ns|m|s)`)
+
+func IsDuration(val interface{}) bool {
+	_, err := Duration(val)
+	return err == nil
+}
+
+func Duration(val interface{}) (time.Duration, error) {
+	switch v := val.(type) {
+	case time.Duration:
+		return v, nil
+	case *time.Duration:
+		if v == nil {
+			return 0, errors.NewCouldNotBeCastedError()
+		}
+		return *v, nil
+	case string:
+		return parseDuration(v)
+	case nil:
+		return 0, errors.NewCouldNotBeCastedError()
+	}
+
+	seconds, err := Number(val)
+	if err != nil {
+		return 0, err
+	}
+
+	return time.Duration(seconds * float64(time.Second)), nil
+}
+
+func parseDuration(str string) (time.Duration, error) {
+	str = strings.ToLower(strings.TrimSpace(str))
+
+	if seconds, err := strconv.ParseFloat(str, 64); err == nil {
+		return time.Duration(seconds * float64(time.Second)), nil
+	}
+
+	sign := time.Duration(1)
+
+	if strings.HasPrefix(str, "-") {
+		sign = -1
+		str = str[1:]
+	}
+
+	if str == "" {
+		return 0, errors.NewCouldNotBeCastedError()
+	}
+
+	var result time.Duration
+
+	for str != "" {
+		match := durationPattern.FindStringSubmatch(str)
+		if match == nil {
+			return 0, errors.NewCouldNotBeCastedError()
+		}
+
+		if match[2] == "d" {
+			days, err := strconv.ParseFloat(match[1], 64)
+			if err != nil {
+				return 0, errors.NewCouldNotBeCastedError()
+			}
+			result += time.Duration(days * float64(24*time.Hour))
+		} else {
+			d, err := time.ParseDuration(match[0])
+			if err != nil {
+				return 0, errors.NewCouldNotBeCastedError()
+			}
+			result += d
+		}
+
+		str = strings.TrimLeft(str[len(match[0]):], " ")
+	}
+
+	return sign * result, nil
+}
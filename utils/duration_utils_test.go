@@ -0,0 +1,60 @@
+package utils
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDuration_ShouldReturnError_WhenInputIsNotValid(t *testing.T) {
+	// Arrange
+	samples := []interface{}{
+		nil,
+		struct{}{},
+		"",
+		"-",
+		"abc",
+		"1y",
+		"1h30",
+		"01:30",
+	}
+
+	for _, input := range samples {
+		// Act
+		_, err := Duration(input)
+
+		// Assert
+		assert.Error(t, err)
+		assert.False(t, IsDuration(input))
+	}
+}
+
+func TestDuration_ShouldReturnDuration_WhenInputIsValid(t *testing.T) {
+	// Arrange
+	duration := 90 * time.Minute
+	sampleMap := map[interface{}]time.Duration{
+		90:            90 * time.Second,
+		1.5:           1500 * time.Millisecond,
+		"90":          90 * time.Second,
+		"1h30m":       90 * time.Minute,
+		"1h 30m":      90 * time.Minute,
+		"2d":          48 * time.Hour,
+		"1D12H":       36 * time.Hour,
+		"1.5d":        36 * time.Hour,
+		"-1d":         -24 * time.Hour,
+		"250ms":       250 * time.Millisecond,
+		duration:      duration,
+		&duration:     duration,
+		"1d2h3m4s5ms": 26*time.Hour + 3*time.Minute + 4*time.Second + 5*time.Millisecond,
+	}
+
+	for input, expected := range sampleMap {
+		// Act
+		result, err := Duration(input)
+
+		// Assert
+		assert.NoError(t, err)
+		assert.Equal(t, expected, result)
+	}
+}
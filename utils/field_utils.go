@@ -0,0 +1,23 @@
+package utils
+
+func MapField(fields map[string]string, mapper func(field string) string, field string) string {
+	if v, ok := fields[field]; ok {
+		return v
+	}
+
+	if mapper != nil {
+		return mapper(field)
+	}
+
+	return field
+}
+
+func MapFields(fields map[string]string, mapper func(field string) string, values []string) []string {
+	result := make([]string, 0)
+
+	for _, v := range values {
+		result = append(result, MapField(fields, mapper, v))
+	}
+
+	return result
+}
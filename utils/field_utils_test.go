@@ -0,0 +1,49 @@
+package utils
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestMapField_ShouldReturnField_WhenThereIsNoMapping(t *testing.T) {
+	// Act
+	result := MapField(nil, nil, "createdAt")
+
+	// Assert
+	assert.Equal(t, "createdAt", result)
+}
+
+func TestMapField_ShouldReturnMappedField_WhenFieldIsInMap(t *testing.T) {
+	// Arrange
+	fields := map[string]string{"createdAt": "created_at"}
+
+	// Act
+	result := MapField(fields, strings.ToUpper, "createdAt")
+
+	// Assert
+	assert.Equal(t, "created_at", result)
+}
+
+func TestMapField_ShouldUseMapper_WhenFieldIsNotInMap(t *testing.T) {
+	// Arrange
+	fields := map[string]string{"createdAt": "created_at"}
+
+	// Act
+	result := MapField(fields, strings.ToUpper, "name")
+
+	// Assert
+	assert.Equal(t, "NAME", result)
+}
+
+func TestMapFields_ShouldMapEachField(t *testing.T) {
+	// Arrange
+	fields := map[string]string{"createdAt": "created_at"}
+
+	// Act
+	result := MapFields(fields, nil, []string{"createdAt", "name"})
+
+	// Assert
+	assert.Equal(t, []string{"created_at", "name"}, result)
+}
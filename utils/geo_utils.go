@@ -0,0 +1,213 @@
+package utils
+
+import (
+	"math"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/filtex/filtex-go/errors"
+)
+
+const EarthRadius = 6371008.8
+
+var (
+	geoCirclePattern = regexp.MustCompile(`(?i)^(\d+(?:\.\d+)?)\s*(m|km|mi)\s+of\s*\(\s*(-?\d+(?:\.\d+)?)\s*,\s*(-?\d+(?:\.\d+)?)\s*\)$`)
+	geoBoxPattern    = regexp.MustCompile(`(?i)^box\s*\(\s*(-?\d+(?:\.\d+)?)\s*,\s*(-?\d+(?:\.\d+)?)\s*,\s*(-?\d+(?:\.\d+)?)\s*,\s*(-?\d+(?:\.\d+)?)\s*\)$`)
+	geoPointPattern  = regexp.MustCompile(`^\(?\s*(-?\d+(?:\.\d+)?)\s*,\s*(-?\d+(?:\.\d+)?)\s*\)?$`)
+	geoUnits         = map[string]float64{
+		"m":  1,
+		"km": 1000,
+		"mi": 1609.344,
+	}
+)
+
+type GeoPoint struct {
+	Lat float64
+	Lng float64
+}
+
+type GeoCircle struct {
+	Center GeoPoint
+	Radius float64
+}
+
+type GeoBox struct {
+	SouthWest GeoPoint
+	NorthEast GeoPoint
+}
+
+func (c *GeoCircle) Contains(point *GeoPoint) bool {
+	return Distance(c.Center, *point) <= c.Radius
+}
+
+func (b *GeoBox) Contains(point *GeoPoint) bool {
+	if point.Lat < b.SouthWest.Lat || point.Lat > b.NorthEast.Lat {
+		return false
+	}
+
+	if b.SouthWest.Lng <= b.NorthEast.Lng {
+		return point.Lng >= b.SouthWest.Lng && point.Lng <= b.NorthEast.Lng
+	}
+
+	return point.Lng >= b.SouthWest.Lng || point.Lng <= b.NorthEast.Lng
+}
+
+func IsGeoArea(val interface{}) bool {
+	_, err := GeoArea(val)
+	return err == nil
+}
+
+func GeoArea(val interface{}) (interface{}, error) {
+	switch v := val.(type) {
+	case *GeoCircle:
+		if v == nil {
+			return nil, errors.NewCouldNotBeCastedError()
+		}
+		return v, nil
+	case GeoCircle:
+		return &v, nil
+	case *GeoBox:
+		if v == nil {
+			return nil, errors.NewCouldNotBeCastedError()
+		}
+		return v, nil
+	case GeoBox:
+		return &v, nil
+	case string:
+		return parseGeoArea(strings.TrimSpace(v))
+	}
+
+	return nil, errors.NewCouldNotBeCastedError()
+}
+
+func Location(val interface{}) (*GeoPoint, error) {
+	switch v := val.(type) {
+	case GeoPoint:
+		return newGeoPoint(v.Lat, v.Lng)
+	case *GeoPoint:
+		if v == nil {
+			return nil, errors.NewCouldNotBeCastedError()
+		}
+		return newGeoPoint(v.Lat, v.Lng)
+	case string:
+		match := geoPointPattern.FindStringSubmatch(strings.TrimSpace(v))
+		if match == nil {
+			return nil, errors.NewCouldNotBeCastedError()
+		}
+		lat, _ := strconv.ParseFloat(match[1], 64)
+		lng, _ := strconv.ParseFloat(match[2], 64)
+		return newGeoPoint(lat, lng)
+	case map[string]interface{}:
+		if coordinates, ok := v["coordinates"]; ok {
+			return Location(coordinates)
+		}
+
+		lat, latErr := Number(v["lat"])
+		lng, lngErr := Number(v["lng"])
+		if v["lat"] == nil || v["lng"] == nil || latErr != nil || lngErr != nil {
+			return nil, errors.NewCouldNotBeCastedError()
+		}
+		return newGeoPoint(lat, lng)
+	case nil:
+		return nil, errors.NewCouldNotBeCastedError()
+	}
+
+	if IsArray(val) {
+		items, err := Array(val)
+		if err != nil || len(items) != 2 {
+			return nil, errors.NewCouldNotBeCastedError()
+		}
+
+		lng, lngErr := Number(items[0])
+		lat, latErr := Number(items[1])
+		if lngErr != nil || latErr != nil {
+			return nil, errors.NewCouldNotBeCastedError()
+		}
+		return newGeoPoint(lat, lng)
+	}
+
+	return nil, errors.NewCouldNotBeCastedError()
+}
+
+func Distance(a GeoPoint, b GeoPoint) float64 {
+	lat1 := a.Lat * math.Pi / 180
+	lat2 := b.Lat * math.Pi / 180
+	dLat := (b.Lat - a.Lat) * math.Pi / 180
+	dLng := (b.Lng - a.Lng) * math.Pi / 180
+
+	h := math.Sin(dLat/2)*math.Sin(dLat/2) + math.Cos(lat1)*math.Cos(lat2)*math.Sin(dLng/2)*math.Sin(dLng/2)
+
+	return 2 * EarthRadius * math.Asin(math.Min(1, math.Sqrt(h)))
+}
+
+func parseGeoArea(str string) (interface{}, error) {
+	if match := geoCirclePattern.FindStringSubmatch(str); match != nil {
+		distance, _ := strconv.ParseFloat(match[1], 64)
+		lat, _ := strconv.ParseFloat(match[3], 64)
+		lng, _ := strconv.ParseFloat(match[4], 64)
+
+		center, err := newGeoPoint(lat, lng)
+		if err != nil {
+			return nil, err
+		}
+
+		return &GeoCircle{
+			Center: *center,
+			Radius: distance * geoUnits[strings.ToLower(match[2])],
+		}, nil
+	}
+
+	if match := geoBoxPattern.FindStringSubmatch(str); match != nil {
+		values := make([]float64, 0)
+
+		for _, v := range match[1:] {
+			f, _ := strconv.ParseFloat(v, 64)
+			values = append(values, f)
+		}
+
+		southWest, err := newGeoPoint(values[0], values[1])
+		if err != nil {
+			return nil, err
+		}
+
+		northEast, err := newGeoPoint(values[2], values[3])
+		if err != nil || northEast.Lat < southWest.Lat {
+			return nil, errors.NewCouldNotBeCastedError()
+		}
+
+		return &GeoBox{
+			SouthWest: *southWest,
+			NorthEast: *northEast,
+		}, nil
+	}
+
+	return nil, errors.NewCouldNotBeCastedError()
+}
+
+func newGeoPoint(lat float64, lng float64) (*GeoPoint, error) {
+	if math.IsNaN(lat) || math.IsNaN(lng) || lat < -90 || lat > 90 || lng < -180 || lng > 180 {
+		return nil, errors.NewCouldNotBeCastedError()
+	}
+
+	return &GeoPoint{
+		Lat: lat,
+		Lng: lng,
+	}, nil
+}
+
+func FormatGeoArea(val interface{}) (string, error) {
+	switch v := val.(type) {
+	case *GeoCircle:
+		return formatGeoFloat(v.Radius) + "m of (" + formatGeoFloat(v.Center.Lat) + ", " + formatGeoFloat(v.Center.Lng) + ")", nil
+	case *GeoBox:
+		return "box(" + formatGeoFloat(v.SouthWest.Lat) + ", " + formatGeoFloat(v.SouthWest.Lng) + ", " +
+			formatGeoFloat(v.NorthEast.Lat) + ", " + formatGeoFloat(v.NorthEast.Lng) + ")", nil
+	}
+
+	return "", errors.NewCouldNotBeCastedError()
+}
+
+func formatGeoFloat(f float64) string {
+	return strconv.FormatFloat(f, 'f', -1, 64)
+}
@@ -0,0 +1,184 @@
+package utils
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGeoArea_ShouldReturnError_WhenInputIsNotValid(t *testing.T) {
+	// Arrange
+	samples := []interface{}{
+		nil,
+		5,
+		"",
+		"abc",
+		"5km",
+		"5 yards of (40, 0)",
+		"5km of (91, 0)",
+		"5km of (40, 181)",
+		"box(40, 0, 41)",
+		"box(41, 0, 40, 1)",
+		"box(40, -190, 41, 0)",
+		(*GeoCircle)(nil),
+	}
+
+	for _, input := range samples {
+		// Act
+		_, err := GeoArea(input)
+
+		// Assert
+		assert.Error(t, err, input)
+		assert.False(t, IsGeoArea(input))
+	}
+}
+
+func TestGeoArea_ShouldReturnCircle_WhenInputIsDistance(t *testing.T) {
+	// Arrange
+	sampleMap := map[interface{}]*GeoCircle{
+		"5km of (40.7128, -74.006)":  {Center: GeoPoint{Lat: 40.7128, Lng: -74.006}, Radius: 5000},
+		"250 m of (0,0)":             {Center: GeoPoint{Lat: 0, Lng: 0}, Radius: 250},
+		"2MI of ( -33.86 , 151.2 )":  {Center: GeoPoint{Lat: -33.86, Lng: 151.2}, Radius: 3218.688},
+		GeoCircle{Radius: 10}:        {Radius: 10},
+		&GeoCircle{Radius: 1}:        {Radius: 1},
+		"  1.5km of (10.5, 20.25)  ": {Center: GeoPoint{Lat: 10.5, Lng: 20.25}, Radius: 1500},
+	}
+
+	for input, output := range sampleMap {
+		// Act
+		result, err := GeoArea(input)
+
+		// Assert
+		assert.NoError(t, err)
+		assert.Equal(t, output, result, input)
+	}
+}
+
+func TestGeoArea_ShouldReturnBox_WhenInputIsBox(t *testing.T) {
+	// Arrange
+	sampleMap := map[interface{}]*GeoBox{
+		"box(40, -75, 41, -73)":       {SouthWest: GeoPoint{Lat: 40, Lng: -75}, NorthEast: GeoPoint{Lat: 41, Lng: -73}},
+		"BOX (-20,170,-10,-170)":      {SouthWest: GeoPoint{Lat: -20, Lng: 170}, NorthEast: GeoPoint{Lat: -10, Lng: -170}},
+		GeoBox{NorthEast: GeoPoint{}}: {},
+	}
+
+	for input, output := range sampleMap {
+		// Act
+		result, err := GeoArea(input)
+
+		// Assert
+		assert.NoError(t, err)
+		assert.Equal(t, output, result, input)
+	}
+}
+
+func TestLocation_ShouldReturnPoint_WhenInputIsValid(t *testing.T) {
+	// Arrange
+	expected := &GeoPoint{Lat: 40.7128, Lng: -74.006}
+	samples := []interface{}{
+		GeoPoint{Lat: 40.7128, Lng: -74.006},
+		&GeoPoint{Lat: 40.7128, Lng: -74.006},
+		"40.7128, -74.006",
+		"(40.7128,-74.006)",
+		map[string]interface{}{"lat": 40.7128, "lng": -74.006},
+		map[string]interface{}{"type": "Point", "coordinates": []interface{}{-74.006, 40.7128}},
+		[]interface{}{-74.006, 40.7128},
+		[]float64{-74.006, 40.7128},
+	}
+
+	for _, input := range samples {
+		// Act
+		result, err := Location(input)
+
+		// Assert
+		assert.NoError(t, err)
+		assert.Equal(t, expected, result, input)
+	}
+}
+
+func TestLocation_ShouldReturnError_WhenInputIsNotValid(t *testing.T) {
+	// Arrange
+	samples := []interface{}{
+		nil,
+		5,
+		"abc",
+		"95, 10",
+		map[string]interface{}{"lat": 40.7128},
+		map[string]interface{}{"lat": "abc", "lng": 0},
+		[]interface{}{1.0},
+		[]interface{}{1.0, 2.0, 3.0},
+		(*GeoPoint)(nil),
+	}
+
+	for _, input := range samples {
+		// Act
+		_, err := Location(input)
+
+		// Assert
+		assert.Error(t, err, input)
+	}
+}
+
+func TestDistance_ShouldReturnHaversineDistance(t *testing.T) {
+	// Arrange
+	newYork := GeoPoint{Lat: 40.7128, Lng: -74.006}
+	london := GeoPoint{Lat: 51.5074, Lng: -0.1278}
+
+	// Act
+	result := Distance(newYork, london)
+
+	// Assert
+	assert.InDelta(t, 5570000, result, 5000)
+	assert.Equal(t, 0.0, Distance(newYork, newYork))
+}
+
+func TestGeoBox_Contains_ShouldHandleAntimeridian(t *testing.T) {
+	// Arrange
+	box := GeoBox{SouthWest: GeoPoint{Lat: -20, Lng: 170}, NorthEast: GeoPoint{Lat: -10, Lng: -170}}
+	sampleMap := map[GeoPoint]bool{
+		{Lat: -15, Lng: 180}:  true,
+		{Lat: -15, Lng: -175}: true,
+		{Lat: -15, Lng: 0}:    false,
+	}
+
+	for input, expected := range sampleMap {
+		// Act
+		result := box.Contains(&input)
+
+		// Assert
+		assert.Equal(t, expected, result, input)
+	}
+}
+
+func TestFormatGeoArea_ShouldReturnParsableValue_WhenInputIsArea(t *testing.T) {
+	// Arrange
+	sampleMap := map[string]string{
+		"5km of (40.7128, -74.006)": "5000m of (40.7128, -74.006)",
+		"1.5 mi of (0, 0)":          "2414.016m of (0, 0)",
+		"box(40, -75, 41, -73.5)":   "box(40, -75, 41, -73.5)",
+	}
+
+	for input, output := range sampleMap {
+		area, _ := GeoArea(input)
+
+		// Act
+		result, err := FormatGeoArea(area)
+
+		// Assert
+		assert.NoError(t, err, input)
+		assert.Equal(t, output, result, input)
+
+		parsed, err := GeoArea(result)
+		assert.NoError(t, err, input)
+		assert.Equal(t, area, parsed, input)
+	}
+}
+
+func TestFormatGeoArea_ShouldReturnError_WhenInputIsNotArea(t *testing.T) {
+	// Act
+	result, err := FormatGeoArea("5km of (0, 0)")
+
+	// Assert
+	assert.Empty(t, result)
+	assert.Error(t, err)
+}
@@ -0,0 +1,94 @@
+package utils
+
+import (
+	"fmt"
+	"net"
+	"net/netip"
+	"strings"
+
+	"github.com/filtex/filtex-go/errors"
+)
+
+func IsIP(val interface{}) bool {
+	_, err := IP(val)
+	return err == nil
+}
+
+func IP(val interface{}) (string, error) {
+	switch v := val.(type) {
+	case net.IP:
+		if addr, ok := netip.AddrFromSlice(v); ok {
+			return addr.Unmap().String(), nil
+		}
+		return "", errors.NewCouldNotBeCastedError()
+	case *net.IPNet:
+		if v == nil {
+			return "", errors.NewCouldNotBeCastedError()
+		}
+		return parseIP(v.String())
+	case string:
+		return parseIP(v)
+	case fmt.Stringer:
+		return parseIP(v.String())
+	}
+
+	return "", errors.NewCouldNotBeCastedError()
+}
+
+func IsSubnet(val interface{}) bool {
+	_, err := Subnet(val)
+	return err == nil
+}
+
+func Subnet(val interface{}) (*netip.Prefix, error) {
+	s, err := IP(val)
+	if err != nil {
+		return nil, err
+	}
+
+	if !strings.Contains(s, "/") {
+		addr, err := netip.ParseAddr(s)
+		if err != nil {
+			return nil, errors.NewCouldNotBeCastedError()
+		}
+		prefix := netip.PrefixFrom(addr, addr.BitLen())
+		return &prefix, nil
+	}
+
+	prefix, err := netip.ParsePrefix(s)
+	if err != nil {
+		return nil, errors.NewCouldNotBeCastedError()
+	}
+
+	prefix = prefix.Masked()
+	return &prefix, nil
+}
+
+func parseIP(s string) (string, error) {
+	if strings.Contains(s, "/") {
+		prefix, err := netip.ParsePrefix(s)
+		if err != nil {
+			return "", errors.NewCouldNotBeCastedError()
+		}
+
+		addr := prefix.Addr()
+		bits := prefix.Bits()
+
+		if addr.Is4In6() {
+			if bits < 96 {
+				return "", errors.NewCouldNotBeCastedError()
+			}
+			addr = addr.Unmap()
+			bits -= 96
+		}
+
+		return netip.PrefixFrom(addr, bits).String(), nil
+	}
+
+	addr, err := netip.ParseAddr(s)
+	if err != nil || addr.Zone() != "" {
+		return "", errors.NewCouldNotBeCastedError()
+	}
+
+	return addr.Unmap().String(), nil
+}
@@ -0,0 +1,76 @@
+package utils
+
+import (
+	"net"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestIP_ShouldReturnError_WhenInputIsNotValid(t *testing.T) {
+	// Arrange
+	samples := []interface{}{
+		nil,
+		5,
+		"",
+		"abc",
+		"256.1.1.1",
+		"10.0.0.0/33",
+		"fe80::1%eth0",
+	}
+
+	for _, input := range samples {
+		// Act
+		_, err := IP(input)
+
+		// Assert
+		assert.Error(t, err)
+		assert.False(t, IsIP(input))
+	}
+}
+
+func TestIP_ShouldReturnNormalizedIP_WhenInputIsValid(t *testing.T) {
+	// Arrange
+	_, network, _ := net.ParseCIDR("192.168.0.0/16")
+	sampleMap := map[interface{}]string{
+		"10.0.0.1":                "10.0.0.1",
+		"::ffff:10.0.0.1":         "10.0.0.1",
+		"2001:DB8:0:0::1":         "2001:db8::1",
+		"10.1.2.3/8":              "10.1.2.3/8",
+		"2001:db8::/32":           "2001:db8::/32",
+		testStringer("127.0.0.1"): "127.0.0.1",
+		network:                   "192.168.0.0/16",
+	}
+
+	for input, expected := range sampleMap {
+		// Act
+		result, err := IP(input)
+
+		// Assert
+		assert.NoError(t, err)
+		assert.Equal(t, expected, result)
+	}
+
+	result, err := IP(net.ParseIP("10.0.0.1"))
+	assert.NoError(t, err)
+	assert.Equal(t, "10.0.0.1", result)
+}
+
+func TestSubnet_ShouldReturnMaskedPrefix_WhenInputIsValid(t *testing.T) {
+	// Arrange
+	sampleMap := map[string]string{
+		"10.1.2.3/8":      "10.0.0.0/8",
+		"10.0.0.1":        "10.0.0.1/32",
+		"2001:db8::1/32":  "2001:db8::/32",
+		"::ffff:10.0.0.1": "10.0.0.1/32",
+	}
+
+	for input, expected := range sampleMap {
+		// Act
+		result, err := Subnet(input)
+
+		// Assert
+		assert.NoError(t, err)
+		assert.Equal(t, expected, result.String())
+	}
+}
@@ -0,0 +1,185 @@
+package utils
+
+import (
+	"math"
+	"math/big"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/filtex/filtex-go/errors"
+)
+
+var decimalPattern = regexp.MustCompile(`^([+-]?)(\d*)(?:\.(\d*))?(?:[eE]([+-]?\d+))?$`)
+
+const maxDecimalExponent = 1000
+
+func IsInteger(val interface{}) bool {
+	_, err := Integer(val)
+	return err == nil
+}
+
+func Integer(val interface{}) (int64, error) {
+	switch v := val.(type) {
+	case int:
+		return int64(v), nil
+	case int8:
+		return int64(v), nil
+	case int16:
+		return int64(v), nil
+	case int32:
+		return int64(v), nil
+	case int64:
+		return v, nil
+	case uint:
+		return Integer(uint64(v))
+	case uint8:
+		return int64(v), nil
+	case uint16:
+		return int64(v), nil
+	case uint32:
+		return int64(v), nil
+	case uint64:
+		if v > math.MaxInt64 {
+			return 0, errors.NewCouldNotBeCastedError()
+		}
+		return int64(v), nil
+	case float32:
+		return Integer(float64(v))
+	case float64:
+		if v != math.Trunc(v) || v < math.MinInt64 || v >= math.MaxInt64 {
+			return 0, errors.NewCouldNotBeCastedError()
+		}
+		return int64(v), nil
+	case nil:
+		return 0, nil
+	}
+
+	s, err := String(val)
+	if err != nil {
+		return 0, err
+	}
+
+	i, err := strconv.ParseInt(s, 10, 64)
+	if err == nil {
+		return i, nil
+	}
+
+	d, err := Decimal(s)
+	if err != nil {
+		return 0, err
+	}
+
+	integerPart, fractionPart, _ := strings.Cut(d, ".")
+	if strings.Trim(fractionPart, "0") != "" {
+		return 0, errors.NewCouldNotBeCastedError()
+	}
+
+	i, err = strconv.ParseInt(integerPart, 10, 64)
+	if err != nil {
+		return 0, errors.NewCouldNotBeCastedError()
+	}
+
+	return i, nil
+}
+
+func IsDecimal(val interface{}) bool {
+	_, err := Decimal(val)
+	return err == nil
+}
+
+func Decimal(val interface{}) (string, error) {
+	switch v := val.(type) {
+	case float32:
+		return strconv.FormatFloat(float64(v), 'f', -1, 32), nil
+	case float64:
+		if math.IsNaN(v) || math.IsInf(v, 0) {
+			return "", errors.NewCouldNotBeCastedError()
+		}
+		return strconv.FormatFloat(v, 'f', -1, 64), nil
+	case bool:
+		return "", errors.NewCouldNotBeCastedError()
+	case nil:
+		return "0", nil
+	}
+
+	s, err := String(val)
+	if err != nil {
+		return "", err
+	}
+
+	return normalizeDecimal(strings.TrimSpace(s))
+}
+
+func CompareDecimal(a interface{}, b interface{}) (int, error) {
+	x, err := rat(a)
+	if err != nil {
+		return 0, err
+	}
+
+	y, err := rat(b)
+	if err != nil {
+		return 0, err
+	}
+
+	return x.Cmp(y), nil
+}
+
+func rat(val interface{}) (*big.Rat, error) {
+	d, err := Decimal(val)
+	if err != nil {
+		return nil, err
+	}
+
+	r, ok := new(big.Rat).SetString(d)
+	if !ok {
+		return nil, errors.NewCouldNotBeCastedError()
+	}
+
+	return r, nil
+}
+
+func normalizeDecimal(s string) (string, error) {
+	match := decimalPattern.FindStringSubmatch(s)
+	if match == nil || match[2]+match[3] == "" {
+		return "", errors.NewCouldNotBeCastedError()
+	}
+
+	sign, integerPart, fractionPart := match[1], match[2], match[3]
+
+	exponent := 0
+
+	if match[4] != "" {
+		e, err := strconv.Atoi(match[4])
+		if err != nil || e > maxDecimalExponent || e < -maxDecimalExponent {
+			return "", errors.NewCouldNotBeCastedError()
+		}
+		exponent = e
+	}
+
+	digits := integerPart + fractionPart
+	point := len(integerPart) + exponent
+
+	if point <= 0 {
+		integerPart, fractionPart = "0", strings.Repeat("0", -point)+digits
+	} else if point >= len(digits) {
+		integerPart, fractionPart = digits+strings.Repeat("0", point-len(digits)), ""
+	} else {
+		integerPart, fractionPart = digits[:point], digits[point:]
+	}
+
+	integerPart = strings.TrimLeft(integerPart, "0")
+	if integerPart == "" {
+		integerPart = "0"
+	}
+
+	if sign != "-" || strings.Trim(digits, "0") == "" {
+		sign = ""
+	}
+
+	if fractionPart == "" {
+		return sign + integerPart, nil
+	}
+
+	return sign + integerPart + "." + fractionPart, nil
+}
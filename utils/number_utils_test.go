@@ -0,0 +1,132 @@
+package utils
+
+import (
+	"encoding/json"
+	"math"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestInteger_ShouldReturnError_WhenInputIsNotValid(t *testing.T) {
+	// Arrange
+	samples := []interface{}{
+		struct{}{},
+		1.5,
+		"1.5",
+		"abc",
+		"9223372036854775808",
+		uint64(math.MaxUint64),
+	}
+
+	for _, input := range samples {
+		// Act
+		_, err := Integer(input)
+
+		// Assert
+		assert.Error(t, err)
+	}
+}
+
+func TestInteger_ShouldReturnValueAsInteger_WhenInputIsValid(t *testing.T) {
+	// Arrange
+	sampleMap := map[interface{}]int64{
+		5:                      5,
+		int32(-5):              -5,
+		uint16(7):              7,
+		float64(12):            12,
+		"-5":                   -5,
+		"9007199254740993":     9007199254740993,
+		"1e3":                  1000,
+		"1.50e1":               15,
+		json.Number("1234567"): 1234567,
+	}
+
+	for input, output := range sampleMap {
+		// Act
+		result, err := Integer(input)
+
+		// Assert
+		assert.NoError(t, err)
+		assert.Equal(t, output, result)
+	}
+}
+
+func TestDecimal_ShouldReturnError_WhenInputIsNotValid(t *testing.T) {
+	// Arrange
+	samples := []interface{}{
+		struct{}{},
+		true,
+		"abc",
+		".",
+		"1e",
+		"1e10000",
+		math.NaN(),
+	}
+
+	for _, input := range samples {
+		// Act
+		_, err := Decimal(input)
+
+		// Assert
+		assert.Error(t, err)
+	}
+}
+
+func TestDecimal_ShouldReturnNormalizedDecimal_WhenInputIsValid(t *testing.T) {
+	// Arrange
+	sampleMap := map[interface{}]string{
+		5:                        "5",
+		10.5:                     "10.5",
+		"10.50":                  "10.50",
+		"-0.25":                  "-0.25",
+		"+3":                     "3",
+		"-0":                     "0",
+		"007.5":                  "7.5",
+		".5":                     "0.5",
+		"1.5e3":                  "1500",
+		"125e-4":                 "0.0125",
+		"12345678901234567890.1": "12345678901234567890.1",
+		json.Number("2.5E2"):     "250",
+	}
+
+	for input, output := range sampleMap {
+		// Act
+		result, err := Decimal(input)
+
+		// Assert
+		assert.NoError(t, err)
+		assert.Equal(t, output, result)
+	}
+}
+
+func TestCompareDecimal_ShouldReturnError_WhenInputIsNotValid(t *testing.T) {
+	// Act
+	_, err := CompareDecimal("abc", "1")
+
+	// Assert
+	assert.Error(t, err)
+}
+
+func TestCompareDecimal_ShouldCompareExactly_WhenInputsAreValid(t *testing.T) {
+	// Arrange
+	samples := []struct {
+		a      interface{}
+		b      interface{}
+		result int
+	}{
+		{"10.50", 10.5, 0},
+		{"0.1", "0.10000000000000001", -1},
+		{"9007199254740993", "9007199254740992", 1},
+		{-2, "-2.0", 0},
+	}
+
+	for _, v := range samples {
+		// Act
+		result, err := CompareDecimal(v.a, v.b)
+
+		// Assert
+		assert.NoError(t, err)
+		assert.Equal(t, v.result, result)
+	}
+}
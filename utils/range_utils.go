@@ -0,0 +1,96 @@
+package utils
+
+import (
+	"strings"
+	"time"
+
+	"github.com/filtex/filtex-go/errors"
+)
+
+type TimeRange struct {
+	Start time.Time
+	End   time.Time
+}
+
+var timeRangeFormats = []struct {
+	format string
+	end    func(start time.Time) time.Time
+}{
+	{"2006-01", func(start time.Time) time.Time { return start.AddDate(0, 1, 0) }},
+	{"2006-01-02", func(start time.Time) time.Time { return start.AddDate(0, 0, 1) }},
+	{"2006-01-02T15", func(start time.Time) time.Time { return start.Add(time.Hour) }},
+	{"2006-01-02 15", func(start time.Time) time.Time { return start.Add(time.Hour) }},
+}
+
+func DayRange(val interface{}) (*TimeRange, error) {
+	start, err := Date(val)
+	if err != nil {
+		return nil, err
+	}
+
+	return &TimeRange{
+		Start: *start,
+		End:   start.AddDate(0, 0, 1),
+	}, nil
+}
+
+func IsTimeRange(val interface{}) bool {
+	_, err := TimeRangeIn(val, nil)
+	return err == nil
+}
+
+func TimeRangeIn(val interface{}, loc *time.Location) (*TimeRange, error) {
+	if r, ok := AsTimeRange(val); ok {
+		return r, nil
+	}
+
+	s, ok := val.(string)
+	if !ok {
+		return nil, errors.NewCouldNotBeCastedError()
+	}
+
+	if loc == nil {
+		loc = time.UTC
+	}
+
+	for _, v := range timeRangeFormats {
+		start, err := time.ParseInLocation(v.format, strings.TrimSpace(s), loc)
+		if err == nil {
+			return &TimeRange{
+				Start: start,
+				End:   v.end(start),
+			}, nil
+		}
+	}
+
+	return nil, errors.NewCouldNotBeCastedError()
+}
+
+func AsTimeRange(val interface{}) (*TimeRange, bool) {
+	switch v := val.(type) {
+	case TimeRange:
+		return &v, true
+	case *TimeRange:
+		return v, v != nil
+	}
+
+	return nil, false
+}
+
+func FormatTimeRange(val interface{}) (string, error) {
+	r, ok := AsTimeRange(val)
+	if !ok {
+		return "", errors.NewCouldNotBeCastedError()
+	}
+
+	for _, v := range timeRangeFormats {
+		str := r.Start.Format(v.format)
+
+		start, err := time.ParseInLocation(v.format, str, r.Start.Location())
+		if err == nil && start.Equal(r.Start) && v.end(start).Equal(r.End) {
+			return str, nil
+		}
+	}
+
+	return "", errors.NewCouldNotBeCastedError()
+}
@@ -0,0 +1,148 @@
+package utils
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDayRange_ShouldReturnError_WhenInputIsNotValid(t *testing.T) {
+	// Act
+	result, err := DayRange("invalid")
+
+	// Assert
+	assert.Nil(t, result)
+	assert.Error(t, err)
+}
+
+func TestDayRange_ShouldReturnStartAndEndOfDayInValueLocation_WhenInputIsValid(t *testing.T) {
+	// Arrange
+	loc := time.FixedZone("UTC+3", 3*60*60)
+	value := time.Date(2024, 03, 10, 15, 30, 0, 0, loc)
+
+	// Act
+	result, err := DayRange(value)
+
+	// Assert
+	assert.NoError(t, err)
+	assert.Equal(t, time.Date(2024, 03, 10, 0, 0, 0, 0, loc), result.Start)
+	assert.Equal(t, time.Date(2024, 03, 11, 0, 0, 0, 0, loc), result.End)
+}
+
+func TestIsTimeRange_ShouldReturnFalse_WhenInputIsNotPartial(t *testing.T) {
+	// Arrange
+	sampleMap := []interface{}{
+		nil,
+		2024,
+		"invalid",
+		"2024-01-05 10:30",
+		"2024-01-05T10:30:00Z",
+		time.Now(),
+	}
+
+	for _, input := range sampleMap {
+		// Act
+		result := IsTimeRange(input)
+
+		// Assert
+		assert.False(t, result)
+	}
+}
+
+func TestIsTimeRange_ShouldReturnTrue_WhenInputIsPartial(t *testing.T) {
+	// Arrange
+	sampleMap := []interface{}{
+		"2024-01",
+		"2024-01-05",
+		"2024-01-05T10",
+		"2024-01-05 10",
+		TimeRange{},
+		&TimeRange{},
+	}
+
+	for _, input := range sampleMap {
+		// Act
+		result := IsTimeRange(input)
+
+		// Assert
+		assert.True(t, result)
+	}
+}
+
+func TestTimeRangeIn_ShouldReturnRangeInLocation_WhenInputIsPartial(t *testing.T) {
+	// Arrange
+	loc := time.FixedZone("UTC+3", 3*60*60)
+	sampleMap := map[string]TimeRange{
+		"2024-01": {
+			Start: time.Date(2024, 01, 01, 0, 0, 0, 0, loc),
+			End:   time.Date(2024, 02, 01, 0, 0, 0, 0, loc),
+		},
+		"2024-01-05": {
+			Start: time.Date(2024, 01, 05, 0, 0, 0, 0, loc),
+			End:   time.Date(2024, 01, 06, 0, 0, 0, 0, loc),
+		},
+		"2024-01-05T10": {
+			Start: time.Date(2024, 01, 05, 10, 0, 0, 0, loc),
+			End:   time.Date(2024, 01, 05, 11, 0, 0, 0, loc),
+		},
+	}
+
+	for input, output := range sampleMap {
+		// Act
+		result, err := TimeRangeIn(input, loc)
+
+		// Assert
+		assert.NoError(t, err)
+		assert.Equal(t, output.Start.UnixNano(), result.Start.UnixNano())
+		assert.Equal(t, output.End.UnixNano(), result.End.UnixNano())
+	}
+}
+
+func TestAsTimeRange_ShouldReturnFalse_WhenInputIsNotTimeRange(t *testing.T) {
+	// Act
+	result, ok := AsTimeRange(time.Now())
+
+	// Assert
+	assert.Nil(t, result)
+	assert.False(t, ok)
+}
+
+func TestFormatTimeRange_ShouldReturnPartialValue_WhenRangeMatchesFormat(t *testing.T) {
+	// Arrange
+	sampleMap := map[string]string{
+		"2024-03":       "2024-03",
+		"2024-03-10":    "2024-03-10",
+		"2024-03-10T15": "2024-03-10T15",
+		"2024-03-10 15": "2024-03-10T15",
+	}
+
+	for input, output := range sampleMap {
+		r, _ := TimeRangeIn(input, time.UTC)
+
+		// Act
+		result, err := FormatTimeRange(r)
+
+		// Assert
+		assert.NoError(t, err, input)
+		assert.Equal(t, output, result, input)
+	}
+}
+
+func TestFormatTimeRange_ShouldReturnError_WhenRangeDoesNotMatchFormat(t *testing.T) {
+	// Arrange
+	samples := []interface{}{
+		"2024-03-10",
+		TimeRange{Start: time.Date(2024, 03, 10, 0, 0, 0, 0, time.UTC), End: time.Date(2024, 03, 12, 0, 0, 0, 0, time.UTC)},
+		TimeRange{Start: time.Date(2024, 03, 10, 0, 30, 0, 0, time.UTC), End: time.Date(2024, 03, 10, 1, 30, 0, 0, time.UTC)},
+	}
+
+	for _, input := range samples {
+		// Act
+		result, err := FormatTimeRange(input)
+
+		// Assert
+		assert.Empty(t, result)
+		assert.Error(t, err)
+	}
+}
@@ -0,0 +1,68 @@
+package utils
+
+import (
+	"encoding/hex"
+	"fmt"
+	"strings"
+
+	"github.com/filtex/filtex-go/errors"
+)
+
+func IsUUID(val interface{}) bool {
+	_, err := UUID(val)
+	return err == nil
+}
+
+func UUID(val interface{}) (string, error) {
+	switch v := val.(type) {
+	case [16]byte:
+		return formatUUID(v[:]), nil
+	case []byte:
+		if len(v) == 16 {
+			return formatUUID(v), nil
+		}
+		return parseUUID(string(v))
+	case string:
+		return parseUUID(v)
+	case fmt.Stringer:
+		return parseUUID(v.String())
+	}
+
+	return "", errors.NewCouldNotBeCastedError()
+}
+
+func UUIDBytes(val interface{}) ([]byte, error) {
+	s, err := UUID(val)
+	if err != nil {
+		return nil, err
+	}
+
+	return hex.DecodeString(strings.ReplaceAll(s, "-", ""))
+}
+
+func parseUUID(s string) (string, error) {
+	s = strings.TrimPrefix(strings.TrimSuffix(strings.TrimPrefix(s, "{"), "}"), "urn:uuid:")
+
+	if len(s) == 36 {
+		if s[8] != '-' || s[13] != '-' || s[18] != '-' || s[23] != '-' {
+			return "", errors.NewCouldNotBeCastedError()
+		}
+		s = s[0:8] + s[9:13] + s[14:18] + s[19:23] + s[24:]
+	}
+
+	if len(s) != 32 {
+		return "", errors.NewCouldNotBeCastedError()
+	}
+
+	b, err := hex.DecodeString(s)
+	if err != nil {
+		return "", errors.NewCouldNotBeCastedError()
+	}
+
+	return formatUUID(b), nil
+}
+
+func formatUUID(b []byte) string {
+	s := hex.EncodeToString(b)
+	return s[0:8] + "-" + s[8:12] + "-" + s[12:16] + "-" + s[16:20] + "-" + s[20:]
+}
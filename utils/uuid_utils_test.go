@@ -0,0 +1,69 @@
+package utils
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+type testStringer string
+
+func (s testStringer) String() string {
+	return string(s)
+}
+
+func TestUUID_ShouldReturnError_WhenInputIsNotValid(t *testing.T) {
+	// Arrange
+	samples := []interface{}{
+		nil,
+		5,
+		"",
+		"abc",
+		"3f2504e0-4f89-11d3-9a0c-0305e82c330",
+		"3f2504e0_4f89_11d3_9a0c_0305e82c3301",
+		"zf2504e0-4f89-11d3-9a0c-0305e82c3301",
+	}
+
+	for _, input := range samples {
+		// Act
+		_, err := UUID(input)
+
+		// Assert
+		assert.Error(t, err)
+		assert.False(t, IsUUID(input))
+	}
+}
+
+func TestUUID_ShouldReturnCanonicalUUID_WhenInputIsValid(t *testing.T) {
+	// Arrange
+	expected := "3f2504e0-4f89-11d3-9a0c-0305e82c3301"
+	bytes := [16]byte{0x3f, 0x25, 0x04, 0xe0, 0x4f, 0x89, 0x11, 0xd3, 0x9a, 0x0c, 0x03, 0x05, 0xe8, 0x2c, 0x33, 0x01}
+	samples := []interface{}{
+		"3f2504e0-4f89-11d3-9a0c-0305e82c3301",
+		"3F2504E0-4F89-11D3-9A0C-0305E82C3301",
+		"3f2504e04f8911d39a0c0305e82c3301",
+		"{3f2504e0-4f89-11d3-9a0c-0305e82c3301}",
+		"urn:uuid:3f2504e0-4f89-11d3-9a0c-0305e82c3301",
+		bytes,
+		bytes[:],
+		testStringer("3f2504e0-4f89-11d3-9a0c-0305e82c3301"),
+	}
+
+	for _, input := range samples {
+		// Act
+		result, err := UUID(input)
+
+		// Assert
+		assert.NoError(t, err)
+		assert.Equal(t, expected, result)
+	}
+}
+
+func TestUUIDBytes_ShouldReturnBytes_WhenInputIsValid(t *testing.T) {
+	// Act
+	result, err := UUIDBytes("3f2504e0-4f89-11d3-9a0c-0305e82c3301")
+
+	// Assert
+	assert.NoError(t, err)
+	assert.Equal(t, []byte{0x3f, 0x25, 0x04, 0xe0, 0x4f, 0x89, 0x11, 0xd3, 0x9a, 0x0c, 0x03, 0x05, 0xe8, 0x2c, 0x33, 0x01}, result)
+}
@@ -57,6 +57,15 @@ func (v *JsonQueryValidator) validateInternal(data []interface{}) error {
 	} else if len(data) == 2 {
 		logicToken := data[0].(models.Token)
 
+		if logicToken.Type == constants.TokenTypeSearch {
+			valueToken, ok := data[1].(models.Token)
+			if !ok || valueToken.Type == constants.TokenTypeNone {
+				return errors.NewInvalidValueError()
+			}
+
+			return nil
+		}
+
 		if logicToken.Type == constants.TokenTypeNone {
 			return errors.NewInvalidLogicError()
 		}
@@ -525,3 +525,29 @@ func TestJsonQueryValidator_Validate_ShouldReturnNil_WhenQueryTokenLengthIsTwoAn
 		assert.NoError(t, err)
 	}
 }
+
+func TestJsonQueryValidator_Validate_ShouldValidateSearch_WhenQueryTokenLengthIsTwoAndSearch(t *testing.T) {
+	// Arrange
+	metadata := models.Metadata{
+		Fields: []models.Field{
+			{
+				Name:  "Value",
+				Type:  constants.FieldTypeString.String(),
+				Label: "Value",
+				Operators: []string{
+					constants.OperatorSearch.String(),
+				},
+			},
+		},
+	}
+
+	jsonQueryValidator := NewJsonQueryValidator(&metadata, tokenizers.NewJsonQueryTokenizer(&metadata))
+
+	// Act
+	validErr := jsonQueryValidator.Validate(`["Search", "red shoes"]`)
+	invalidErr := jsonQueryValidator.Validate(`["Search", ""]`)
+
+	// Assert
+	assert.NoError(t, validErr)
+	assert.Error(t, invalidErr)
+}
@@ -0,0 +1,78 @@
+package validators
+
+import (
+	"github.com/filtex/filtex-go/constants"
+	"github.com/filtex/filtex-go/errors"
+	"github.com/filtex/filtex-go/models"
+	"github.com/filtex/filtex-go/tokenizers"
+)
+
+type RsqlQueryValidator struct {
+	metadata       *models.Metadata
+	queryTokenizer tokenizers.RsqlQueryTokenizer
+}
+
+func NewRsqlQueryValidator(metadata *models.Metadata, queryTokenizer tokenizers.RsqlQueryTokenizer) *RsqlQueryValidator {
+	return &RsqlQueryValidator{
+		metadata:       metadata,
+		queryTokenizer: queryTokenizer,
+	}
+}
+
+func (v *RsqlQueryValidator) Validate(query string) error {
+	tokens, err := v.queryTokenizer.Tokenize(query)
+	if err != nil {
+		return err
+	}
+
+	return v.validateInternal(tokens)
+}
+
+func (v *RsqlQueryValidator) validateInternal(tokens *[]models.Token) error {
+	openGroupTokenCount := 0
+	closeGroupTokenCount := 0
+
+	var lastTokenType constants.TokenType
+
+	for _, t := range *tokens {
+		if t.Type == constants.TokenTypeNone {
+			return errors.NewInvalidTokenError()
+		}
+
+		if t.Type.IsOpenGroupTokenType() {
+			openGroupTokenCount++
+		}
+
+		if t.Type.IsCloseGroupTokenType() {
+			closeGroupTokenCount++
+
+			if closeGroupTokenCount > openGroupTokenCount {
+				return errors.NewMismatchedBracketsError()
+			}
+		}
+
+		if t.Type.IsLogicTokenType() && (lastTokenType == constants.TokenTypeNone || lastTokenType.IsLogicTokenType() || lastTokenType.IsOpenGroupTokenType()) {
+			return errors.NewInvalidLogicError()
+		}
+
+		lastTokenType = t.Type
+	}
+
+	if len(*tokens) == 0 {
+		return nil
+	}
+
+	if lastTokenType.IsFieldTokenType() ||
+		lastTokenType.IsComparerTokenType() ||
+		lastTokenType.IsSeparatorTokenType() ||
+		lastTokenType.IsLogicTokenType() ||
+		lastTokenType.IsOpenGroupTokenType() {
+		return errors.NewInvalidLastTokenError()
+	}
+
+	if openGroupTokenCount != closeGroupTokenCount {
+		return errors.NewMismatchedBracketsError()
+	}
+
+	return nil
+}
@@ -0,0 +1,125 @@
+package validators
+
+import (
+	"testing"
+
+	"github.com/filtex/filtex-go/constants"
+	"github.com/filtex/filtex-go/errors"
+	"github.com/filtex/filtex-go/models"
+	"github.com/filtex/filtex-go/tokenizers"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func newRsqlValidatorTestMetadata() *models.Metadata {
+	return &models.Metadata{
+		Fields: []models.Field{
+			{
+				Name:  "Value",
+				Type:  constants.FieldTypeNumber.String(),
+				Label: "Value",
+				Operators: []string{
+					constants.OperatorEqual.String(),
+					constants.OperatorGreaterThan.String(),
+					constants.OperatorIn.String(),
+				},
+			},
+		},
+	}
+}
+
+func TestRsqlQueryValidator_Validate_ShouldReturnNil_WhenThereIsNoToken(t *testing.T) {
+	// Arrange
+	rsqlQueryTokenizerMock := tokenizers.NewRsqlQueryTokenizerMock()
+	rsqlQueryTokenizerMock.
+		On("Tokenize", mock.Anything).
+		Return(&[]models.Token{}, nil)
+
+	rsqlQueryValidator := NewRsqlQueryValidator(newRsqlValidatorTestMetadata(), rsqlQueryTokenizerMock)
+
+	// Act
+	err := rsqlQueryValidator.Validate("")
+
+	// Assert
+	assert.NoError(t, err)
+}
+
+func TestRsqlQueryValidator_Validate_ShouldReturnError_WhenTokensAreNotValid(t *testing.T) {
+	// Arrange
+	queryMap := map[string][]models.Token{
+		"Value=foo=1": {
+			{Type: constants.TokenTypeField, Value: "Value"},
+			{Type: constants.TokenTypeNone, Value: "=foo="},
+			{Type: constants.TokenTypeValue, Value: 1.0},
+		},
+		"Value==1)": {
+			{Type: constants.TokenTypeField, Value: "Value"},
+			{Type: constants.TokenTypeEqual, Value: "=="},
+			{Type: constants.TokenTypeValue, Value: 1.0},
+			{Type: constants.TokenTypeCloseBracket, Value: ")"},
+		},
+		"(Value==1": {
+			{Type: constants.TokenTypeOpenBracket, Value: "("},
+			{Type: constants.TokenTypeField, Value: "Value"},
+			{Type: constants.TokenTypeEqual, Value: "=="},
+			{Type: constants.TokenTypeValue, Value: 1.0},
+		},
+		";Value==1": {
+			{Type: constants.TokenTypeAnd, Value: ";"},
+			{Type: constants.TokenTypeField, Value: "Value"},
+			{Type: constants.TokenTypeEqual, Value: "=="},
+			{Type: constants.TokenTypeValue, Value: 1.0},
+		},
+		"Value==1;": {
+			{Type: constants.TokenTypeField, Value: "Value"},
+			{Type: constants.TokenTypeEqual, Value: "=="},
+			{Type: constants.TokenTypeValue, Value: 1.0},
+			{Type: constants.TokenTypeAnd, Value: ";"},
+		},
+	}
+
+	expectedMap := map[string]error{
+		"Value=foo=1": errors.NewInvalidTokenError(),
+		"Value==1)":   errors.NewMismatchedBracketsError(),
+		"(Value==1":   errors.NewMismatchedBracketsError(),
+		";Value==1":   errors.NewInvalidLogicError(),
+		"Value==1;":   errors.NewInvalidLastTokenError(),
+	}
+
+	for query, tokens := range queryMap {
+		// Arrange
+		tokens := tokens
+		rsqlQueryTokenizerMock := tokenizers.NewRsqlQueryTokenizerMock()
+		rsqlQueryTokenizerMock.
+			On("Tokenize", mock.MatchedBy(func(q string) bool { return q == query })).
+			Return(&tokens, nil)
+
+		rsqlQueryValidator := NewRsqlQueryValidator(newRsqlValidatorTestMetadata(), rsqlQueryTokenizerMock)
+
+		// Act
+		err := rsqlQueryValidator.Validate(query)
+
+		// Assert
+		assert.Equal(t, expectedMap[query], err, query)
+	}
+}
+
+func TestRsqlQueryValidator_Validate_ShouldReturnNil_WhenQueryIsValid(t *testing.T) {
+	// Arrange
+	samples := []string{
+		`Value==1`,
+		`Value=gt=1;(Value==2,Value==3)`,
+		`Value=in=(1, 2)`,
+	}
+
+	for _, query := range samples {
+		// Arrange
+		rsqlQueryValidator := NewRsqlQueryValidator(newRsqlValidatorTestMetadata(), tokenizers.NewRsqlQueryTokenizer(newRsqlValidatorTestMetadata()))
+
+		// Act
+		err := rsqlQueryValidator.Validate(query)
+
+		// Assert
+		assert.NoError(t, err, query)
+	}
+}
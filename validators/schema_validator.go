@@ -0,0 +1,68 @@
+package validators
+
+import (
+	"strings"
+
+	"github.com/filtex/filtex-go/constants"
+	"github.com/filtex/filtex-go/errors"
+	"github.com/filtex/filtex-go/expressions"
+	"github.com/filtex/filtex-go/models"
+)
+
+type SchemaValidator struct {
+	metadata *models.Metadata
+}
+
+func NewSchemaValidator(metadata *models.Metadata) *SchemaValidator {
+	return &SchemaValidator{
+		metadata: metadata,
+	}
+}
+
+func (v *SchemaValidator) Validate(expression expressions.Expression) error {
+	switch e := expression.(type) {
+	case *expressions.LogicExpression:
+		for _, item := range e.Expressions {
+			if err := v.Validate(item); err != nil {
+				return err
+			}
+		}
+	case *expressions.OperatorExpression:
+		return v.validateField(e.Field, e.Type, e.Operator)
+	case *expressions.SearchExpression:
+		for _, field := range e.Fields {
+			if err := v.validateField(field, constants.FieldTypeUnknown, constants.OperatorSearch); err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
+func (v *SchemaValidator) validateField(name string, fieldType constants.FieldType, operator constants.Operator) error {
+	var field *models.Field
+
+	for i, f := range v.metadata.Fields {
+		if f.Name == name {
+			field = &v.metadata.Fields[i]
+			break
+		}
+	}
+
+	if field == nil {
+		return errors.NewRemovedFieldError(name)
+	}
+
+	if fieldType != constants.FieldTypeUnknown && field.Type != fieldType.String() {
+		return errors.NewRetypedFieldError(name, fieldType.String(), field.Type)
+	}
+
+	for _, o := range field.Operators {
+		if strings.ToLower(o) == strings.ToLower(operator.String()) {
+			return nil
+		}
+	}
+
+	return errors.NewUnsupportedOperatorError(name, operator.String())
+}
@@ -0,0 +1,109 @@
+package validators
+
+import (
+	goErrors "errors"
+	"testing"
+
+	"github.com/filtex/filtex-go/constants"
+	"github.com/filtex/filtex-go/errors"
+	"github.com/filtex/filtex-go/expressions"
+	"github.com/filtex/filtex-go/models"
+	"github.com/stretchr/testify/assert"
+)
+
+func newTestSchemaMetadata() *models.Metadata {
+	return &models.Metadata{
+		Version: "2",
+		Fields: []models.Field{
+			{
+				Name:  "name",
+				Type:  constants.FieldTypeString.String(),
+				Label: "Name",
+				Operators: []string{
+					constants.OperatorEqual.String(),
+					constants.OperatorSearch.String(),
+				},
+			},
+			{
+				Name:  "age",
+				Type:  constants.FieldTypeInteger.String(),
+				Label: "Age",
+				Operators: []string{
+					constants.OperatorEqual.String(),
+					constants.OperatorGreaterThan.String(),
+				},
+			},
+		},
+	}
+}
+
+func TestSchemaValidator_Validate_ShouldReturnNil_WhenFieldsAreUnchanged(t *testing.T) {
+	// Arrange
+	expression := expressions.NewLogicExpression(constants.LogicAnd, []expressions.Expression{
+		expressions.NewOperatorExpression(constants.FieldTypeString, "name", constants.OperatorEqual, "Filtex"),
+		expressions.NewOperatorExpression(constants.FieldTypeInteger, "age", constants.OperatorGreaterThan, int64(18)),
+		expressions.NewSearchExpression([]string{"name"}, "filtex"),
+	})
+
+	// Act
+	err := NewSchemaValidator(newTestSchemaMetadata()).Validate(expression)
+
+	// Assert
+	assert.NoError(t, err)
+}
+
+func TestSchemaValidator_Validate_ShouldReturnRemovedFieldError_WhenFieldIsRemoved(t *testing.T) {
+	// Arrange
+	expression := expressions.NewLogicExpression(constants.LogicOr, []expressions.Expression{
+		expressions.NewOperatorExpression(constants.FieldTypeString, "name", constants.OperatorEqual, "Filtex"),
+		expressions.NewOperatorExpression(constants.FieldTypeBoolean, "status", constants.OperatorEqual, true),
+	})
+
+	// Act
+	err := NewSchemaValidator(newTestSchemaMetadata()).Validate(expression)
+
+	// Assert
+	var schemaError *errors.SchemaError
+	assert.True(t, goErrors.As(err, &schemaError))
+	assert.Equal(t, "status", schemaError.Field)
+	assert.Equal(t, "status: removed field", err.Error())
+}
+
+func TestSchemaValidator_Validate_ShouldReturnRetypedFieldError_WhenFieldTypeIsChanged(t *testing.T) {
+	// Arrange
+	expression := expressions.NewOperatorExpression(constants.FieldTypeNumber, "age", constants.OperatorEqual, 18.0)
+
+	// Act
+	err := NewSchemaValidator(newTestSchemaMetadata()).Validate(expression)
+
+	// Assert
+	var schemaError *errors.SchemaError
+	assert.True(t, goErrors.As(err, &schemaError))
+	assert.Equal(t, "number", schemaError.PreviousType)
+	assert.Equal(t, "integer", schemaError.CurrentType)
+	assert.Equal(t, "age: retyped field from number to integer", err.Error())
+}
+
+func TestSchemaValidator_Validate_ShouldReturnUnsupportedOperatorError_WhenOperatorIsRemoved(t *testing.T) {
+	// Arrange
+	expression := expressions.NewOperatorExpression(constants.FieldTypeInteger, "age", constants.OperatorLessThan, int64(18))
+
+	// Act
+	err := NewSchemaValidator(newTestSchemaMetadata()).Validate(expression)
+
+	// Assert
+	var queryError *errors.QueryError
+	assert.True(t, goErrors.As(err, &queryError))
+	assert.Equal(t, "age", queryError.Field)
+}
+
+func TestSchemaValidator_Validate_ShouldReturnError_WhenSearchFieldIsRemoved(t *testing.T) {
+	// Arrange
+	expression := expressions.NewSearchExpression([]string{"name", "description"}, "filtex")
+
+	// Act
+	err := NewSchemaValidator(newTestSchemaMetadata()).Validate(expression)
+
+	// Assert
+	assert.Equal(t, errors.NewRemovedFieldError("description"), err)
+}